@@ -28,6 +28,7 @@ const (
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_DelegatorAutoCompound_FullMethodName       = "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
 
@@ -54,6 +55,8 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether the staking rewards of a delegator are auto-compounded.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -150,6 +153,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -183,6 +195,8 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether the staking rewards of a delegator are auto-compounded.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -222,6 +236,9 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -400,6 +417,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +496,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
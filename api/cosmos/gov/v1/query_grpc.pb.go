@@ -21,17 +21,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName           = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName               = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName              = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                   = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                  = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                 = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName               = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName            = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName    = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName     = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Governor_FullMethodName               = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName              = "/cosmos.gov.v1.Query/Governors"
+	Query_GovernanceDelegation_FullMethodName   = "/cosmos.gov.v1.Query/GovernanceDelegation"
+	Query_GovernorDelegatedPower_FullMethodName = "/cosmos.gov.v1.Query/GovernorDelegatedPower"
+	Query_SimulateProposal_FullMethodName       = "/cosmos.gov.v1.Query/SimulateProposal"
+	Query_ConvictionLocks_FullMethodName        = "/cosmos.gov.v1.Query/ConvictionLocks"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +66,19 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// Governor queries a governor based on its address.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of an account.
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// GovernorDelegatedPower queries the voting power delegated to a governor.
+	GovernorDelegatedPower(ctx context.Context, in *QueryGovernorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryGovernorDelegatedPowerResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current state, in a
+	// branched context discarded afterwards, as the gov module would execute them once passed.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
+	// ConvictionLocks queries the conviction locks of a delegator.
+	ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +188,60 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernorDelegatedPower(ctx context.Context, in *QueryGovernorDelegatedPowerRequest, opts ...grpc.CallOption) (*QueryGovernorDelegatedPowerResponse, error) {
+	out := new(QueryGovernorDelegatedPowerResponse)
+	err := c.cc.Invoke(ctx, Query_GovernorDelegatedPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error) {
+	out := new(QueryConvictionLocksResponse)
+	err := c.cc.Invoke(ctx, Query_ConvictionLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +268,19 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// Governor queries a governor based on its address.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of an account.
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// GovernorDelegatedPower queries the voting power delegated to a governor.
+	GovernorDelegatedPower(context.Context, *QueryGovernorDelegatedPowerRequest) (*QueryGovernorDelegatedPowerResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current state, in a
+	// branched context discarded afterwards, as the gov module would execute them once passed.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
+	// ConvictionLocks queries the conviction locks of a delegator.
+	ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +321,24 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governors not implemented")
+}
+func (UnimplementedQueryServer) GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (UnimplementedQueryServer) GovernorDelegatedPower(context.Context, *QueryGovernorDelegatedPowerRequest) (*QueryGovernorDelegatedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorDelegatedPower not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
+func (UnimplementedQueryServer) ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvictionLocks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +550,114 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernorDelegatedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorDelegatedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernorDelegatedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernorDelegatedPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernorDelegatedPower(ctx, req.(*QueryGovernorDelegatedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConvictionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvictionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvictionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConvictionLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvictionLocks(ctx, req.(*QueryConvictionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +709,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "GovernorDelegatedPower",
+			Handler:    _Query_GovernorDelegatedPower_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
+		{
+			MethodName: "ConvictionLocks",
+			Handler:    _Query_ConvictionLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
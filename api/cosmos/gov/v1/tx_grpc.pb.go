@@ -31,6 +31,12 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_RegisterGovernor_FullMethodName             = "/cosmos.gov.v1.Msg/RegisterGovernor"
+	Msg_DeregisterGovernor_FullMethodName           = "/cosmos.gov.v1.Msg/DeregisterGovernor"
+	Msg_DelegateVote_FullMethodName                 = "/cosmos.gov.v1.Msg/DelegateVote"
+	Msg_UndelegateVote_FullMethodName               = "/cosmos.gov.v1.Msg/UndelegateVote"
+	Msg_VetoProposal_FullMethodName                 = "/cosmos.gov.v1.Msg/VetoProposal"
+	Msg_LockConviction_FullMethodName               = "/cosmos.gov.v1.Msg/LockConviction"
 )
 
 // MsgClient is the client API for Msg service.
@@ -60,6 +66,21 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// RegisterGovernor defines a method to register an account as a governor, to which
+	// other accounts can delegate their governance voting power.
+	RegisterGovernor(ctx context.Context, in *MsgRegisterGovernor, opts ...grpc.CallOption) (*MsgRegisterGovernorResponse, error)
+	// DeregisterGovernor defines a method to deregister a governor. All the governance
+	// delegations to the governor are removed.
+	DeregisterGovernor(ctx context.Context, in *MsgDeregisterGovernor, opts ...grpc.CallOption) (*MsgDeregisterGovernorResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account
+	// to a governor.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove the governance delegation of an account.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
+	// VetoProposal defines a method for the veto guardian of a timelocked proposal to veto it.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
+	// LockConviction defines a method to lock the shares of a delegation for a conviction period.
+	LockConviction(ctx context.Context, in *MsgLockConviction, opts ...grpc.CallOption) (*MsgLockConvictionResponse, error)
 }
 
 type msgClient struct {
@@ -160,6 +181,60 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) RegisterGovernor(ctx context.Context, in *MsgRegisterGovernor, opts ...grpc.CallOption) (*MsgRegisterGovernorResponse, error) {
+	out := new(MsgRegisterGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterGovernor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterGovernor(ctx context.Context, in *MsgDeregisterGovernor, opts ...grpc.CallOption) (*MsgDeregisterGovernorResponse, error) {
+	out := new(MsgDeregisterGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterGovernor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockConviction(ctx context.Context, in *MsgLockConviction, opts ...grpc.CallOption) (*MsgLockConvictionResponse, error) {
+	out := new(MsgLockConvictionResponse)
+	err := c.cc.Invoke(ctx, Msg_LockConviction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -187,6 +262,21 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// RegisterGovernor defines a method to register an account as a governor, to which
+	// other accounts can delegate their governance voting power.
+	RegisterGovernor(context.Context, *MsgRegisterGovernor) (*MsgRegisterGovernorResponse, error)
+	// DeregisterGovernor defines a method to deregister a governor. All the governance
+	// delegations to the governor are removed.
+	DeregisterGovernor(context.Context, *MsgDeregisterGovernor) (*MsgDeregisterGovernorResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account
+	// to a governor.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove the governance delegation of an account.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
+	// VetoProposal defines a method for the veto guardian of a timelocked proposal to veto it.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
+	// LockConviction defines a method to lock the shares of a delegation for a conviction period.
+	LockConviction(context.Context, *MsgLockConviction) (*MsgLockConvictionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -224,6 +314,24 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) RegisterGovernor(context.Context, *MsgRegisterGovernor) (*MsgRegisterGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGovernor not implemented")
+}
+func (UnimplementedMsgServer) DeregisterGovernor(context.Context, *MsgDeregisterGovernor) (*MsgDeregisterGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterGovernor not implemented")
+}
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (UnimplementedMsgServer) UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
+func (UnimplementedMsgServer) VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
+func (UnimplementedMsgServer) LockConviction(context.Context, *MsgLockConviction) (*MsgLockConvictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockConviction not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -417,6 +525,114 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterGovernor(ctx, req.(*MsgRegisterGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterGovernor(ctx, req.(*MsgDeregisterGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockConviction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockConviction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockConviction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockConviction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockConviction(ctx, req.(*MsgLockConviction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +680,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "RegisterGovernor",
+			Handler:    _Msg_RegisterGovernor_Handler,
+		},
+		{
+			MethodName: "DeregisterGovernor",
+			Handler:    _Msg_DeregisterGovernor_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
+		{
+			MethodName: "LockConviction",
+			Handler:    _Msg_LockConviction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -33,6 +33,11 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_TokenizeShareRecord_FullMethodName           = "/cosmos.staking.v1beta1.Query/TokenizeShareRecord"
+	Query_PendingCommissionChange_FullMethodName       = "/cosmos.staking.v1beta1.Query/PendingCommissionChange"
+	Query_PendingCommissionChanges_FullMethodName      = "/cosmos.staking.v1beta1.Query/PendingCommissionChanges"
+	Query_TokenizeShareRecordsByOwner_FullMethodName   = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsByOwner"
+	Query_LiquidStaked_FullMethodName                  = "/cosmos.staking.v1beta1.Query/LiquidStaked"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +97,23 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// PendingCommissionChange queries the pending commission change of a validator.
+	PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error)
+	// PendingCommissionChanges queries the pending commission changes of all validators.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error)
+	// TokenizeShareRecordsByOwner queries the tokenize share records owned by an address.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TokenizeShareRecordsByOwner(ctx context.Context, in *QueryTokenizeShareRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsByOwnerResponse, error)
+	// LiquidStaked queries the amount of tokens delegated through tokenize share records,
+	// in total or for a validator.
+	LiquidStaked(ctx context.Context, in *QueryLiquidStakedRequest, opts ...grpc.CallOption) (*QueryLiquidStakedResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +250,51 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error) {
+	out := new(QueryPendingCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error) {
+	out := new(QueryPendingCommissionChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsByOwner(ctx context.Context, in *QueryTokenizeShareRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsByOwnerResponse, error) {
+	out := new(QueryTokenizeShareRecordsByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidStaked(ctx context.Context, in *QueryLiquidStakedRequest, opts ...grpc.CallOption) (*QueryLiquidStakedResponse, error) {
+	out := new(QueryLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +352,23 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// PendingCommissionChange queries the pending commission change of a validator.
+	PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error)
+	// PendingCommissionChanges queries the pending commission changes of all validators.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error)
+	// TokenizeShareRecordsByOwner queries the tokenize share records owned by an address.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TokenizeShareRecordsByOwner(context.Context, *QueryTokenizeShareRecordsByOwnerRequest) (*QueryTokenizeShareRecordsByOwnerResponse, error)
+	// LiquidStaked queries the amount of tokens delegated through tokenize share records,
+	// in total or for a validator.
+	LiquidStaked(context.Context, *QueryLiquidStakedRequest) (*QueryLiquidStakedResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +418,21 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChange not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChanges not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsByOwner(context.Context, *QueryTokenizeShareRecordsByOwnerRequest) (*QueryTokenizeShareRecordsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsByOwner not implemented")
+}
+func (UnimplementedQueryServer) LiquidStaked(context.Context, *QueryLiquidStakedRequest) (*QueryLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStaked not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +698,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChange(ctx, req.(*QueryPendingCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, req.(*QueryPendingCommissionChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsByOwner(ctx, req.(*QueryTokenizeShareRecordsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidStaked(ctx, req.(*QueryLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +851,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChange",
+			Handler:    _Query_PendingCommissionChange_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChanges",
+			Handler:    _Query_PendingCommissionChanges_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsByOwner",
+			Handler:    _Query_TokenizeShareRecordsByOwner_Handler,
+		},
+		{
+			MethodName: "LiquidStaked",
+			Handler:    _Query_LiquidStaked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -739,6 +739,8 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 		))
 	}
 
+	app.resetKVAccessTrace()
+
 	header := cmtproto.Header{
 		ChainID:            app.chainID,
 		Height:             req.Height,
@@ -961,6 +963,7 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 	}
 
 	app.cms.Commit()
+	app.flushKVAccessTrace(header.Height)
 
 	resp := &abci.CommitResponse{
 		RetainHeight: retainHeight,
@@ -1049,6 +1052,9 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 				Value:     []byte(app.version),
 			}
 
+		case QueryPathKVTrace:
+			return handleQueryKVTrace(app, req)
+
 		default:
 			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
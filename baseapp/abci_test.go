@@ -683,6 +683,56 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_KVAccessTracing(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetKVAccessTracing(true))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// no block was committed yet
+	res, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/app/kvtrace"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.JSONEq(t, `{"height":0,"entries":[]}`, string(res.Value))
+
+	txs := [][]byte{}
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// only the message executions are attributed, not the ante handler
+	report := suite.baseApp.KVAccessReport()
+	require.NotNil(t, report)
+	require.Equal(t, int64(1), report.Height)
+	require.Len(t, report.Entries, 1)
+	entry := report.Entries[0]
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), entry.MsgTypeURL)
+	require.Equal(t, capKey1.Name(), entry.StoreKey)
+	require.Equal(t, uint64(3), entry.Reads)
+	require.Equal(t, uint64(3), entry.Writes)
+	require.NotZero(t, entry.Gas)
+
+	res, err = suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/app/kvtrace"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+	require.Contains(t, string(res.Value), entry.MsgTypeURL)
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -245,6 +245,10 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
 // reached or the mempool is exhausted.
 //
+// If the mempool is a LanedMempool, its lanes are enumerated in order, each of
+// them until its share of RequestPrepareProposal.MaxBytes and of the max block
+// gas is reached or it is exhausted.
+//
 // Note:
 //
 // - Step (2) is identical to the validation step performed in
@@ -285,80 +289,129 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
-		iterator := h.mempool.Select(ctx, req.Txs)
 		selectedTxsSignersSeqs := make(map[string]uint64)
-		var selectedTxsNums int
-		for iterator != nil {
-			memTx := iterator.Tx()
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
-			if err != nil {
+
+		// A laned mempool is enumerated lane by lane, each lane being limited to
+		// its share of the block space.
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool); ok {
+			if err := h.selectLanedTxs(ctx, req, lanedMempool, maxBlockGas, selectedTxsSignersSeqs); err != nil {
 				return nil, err
 			}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
+			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		}
 
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
-				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				iterator = iterator.Next()
+		if _, err := h.selectTxs(ctx, h.mempool.Select(ctx, req.Txs), uint64(req.MaxTxBytes), maxBlockGas, selectedTxsSignersSeqs, nil); err != nil {
+			return nil, err
+		}
+
+		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+	}
+}
+
+// signerSequenceKey returns the key under which the sequence of a signer is
+// tracked while selecting transactions. The high 32 bits of a sequence are the
+// nonce lane it belongs to, whose sequences are independent of the ones of the
+// other lanes of the signer, so each lane of a signer is tracked separately.
+func signerSequenceKey(signer mempool.SignerData) string {
+	return fmt.Sprintf("%s/%d", signer.Signer, signer.Sequence>>32)
+}
+
+// selectTxs enumerates the given mempool iterator, selecting the valid
+// transactions with the TxSelector until it halts. Transactions for which match
+// returns false are skipped, if match is not nil. It returns the total gas limit
+// of the transactions selected.
+func (h *DefaultProposalHandler) selectTxs(
+	ctx sdk.Context,
+	iterator mempool.Iterator,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+	match func(sdk.Tx) bool,
+) (uint64, error) {
+	var selectedTxsGas uint64
+	selectedTxsNums := len(h.txSelector.SelectedTxs(ctx))
+	for iterator != nil {
+		memTx := iterator.Tx()
+		if match != nil && !match(memTx) {
+			iterator = iterator.Next()
+			continue
+		}
+
+		signerData, err := h.signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			return 0, err
+		}
+
+		// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+		// so we add them and continue given that we don't need to check the sequence.
+		shouldAdd := true
+		txSignersSeqs := make(map[string]uint64)
+		for _, signer := range signerData {
+			key := signerSequenceKey(signer)
+			seq, ok := selectedTxsSignersSeqs[key]
+			if !ok {
+				txSignersSeqs[key] = signer.Sequence
 				continue
 			}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					break
-				}
+			// If we have seen this signer before in this block, we must make
+			// sure that the current sequence is seq+1; otherwise is invalid
+			// and we skip it.
+			if seq+1 != signer.Sequence {
+				shouldAdd = false
+				break
+			}
+			txSignersSeqs[key] = signer.Sequence
+		}
+		if !shouldAdd {
+			iterator = iterator.Next()
+			continue
+		}
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			err := h.mempool.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return 0, err
+			}
+		} else {
+			stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
+			txsLen := len(h.txSelector.SelectedTxs(ctx))
+			if txsLen != selectedTxsNums {
+				if gasTx, ok := memTx.(GasTx); ok {
+					selectedTxsGas += gasTx.GetGas()
 				}
-				selectedTxsNums = txsLen
 			}
+			for sender, seq := range txSignersSeqs {
+				// If txsLen != selectedTxsNums is true, it means that we've
+				// added a new tx to the selected txs, so we need to update
+				// the sequence of the sender.
+				if txsLen != selectedTxsNums {
+					selectedTxsSignersSeqs[sender] = seq
+				} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+					// The transaction hasn't been added but it passed the
+					// verification, so we know that the sequence is correct.
+					// So we set this sender's sequence to seq-1, in order
+					// to avoid unnecessary calls to PrepareProposalVerifyTx.
+					selectedTxsSignersSeqs[sender] = seq - 1
+				}
+			}
+			selectedTxsNums = txsLen
 
-			iterator = iterator.Next()
+			if stop {
+				break
+			}
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		iterator = iterator.Next()
 	}
+
+	return selectedTxsGas, nil
 }
 
 // ProcessProposalHandler returns the default implementation for processing an
@@ -367,6 +420,10 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // 1. The transaction bytes must decode to a valid transaction.
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
+// If the mempool is a LanedMempool, the transactions must also be ordered by
+// lane and each lane must not exceed its share of the block space, see
+// laneChecker.
+//
 // If any transaction fails to pass either condition, the proposal is rejected.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
@@ -383,9 +440,15 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
 		var totalTxGas uint64
 
-		var maxBlockGas int64
+		var maxBlockGas, maxBlockBytes int64
 		if b := ctx.ConsensusParams().Block; b != nil { // nolint:staticcheck // ignore linting error
 			maxBlockGas = b.MaxGas
+			maxBlockBytes = b.MaxBytes
+		}
+
+		var lanes *laneChecker
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool); ok {
+			lanes = newLaneChecker(lanedMempool, maxBlockBytes, maxBlockGas)
 		}
 
 		for _, txBytes := range req.Txs {
@@ -394,6 +457,12 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 			}
 
+			if lanes != nil {
+				if err := lanes.check(ctx, tx, txBytes); err != nil {
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+				}
+			}
+
 			if maxBlockGas > 0 {
 				gasTx, ok := tx.(GasTx)
 				if ok {
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
@@ -21,6 +22,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	authtx "cosmossdk.io/x/auth/tx"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -610,6 +612,11 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 		{tx: buildMsg(s.T(), txConfig, []byte(`1252345678910`), [][]byte{secret1}, []uint64{3}), priority: 10},
 		{tx: buildMsg(s.T(), txConfig, []byte(`13`), [][]byte{secret1}, []uint64{5}), priority: 10},
 		{tx: buildMsg(s.T(), txConfig, []byte(`14`), [][]byte{secret1}, []uint64{6}), priority: 8},
+		// test 5, the high 32 bits of a sequence are its nonce lane
+		{tx: buildMsg(s.T(), txConfig, []byte(`15`), [][]byte{secret1}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`16`), [][]byte{secret1}, []uint64{1<<32 | 0}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`17`), [][]byte{secret1}, []uint64{1<<32 | 1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`18`), [][]byte{secret1}, []uint64{1<<32 | 3}), priority: 10},
 	}
 
 	for i := range testTxs {
@@ -671,6 +678,16 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 			},
 			expectedTxs: []int{},
 		},
+		"sequences tracked per nonce lane": {
+			// tx 18 is skipped as it does not follow tx 17 in lane 1, while tx 16 does
+			// not need to follow tx 15 which is in lane 0.
+			ctx:      s.ctx,
+			txInputs: []testTx{testTxs[15], testTxs[16], testTxs[17], testTxs[18]},
+			req: &abci.PrepareProposalRequest{
+				MaxTxBytes: 1000,
+			},
+			expectedTxs: []int{15, 16, 17},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -709,6 +726,116 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LanedMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	// txs whose value starts with "p" go to the priority lane
+	isPriority := func(_ context.Context, tx sdk.Tx) bool {
+		msg, ok := tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue)
+		return ok && bytes.HasPrefix(msg.Value, []byte("p"))
+	}
+
+	newMempool := func() *mempool.LanedMempool {
+		mp, err := mempool.NewLanedMempool(
+			mempool.Lane{
+				Name:          "priority",
+				Mempool:       mempool.DefaultPriorityMempool(),
+				Match:         isPriority,
+				MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			},
+			mempool.Lane{
+				Name:          "default",
+				Mempool:       mempool.DefaultPriorityMempool(),
+				Match:         mempool.MatchAll,
+				MaxBlockSpace: math.LegacyOneDec(),
+			},
+		)
+		s.Require().NoError(err)
+		return mp
+	}
+
+	type testTx struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}
+
+	testTxs := []testTx{
+		{tx: buildMsg(s.T(), txConfig, []byte(`d1`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 20},
+		{tx: buildMsg(s.T(), txConfig, []byte(`p1`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`d2`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 19},
+		{tx: buildMsg(s.T(), txConfig, []byte(`p2`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 9},
+		{tx: buildMsg(s.T(), txConfig, []byte(`p3`), [][]byte{[]byte("secret5")}, []uint64{1}), priority: 8},
+	}
+
+	var size int64
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		testTxs[i].bz = bz
+
+		txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+		if size != 0 {
+			s.Require().Equal(size, txSize)
+		}
+		size = txSize
+	}
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	mp := newMempool()
+	for _, v := range testTxs {
+		app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(v.priority), v.tx))
+	}
+	s.Require().Equal(len(testTxs), mp.CountTx())
+
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+
+	// the priority lane may only use half of the block space, and the lanes
+	// are ordered regardless of the txs priorities
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.PrepareProposalRequest{MaxTxBytes: 4 * size})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[1].bz, testTxs[3].bz, testTxs[0].bz, testTxs[2].bz}, resp.Txs)
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 4 * size, MaxGas: -1},
+	})
+	testCases := map[string]struct {
+		txs       [][]byte
+		expStatus abci.ProcessProposalStatus
+	}{
+		"prepared proposal": {
+			txs:       resp.Txs,
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:       [][]byte{testTxs[0].bz, testTxs[1].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane exceeding its block space": {
+			txs:       [][]byte{testTxs[1].bz, testTxs[3].bz, testTxs[4].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"default lane only": {
+			txs:       [][]byte{testTxs[0].bz, testTxs[2].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			res, err := ph.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: tc.txs})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, res.Status)
+		})
+	}
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
@@ -0,0 +1,83 @@
+package accesstrace
+
+import (
+	"cosmossdk.io/store/types"
+)
+
+// MultiStore wraps a MultiStore so that the operations performed on its KV
+// stores, and on the ones of its branches, are attributed to the given message
+// type URL. The gas of the operations on transient stores is computed from
+// transientGasConfig, the one of the other stores from kvGasConfig.
+type MultiStore struct {
+	types.MultiStore
+
+	tracer             *Tracer
+	msgTypeURL         string
+	kvGasConfig        types.GasConfig
+	transientGasConfig types.GasConfig
+}
+
+// NewMultiStore returns a new tracing MultiStore.
+func NewMultiStore(
+	parent types.MultiStore,
+	tracer *Tracer,
+	msgTypeURL string,
+	kvGasConfig, transientGasConfig types.GasConfig,
+) MultiStore {
+	return MultiStore{
+		MultiStore:         parent,
+		tracer:             tracer,
+		msgTypeURL:         msgTypeURL,
+		kvGasConfig:        kvGasConfig,
+		transientGasConfig: transientGasConfig,
+	}
+}
+
+// GetKVStore implements MultiStore.
+func (ms MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	gasConfig := ms.kvGasConfig
+	if _, ok := key.(*types.TransientStoreKey); ok {
+		gasConfig = ms.transientGasConfig
+	}
+
+	return NewStore(ms.MultiStore.GetKVStore(key), ms.tracer, gasConfig, ms.msgTypeURL, key.Name())
+}
+
+// CacheMultiStore implements MultiStore, the branch being traced as well.
+func (ms MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return cacheMultiStore{
+		branch: ms.MultiStore.CacheMultiStore(),
+		traced: ms,
+	}
+}
+
+// branch is the CacheMultiStore wrapped by a cacheMultiStore. It is a distinct
+// type so that the embedded field does not collide with the CacheMultiStore
+// method.
+type branch interface {
+	types.CacheMultiStore
+}
+
+// cacheMultiStore is a traced branch of a MultiStore.
+type cacheMultiStore struct {
+	branch
+
+	traced MultiStore
+}
+
+// GetKVStore implements MultiStore.
+func (cms cacheMultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return cms.withParent(cms.branch).GetKVStore(key)
+}
+
+// CacheMultiStore implements MultiStore.
+func (cms cacheMultiStore) CacheMultiStore() types.CacheMultiStore {
+	return cms.withParent(cms.branch).CacheMultiStore()
+}
+
+func (cms cacheMultiStore) withParent(parent types.MultiStore) MultiStore {
+	ms := cms.traced
+	ms.MultiStore = parent
+
+	return ms
+}
@@ -0,0 +1,165 @@
+package accesstrace
+
+import (
+	"io"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, attributing every operation to the
+// message type URL and store key it was created for. The gas of each operation
+// is computed from the given gas config as charged by gaskv.Store, which must
+// wrap this store for the attribution to match the gas consumed.
+type Store struct {
+	parent     types.KVStore
+	tracer     *Tracer
+	gasConfig  types.GasConfig
+	msgTypeURL string
+	storeKey   string
+}
+
+// NewStore returns a reference to a new tracing Store.
+func NewStore(parent types.KVStore, tracer *Tracer, gasConfig types.GasConfig, msgTypeURL, storeKey string) *Store {
+	return &Store{
+		parent:     parent,
+		tracer:     tracer,
+		gasConfig:  gasConfig,
+		msgTypeURL: msgTypeURL,
+		storeKey:   storeKey,
+	}
+}
+
+func (s *Store) record(stats Stats) {
+	s.tracer.record(s.msgTypeURL, s.storeKey, stats)
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+
+	s.record(Stats{
+		Reads:     1,
+		BytesRead: uint64(len(key) + len(value)),
+		Gas: s.gasConfig.ReadCostFlat +
+			s.gasConfig.ReadCostPerByte*types.Gas(len(key)) +
+			s.gasConfig.ReadCostPerByte*types.Gas(len(value)),
+	})
+
+	return value
+}
+
+// Set implements KVStore.
+func (s *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	n := types.Gas(len(key) + len(value))
+	s.record(Stats{
+		Writes:       1,
+		BytesWritten: uint64(n),
+		Gas:          s.gasConfig.WriteCostFlat + s.gasConfig.WriteCostPerByte*n,
+	})
+
+	s.parent.Set(key, value)
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.record(Stats{Has: 1, Gas: s.gasConfig.HasCost})
+
+	return s.parent.Has(key)
+}
+
+// Delete implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.record(Stats{Deletes: 1, Gas: s.gasConfig.DeleteCost})
+
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(s.parent.Iterator(start, end))
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(s.parent.ReverseIterator(start, end))
+}
+
+func (s *Store) iterator(parent types.Iterator) types.Iterator {
+	it := &iterator{parent: parent, store: s}
+	it.recordSeek()
+
+	return it
+}
+
+// CacheWrap implements KVStore.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a tracing KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a tracing KVStore")
+}
+
+// iterator records the pairs visited as gaskv charges them, i.e. the current
+// pair on creation and again on every call to Next, before advancing.
+type iterator struct {
+	parent types.Iterator
+	store  *Store
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() (start, end []byte) {
+	return it.parent.Domain()
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.parent.Valid()
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() {
+	it.recordSeek()
+	it.parent.Next()
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	return it.parent.Key()
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	return it.parent.Value()
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	return it.parent.Close()
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	return it.parent.Error()
+}
+
+func (it *iterator) recordSeek() {
+	stats := Stats{IterNext: 1, Gas: it.store.gasConfig.IterNextCostFlat}
+	if it.Valid() {
+		n := types.Gas(len(it.Key()) + len(it.Value()))
+		stats.BytesRead = uint64(n)
+		stats.Gas += it.store.gasConfig.ReadCostPerByte * n
+	}
+	it.store.record(stats)
+}
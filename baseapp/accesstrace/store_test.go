@@ -0,0 +1,95 @@
+package accesstrace_test
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/gaskv"
+	"cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/accesstrace"
+)
+
+const msgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func keyFmt(i int) []byte { return []byte(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return []byte(fmt.Sprintf("value%0.8d", i)) }
+
+func TestStoreGasAttribution(t *testing.T) {
+	tracer := accesstrace.NewTracer()
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	st := gaskv.NewStore(accesstrace.NewStore(mem, tracer, types.KVGasConfig(), msgTypeURL, "bank"), meter, types.KVGasConfig())
+
+	require.Panics(t, func() { st.CacheWrap() })
+
+	require.False(t, st.Has(keyFmt(1)))
+	require.Empty(t, st.Get(keyFmt(1)))
+	for i := 1; i <= 3; i++ {
+		st.Set(keyFmt(i), valFmt(i))
+	}
+	require.Equal(t, valFmt(2), st.Get(keyFmt(2)))
+	st.Delete(keyFmt(3))
+
+	it := st.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+
+	report := tracer.Flush(10)
+	require.Equal(t, int64(10), report.Height)
+	require.Len(t, report.Entries, 1)
+
+	entry := report.Entries[0]
+	require.Equal(t, msgTypeURL, entry.MsgTypeURL)
+	require.Equal(t, "bank", entry.StoreKey)
+	require.Equal(t, uint64(1), entry.Has)
+	require.Equal(t, uint64(2), entry.Reads)
+	require.Equal(t, uint64(3), entry.Writes)
+	require.Equal(t, uint64(1), entry.Deletes)
+	// the first pair is charged on creation and both pairs again on Next
+	require.Equal(t, uint64(3), entry.IterNext)
+	require.Equal(t, uint64(3*(len(keyFmt(1))+len(valFmt(1)))), entry.BytesWritten)
+	require.Equal(t, meter.GasConsumed(), entry.Gas)
+
+	// the tracer is reset once flushed
+	require.Empty(t, tracer.Flush(11).Entries)
+}
+
+func TestMultiStoreAttribution(t *testing.T) {
+	tracer := accesstrace.NewTracer()
+	bankKey := types.NewKVStoreKey("bank")
+	tKey := types.NewTransientStoreKey("transient_bank")
+
+	stores := map[types.StoreKey]types.CacheWrapper{
+		bankKey: dbadapter.Store{DB: dbm.NewMemDB()},
+		tKey:    dbadapter.Store{DB: dbm.NewMemDB()},
+	}
+	parent := cachemulti.NewStore(dbm.NewMemDB(), stores, nil, nil, nil)
+	ms := accesstrace.NewMultiStore(parent, tracer, msgTypeURL, types.KVGasConfig(), types.TransientGasConfig())
+
+	ms.GetKVStore(bankKey).Set(keyFmt(1), valFmt(1))
+	ms.GetKVStore(tKey).Set(keyFmt(1), valFmt(1))
+
+	// the operations performed on branches are attributed as well
+	branch := ms.CacheMultiStore()
+	branch.GetKVStore(bankKey).Get(keyFmt(1))
+	branch.CacheMultiStore().GetKVStore(bankKey).Has(keyFmt(1))
+
+	report := tracer.Flush(1)
+	require.Len(t, report.Entries, 2)
+	require.Equal(t, "bank", report.Entries[0].StoreKey)
+	require.Equal(t, uint64(1), report.Entries[0].Writes)
+	require.Equal(t, uint64(1), report.Entries[0].Reads)
+	require.Equal(t, uint64(1), report.Entries[0].Has)
+	require.Equal(t, "transient_bank", report.Entries[1].StoreKey)
+
+	n := types.Gas(len(keyFmt(1)) + len(valFmt(1)))
+	cfg := types.TransientGasConfig()
+	require.Equal(t, cfg.WriteCostFlat+cfg.WriteCostPerByte*n, report.Entries[1].Gas)
+}
@@ -0,0 +1,101 @@
+package accesstrace
+
+import (
+	"sort"
+	"sync"
+
+	"cosmossdk.io/store/types"
+)
+
+// Stats aggregates the KV operations performed on a store.
+type Stats struct {
+	Reads        uint64    `json:"reads"`
+	Has          uint64    `json:"has"`
+	Writes       uint64    `json:"writes"`
+	Deletes      uint64    `json:"deletes"`
+	IterNext     uint64    `json:"iter_next"`
+	BytesRead    uint64    `json:"bytes_read"`
+	BytesWritten uint64    `json:"bytes_written"`
+	Gas          types.Gas `json:"gas"`
+}
+
+// Add adds the given stats to s.
+func (s *Stats) Add(o Stats) {
+	s.Reads += o.Reads
+	s.Has += o.Has
+	s.Writes += o.Writes
+	s.Deletes += o.Deletes
+	s.IterNext += o.IterNext
+	s.BytesRead += o.BytesRead
+	s.BytesWritten += o.BytesWritten
+	s.Gas += o.Gas
+}
+
+// Entry is the aggregate of the KV operations performed on a store while
+// executing messages of a given type.
+type Entry struct {
+	MsgTypeURL string `json:"msg_type_url"`
+	StoreKey   string `json:"store_key"`
+	Stats
+}
+
+// BlockReport is the aggregate of the KV operations performed while executing
+// the messages of a block.
+type BlockReport struct {
+	Height  int64   `json:"height"`
+	Entries []Entry `json:"entries"`
+}
+
+type entryKey struct {
+	msgTypeURL string
+	storeKey   string
+}
+
+// Tracer aggregates the KV operations performed by messages, by message type
+// URL and store key. It is safe for concurrent use.
+type Tracer struct {
+	mtx     sync.Mutex
+	entries map[entryKey]*Stats
+}
+
+// NewTracer returns a new Tracer.
+func NewTracer() *Tracer {
+	return &Tracer{entries: make(map[entryKey]*Stats)}
+}
+
+func (t *Tracer) record(msgTypeURL, storeKey string, s Stats) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	k := entryKey{msgTypeURL: msgTypeURL, storeKey: storeKey}
+	stats, ok := t.entries[k]
+	if !ok {
+		stats = &Stats{}
+		t.entries[k] = stats
+	}
+	stats.Add(s)
+}
+
+// Flush returns the aggregate of the operations recorded since the last flush
+// as the report of the given block height, and resets the tracer. Entries are
+// sorted by message type URL and store key.
+func (t *Tracer) Flush(height int64) BlockReport {
+	t.mtx.Lock()
+	entries := t.entries
+	t.entries = make(map[entryKey]*Stats)
+	t.mtx.Unlock()
+
+	report := BlockReport{Height: height, Entries: make([]Entry, 0, len(entries))}
+	for k, stats := range entries {
+		report.Entries = append(report.Entries, Entry{MsgTypeURL: k.msgTypeURL, StoreKey: k.storeKey, Stats: *stats})
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.MsgTypeURL != b.MsgTypeURL {
+			return a.MsgTypeURL < b.MsgTypeURL
+		}
+		return a.StoreKey < b.StoreKey
+	})
+
+	return report
+}
@@ -45,13 +45,20 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContext(height, false)
+		// Create the sdk.Context. When proofs are requested, the keys read by the
+		// query are recorded so that they can be proven once it is executed.
+		prove := queryProofsRequested(md)
+		sdkCtx, err := app.CreateQueryContext(height, prove)
 		if err != nil {
 			return nil, err
 		}
 
+		var recorder *queryReadRecorder
+		if prove {
+			recorder = newQueryReadRecorder()
+			sdkCtx = sdkCtx.WithMultiStore(recordingMultiStore{MultiStore: sdkCtx.MultiStore(), recorder: recorder})
+		}
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
@@ -67,7 +74,20 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
 
-		return handler(grpcCtx, req)
+		resp, err = handler(grpcCtx, req)
+		if err != nil || !prove {
+			return resp, err
+		}
+
+		proofs, err := app.createQueryProofs(height, recorder)
+		if err != nil {
+			return nil, err
+		}
+		if err = grpc.SetHeader(grpcCtx, proofs); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to set query proofs header")
+		}
+
+		return resp, nil
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
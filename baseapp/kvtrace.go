@@ -0,0 +1,100 @@
+package baseapp
+
+import (
+	"encoding/json"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/accesstrace"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// QueryPathKVTrace is the ABCI query path, under the "app" prefix, returning the
+// JSON encoded KV access report of the last committed block.
+const QueryPathKVTrace = "kvtrace"
+
+func (app *BaseApp) setKVAccessTracing(enabled bool) {
+	if enabled {
+		app.kvAccessTracer = accesstrace.NewTracer()
+	} else {
+		app.kvAccessTracer = nil
+	}
+}
+
+// kvAccessTraceContext returns the context the given message is executed with,
+// whose KV operations are attributed to the message type URL.
+func (app *BaseApp) kvAccessTraceContext(ctx sdk.Context, msg sdk.Msg) sdk.Context {
+	ms := accesstrace.NewMultiStore(
+		ctx.MultiStore(),
+		app.kvAccessTracer,
+		sdk.MsgTypeURL(msg),
+		ctx.KVGasConfig(),
+		ctx.TransientKVGasConfig(),
+	)
+
+	return ctx.WithMultiStore(ms)
+}
+
+// resetKVAccessTrace discards the KV operations recorded since the last commit,
+// e.g. by an aborted optimistic execution.
+func (app *BaseApp) resetKVAccessTrace() {
+	if app.kvAccessTracer != nil {
+		app.kvAccessTracer.Flush(0)
+	}
+}
+
+// flushKVAccessTrace aggregates the KV operations of the committed block, emits
+// them as telemetry and keeps them for the debug query.
+func (app *BaseApp) flushKVAccessTrace(height int64) {
+	if app.kvAccessTracer == nil {
+		return
+	}
+
+	report := app.kvAccessTracer.Flush(height)
+	app.kvAccessReport.Store(&report)
+
+	for _, entry := range report.Entries {
+		labels := []metrics.Label{
+			telemetry.NewLabel("msg_type_url", entry.MsgTypeURL),
+			telemetry.NewLabel("store_key", entry.StoreKey),
+		}
+		telemetry.IncrCounterWithLabels([]string{"store", "kv_access", "reads"}, float32(entry.Reads+entry.Has+entry.IterNext), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "kv_access", "writes"}, float32(entry.Writes+entry.Deletes), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "kv_access", "bytes_read"}, float32(entry.BytesRead), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "kv_access", "bytes_written"}, float32(entry.BytesWritten), labels)
+		telemetry.IncrCounterWithLabels([]string{"store", "kv_access", "gas"}, float32(entry.Gas), labels)
+	}
+}
+
+// KVAccessReport returns the KV access report of the last committed block, or
+// nil if KV access tracing is disabled or no block was committed yet.
+func (app *BaseApp) KVAccessReport() *accesstrace.BlockReport {
+	return app.kvAccessReport.Load()
+}
+
+func handleQueryKVTrace(app *BaseApp, req *abci.QueryRequest) *abci.QueryResponse {
+	if app.kvAccessTracer == nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "KV access tracing is disabled"), app.trace)
+	}
+
+	report := app.KVAccessReport()
+	if report == nil {
+		report = &accesstrace.BlockReport{Entries: []accesstrace.Entry{}}
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode KV access report"), app.trace)
+	}
+
+	return &abci.QueryResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    report.Height,
+		Value:     bz,
+	}
+}
@@ -0,0 +1,123 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// laneLimit returns the share of limit a lane may use.
+func laneLimit(limit uint64, maxBlockSpace math.LegacyDec) uint64 {
+	return maxBlockSpace.MulInt(math.NewIntFromUint64(limit)).TruncateInt().Uint64()
+}
+
+// selectLanedTxs selects the transactions of the lanes of the mempool, in order.
+// Each lane is limited to its share of the max tx bytes and max block gas, and
+// to the block space left by the previous lanes.
+func (h *DefaultProposalHandler) selectLanedTxs(
+	ctx sdk.Context,
+	req *abci.PrepareProposalRequest,
+	mp *mempool.LanedMempool,
+	maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+) error {
+	maxTxBytes := uint64(req.MaxTxBytes)
+
+	var totalTxBytes, totalTxGas uint64
+	for i, lane := range mp.Lanes() {
+		maxLaneTxBytes := min(totalTxBytes+laneLimit(maxTxBytes, lane.MaxBlockSpace), maxTxBytes)
+		if maxLaneTxBytes <= totalTxBytes {
+			continue
+		}
+
+		var maxLaneBlockGas uint64
+		if maxBlockGas > 0 {
+			// a zero max block gas is unlimited for the TxSelector, lanes with no
+			// gas left are skipped
+			maxLaneBlockGas = min(totalTxGas+laneLimit(maxBlockGas, lane.MaxBlockSpace), maxBlockGas)
+			if maxLaneBlockGas <= totalTxGas {
+				continue
+			}
+		}
+
+		// the transactions are only selected from the lane they match first, as
+		// checked by ProcessProposal
+		match := func(tx sdk.Tx) bool {
+			idx, err := mp.LaneIndex(ctx, tx)
+			return err == nil && idx == i
+		}
+
+		laneTxGas, err := h.selectTxs(ctx, lane.Mempool.Select(ctx, req.Txs), maxLaneTxBytes, maxLaneBlockGas, selectedTxsSignersSeqs, match)
+		if err != nil {
+			return err
+		}
+
+		totalTxGas += laneTxGas
+		totalTxBytes = uint64(cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(h.txSelector.SelectedTxs(ctx))))
+	}
+
+	return nil
+}
+
+// laneChecker verifies that the transactions of a proposal are ordered by lane,
+// the lane of a transaction being the first one it matches, and that each lane
+// does not use more than its share of the block space.
+//
+// As the max tx bytes of a proposal are not known to ProcessProposal, the share
+// of the block max bytes is checked instead, which PrepareProposal never exceeds.
+type laneChecker struct {
+	mempool       *mempool.LanedMempool
+	maxBlockBytes int64
+	maxBlockGas   int64
+
+	lane      int
+	laneBytes uint64
+	laneGas   uint64
+}
+
+func newLaneChecker(mp *mempool.LanedMempool, maxBlockBytes, maxBlockGas int64) *laneChecker {
+	return &laneChecker{
+		mempool:       mp,
+		maxBlockBytes: maxBlockBytes,
+		maxBlockGas:   maxBlockGas,
+	}
+}
+
+// check checks the next transaction of the proposal.
+func (c *laneChecker) check(ctx sdk.Context, tx sdk.Tx, txBz []byte) error {
+	idx, err := c.mempool.LaneIndex(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	lanes := c.mempool.Lanes()
+	switch {
+	case idx < c.lane:
+		return fmt.Errorf("tx of lane %s is after txs of lane %s", lanes[idx].Name, lanes[c.lane].Name)
+	case idx > c.lane:
+		c.lane, c.laneBytes, c.laneGas = idx, 0, 0
+	}
+	lane := lanes[idx]
+
+	c.laneBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	if c.maxBlockBytes > 0 && c.laneBytes > laneLimit(uint64(c.maxBlockBytes), lane.MaxBlockSpace) {
+		return fmt.Errorf("txs of lane %s exceed its max block space", lane.Name)
+	}
+
+	if c.maxBlockGas > 0 {
+		if gasTx, ok := tx.(GasTx); ok {
+			c.laneGas += gasTx.GetGas()
+		}
+		if c.laneGas > laneLimit(uint64(c.maxBlockGas), lane.MaxBlockSpace) {
+			return fmt.Errorf("txs of lane %s exceed its max block gas", lane.Name)
+		}
+	}
+
+	return nil
+}
@@ -76,6 +76,13 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetKVAccessTracing provides a BaseApp option function that enables the
+// attribution of the KV operations performed by the messages of each block to
+// their type URL and store key.
+func SetKVAccessTracing(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setKVAccessTracing(enabled) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
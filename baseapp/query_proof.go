@@ -0,0 +1,195 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"google.golang.org/grpc/metadata"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// queryRead is a key read by a query from the store of the given name.
+type queryRead struct {
+	storeName string
+	key       []byte
+}
+
+// queryReadRecorder records the keys read by a query, in order and without
+// duplicates, and the first store the query iterated over, if any.
+type queryReadRecorder struct {
+	mtx           sync.Mutex
+	seen          map[string]struct{}
+	reads         []queryRead
+	iteratedStore string
+}
+
+func newQueryReadRecorder() *queryReadRecorder {
+	return &queryReadRecorder{seen: make(map[string]struct{})}
+}
+
+func (r *queryReadRecorder) record(storeName string, key []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	id := storeName + "/" + string(key)
+	if _, ok := r.seen[id]; ok {
+		return
+	}
+	r.seen[id] = struct{}{}
+	r.reads = append(r.reads, queryRead{storeName: storeName, key: append([]byte(nil), key...)})
+}
+
+func (r *queryReadRecorder) recordIteration(storeName string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.iteratedStore == "" {
+		r.iteratedStore = storeName
+	}
+}
+
+// recordingMultiStore wraps the multi-store of a query context so that the
+// keys read through its KV stores, and through the ones of its branches, are
+// recorded.
+type recordingMultiStore struct {
+	storetypes.MultiStore
+
+	recorder *queryReadRecorder
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms recordingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return recordingKVStore{
+		KVStore:   ms.MultiStore.GetKVStore(key),
+		storeName: key.Name(),
+		recorder:  ms.recorder,
+	}
+}
+
+// CacheMultiStore implements storetypes.MultiStore, the reads performed on the
+// branch being recorded as well.
+func (ms recordingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return recordingCacheMultiStore{
+		recordingBranch: ms.MultiStore.CacheMultiStore(),
+		recorder:        ms.recorder,
+	}
+}
+
+// recordingBranch is the CacheMultiStore wrapped by a recordingCacheMultiStore.
+// It is a distinct type so that the embedded field does not collide with the
+// CacheMultiStore method.
+type recordingBranch interface {
+	storetypes.CacheMultiStore
+}
+
+// recordingCacheMultiStore is a branch of a recordingMultiStore.
+type recordingCacheMultiStore struct {
+	recordingBranch
+
+	recorder *queryReadRecorder
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (cms recordingCacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return recordingMultiStore{MultiStore: cms.recordingBranch, recorder: cms.recorder}.GetKVStore(key)
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (cms recordingCacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return recordingMultiStore{MultiStore: cms.recordingBranch, recorder: cms.recorder}.CacheMultiStore()
+}
+
+// recordingKVStore records the keys read from the wrapped KV store.
+//
+// NOTE: Only point reads can be proven, the absence of the keys skipped by an
+// iterator cannot. The stores iterated over are recorded so that the proofs
+// of such queries are refused rather than returned incomplete.
+type recordingKVStore struct {
+	storetypes.KVStore
+
+	storeName string
+	recorder  *queryReadRecorder
+}
+
+// Get implements storetypes.KVStore.
+func (s recordingKVStore) Get(key []byte) []byte {
+	s.recorder.record(s.storeName, key)
+	return s.KVStore.Get(key)
+}
+
+// Has implements storetypes.KVStore.
+func (s recordingKVStore) Has(key []byte) bool {
+	s.recorder.record(s.storeName, key)
+	return s.KVStore.Has(key)
+}
+
+// Iterator implements storetypes.KVStore.
+func (s recordingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.recorder.recordIteration(s.storeName)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements storetypes.KVStore.
+func (s recordingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.recorder.recordIteration(s.storeName)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// queryProofsRequested returns true if the query proofs were requested by the
+// given gRPC metadata.
+func queryProofsRequested(md metadata.MD) bool {
+	values := md.Get(grpctypes.GRPCQueryProveHeader)
+	return len(values) == 1 && values[0] == "true"
+}
+
+// createQueryProofs returns the gRPC metadata holding the merkle proofs of the
+// reads recorded by a query executed at the given height. Every proof is an
+// encoded abci.QueryResponse, whose proof ops go up to the app hash committed
+// at the given height. Queries iterating over a store cannot be proven and
+// are refused.
+func (app *BaseApp) createQueryProofs(height int64, recorder *queryReadRecorder) (metadata.MD, error) {
+	queryable, ok := app.cms.(storetypes.Queryable)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multi-store does not support query proofs")
+	}
+
+	recorder.mtx.Lock()
+	reads, iteratedStore := recorder.reads, recorder.iteratedStore
+	recorder.mtx.Unlock()
+
+	if iteratedStore != "" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "query proofs are not supported for queries iterating over a store, store %s was iterated", iteratedStore)
+	}
+
+	md := metadata.MD{}
+	for _, read := range reads {
+		res, err := queryable.Query(&storetypes.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", read.storeName),
+			Data:   read.key,
+			Height: height,
+			Prove:  true,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to prove key %X of store %s", read.key, read.storeName)
+		}
+
+		bz, err := (&abci.QueryResponse{
+			Key:      read.key,
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+			Height:   height,
+		}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		md.Append(grpctypes.GRPCQueryProofHeader, string(bz))
+	}
+
+	return md, nil
+}
@@ -0,0 +1,47 @@
+package baseapp
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func TestRecordingMultiStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("store")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	cms.Commit()
+
+	app := &BaseApp{cms: cms}
+	recorder := newQueryReadRecorder()
+	ms := recordingMultiStore{MultiStore: cms.CacheMultiStore(), recorder: recorder}
+
+	// the reads through the store and its nested branches are recorded once
+	require.Equal(t, []byte("1"), ms.GetKVStore(key).Get([]byte("a")))
+	require.True(t, ms.CacheMultiStore().GetKVStore(key).Has([]byte("a")))
+	require.False(t, ms.CacheMultiStore().CacheMultiStore().GetKVStore(key).Has([]byte("b")))
+	require.Equal(t, []queryRead{
+		{storeName: "store", key: []byte("a")},
+		{storeName: "store", key: []byte("b")},
+	}, recorder.reads)
+
+	md, err := app.createQueryProofs(cms.LastCommitID().Version, recorder)
+	require.NoError(t, err)
+	require.Len(t, md.Get(grpctypes.GRPCQueryProofHeader), 2)
+
+	// the queries iterating over a store cannot be proven
+	it := ms.CacheMultiStore().GetKVStore(key).Iterator(nil, nil)
+	require.NoError(t, it.Close())
+	_, err = app.createQueryProofs(cms.LastCommitID().Version, recorder)
+	require.ErrorContains(t, err, "store store was iterated")
+}
@@ -18,7 +18,7 @@ const (
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
@@ -69,6 +69,9 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 
 	// parse height header
 	md, _ := metadata.FromOutgoingContext(grpcCtx)
+	if len(md.Get(grpctypes.GRPCQueryProveHeader)) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "client.Context.Invoke: query proofs require a gRPC client")
+	}
 	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
 		height, err := strconv.ParseInt(heights[0], 10, 64)
 		if err != nil {
@@ -0,0 +1,143 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagTLSCert  = "tls-cert"
+	flagTLSKey   = "tls-key"
+	flagClientCA = "client-ca"
+	flagAuditLog = "audit-log"
+	flagApprove  = "approve"
+)
+
+// RemoteSignerCommand serves the keys of the keyring to the clients of the
+// remote keyring backend.
+func RemoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer <listen-address>",
+		Short: "Serve the keys of the keyring to remote clients",
+		Long: `Serve the keys of the keyring, of any backend, to the clients of the remote
+keyring backend over gRPC, so that the hosts using them never hold key material.
+The remote clients can only list the keys and sign with them.
+
+The clients are authenticated with mutual TLS: only the clients with a
+certificate issued by the --client-ca certificate authority are accepted.
+All the requests are appended, as JSON lines, along with the common name of the
+certificate of their client and their outcome to the --audit-log file, if set.
+The signatures are only returned once their request is in the audit log. With --approve, each sign
+request must be confirmed on the terminal.
+
+The clients use the remote keyring backend, configured by the
+keyring-remote/config.json file of their keyring directory:
+
+    {
+      "address": "signer.example.com:9190",
+      "cert_file": "client.crt",
+      "key_file": "client.key",
+      "ca_file": "ca.crt"
+    }
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			clientCAFile, _ := cmd.Flags().GetString(flagClientCA)
+			if certFile == "" || keyFile == "" || clientCAFile == "" {
+				return fmt.Errorf("the --%s, --%s and --%s flags are required", flagTLSCert, flagTLSKey, flagClientCA)
+			}
+
+			tlsConfig, err := keyring.NewRemoteServerTLSConfig(certFile, keyFile, clientCAFile)
+			if err != nil {
+				return err
+			}
+
+			var opts []keyring.RemoteSignerOption
+			if auditLog, _ := cmd.Flags().GetString(flagAuditLog); auditLog != "" {
+				audit, err := keyring.OpenRemoteAuditFile(auditLog)
+				if err != nil {
+					return err
+				}
+				defer audit.Close()
+
+				opts = append(opts, keyring.WithRemoteSignerAuditSink(audit))
+			}
+
+			if approve, _ := cmd.Flags().GetBool(flagApprove); approve {
+				opts = append(opts, keyring.WithRemoteSignApprover(terminalApprover(cmd)))
+			}
+
+			lis, err := net.Listen("tcp", args[0])
+			if err != nil {
+				return err
+			}
+
+			server := keyring.NewRemoteSignerServer(clientCtx.Keyring, clientCtx.Codec, tlsConfig, opts...)
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			go func() {
+				<-ctx.Done()
+				server.GracefulStop()
+			}()
+
+			cmd.PrintErrln(fmt.Sprintf("Serving the keys of the %s keyring on %s", clientCtx.Keyring.Backend(), lis.Addr()))
+
+			return server.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagTLSCert, "", "PEM encoded TLS certificate of the remote signer")
+	cmd.Flags().String(flagTLSKey, "", "PEM encoded TLS key of the remote signer")
+	cmd.Flags().String(flagClientCA, "", "PEM encoded certificate authority of the client certificates")
+	cmd.Flags().String(flagAuditLog, "", "File to append the audit log of the requests to")
+	cmd.Flags().Bool(flagApprove, false, "Confirm each sign request on the terminal")
+
+	return cmd
+}
+
+// terminalApprover returns an approver confirming the sign requests on the
+// terminal, one at a time.
+func terminalApprover(cmd *cobra.Command) keyring.RemoteSignApprover {
+	var mu sync.Mutex
+	buf := bufio.NewReader(cmd.InOrStdin())
+
+	return func(_ context.Context, req keyring.RemoteSignApproval) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		prompt := fmt.Sprintf("Sign request from %s with key %s (%s) of message %s. Approve?",
+			req.Peer, req.Key.Name, req.SignMode, hex.EncodeToString(req.Msg))
+		yes, err := input.GetConfirmation(prompt, buf, cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+
+		if !yes {
+			return errors.New("sign request not approved")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,572 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// flagThresholdTx is the flag of the aggregate command setting the transaction
+// of the signing package.
+const flagThresholdTx = "tx"
+
+// ThresholdCommands returns the commands to generate threshold keys and sign
+// with them.
+func ThresholdCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "threshold",
+		Short: "Generate threshold keys and sign with them",
+		Long: `Generate FROST threshold keys, of which any t of their n participants can
+produce a standard Ed25519 signature, and sign with them.
+
+Each participant runs the rounds of the key generation and of the signing with
+its own keyring, and the participants exchange the output files of each round.
+The private key never exists in a single place: the keyring of each
+participant only stores its share of the key, along with an offline record of
+the public key of the group.
+
+Key generation of a 2-of-3 key, each participant using its own identifier:
+
+    $ <appd> keys threshold dkg-round1 mykey 1 2 3 > round1-1.json
+    $ <appd> keys threshold dkg-round2 mykey round1-*.json > round2-1.json
+    $ <appd> keys threshold dkg-finalize mykey round2-*.json
+
+Signing of a message by 2 participants:
+
+    $ <appd> keys threshold sign-round1 mykey > commitment-1.json
+    $ <appd> keys threshold signing-package message.bin commitment-*.json > package.json
+    $ <appd> keys threshold sign-round2 mykey package.json > share-1.json
+    $ <appd> keys threshold aggregate mykey package.json share-*.json
+
+Signing of a transaction generated with the --generate-only flag, whose only
+signer is the threshold key, by 2 participants:
+
+    $ <appd> keys threshold sign-round1 mykey > commitment-1.json
+    $ <appd> keys threshold tx-signing-package mykey tx.json commitment-*.json > package.json
+    $ <appd> keys threshold sign-round2 mykey package.json > share-1.json
+    $ <appd> keys threshold aggregate mykey package.json share-*.json --tx tx.json > signed-tx.json
+
+The threshold key is an Ed25519 key, its account must therefore be on a chain
+accepting Ed25519 account keys.
+`,
+	}
+
+	cmd.AddCommand(
+		thresholdDKGRound1Cmd(),
+		thresholdDKGRound2Cmd(),
+		thresholdDKGFinalizeCmd(),
+		thresholdSignRound1Cmd(),
+		thresholdSigningPackageCmd(),
+		thresholdTxSigningPackageCmd(),
+		thresholdSignRound2Cmd(),
+		thresholdAggregateCmd(),
+	)
+
+	return cmd
+}
+
+func thresholdDKGRound1Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dkg-round1 <name> <identifier> <threshold> <participants>",
+		Short: "Start the generation of a threshold key",
+		Long: `Start the generation of a threshold key of the given threshold and number of
+participants, and print the round 1 package to send to all the other
+participants. Each participant must use a distinct identifier between 1 and
+the number of participants.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if _, err := clientCtx.Keyring.Key(name); err == nil {
+				return fmt.Errorf("key %s already exists", name)
+			}
+
+			params := make([]uint16, 3)
+			for i, arg := range args[1:] {
+				v, err := strconv.ParseUint(arg, 10, 16)
+				if err != nil {
+					return fmt.Errorf("invalid argument %s: %w", arg, err)
+				}
+				params[i] = uint16(v)
+			}
+
+			secret, pkg, err := frost.DKGRound1(params[0], params[1], params[2])
+			if err != nil {
+				return err
+			}
+
+			if err := keyring.SetThresholdShare(clientCtx.Keyring, name, &keyring.ThresholdShare{DKG: secret}); err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, pkg)
+		},
+	}
+}
+
+func thresholdDKGRound2Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dkg-round2 <name> <round1-files>...",
+		Short: "Verify the round 1 packages of a threshold key generation",
+		Long: `Verify the round 1 packages of all the participants of the generation of a
+threshold key, and print the round 2 packages to send to all the other
+participants. Each round 2 package holds the secret share of its receiver,
+encrypted for it.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := getThresholdDKG(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			round1, err := readThresholdFiles[frost.DKGRound1Package](args[1:])
+			if err != nil {
+				return err
+			}
+
+			round2, err := frost.DKGRound2(share.DKG, round1)
+			if err != nil {
+				return err
+			}
+
+			if err := keyring.SetThresholdShare(clientCtx.Keyring, args[0], share); err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, round2)
+		},
+	}
+}
+
+func thresholdDKGFinalizeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dkg-finalize <name> <round2-files>...",
+		Short: "Finalize the generation of a threshold key",
+		Long: `Verify the secret shares sent by the other participants of the generation of a
+threshold key, among the round 2 packages of all the participants, and store
+the threshold key in the keyring.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := getThresholdDKG(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			var round2 []frost.DKGRound2Package
+			for _, file := range args[1:] {
+				pkgs, err := readThresholdFiles[[]frost.DKGRound2Package]([]string{file})
+				if err != nil {
+					return err
+				}
+				round2 = append(round2, pkgs[0]...)
+			}
+
+			keyShare, err := frost.DKGFinalize(share.DKG, round2)
+			if err != nil {
+				return err
+			}
+
+			k, err := keyring.SaveThresholdKey(clientCtx.Keyring, args[0], keyShare)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(clientCtx, cmd, k, false, false, "", clientCtx.OutputFormat)
+		},
+	}
+}
+
+func thresholdSignRound1Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-round1 <name>",
+		Short: "Start a signing with a threshold key",
+		Long: `Generate the nonces of a signing with a threshold key, and print their
+commitment to send to the participant building the signing package. The
+nonces are stored in the keyring until the signature share is computed, and
+replace the ones of any previous signing not completed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := getThresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			nonces, commitment, err := frost.SignRound1(share.KeyShare)
+			if err != nil {
+				return err
+			}
+
+			share.Nonces = nonces
+			if err := keyring.SetThresholdShare(clientCtx.Keyring, args[0], share); err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, commitment)
+		},
+	}
+}
+
+func thresholdSigningPackageCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "signing-package <message-file> <commitment-files>...",
+		Short: "Build the signing package of a message",
+		Long: `Build the signing package of the message of the given file from the
+commitments of the signing participants, at least as many as the threshold,
+and print it to send to all the signing participants.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			commitments, err := readThresholdFiles[frost.SigningCommitment](args[1:])
+			if err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, frost.SigningPackage{Message: msg, Commitments: commitments})
+		},
+	}
+}
+
+func thresholdTxSigningPackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-signing-package <name> <tx-file> <commitment-files>...",
+		Short: "Build the signing package of a transaction",
+		Long: `Build the signing package of the sign bytes of the transaction of the given
+file by the threshold key, from the commitments of the signing participants, at
+least as many as the threshold, and print it to send to all the signing
+participants. The threshold key must be the only signer of the transaction.
+
+Unless the --offline flag is set, the account number and the sequence of the
+threshold key are queried.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, _, signBytes, err := thresholdTx(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			commitments, err := readThresholdFiles[frost.SigningCommitment](args[2:])
+			if err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, frost.SigningPackage{Message: signBytes, Commitments: commitments})
+		},
+	}
+
+	addThresholdTxFlags(cmd)
+
+	return cmd
+}
+
+func thresholdSignRound2Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-round2 <name> <signing-package-file>",
+		Short: "Compute the signature share of a signing package",
+		Long: `Compute the signature share of a signing package with a threshold key, and
+print it to send to the participant aggregating the signature. The nonces of
+the signing are deleted from the keyring.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := getThresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			if share.Nonces == nil {
+				return fmt.Errorf("no signing with %s in progress, run sign-round1 first", args[0])
+			}
+
+			pkg, err := readThresholdFiles[frost.SigningPackage](args[1:])
+			if err != nil {
+				return err
+			}
+
+			sigShare, err := frost.SignRound2(share.KeyShare, share.Nonces, &pkg[0])
+			if err != nil {
+				return err
+			}
+
+			// nonces must never be reused
+			share.Nonces = nil
+			if err := keyring.SetThresholdShare(clientCtx.Keyring, args[0], share); err != nil {
+				return err
+			}
+
+			return printThresholdJSON(cmd, sigShare)
+		},
+	}
+}
+
+func thresholdAggregateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate <name> <signing-package-file> <share-files>...",
+		Short: "Aggregate the signature shares of a signing package",
+		Long: `Verify the signature shares of the signing participants of a signing package,
+and print their aggregated Ed25519 signature, base64 encoded.
+
+If the --tx flag is set, the signing package must be the one built by
+tx-signing-package for the transaction of the given file, with the same
+account number and sequence, and the transaction signed by the aggregated
+signature is printed instead.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := getThresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			pkg, err := readThresholdFiles[frost.SigningPackage](args[1:2])
+			if err != nil {
+				return err
+			}
+
+			sigShares, err := readThresholdFiles[frost.SignatureShare](args[2:])
+			if err != nil {
+				return err
+			}
+
+			sig, err := frost.Aggregate(share.KeyShare, &pkg[0], sigShares)
+			if err != nil {
+				return err
+			}
+
+			txFile, err := cmd.Flags().GetString(flagThresholdTx)
+			if err != nil {
+				return err
+			}
+
+			if txFile == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), base64.StdEncoding.EncodeToString(sig))
+				return err
+			}
+
+			txBuilder, txSig, signBytes, err := thresholdTx(cmd, args[0], txFile)
+			if err != nil {
+				return err
+			}
+
+			if !bytes.Equal(pkg[0].Message, signBytes) {
+				return fmt.Errorf("the signing package is not the one of the transaction %s", txFile)
+			}
+
+			txSig.Data.(*signing.SingleSignatureData).Signature = sig
+			if err := txBuilder.SetSignatures(txSig); err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagThresholdTx, "", "File of the transaction of the signing package, printed signed by the aggregated signature")
+	addThresholdTxFlags(cmd)
+
+	return cmd
+}
+
+// thresholdTx returns the transaction of the given file signed by the
+// threshold key `name`, with an empty signature to complete with the
+// aggregated one, along with its sign bytes.
+func thresholdTx(cmd *cobra.Command, name, file string) (client.TxBuilder, signing.SignatureV2, []byte, error) {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	k, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	if txf.ChainID() == "" {
+		return nil, signing.SignatureV2{}, nil, errors.New("set the chain id with either the --chain-id flag or config file")
+	}
+
+	if !clientCtx.Offline {
+		accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(pubKey.Address()))
+		if err != nil {
+			return nil, signing.SignatureV2{}, nil, err
+		}
+
+		txf = txf.WithAccountNumber(accNum).WithSequence(seq)
+	}
+
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode, err = authsigning.APISignModeToInternal(clientCtx.TxConfig.SignModeHandler().DefaultMode())
+		if err != nil {
+			return nil, signing.SignatureV2{}, nil, err
+		}
+	}
+
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	parsedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, fmt.Errorf("invalid file %s: %w", file, err)
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(parsedTx)
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	// as with any signing, the signer info is set before computing the sign
+	// bytes, which depend on it with SIGN_MODE_DIRECT
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+
+	signBytes, err := authsigning.GetSignBytesAdapter(cmd.Context(), clientCtx.TxConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return nil, signing.SignatureV2{}, nil, err
+	}
+
+	return txBuilder, sig, signBytes, nil
+}
+
+// addThresholdTxFlags adds the flags of the signing of a transaction with a
+// threshold key.
+func addThresholdTxFlags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.String(flags.FlagChainID, "", "The network chain ID")
+	f.String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	f.Bool(flags.FlagOffline, false, "Offline mode, the account number and sequence must be set")
+	f.Uint64P(flags.FlagAccountNumber, "a", 0, "The account number of the threshold key (offline mode only)")
+	f.Uint64P(flags.FlagSequence, "s", 0, "The sequence number of the threshold key (offline mode only)")
+	f.String(flags.FlagSignMode, "", "Choose sign mode (direct|amino-json|textual), this is an advanced feature")
+}
+
+func getThresholdDKG(kr keyring.Keyring, name string) (*keyring.ThresholdShare, error) {
+	share, err := keyring.GetThresholdShare(kr, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if share.DKG == nil {
+		return nil, fmt.Errorf("no generation of %s in progress, run dkg-round1 first", name)
+	}
+
+	return share, nil
+}
+
+func getThresholdKeyShare(kr keyring.Keyring, name string) (*keyring.ThresholdShare, error) {
+	share, err := keyring.GetThresholdShare(kr, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if share.KeyShare == nil {
+		return nil, errors.New("the generation of the threshold key is not finalized")
+	}
+
+	return share, nil
+}
+
+// readThresholdFiles reads the JSON values of the given files.
+func readThresholdFiles[T any](files []string) ([]T, error) {
+	values := make([]T, len(files))
+	for i, file := range files {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(bz, &values[i]); err != nil {
+			return nil, fmt.Errorf("invalid file %s: %w", file, err)
+		}
+	}
+
+	return values, nil
+}
+
+func printThresholdJSON(cmd *cobra.Command, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -0,0 +1,173 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+	authsigning "cosmossdk.io/x/auth/signing"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func Test_runThresholdCmds(t *testing.T) {
+	dir := t.TempDir()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	cdc := encCfg.Codec
+	var addrCodec address.Codec = addresscodec.NewBech32Codec("cosmos")
+
+	// each participant has its own keyring
+	kbs := make([]keyring.Keyring, 3)
+	for i := range kbs {
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, t.TempDir(), nil, cdc)
+		require.NoError(t, err)
+		kbs[i] = kb
+	}
+
+	run := func(kb keyring.Keyring, args ...string) (string, error) {
+		cmd := ThresholdCommands()
+		cmd.PersistentFlags().AddFlagSet(Commands().PersistentFlags())
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+
+		clientCtx := client.Context{}.
+			WithKeyring(kb).
+			WithCodec(cdc).
+			WithTxConfig(encCfg.TxConfig).
+			WithAddressCodec(addrCodec)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	// runToFile runs the command and writes its output to the given file
+	runToFile := func(kb keyring.Keyring, file string, args ...string) string {
+		out, err := run(kb, args...)
+		require.NoError(t, err)
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.WriteFile(path, []byte(out), 0o600))
+		return path
+	}
+
+	var round1, round2 []string
+	for i, kb := range kbs {
+		round1 = append(round1, runToFile(kb, fmt.Sprintf("round1-%d.json", i), "dkg-round1", "mykey", fmt.Sprint(i+1), "2", "3"))
+	}
+
+	// the generation must be run in order
+	_, err := run(kbs[0], "dkg-finalize", "mykey", round1[0])
+	require.Error(t, err)
+
+	for i, kb := range kbs {
+		round2 = append(round2, runToFile(kb, fmt.Sprintf("round2-%d.json", i), append([]string{"dkg-round2", "mykey"}, round1...)...))
+	}
+
+	for _, kb := range kbs {
+		_, err := run(kb, append([]string{"dkg-finalize", "mykey"}, round2...)...)
+		require.NoError(t, err)
+	}
+
+	k, err := kbs[0].Key("mykey")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeOffline, k.GetType())
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+
+	msg := []byte("threshold message")
+	msgFile := filepath.Join(dir, "message.bin")
+	require.NoError(t, os.WriteFile(msgFile, msg, 0o600))
+
+	// sign with the participants 1 and 3
+	signers := []keyring.Keyring{kbs[0], kbs[2]}
+	var commitments, shares []string
+	for i, kb := range signers {
+		commitments = append(commitments, runToFile(kb, fmt.Sprintf("commitment-%d.json", i), "sign-round1", "mykey"))
+	}
+
+	pkg := runToFile(kbs[1], "package.json", append([]string{"signing-package", msgFile}, commitments...)...)
+	for i, kb := range signers {
+		shares = append(shares, runToFile(kb, fmt.Sprintf("share-%d.json", i), "sign-round2", "mykey", pkg))
+	}
+
+	// the nonces cannot be reused
+	_, err = run(kbs[0], "sign-round2", "mykey", pkg)
+	require.ErrorContains(t, err, "no signing with mykey in progress")
+
+	out, err := run(kbs[1], append([]string{"aggregate", "mykey", pkg}, shares...)...)
+	require.NoError(t, err)
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(out))
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(pubKey.Bytes(), msg, sig))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// sign a transaction with the participants 2 and 3
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	from := sdk.AccAddress(pubKey.Address()).String()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	txFile := filepath.Join(dir, "tx.json")
+	require.NoError(t, os.WriteFile(txFile, txBz, 0o600))
+
+	txFlags := []string{"--offline", "--chain-id", "test-chain", "--account-number", "7", "--sequence", "3"}
+	signers = []keyring.Keyring{kbs[1], kbs[2]}
+	commitments, shares = nil, nil
+	for i, kb := range signers {
+		commitments = append(commitments, runToFile(kb, fmt.Sprintf("tx-commitment-%d.json", i), "sign-round1", "mykey"))
+	}
+
+	txPkg := runToFile(kbs[0], "tx-package.json", append(append([]string{"tx-signing-package", "mykey", txFile}, commitments...), txFlags...)...)
+	for i, kb := range signers {
+		shares = append(shares, runToFile(kb, fmt.Sprintf("tx-share-%d.json", i), "sign-round2", "mykey", txPkg))
+	}
+
+	// the signing package must be the one of the transaction
+	_, err = run(kbs[0], append(append([]string{"aggregate", "mykey", txPkg, "--tx", txFile}, shares...), "--offline", "--chain-id", "test-chain", "--account-number", "7", "--sequence", "4")...)
+	require.ErrorContains(t, err, "not the one of the transaction")
+
+	out, err = run(kbs[0], append(append([]string{"aggregate", "mykey", txPkg, "--tx", txFile}, shares...), txFlags...)...)
+	require.NoError(t, err)
+	signedTx, err := encCfg.TxConfig.TxJSONDecoder()([]byte(out))
+	require.NoError(t, err)
+
+	sigs, err := signedTx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, pubKey, sigs[0].PubKey)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+
+	signerData := authsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+		PubKey:        pubKey,
+		Address:       from,
+	}
+	sigData := sigs[0].Data.(*signing.SingleSignatureData)
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), encCfg.TxConfig.SignModeHandler(), sigData.SignMode, signerData, signedTx)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(signBytes, sigData.Signature))
+}
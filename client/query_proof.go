@@ -0,0 +1,138 @@
+package client
+
+import (
+	"bytes"
+	gocontext "context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/store/rootmulti"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// QueryProof is the merkle proof of a key read by a gRPC query, as returned
+// by the node when the query proofs are requested with WithQueryProofs.
+//
+// NOTE: The proofs are only returned by the baseapp gRPC server, and only for
+// the queries reading single keys: the node refuses to prove the queries
+// iterating over a store, e.g. all the paginated ones, and the server/v2 nodes
+// refuse all the query proofs.
+type QueryProof struct {
+	// StoreName is the name of the store the key was read from.
+	StoreName string
+	Key       []byte
+	// Value is the value of the key, nil if the key does not exist.
+	Value []byte
+	// Height is the height the query was executed at.
+	Height   int64
+	ProofOps *cmtprotocrypto.ProofOps
+}
+
+// WithQueryProofs returns a copy of the given context requesting the merkle
+// proofs of the keys read by the gRPC queries it is used for. The proofs are
+// returned in the response header, which must be captured with the
+// grpc.Header call option, and can be parsed with QueryProofsFromHeader.
+func WithQueryProofs(ctx gocontext.Context) gocontext.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCQueryProveHeader, "true")
+}
+
+// QueryProofsFromHeader parses the query proofs of the given gRPC response
+// header.
+func QueryProofsFromHeader(md metadata.MD) ([]QueryProof, error) {
+	values := md.Get(grpctypes.GRPCQueryProofHeader)
+	proofs := make([]QueryProof, 0, len(values))
+	for _, value := range values {
+		var res abci.QueryResponse
+		if err := res.Unmarshal([]byte(value)); err != nil {
+			return nil, fmt.Errorf("failed to decode query proof: %w", err)
+		}
+
+		// the last op proves the store root against the app hash
+		if res.ProofOps == nil || len(res.ProofOps.Ops) < 2 {
+			return nil, fmt.Errorf("query proof of key %X has no store proof", res.Key)
+		}
+		ops := res.ProofOps.Ops
+
+		proofs = append(proofs, QueryProof{
+			StoreName: string(ops[len(ops)-1].Key),
+			Key:       res.Key,
+			Value:     res.Value,
+			Height:    res.Height,
+			ProofOps:  res.ProofOps,
+		})
+	}
+
+	return proofs, nil
+}
+
+// VerifyQueryProofs verifies the given query proofs against the app hash of the
+// given trusted header. As the app hash of a block commits to the state of the
+// previous block, the header must be the one of the block following the height
+// the query was executed at.
+//
+// NOTE: Only the keys and values of the proofs are verified, they are not
+// bound to the query response. The caller must check the values it relies on
+// with VerifyQueryValue.
+func VerifyQueryProofs(header cmtproto.Header, proofs []QueryProof) error {
+	if len(proofs) == 0 {
+		return errors.New("no query proof to verify")
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+	for _, proof := range proofs {
+		if proof.Height+1 != header.Height {
+			return fmt.Errorf("query proof of height %d cannot be verified against header of height %d", proof.Height, header.Height)
+		}
+
+		keyPath := merkle.KeyPath{}.
+			AppendKey([]byte(proof.StoreName), merkle.KeyEncodingURL).
+			AppendKey(proof.Key, merkle.KeyEncodingHex).
+			String()
+
+		var err error
+		if proof.Value == nil {
+			err = prt.VerifyAbsence(proof.ProofOps, header.AppHash, keyPath)
+		} else {
+			err = prt.VerifyValue(proof.ProofOps, header.AppHash, keyPath, proof.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid query proof of key %X in store %s: %w", proof.Key, proof.StoreName, err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyQueryValue verifies the given query proofs against the app hash of the
+// given trusted header, and checks that they prove the given key of the store
+// to hold the given value, nil meaning that the key does not exist. The value
+// is the one the caller decoded from the query response.
+func VerifyQueryValue(header cmtproto.Header, proofs []QueryProof, storeName string, key, value []byte) error {
+	if err := VerifyQueryProofs(header, proofs); err != nil {
+		return err
+	}
+
+	proven := false
+	for _, proof := range proofs {
+		if proof.StoreName != storeName || !bytes.Equal(proof.Key, key) {
+			continue
+		}
+
+		if (proof.Value == nil) != (value == nil) || !bytes.Equal(proof.Value, value) {
+			return fmt.Errorf("value of key %X in store %s does not match its proven value", key, storeName)
+		}
+		proven = true
+	}
+	if !proven {
+		return fmt.Errorf("no query proof of key %X in store %s", key, storeName)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package client_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// setupQueryProofs commits the given key/value pairs to a store, and returns
+// the header committing to them and the proofs of the given keys, as a
+// baseapp node would return them.
+func setupQueryProofs(t *testing.T, kvs map[string]string, keys ...string) (cmtproto.Header, []client.QueryProof) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("bank")
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	store := rs.GetKVStore(key)
+	for k, v := range kvs {
+		store.Set([]byte(k), []byte(v))
+	}
+	commitID := rs.Commit()
+
+	md := metadata.MD{}
+	for _, k := range keys {
+		res, err := rs.Query(&storetypes.RequestQuery{
+			Path:   "/bank/key",
+			Data:   []byte(k),
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+
+		bz, err := (&abci.QueryResponse{
+			Key:      []byte(k),
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+			Height:   commitID.Version,
+		}).Marshal()
+		require.NoError(t, err)
+		md.Append(grpctypes.GRPCQueryProofHeader, string(bz))
+	}
+
+	proofs, err := client.QueryProofsFromHeader(md)
+	require.NoError(t, err)
+	require.Len(t, proofs, len(keys))
+
+	return cmtproto.Header{Height: commitID.Version + 1, AppHash: commitID.Hash}, proofs
+}
+
+func TestVerifyQueryProofs(t *testing.T) {
+	kvs := map[string]string{"alice": "100", "bob": "200"}
+
+	testCases := []struct {
+		name     string
+		malleate func(header *cmtproto.Header, proofs []client.QueryProof)
+		expErr   string
+	}{
+		{
+			name:     "valid proofs of existence and absence",
+			malleate: func(*cmtproto.Header, []client.QueryProof) {},
+		},
+		{
+			name: "tampered value",
+			malleate: func(_ *cmtproto.Header, proofs []client.QueryProof) {
+				proofs[0].Value = []byte("1000")
+			},
+			expErr: "invalid query proof of key",
+		},
+		{
+			name: "tampered absence",
+			malleate: func(_ *cmtproto.Header, proofs []client.QueryProof) {
+				proofs[2].Value = []byte("300")
+			},
+			expErr: "invalid query proof of key",
+		},
+		{
+			name: "proof of another key",
+			malleate: func(_ *cmtproto.Header, proofs []client.QueryProof) {
+				proofs[0].Key = proofs[1].Key
+			},
+			expErr: "invalid query proof of key",
+		},
+		{
+			name: "proof of another store",
+			malleate: func(_ *cmtproto.Header, proofs []client.QueryProof) {
+				proofs[0].StoreName = "staking"
+			},
+			expErr: "invalid query proof of key",
+		},
+		{
+			name: "header of another height",
+			malleate: func(header *cmtproto.Header, _ []client.QueryProof) {
+				header.Height++
+			},
+			expErr: "cannot be verified against header of height",
+		},
+		{
+			name: "header of another app hash",
+			malleate: func(header *cmtproto.Header, _ []client.QueryProof) {
+				header.AppHash = make([]byte, len(header.AppHash))
+			},
+			expErr: "invalid query proof of key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header, proofs := setupQueryProofs(t, kvs, "alice", "bob", "carol")
+			tc.malleate(&header, proofs)
+
+			err := client.VerifyQueryProofs(header, proofs)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	require.ErrorContains(t, client.VerifyQueryProofs(cmtproto.Header{}, nil), "no query proof to verify")
+}
+
+func TestVerifyQueryValue(t *testing.T) {
+	header, proofs := setupQueryProofs(t, map[string]string{"alice": "100"}, "alice", "carol")
+
+	require.NoError(t, client.VerifyQueryValue(header, proofs, "bank", []byte("alice"), []byte("100")))
+	require.NoError(t, client.VerifyQueryValue(header, proofs, "bank", []byte("carol"), nil))
+
+	// the response values must be the proven ones
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "bank", []byte("alice"), []byte("1000")), "does not match its proven value")
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "bank", []byte("alice"), nil), "does not match its proven value")
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "bank", []byte("carol"), []byte{}), "does not match its proven value")
+
+	// the keys must be proven
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "bank", []byte("bob"), nil), "no query proof of key")
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "staking", []byte("alice"), []byte("100")), "no query proof of key")
+
+	// the proofs must be valid
+	proofs[0].Value = []byte("1000")
+	require.ErrorContains(t, client.VerifyQueryValue(header, proofs, "bank", []byte("alice"), []byte("1000")), "invalid query proof of key")
+}
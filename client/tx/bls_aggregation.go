@@ -0,0 +1,73 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AggregateBLSSignatures replaces the signatures of the tx being built by
+// their aggregated BLS signature, verified at once by the x/auth
+// SigVerificationDecorator.
+//
+// The given signatures are the partial signatures of all the tx signers, in
+// the order of the tx signers, collected for example from the output of
+// `tx sign --signature-only`. All the signers must use bls12_381 keys and
+// single signatures, and, as with any tx with multiple signers, must sign the
+// tx with the signer infos of all the signers when using SIGN_MODE_DIRECT.
+// If no signatures are given, the current signatures of the tx are aggregated.
+func AggregateBLSSignatures(txBuilder client.TxBuilder, sigs ...signing.SignatureV2) error {
+	if len(sigs) == 0 {
+		var err error
+		sigs, err = txBuilder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(sigs) < 2 {
+		return fmt.Errorf("at least 2 signatures are required to aggregate them, got %d", len(sigs))
+	}
+
+	sigBzs := make([][]byte, len(sigs))
+	for i, sig := range sigs {
+		if _, ok := sig.PubKey.(*bls12_381.PubKey); !ok {
+			return fmt.Errorf("signature %d: expected a bls12_381 public key, got %T", i, sig.PubKey)
+		}
+
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return fmt.Errorf("signature %d: expected %T, got %T", i, &signing.SingleSignatureData{}, sig.Data)
+		}
+
+		sigBzs[i] = data.Signature
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigBzs)
+	if err != nil {
+		return err
+	}
+
+	// the aggregated signature is the signature of the first signer, the
+	// others are left empty
+	aggSigs := make([]signing.SignatureV2, len(sigs))
+	for i, sig := range sigs {
+		sigBz := []byte{}
+		if i == 0 {
+			sigBz = aggSig
+		}
+
+		aggSigs[i] = signing.SignatureV2{
+			PubKey: sig.PubKey,
+			Data: &signing.SingleSignatureData{
+				SignMode:  sig.Data.(*signing.SingleSignatureData).SignMode,
+				Signature: sigBz,
+			},
+			Sequence: sig.Sequence,
+		}
+	}
+
+	return txBuilder.SetSignatures(aggSigs...)
+}
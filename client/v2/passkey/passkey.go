@@ -0,0 +1,83 @@
+// Package passkey provides the tooling to sign txs with passkeys, for accounts
+// verifying WebAuthn assertions such as the x/accounts passkey account.
+//
+// A tx is signed with a passkey by requesting a WebAuthn assertion, with
+// navigator.credentials.get, whose challenge is the Challenge of the tx, and
+// by setting the Signature of the returned assertion as the tx signature.
+package passkey
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// Challenge returns the challenge of the WebAuthn assertion signing the tx
+// data for the given signer with the given sign mode.
+func Challenge(
+	ctx context.Context,
+	handlerMap *txsigning.HandlerMap,
+	signMode apisigning.SignMode,
+	signerData txsigning.SignerData,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	signBytes, err := handlerMap.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return secp256r1.WebAuthnChallenge(signBytes), nil
+}
+
+// Signature returns the tx signature of the authenticator data, client data
+// JSON and ASN.1 DER encoded signature of a WebAuthn assertion.
+func Signature(authenticatorData, clientDataJSON, derSignature []byte) ([]byte, error) {
+	assertion, err := secp256r1.NewWebAuthnAssertion(authenticatorData, clientDataJSON, derSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(assertion)
+}
+
+// credential is the JSON serialization of a PublicKeyCredential holding a
+// WebAuthn assertion, as returned by PublicKeyCredential.toJSON.
+type credential struct {
+	Response struct {
+		AuthenticatorData string `json:"authenticatorData"`
+		ClientDataJSON    string `json:"clientDataJSON"`
+		Signature         string `json:"signature"`
+	} `json:"response"`
+}
+
+// SignatureFromCredentialJSON returns the tx signature of the JSON serialized
+// PublicKeyCredential returned by navigator.credentials.get.
+func SignatureFromCredentialJSON(bz []byte) ([]byte, error) {
+	var cred credential
+	if err := json.Unmarshal(bz, &cred); err != nil {
+		return nil, fmt.Errorf("invalid credential JSON: %w", err)
+	}
+
+	authenticatorData, err := base64.RawURLEncoding.DecodeString(cred.Response.AuthenticatorData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticator data: %w", err)
+	}
+
+	clientDataJSON, err := base64.RawURLEncoding.DecodeString(cred.Response.ClientDataJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client data JSON: %w", err)
+	}
+
+	derSignature, err := base64.RawURLEncoding.DecodeString(cred.Response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return Signature(authenticatorData, clientDataJSON, derSignature)
+}
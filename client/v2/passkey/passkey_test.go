@@ -0,0 +1,69 @@
+package passkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+type mockHandler struct{}
+
+func (mockHandler) Mode() apisigning.SignMode {
+	return apisigning.SignMode_SIGN_MODE_DIRECT
+}
+
+func (mockHandler) GetSignBytes(_ context.Context, signerData txsigning.SignerData, _ txsigning.TxData) ([]byte, error) {
+	return []byte(fmt.Sprintf("%s/%d/%d", signerData.ChainID, signerData.AccountNumber, signerData.Sequence)), nil
+}
+
+func TestSignatureFromCredentialJSON(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pk, err := secp256r1.NewPubKey(elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y))
+	require.NoError(t, err)
+
+	handlerMap := txsigning.NewHandlerMap(mockHandler{})
+	signerData := txsigning.SignerData{ChainID: "test-chain", AccountNumber: 1, Sequence: 2}
+	challenge, err := Challenge(context.Background(), handlerMap, apisigning.SignMode_SIGN_MODE_DIRECT, signerData, txsigning.TxData{})
+	require.NoError(t, err)
+
+	_, err = Challenge(context.Background(), handlerMap, apisigning.SignMode_SIGN_MODE_TEXTUAL, signerData, txsigning.TxData{})
+	require.Error(t, err)
+
+	// the assertion of the challenge, as signed by the authenticator
+	rpIDHash := sha256.Sum256([]byte("example.com"))
+	authenticatorData := append(rpIDHash[:], 0x01, 0, 0, 0, 0)
+	clientDataJSON := []byte(fmt.Sprintf(`{"type":"webauthn.get","challenge":%q,"origin":"https://example.com"}`,
+		base64.RawURLEncoding.EncodeToString(challenge)))
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	derSignature, err := ecdsa.SignASN1(rand.Reader, sk, digest[:])
+	require.NoError(t, err)
+
+	credentialJSON := fmt.Sprintf(`{"id":"id","type":"public-key","response":{"authenticatorData":%q,"clientDataJSON":%q,"signature":%q}}`,
+		base64.RawURLEncoding.EncodeToString(authenticatorData),
+		base64.RawURLEncoding.EncodeToString(clientDataJSON),
+		base64.RawURLEncoding.EncodeToString(derSignature),
+	)
+
+	sig, err := SignatureFromCredentialJSON([]byte(credentialJSON))
+	require.NoError(t, err)
+	rp := secp256r1.WebAuthnRelyingParty{ID: "example.com", Origins: []string{"https://example.com"}}
+	require.NoError(t, pk.VerifyWebAuthnAssertion(rp, []byte("test-chain/1/2"), sig))
+	require.Error(t, pk.VerifyWebAuthnAssertion(rp, []byte("test-chain/1/3"), sig))
+
+	_, err = SignatureFromCredentialJSON([]byte(`{"response":{"signature":"not base64url!"}}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,400 @@
+package frost
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// DKGSecret is the secret state of a participant between the rounds of the
+// distributed key generation.
+type DKGSecret struct {
+	Identifier   uint16 `json:"identifier"`
+	Threshold    uint16 `json:"threshold"`
+	Participants uint16 `json:"participants"`
+	// Coefficients are the coefficients of the secret polynomial of the
+	// participant.
+	Coefficients [][]byte `json:"coefficients"`
+	// EncryptionSecret is the secret key used to decrypt the round 2 packages
+	// of the participant.
+	EncryptionSecret []byte `json:"encryption_secret"`
+	// Round1Packages are the round 1 packages of the other participants, set
+	// by DKGRound2.
+	Round1Packages []DKGRound1Package `json:"round1_packages,omitempty"`
+}
+
+// DKGRound1Package is the public package of a participant in the first round of
+// the distributed key generation, sent to all the other participants.
+type DKGRound1Package struct {
+	Identifier uint16 `json:"identifier"`
+	// Commitments are the commitments to the coefficients of the secret
+	// polynomial of the participant.
+	Commitments [][]byte `json:"commitments"`
+	// ProofR and ProofMu are the proof of knowledge of the secret of the
+	// participant.
+	ProofR  []byte `json:"proof_r"`
+	ProofMu []byte `json:"proof_mu"`
+	// EncryptionKey is the public key used to encrypt the round 2 packages
+	// sent to the participant.
+	EncryptionKey []byte `json:"encryption_key"`
+}
+
+// DKGRound2Package is the package of a participant in the second round of the
+// distributed key generation, holding the encrypted secret share of its
+// receiver.
+type DKGRound2Package struct {
+	Sender         uint16 `json:"sender"`
+	Receiver       uint16 `json:"receiver"`
+	Nonce          []byte `json:"nonce"`
+	EncryptedShare []byte `json:"encrypted_share"`
+}
+
+// DKGRound1 starts the distributed key generation of a threshold-of-
+// participants key for the participant of the given identifier, between 1 and
+// the number of participants.
+func DKGRound1(identifier, threshold, participants uint16) (*DKGSecret, *DKGRound1Package, error) {
+	if threshold < 2 || threshold > participants {
+		return nil, nil, fmt.Errorf("invalid threshold %d of %d participants", threshold, participants)
+	}
+
+	if identifier < 1 || identifier > participants {
+		return nil, nil, fmt.Errorf("invalid identifier %d of %d participants", identifier, participants)
+	}
+
+	secret := &DKGSecret{
+		Identifier:   identifier,
+		Threshold:    threshold,
+		Participants: participants,
+	}
+	pkg := &DKGRound1Package{Identifier: identifier}
+
+	for i := uint16(0); i < threshold; i++ {
+		coefficient, err := randomScalar()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		secret.Coefficients = append(secret.Coefficients, coefficient.Bytes())
+		pkg.Commitments = append(pkg.Commitments, new(edwards25519.Point).ScalarBaseMult(coefficient).Bytes())
+	}
+
+	// prove the knowledge of the secret, the first coefficient
+	k, err := randomScalar()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a0, err := decodeScalar(secret.Coefficients[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(edwards25519.Point).ScalarBaseMult(k)
+	c := dkgChallenge(identifier, pkg.Commitments[0], r.Bytes())
+	pkg.ProofR = r.Bytes()
+	pkg.ProofMu = new(edwards25519.Scalar).MultiplyAdd(a0, c, k).Bytes()
+
+	encryptionSecret, err := randomScalar()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret.EncryptionSecret = encryptionSecret.Bytes()
+	pkg.EncryptionKey = new(edwards25519.Point).ScalarBaseMult(encryptionSecret).Bytes()
+
+	return secret, pkg, nil
+}
+
+// DKGRound2 verifies the round 1 packages of the other participants, and
+// returns the round 2 packages to send them. The round 1 packages are kept in
+// the secret for DKGFinalize.
+func DKGRound2(secret *DKGSecret, round1Packages []DKGRound1Package) ([]DKGRound2Package, error) {
+	others := make(map[uint16]DKGRound1Package, len(round1Packages))
+	for _, pkg := range round1Packages {
+		if pkg.Identifier == secret.Identifier {
+			continue
+		}
+
+		if pkg.Identifier < 1 || pkg.Identifier > secret.Participants {
+			return nil, fmt.Errorf("invalid round 1 package identifier %d", pkg.Identifier)
+		}
+
+		if _, ok := others[pkg.Identifier]; ok {
+			return nil, fmt.Errorf("duplicate round 1 package of participant %d", pkg.Identifier)
+		}
+
+		if err := verifyRound1Package(secret.Threshold, pkg); err != nil {
+			return nil, fmt.Errorf("invalid round 1 package of participant %d: %w", pkg.Identifier, err)
+		}
+
+		others[pkg.Identifier] = pkg
+	}
+
+	if len(others) != int(secret.Participants)-1 {
+		return nil, fmt.Errorf("expected %d round 1 packages, got %d", secret.Participants-1, len(others))
+	}
+
+	encryptionSecret, err := decodeScalar(secret.EncryptionSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	var round2Packages []DKGRound2Package
+	secret.Round1Packages = nil
+	for id := uint16(1); id <= secret.Participants; id++ {
+		pkg, ok := others[id]
+		if !ok {
+			continue
+		}
+
+		share, err := evaluatePolynomial(secret.Coefficients, id)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := shareCipher(encryptionSecret, pkg.EncryptionKey, secret.Identifier, id)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+
+		round2Packages = append(round2Packages, DKGRound2Package{
+			Sender:         secret.Identifier,
+			Receiver:       id,
+			Nonce:          nonce,
+			EncryptedShare: aead.Seal(nil, nonce, share.Bytes(), shareAdditionalData(secret.Identifier, id)),
+		})
+		secret.Round1Packages = append(secret.Round1Packages, pkg)
+	}
+
+	return round2Packages, nil
+}
+
+// DKGFinalize verifies the secret shares sent by the other participants in
+// their round 2 packages, among which the ones not sent to the participant are
+// ignored, and returns the key share of the participant.
+func DKGFinalize(secret *DKGSecret, round2Packages []DKGRound2Package) (*KeyShare, error) {
+	if len(secret.Round1Packages) != int(secret.Participants)-1 {
+		return nil, errors.New("the round 2 of the key generation was not run")
+	}
+
+	encryptionSecret, err := decodeScalar(secret.EncryptionSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	received := make(map[uint16]DKGRound2Package, len(round2Packages))
+	for _, pkg := range round2Packages {
+		if pkg.Receiver != secret.Identifier {
+			continue
+		}
+
+		if _, ok := received[pkg.Sender]; ok {
+			return nil, fmt.Errorf("duplicate round 2 package of participant %d", pkg.Sender)
+		}
+		received[pkg.Sender] = pkg
+	}
+
+	// the own share of the participant
+	secretShare, err := evaluatePolynomial(secret.Coefficients, secret.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	ownCommitments, err := decodePoints(commitmentsOf(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	allCommitments := [][]*edwards25519.Point{ownCommitments}
+	for _, round1Package := range secret.Round1Packages {
+		commitments, err := decodePoints(round1Package.Commitments)
+		if err != nil {
+			return nil, err
+		}
+		allCommitments = append(allCommitments, commitments)
+
+		pkg, ok := received[round1Package.Identifier]
+		if !ok {
+			return nil, fmt.Errorf("missing round 2 package of participant %d", round1Package.Identifier)
+		}
+
+		aead, err := shareCipher(encryptionSecret, round1Package.EncryptionKey, pkg.Sender, pkg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+
+		shareBz, err := aead.Open(nil, pkg.Nonce, pkg.EncryptedShare, shareAdditionalData(pkg.Sender, pkg.Receiver))
+		if err != nil {
+			return nil, fmt.Errorf("invalid round 2 package of participant %d: %w", pkg.Sender, err)
+		}
+
+		share, err := decodeScalar(shareBz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid round 2 package of participant %d: %w", pkg.Sender, err)
+		}
+
+		// the share must be the evaluation of the committed polynomial
+		if new(edwards25519.Point).ScalarBaseMult(share).Equal(evaluateCommitments(commitments, secret.Identifier)) != 1 {
+			return nil, fmt.Errorf("invalid secret share of participant %d", pkg.Sender)
+		}
+
+		secretShare.Add(secretShare, share)
+	}
+
+	groupPubKey := edwards25519.NewIdentityPoint()
+	for _, commitments := range allCommitments {
+		groupPubKey.Add(groupPubKey, commitments[0])
+	}
+
+	verificationShares := make(map[uint16][]byte, secret.Participants)
+	for id := uint16(1); id <= secret.Participants; id++ {
+		verificationShare := edwards25519.NewIdentityPoint()
+		for _, commitments := range allCommitments {
+			verificationShare.Add(verificationShare, evaluateCommitments(commitments, id))
+		}
+		verificationShares[id] = verificationShare.Bytes()
+	}
+
+	return &KeyShare{
+		Identifier:         secret.Identifier,
+		Threshold:          secret.Threshold,
+		Participants:       secret.Participants,
+		SecretShare:        secretShare.Bytes(),
+		GroupPublicKey:     groupPubKey.Bytes(),
+		VerificationShares: verificationShares,
+	}, nil
+}
+
+// verifyRound1Package verifies the commitments and the proof of knowledge of a
+// round 1 package.
+func verifyRound1Package(threshold uint16, pkg DKGRound1Package) error {
+	if len(pkg.Commitments) != int(threshold) {
+		return fmt.Errorf("expected %d commitments, got %d", threshold, len(pkg.Commitments))
+	}
+
+	commitments, err := decodePoints(pkg.Commitments)
+	if err != nil {
+		return err
+	}
+
+	if _, err := decodePoint(pkg.EncryptionKey); err != nil {
+		return fmt.Errorf("invalid encryption key: %w", err)
+	}
+
+	r, err := decodePoint(pkg.ProofR)
+	if err != nil {
+		return err
+	}
+
+	mu, err := decodeScalar(pkg.ProofMu)
+	if err != nil {
+		return err
+	}
+
+	// R == mu * G - c * commitment
+	c := dkgChallenge(pkg.Identifier, pkg.Commitments[0], pkg.ProofR)
+	expected := new(edwards25519.Point).ScalarMult(c, commitments[0])
+	expected.Subtract(new(edwards25519.Point).ScalarBaseMult(mu), expected)
+	if expected.Equal(r) != 1 {
+		return errors.New("invalid proof of knowledge")
+	}
+
+	return nil
+}
+
+// dkgChallenge computes the challenge of the proof of knowledge of a
+// participant secret.
+func dkgChallenge(identifier uint16, commitment, r []byte) *edwards25519.Scalar {
+	return hashToScalar([]byte(contextString), []byte("dkg"), identifierScalar(identifier).Bytes(), commitment, r)
+}
+
+// evaluatePolynomial evaluates the polynomial of the given coefficients at the
+// given identifier.
+func evaluatePolynomial(coefficients [][]byte, id uint16) (*edwards25519.Scalar, error) {
+	x := identifierScalar(id)
+	value := edwards25519.NewScalar()
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		coefficient, err := decodeScalar(coefficients[i])
+		if err != nil {
+			return nil, err
+		}
+		value.MultiplyAdd(value, x, coefficient)
+	}
+	return value, nil
+}
+
+// evaluateCommitments evaluates the commitments to the coefficients of a
+// polynomial at the given identifier.
+func evaluateCommitments(commitments []*edwards25519.Point, id uint16) *edwards25519.Point {
+	x := identifierScalar(id)
+	value := edwards25519.NewIdentityPoint()
+	for i := len(commitments) - 1; i >= 0; i-- {
+		value.ScalarMult(x, value)
+		value.Add(value, commitments[i])
+	}
+	return value
+}
+
+func commitmentsOf(secret *DKGSecret) [][]byte {
+	commitments := make([][]byte, len(secret.Coefficients))
+	for i, bz := range secret.Coefficients {
+		coefficient, err := decodeScalar(bz)
+		if err != nil {
+			return nil
+		}
+		commitments[i] = new(edwards25519.Point).ScalarBaseMult(coefficient).Bytes()
+	}
+	return commitments
+}
+
+func decodePoints(bzs [][]byte) ([]*edwards25519.Point, error) {
+	if len(bzs) == 0 {
+		return nil, errors.New("no points")
+	}
+
+	points := make([]*edwards25519.Point, len(bzs))
+	for i, bz := range bzs {
+		p, err := decodePoint(bz)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = p
+	}
+	return points, nil
+}
+
+// shareCipher returns the cipher of the secret shares sent by the sender to
+// the receiver, keyed by the Diffie-Hellman secret of their encryption keys.
+func shareCipher(encryptionSecret *edwards25519.Scalar, encryptionKey []byte, sender, receiver uint16) (cipher.AEAD, error) {
+	pk, err := decodePoint(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dh := new(edwards25519.Point).ScalarMult(encryptionSecret, pk)
+	key := sha256.Sum256(append(append([]byte(contextString+"dkg-share"), dh.Bytes()...), shareAdditionalData(sender, receiver)...))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func shareAdditionalData(sender, receiver uint16) []byte {
+	ad := make([]byte, 4)
+	binary.BigEndian.PutUint16(ad, sender)
+	binary.BigEndian.PutUint16(ad[2:], receiver)
+	return ad
+}
@@ -0,0 +1,13 @@
+package frost
+
+import "filippo.io/edwards25519"
+
+// DeriveNonce derives the nonce of the given randomness for the secret share.
+func DeriveNonce(randomBytes, secretShare []byte) ([]byte, error) {
+	secret, err := edwards25519.NewScalar().SetCanonicalBytes(secretShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveNonce(randomBytes, secret).Bytes(), nil
+}
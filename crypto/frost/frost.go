@@ -0,0 +1,416 @@
+// Package frost implements FROST(Ed25519, SHA-512) threshold signing, as
+// specified by RFC 9591, along with a distributed key generation, so that t of
+// the n holders of shares of an Ed25519 key can produce a standard Ed25519
+// signature without the key ever existing in a single place.
+//
+// All the exchanged packages are JSON serializable so that the rounds can be
+// run by exchanging files. The round 1 packages of the key generation, the
+// signing commitments and the signature shares are public, but must be
+// exchanged over authenticated channels. The round 2 packages of the key
+// generation are encrypted for their recipient.
+package frost
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"filippo.io/edwards25519"
+)
+
+const (
+	// contextString is the context string of FROST(Ed25519, SHA-512).
+	contextString = "FROST-ED25519-SHA512-v1"
+
+	// SignatureSize is the size of the Ed25519 signatures produced by Aggregate.
+	SignatureSize = 64
+)
+
+// KeyShare is the share of a threshold key held by a participant.
+type KeyShare struct {
+	Identifier   uint16 `json:"identifier"`
+	Threshold    uint16 `json:"threshold"`
+	Participants uint16 `json:"participants"`
+	// SecretShare is the secret share of the participant.
+	SecretShare []byte `json:"secret_share"`
+	// GroupPublicKey is the Ed25519 public key of the threshold key.
+	GroupPublicKey []byte `json:"group_public_key"`
+	// VerificationShares are the public keys of the secret shares of all the
+	// participants, used to verify their signature shares.
+	VerificationShares map[uint16][]byte `json:"verification_shares"`
+}
+
+// SigningNonces are the secret nonces of a participant for a single signing.
+// They must never be reused.
+type SigningNonces struct {
+	Hiding  []byte `json:"hiding"`
+	Binding []byte `json:"binding"`
+}
+
+// SigningCommitment is the public commitment to the SigningNonces of a
+// participant.
+type SigningCommitment struct {
+	Identifier uint16 `json:"identifier"`
+	Hiding     []byte `json:"hiding"`
+	Binding    []byte `json:"binding"`
+}
+
+// SigningPackage is the message to sign along with the commitments of the
+// participants signing it, at least as many as the threshold.
+type SigningPackage struct {
+	Message     []byte              `json:"message"`
+	Commitments []SigningCommitment `json:"commitments"`
+}
+
+// SignatureShare is the share of the signature of a SigningPackage computed by
+// a participant.
+type SignatureShare struct {
+	Identifier uint16 `json:"identifier"`
+	Share      []byte `json:"share"`
+}
+
+// SignRound1 generates the nonces of the participant for a single signing,
+// along with their commitment to share with the other signers.
+func SignRound1(ks *KeyShare) (*SigningNonces, *SigningCommitment, error) {
+	secret, err := decodeScalar(ks.SecretShare)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hiding, err := generateNonce(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	binding, err := generateNonce(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonces := &SigningNonces{Hiding: hiding.Bytes(), Binding: binding.Bytes()}
+	commitment := &SigningCommitment{
+		Identifier: ks.Identifier,
+		Hiding:     new(edwards25519.Point).ScalarBaseMult(hiding).Bytes(),
+		Binding:    new(edwards25519.Point).ScalarBaseMult(binding).Bytes(),
+	}
+
+	return nonces, commitment, nil
+}
+
+// SignRound2 computes the signature share of the participant for the given
+// signing package, which must include the commitment to its nonces.
+func SignRound2(ks *KeyShare, nonces *SigningNonces, pkg *SigningPackage) (*SignatureShare, error) {
+	commitments, err := decodeCommitments(ks, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := decodeScalar(ks.SecretShare)
+	if err != nil {
+		return nil, err
+	}
+
+	hiding, err := decodeScalar(nonces.Hiding)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := decodeScalar(nonces.Binding)
+	if err != nil {
+		return nil, err
+	}
+
+	own, ok := commitments[ks.Identifier]
+	if !ok {
+		return nil, fmt.Errorf("signing package does not include the commitment of participant %d", ks.Identifier)
+	}
+
+	if own.hiding.Equal(new(edwards25519.Point).ScalarBaseMult(hiding)) != 1 ||
+		own.binding.Equal(new(edwards25519.Point).ScalarBaseMult(binding)) != 1 {
+		return nil, errors.New("signing package commitment does not match the nonces")
+	}
+
+	groupPubKey, err := decodePoint(ks.GroupPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bindingFactors := computeBindingFactors(groupPubKey, commitments, pkg.Message)
+	groupCommitment := computeGroupCommitment(commitments, bindingFactors)
+	lambda := deriveInterpolatingValue(commitments, ks.Identifier)
+	challenge := computeChallenge(groupCommitment, groupPubKey, pkg.Message)
+
+	// share = hiding + binding * binding_factor + lambda * secret * challenge
+	share := new(edwards25519.Scalar).Multiply(lambda, secret)
+	share.Multiply(share, challenge)
+	share.MultiplyAdd(binding, bindingFactors[ks.Identifier], share)
+	share.Add(share, hiding)
+
+	return &SignatureShare{Identifier: ks.Identifier, Share: share.Bytes()}, nil
+}
+
+// Aggregate verifies the signature shares of the signers of the given signing
+// package and aggregates them into an Ed25519 signature of the message by the
+// group public key.
+func Aggregate(ks *KeyShare, pkg *SigningPackage, shares []SignatureShare) ([]byte, error) {
+	commitments, err := decodeCommitments(ks, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shares) != len(commitments) {
+		return nil, fmt.Errorf("expected %d signature shares, got %d", len(commitments), len(shares))
+	}
+
+	groupPubKey, err := decodePoint(ks.GroupPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bindingFactors := computeBindingFactors(groupPubKey, commitments, pkg.Message)
+	groupCommitment := computeGroupCommitment(commitments, bindingFactors)
+	challenge := computeChallenge(groupCommitment, groupPubKey, pkg.Message)
+
+	z := edwards25519.NewScalar()
+	seen := make(map[uint16]bool, len(shares))
+	for _, share := range shares {
+		c, ok := commitments[share.Identifier]
+		if !ok || seen[share.Identifier] {
+			return nil, fmt.Errorf("unexpected signature share of participant %d", share.Identifier)
+		}
+		seen[share.Identifier] = true
+
+		zi, err := decodeScalar(share.Share)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature share of participant %d: %w", share.Identifier, err)
+		}
+
+		verificationShare, err := decodePoint(ks.VerificationShares[share.Identifier])
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification share of participant %d: %w", share.Identifier, err)
+		}
+
+		// zi * G == hiding + binding * binding_factor + verification_share * challenge * lambda
+		lambda := deriveInterpolatingValue(commitments, share.Identifier)
+		commShare := new(edwards25519.Point).ScalarMult(bindingFactors[share.Identifier], c.binding)
+		commShare.Add(commShare, c.hiding)
+		r := new(edwards25519.Point).ScalarMult(new(edwards25519.Scalar).Multiply(challenge, lambda), verificationShare)
+		r.Add(r, commShare)
+		if new(edwards25519.Point).ScalarBaseMult(zi).Equal(r) != 1 {
+			return nil, fmt.Errorf("invalid signature share of participant %d", share.Identifier)
+		}
+
+		z.Add(z, zi)
+	}
+
+	return append(groupCommitment.Bytes(), z.Bytes()...), nil
+}
+
+// commitment is a decoded SigningCommitment.
+type commitment struct {
+	hiding  *edwards25519.Point
+	binding *edwards25519.Point
+}
+
+// decodeCommitments decodes and validates the commitments of a signing
+// package, by identifier.
+func decodeCommitments(ks *KeyShare, pkg *SigningPackage) (map[uint16]commitment, error) {
+	if len(pkg.Commitments) < int(ks.Threshold) {
+		return nil, fmt.Errorf("expected at least %d commitments, got %d", ks.Threshold, len(pkg.Commitments))
+	}
+
+	commitments := make(map[uint16]commitment, len(pkg.Commitments))
+	for _, c := range pkg.Commitments {
+		if _, ok := ks.VerificationShares[c.Identifier]; !ok {
+			return nil, fmt.Errorf("unknown participant %d", c.Identifier)
+		}
+
+		if _, ok := commitments[c.Identifier]; ok {
+			return nil, fmt.Errorf("duplicate commitment of participant %d", c.Identifier)
+		}
+
+		hiding, err := decodePoint(c.Hiding)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment of participant %d: %w", c.Identifier, err)
+		}
+
+		binding, err := decodePoint(c.Binding)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commitment of participant %d: %w", c.Identifier, err)
+		}
+
+		commitments[c.Identifier] = commitment{hiding: hiding, binding: binding}
+	}
+
+	return commitments, nil
+}
+
+// sortedIdentifiers returns the identifiers of the given commitments in
+// ascending order.
+func sortedIdentifiers(commitments map[uint16]commitment) []uint16 {
+	ids := make([]uint16, 0, len(commitments))
+	for id := range commitments {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// computeBindingFactors computes the binding factor of each signer.
+func computeBindingFactors(groupPubKey *edwards25519.Point, commitments map[uint16]commitment, msg []byte) map[uint16]*edwards25519.Scalar {
+	var encodedCommitments []byte
+	for _, id := range sortedIdentifiers(commitments) {
+		c := commitments[id]
+		encodedCommitments = append(encodedCommitments, identifierScalar(id).Bytes()...)
+		encodedCommitments = append(encodedCommitments, c.hiding.Bytes()...)
+		encodedCommitments = append(encodedCommitments, c.binding.Bytes()...)
+	}
+
+	msgHash := h4(msg)
+	commitmentsHash := h5(encodedCommitments)
+
+	prefix := append(append(groupPubKey.Bytes(), msgHash...), commitmentsHash...)
+	bindingFactors := make(map[uint16]*edwards25519.Scalar, len(commitments))
+	for id := range commitments {
+		input := append(append([]byte{}, prefix...), identifierScalar(id).Bytes()...)
+		bindingFactors[id] = h1(input)
+	}
+
+	return bindingFactors
+}
+
+// computeGroupCommitment computes the commitment of the group signature.
+func computeGroupCommitment(commitments map[uint16]commitment, bindingFactors map[uint16]*edwards25519.Scalar) *edwards25519.Point {
+	groupCommitment := edwards25519.NewIdentityPoint()
+	for id, c := range commitments {
+		groupCommitment.Add(groupCommitment, c.hiding)
+		groupCommitment.Add(groupCommitment, new(edwards25519.Point).ScalarMult(bindingFactors[id], c.binding))
+	}
+	return groupCommitment
+}
+
+// deriveInterpolatingValue computes the Lagrange coefficient at 0 of the given
+// signer among the signers of the commitments.
+func deriveInterpolatingValue(commitments map[uint16]commitment, id uint16) *edwards25519.Scalar {
+	xi := identifierScalar(id)
+	numerator := identifierScalar(1)
+	denominator := identifierScalar(1)
+	for other := range commitments {
+		if other == id {
+			continue
+		}
+
+		xj := identifierScalar(other)
+		numerator.Multiply(numerator, xj)
+		denominator.Multiply(denominator, new(edwards25519.Scalar).Subtract(xj, xi))
+	}
+
+	return numerator.Multiply(numerator, new(edwards25519.Scalar).Invert(denominator))
+}
+
+// computeChallenge computes the Ed25519 challenge of the signature.
+func computeChallenge(groupCommitment, groupPubKey *edwards25519.Point, msg []byte) *edwards25519.Scalar {
+	return h2(append(append(groupCommitment.Bytes(), groupPubKey.Bytes()...), msg...))
+}
+
+// generateNonce generates a nonce from randomness and the secret, as a
+// mitigation for bad randomness.
+func generateNonce(secret *edwards25519.Scalar) (*edwards25519.Scalar, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+
+	return deriveNonce(randomBytes, secret), nil
+}
+
+// deriveNonce derives a nonce from the given randomness and the secret.
+func deriveNonce(randomBytes []byte, secret *edwards25519.Scalar) *edwards25519.Scalar {
+	return h3(append(append([]byte{}, randomBytes...), secret.Bytes()...))
+}
+
+// randomScalar returns a uniformly random scalar.
+func randomScalar() (*edwards25519.Scalar, error) {
+	bz := make([]byte, 64)
+	if _, err := rand.Read(bz); err != nil {
+		return nil, err
+	}
+
+	return edwards25519.NewScalar().SetUniformBytes(bz)
+}
+
+// identifierScalar returns the scalar of a participant identifier.
+func identifierScalar(id uint16) *edwards25519.Scalar {
+	bz := make([]byte, 32)
+	binary.LittleEndian.PutUint16(bz, id)
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(bz)
+	if err != nil {
+		panic(err) // unreachable, any uint16 is canonical
+	}
+	return s
+}
+
+func decodeScalar(bz []byte) (*edwards25519.Scalar, error) {
+	return edwards25519.NewScalar().SetCanonicalBytes(bz)
+}
+
+// decodePoint decodes a point, which must not be the identity and must be in
+// the prime order subgroup.
+func decodePoint(bz []byte) (*edwards25519.Point, error) {
+	p, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, errors.New("identity point")
+	}
+
+	// [L]P = [L-1]P + P is the identity iff P is in the prime order subgroup
+	minusOne := new(edwards25519.Scalar).Negate(identifierScalar(1))
+	lp := new(edwards25519.Point).ScalarMult(minusOne, p)
+	if lp.Add(lp, p).Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return nil, errors.New("point is not in the prime order subgroup")
+	}
+
+	return p, nil
+}
+
+func hashToScalar(parts ...[]byte) *edwards25519.Scalar {
+	s, err := edwards25519.NewScalar().SetUniformBytes(hash(parts...))
+	if err != nil {
+		panic(err) // unreachable, the hash is 64 bytes
+	}
+	return s
+}
+
+func hash(parts ...[]byte) []byte {
+	h := sha512.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
+
+func h1(m []byte) *edwards25519.Scalar {
+	return hashToScalar([]byte(contextString), []byte("rho"), m)
+}
+
+func h2(m []byte) *edwards25519.Scalar {
+	return hashToScalar(m)
+}
+
+func h3(m []byte) *edwards25519.Scalar {
+	return hashToScalar([]byte(contextString), []byte("nonce"), m)
+}
+
+func h4(m []byte) []byte {
+	return hash([]byte(contextString), []byte("msg"), m)
+}
+
+func h5(m []byte) []byte {
+	return hash([]byte(contextString), []byte("com"), m)
+}
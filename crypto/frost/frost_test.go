@@ -0,0 +1,295 @@
+package frost_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+)
+
+// keyGen runs the distributed key generation of a threshold-of-participants
+// key, returning the key shares of all the participants.
+func keyGen(t *testing.T, threshold, participants uint16) []*frost.KeyShare {
+	t.Helper()
+
+	secrets := make([]*frost.DKGSecret, participants)
+	var round1 []frost.DKGRound1Package
+	for i := range secrets {
+		secret, pkg, err := frost.DKGRound1(uint16(i+1), threshold, participants)
+		require.NoError(t, err)
+		secrets[i] = secret
+		round1 = append(round1, *pkg)
+	}
+
+	var round2 []frost.DKGRound2Package
+	for _, secret := range secrets {
+		pkgs, err := frost.DKGRound2(secret, round1)
+		require.NoError(t, err)
+		require.Len(t, pkgs, int(participants)-1)
+		round2 = append(round2, pkgs...)
+	}
+
+	shares := make([]*frost.KeyShare, participants)
+	for i, secret := range secrets {
+		ks, err := frost.DKGFinalize(secret, round2)
+		require.NoError(t, err)
+		shares[i] = ks
+	}
+
+	for _, ks := range shares[1:] {
+		require.Equal(t, shares[0].GroupPublicKey, ks.GroupPublicKey)
+		require.Equal(t, shares[0].VerificationShares, ks.VerificationShares)
+	}
+
+	return shares
+}
+
+// sign runs the signing rounds of the given signers, returning the signing
+// package and the signature shares.
+func sign(t *testing.T, msg []byte, signers ...*frost.KeyShare) (*frost.SigningPackage, []frost.SignatureShare) {
+	t.Helper()
+
+	pkg := &frost.SigningPackage{Message: msg}
+	nonces := make([]*frost.SigningNonces, len(signers))
+	for i, ks := range signers {
+		n, commitment, err := frost.SignRound1(ks)
+		require.NoError(t, err)
+		nonces[i] = n
+		pkg.Commitments = append(pkg.Commitments, *commitment)
+	}
+
+	var shares []frost.SignatureShare
+	for i, ks := range signers {
+		share, err := frost.SignRound2(ks, nonces[i], pkg)
+		require.NoError(t, err)
+		shares = append(shares, *share)
+	}
+
+	return pkg, shares
+}
+
+func TestThresholdSignature(t *testing.T) {
+	shares := keyGen(t, 2, 3)
+	msg := []byte("threshold message")
+
+	for _, signers := range [][]*frost.KeyShare{
+		{shares[0], shares[1]},
+		{shares[0], shares[2]},
+		{shares[1], shares[2]},
+		{shares[0], shares[1], shares[2]},
+	} {
+		pkg, sigShares := sign(t, msg, signers...)
+		sig, err := frost.Aggregate(shares[0], pkg, sigShares)
+		require.NoError(t, err)
+		require.Len(t, sig, frost.SignatureSize)
+		require.True(t, ed25519.Verify(shares[0].GroupPublicKey, msg, sig))
+		require.False(t, ed25519.Verify(shares[0].GroupPublicKey, []byte("other message"), sig))
+	}
+}
+
+// TestRFC9591Vectors checks the signing of the FROST(Ed25519, SHA-512) test
+// vectors of RFC 9591, Appendix E.1, by the participants 1 and 3 of a 2-of-3
+// key.
+func TestRFC9591Vectors(t *testing.T) {
+	decode := func(s string) []byte {
+		bz, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return bz
+	}
+
+	groupSecretKey := decode("7b1c33d3f5291d85de664833beb1ad469f7fb6025a0ec78b3a790c6e13a98304")
+	groupPublicKey := decode("15d21ccd7ee42959562fc8aa63224c8851fb3ec85a3faf66040d380fb9738673")
+	coefficient := decode("178199860edd8c62f5212ee91eff1295d0d670ab4ed4506866bae57e7030b204")
+	msg := decode("74657374")
+
+	participantShares := map[uint16][]byte{
+		1: decode("929dcc590407aae7d388761cddb0c0db6f5627aea8e217f4a033f2ec83d93509"),
+		2: decode("a91e66e012e4364ac9aaa405fcafd370402d9859f7b6685c07eed76bf409e80d"),
+		3: decode("d3cb090a075eb154e82fdb4b3cb507f110040905468bb9c46da8bdea643a9a02"),
+	}
+
+	signers := []struct {
+		identifier        uint16
+		hidingRandomness  []byte
+		bindingRandomness []byte
+		hidingNonce       []byte
+		bindingNonce      []byte
+		hidingCommitment  []byte
+		bindingCommitment []byte
+		sigShare          []byte
+	}{
+		{
+			identifier:        1,
+			hidingRandomness:  decode("0fd2e39e111cdc266f6c0f4d0fd45c947761f1f5d3cb583dfcb9bbaf8d4c9fec"),
+			bindingRandomness: decode("69cd85f631d5f7f2721ed5e40519b1366f340a87c2f6856363dbdcda348a7501"),
+			hidingNonce:       decode("812d6104142944d5a55924de6d49940956206909f2acaeedecda2b726e630407"),
+			bindingNonce:      decode("b1110165fc2334149750b28dd813a39244f315cff14d4e89e6142f262ed83301"),
+			hidingCommitment:  decode("b5aa8ab305882a6fc69cbee9327e5a45e54c08af61ae77cb8207be3d2ce13de3"),
+			bindingCommitment: decode("67e98ab55aa310c3120418e5050c9cf76cf387cb20ac9e4b6fdb6f82a469f932"),
+			sigShare:          decode("001719ab5a53ee1a12095cd088fd149702c0720ce5fd2f29dbecf24b7281b603"),
+		},
+		{
+			identifier:        3,
+			hidingRandomness:  decode("86d64a260059e495d0fb4fcc17ea3da7452391baa494d4b00321098ed2a0062f"),
+			bindingRandomness: decode("13e6b25afb2eba51716a9a7d44130c0dbae0004a9ef8d7b5550c8a0e07c61775"),
+			hidingNonce:       decode("c256de65476204095ebdc01bd11dc10e57b36bc96284595b8215222374f99c0e"),
+			bindingNonce:      decode("243d71944d929063bc51205714ae3c2218bd3451d0214dfb5aeec2a90c35180d"),
+			hidingCommitment:  decode("cfbdb165bd8aad6eb79deb8d287bcc0ab6658ae57fdcc98ed12c0669e90aec91"),
+			bindingCommitment: decode("7487bc41a6e712eea2f2af24681b58b1cf1da278ea11fe4e8b78398965f13552"),
+			sigShare:          decode("bd86125de990acc5e1f13781d8e32c03a9bbd4c53539bbc106058bfd14326007"),
+		},
+	}
+
+	sig := decode("36282629c383bb820a88b71cae937d41f2f2adfcc3d02e55507e2fb9e2dd3cbe" +
+		"bd9d2b0844e49ae0f3fa935161e1419aab7b47d21a37ebeae1f17d4987b3160b")
+
+	// the group key and the participant shares, f(i) = secret + coefficient * i
+	secret, err := edwards25519.NewScalar().SetCanonicalBytes(groupSecretKey)
+	require.NoError(t, err)
+	require.Equal(t, groupPublicKey, new(edwards25519.Point).ScalarBaseMult(secret).Bytes())
+
+	a1, err := edwards25519.NewScalar().SetCanonicalBytes(coefficient)
+	require.NoError(t, err)
+
+	verificationShares := make(map[uint16][]byte, len(participantShares))
+	for id, share := range participantShares {
+		x, err := edwards25519.NewScalar().SetCanonicalBytes(append([]byte{byte(id)}, make([]byte, 31)...))
+		require.NoError(t, err)
+		require.Equal(t, share, new(edwards25519.Scalar).MultiplyAdd(a1, x, secret).Bytes())
+
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(share)
+		require.NoError(t, err)
+		verificationShares[id] = new(edwards25519.Point).ScalarBaseMult(s).Bytes()
+	}
+
+	keyShare := func(id uint16) *frost.KeyShare {
+		return &frost.KeyShare{
+			Identifier:         id,
+			Threshold:          2,
+			Participants:       3,
+			SecretShare:        participantShares[id],
+			GroupPublicKey:     groupPublicKey,
+			VerificationShares: verificationShares,
+		}
+	}
+
+	// round one
+	pkg := &frost.SigningPackage{Message: msg}
+	nonces := make([]*frost.SigningNonces, len(signers))
+	for i, signer := range signers {
+		hiding, err := frost.DeriveNonce(signer.hidingRandomness, participantShares[signer.identifier])
+		require.NoError(t, err)
+		require.Equal(t, signer.hidingNonce, hiding)
+
+		binding, err := frost.DeriveNonce(signer.bindingRandomness, participantShares[signer.identifier])
+		require.NoError(t, err)
+		require.Equal(t, signer.bindingNonce, binding)
+
+		for _, c := range []struct{ nonce, commitment []byte }{{hiding, signer.hidingCommitment}, {binding, signer.bindingCommitment}} {
+			s, err := edwards25519.NewScalar().SetCanonicalBytes(c.nonce)
+			require.NoError(t, err)
+			require.Equal(t, c.commitment, new(edwards25519.Point).ScalarBaseMult(s).Bytes())
+		}
+
+		nonces[i] = &frost.SigningNonces{Hiding: hiding, Binding: binding}
+		pkg.Commitments = append(pkg.Commitments, frost.SigningCommitment{
+			Identifier: signer.identifier,
+			Hiding:     signer.hidingCommitment,
+			Binding:    signer.bindingCommitment,
+		})
+	}
+
+	// round two
+	var sigShares []frost.SignatureShare
+	for i, signer := range signers {
+		share, err := frost.SignRound2(keyShare(signer.identifier), nonces[i], pkg)
+		require.NoError(t, err)
+		require.Equal(t, signer.sigShare, share.Share)
+		sigShares = append(sigShares, *share)
+	}
+
+	aggregated, err := frost.Aggregate(keyShare(2), pkg, sigShares)
+	require.NoError(t, err)
+	require.Equal(t, sig, aggregated)
+	require.True(t, ed25519.Verify(groupPublicKey, msg, aggregated))
+}
+
+func TestSignBelowThreshold(t *testing.T) {
+	shares := keyGen(t, 2, 3)
+
+	_, commitment, err := frost.SignRound1(shares[0])
+	require.NoError(t, err)
+	nonces, _, err := frost.SignRound1(shares[0])
+	require.NoError(t, err)
+
+	pkg := &frost.SigningPackage{Message: []byte("msg"), Commitments: []frost.SigningCommitment{*commitment}}
+	_, err = frost.SignRound2(shares[0], nonces, pkg)
+	require.Error(t, err)
+}
+
+func TestSignMismatchedNonces(t *testing.T) {
+	shares := keyGen(t, 2, 3)
+
+	pkg := &frost.SigningPackage{Message: []byte("msg")}
+	for _, ks := range shares[:2] {
+		_, commitment, err := frost.SignRound1(ks)
+		require.NoError(t, err)
+		pkg.Commitments = append(pkg.Commitments, *commitment)
+	}
+
+	nonces, _, err := frost.SignRound1(shares[0])
+	require.NoError(t, err)
+	_, err = frost.SignRound2(shares[0], nonces, pkg)
+	require.ErrorContains(t, err, "does not match the nonces")
+}
+
+func TestAggregateInvalidShare(t *testing.T) {
+	shares := keyGen(t, 2, 3)
+
+	pkg, sigShares := sign(t, []byte("msg"), shares[0], shares[1])
+	sigShares[1].Share = sigShares[0].Share
+	_, err := frost.Aggregate(shares[2], pkg, sigShares)
+	require.Error(t, err)
+}
+
+func TestDKGInvalidPackages(t *testing.T) {
+	_, _, err := frost.DKGRound1(1, 1, 3)
+	require.Error(t, err)
+	_, _, err = frost.DKGRound1(4, 2, 3)
+	require.Error(t, err)
+
+	secret1, pkg1, err := frost.DKGRound1(1, 2, 2)
+	require.NoError(t, err)
+	secret2, pkg2, err := frost.DKGRound1(2, 2, 2)
+	require.NoError(t, err)
+
+	// invalid proof of knowledge
+	forged := *pkg2
+	forged.ProofMu = pkg1.ProofMu
+	_, err = frost.DKGRound2(secret1, []frost.DKGRound1Package{*pkg1, forged})
+	require.ErrorContains(t, err, "proof of knowledge")
+
+	// missing package
+	_, err = frost.DKGRound2(secret1, []frost.DKGRound1Package{*pkg1})
+	require.Error(t, err)
+
+	round2, err := frost.DKGRound2(secret2, []frost.DKGRound1Package{*pkg1, *pkg2})
+	require.NoError(t, err)
+	_, err = frost.DKGRound2(secret1, []frost.DKGRound1Package{*pkg1, *pkg2})
+	require.NoError(t, err)
+
+	// tampered share
+	tampered := round2[0]
+	tampered.EncryptedShare = append([]byte{}, tampered.EncryptedShare...)
+	tampered.EncryptedShare[0] ^= 1
+	_, err = frost.DKGFinalize(secret1, []frost.DKGRound2Package{tampered})
+	require.Error(t, err)
+
+	ks, err := frost.DKGFinalize(secret1, round2)
+	require.NoError(t, err)
+	require.Len(t, ks.GroupPublicKey, ed25519.PublicKeySize)
+}
@@ -37,4 +37,8 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend delegates listing the keys and signing to a remote signer over gRPC
+//		with mutual TLS, configured by the keyring-remote/config.json file of the keyring
+//		directory. The host using it never holds key material. NewRemoteSignerServer
+//		returns a reference remote signer serving the keys of a keyring of any backend.
 package keyring
@@ -56,7 +56,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 
 	// Get the db keyring used in the keystore.
@@ -179,7 +179,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -201,6 +201,8 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendRemote:
+		return newRemoteBackend(rootDir, cdc)
 	default:
 		return nil, errorsmod.Wrap(ErrUnknownBacked, backend)
 	}
@@ -526,6 +528,13 @@ func (ks keystore) Delete(uid string) error {
 		return err
 	}
 
+	// threshold keys also store the key share of the participant
+	if _, err := ks.db.Get(thresholdKey(uid)); err == nil {
+		if err := ks.db.Remove(thresholdKey(uid)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
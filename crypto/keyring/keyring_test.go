@@ -20,6 +20,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -359,6 +360,48 @@ func TestOfflineKey(t *testing.T) {
 	}
 }
 
+func TestThresholdKey(t *testing.T) {
+	cdc := getCodec()
+	for _, backend := range []string{BackendTest, BackendMemory} {
+		t.Run(backend, func(t *testing.T) {
+			kb, err := New("keybasename", backend, t.TempDir(), nil, cdc)
+			require.NoError(t, err)
+
+			secret, _, err := frost.DKGRound1(1, 2, 3)
+			require.NoError(t, err)
+			require.NoError(t, SetThresholdShare(kb, "threshold", &ThresholdShare{DKG: secret}))
+
+			share, err := GetThresholdShare(kb, "threshold")
+			require.NoError(t, err)
+			require.Equal(t, secret, share.DKG)
+
+			// the key generation in progress is not a key
+			keys, err := kb.List()
+			require.NoError(t, err)
+			require.Empty(t, keys)
+
+			pub := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
+			keyShare := &frost.KeyShare{Identifier: 1, Threshold: 2, Participants: 3, GroupPublicKey: pub.Key}
+			k, err := SaveThresholdKey(kb, "threshold", keyShare)
+			require.NoError(t, err)
+			require.NotNil(t, k.GetOffline())
+
+			key, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Equal(t, pub, key)
+
+			share, err = GetThresholdShare(kb, "threshold")
+			require.NoError(t, err)
+			require.Nil(t, share.DKG)
+			require.Equal(t, keyShare, share.KeyShare)
+
+			require.NoError(t, kb.Delete("threshold"))
+			_, err = GetThresholdShare(kb, "threshold")
+			require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+		})
+	}
+}
+
 func TestSignVerifyKeyRing(t *testing.T) {
 	dir := t.TempDir()
 	cdc := getCodec()
@@ -0,0 +1,326 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// BackendRemote is the keyring backend delegating to a remote signer, see
+	// NewRemote.
+	BackendRemote = "remote"
+
+	keyringRemoteDirName    = "keyring-remote"
+	keyringRemoteConfigName = "config.json"
+)
+
+// ErrRemoteUnsupported is raised when calling a keyring method not supported
+// by the remote signer, which only lists the keys and signs with them.
+var ErrRemoteUnsupported = errors.New("unsupported by the remote keyring")
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteConfig is the configuration of the connection to a remote signer,
+// authenticated with mutual TLS.
+//
+// The remote backend reads it from the keyring-remote/config.json file of the
+// keyring directory, relative paths being relative to that directory.
+type RemoteConfig struct {
+	// Address is the address of the remote signer.
+	Address string `json:"address"`
+	// CertFile and KeyFile are the PEM encoded certificate and key of the
+	// client.
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// CAFile is the PEM encoded certificate authority of the remote signer
+	// certificate.
+	CAFile string `json:"ca_file"`
+	// ServerName overrides the name the remote signer certificate is
+	// verified against, the host of the address by default.
+	ServerName string `json:"server_name,omitempty"`
+}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer, so that
+// the host using it never holds key material.
+type remoteKeystore struct {
+	client RemoteSignerClient
+	// codec is the gRPC codec of the remote signer service calls.
+	codec grpc.CallOption
+	cdc   codec.Codec
+}
+
+// NewRemote returns a keyring delegating to the remote signer served over the
+// given connection, see NewRemoteSignerServer. The remote keyring only lists
+// the keys of the remote signer and signs with them, all the other methods
+// return ErrRemoteUnsupported.
+func NewRemote(conn grpc.ClientConnInterface, cdc codec.Codec) Keyring {
+	return remoteKeystore{
+		client: NewRemoteSignerClient(conn),
+		codec:  grpc.ForceCodec(remoteSignerCodec(cdc)),
+		cdc:    cdc,
+	}
+}
+
+// DialRemote connects to the remote signer of the given configuration.
+func DialRemote(cfg RemoteConfig, cdc codec.Codec) (Keyring, error) {
+	tlsConfig, err := NewRemoteClientTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemote(conn, cdc), nil
+}
+
+// NewRemoteClientTLSConfig returns the mutual TLS configuration of the client
+// of a remote signer.
+func NewRemoteClientTLSConfig(cfg RemoteConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := loadCertPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   cfg.ServerName,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// newRemoteBackend connects to the remote signer configured in the keyring
+// directory.
+func newRemoteBackend(rootDir string, cdc codec.Codec) (Keyring, error) {
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+	bz, err := os.ReadFile(filepath.Join(dir, keyringRemoteConfigName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the remote keyring configuration: %w", err)
+	}
+
+	var cfg RemoteConfig
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid remote keyring configuration: %w", err)
+	}
+
+	for _, file := range []*string{&cfg.CertFile, &cfg.KeyFile, &cfg.CAFile} {
+		if *file != "" && !filepath.IsAbs(*file) {
+			*file = filepath.Join(dir, *file)
+		}
+	}
+
+	return DialRemote(cfg, cdc)
+}
+
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// DB returns nil, as the keys are held by the remote signer.
+func (ks remoteKeystore) DB() keyring.Keyring {
+	return nil
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	res, err := ks.client.List(context.Background(), &RemoteListRequest{}, ks.codec)
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return res.Records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return SigningAlgoList{}, SigningAlgoList{}
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	return ks.key(&RemoteKeyRequest{Uid: uid})
+}
+
+func (ks remoteKeystore) KeyByAddress(address []byte) (*Record, error) {
+	return ks.key(&RemoteKeyRequest{Address: address})
+}
+
+func (ks remoteKeystore) key(req *RemoteKeyRequest) (*Record, error) {
+	res, err := ks.client.Key(context.Background(), req, ks.codec)
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	if res.Record == nil {
+		return nil, errors.New("the remote signer returned no record")
+	}
+
+	return res.Record, nil
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Uid: uid, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Address: address, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) sign(req *RemoteSignRequest) ([]byte, types.PubKey, error) {
+	res, err := ks.client.Sign(context.Background(), req, ks.codec)
+	if err != nil {
+		return nil, nil, fromRemoteError(err)
+	}
+
+	pubKey, ok := res.PubKey.GetCachedValue().(types.PubKey)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "the remote signer returned a %T public key", res.PubKey.GetCachedValue())
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.armorPubKey(k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.armorPubKey(k)
+}
+
+func (ks remoteKeystore) armorPubKey(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) DeleteByAddress([]byte) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+// fromRemoteError converts the gRPC errors of the remote signer to the errors
+// of the local keyring.
+func fromRemoteError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, st.Message())
+	case codes.FailedPrecondition:
+		return errorsmod.Wrap(ErrOfflineSign, st.Message())
+	default:
+		return err
+	}
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+
+	return pool, nil
+}
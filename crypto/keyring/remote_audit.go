@@ -0,0 +1,109 @@
+package keyring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Outcomes of the requests of a remote signer, as recorded in its audit log.
+const (
+	RemoteAuditOutcomeOK       = "ok"
+	RemoteAuditOutcomeRejected = "rejected"
+	RemoteAuditOutcomeFailed   = "failed"
+)
+
+// RemoteAuditEntry is an entry of the audit log of a remote signer, recording
+// a request along with the identity of its client and its outcome.
+type RemoteAuditEntry struct {
+	Time time.Time `json:"time"`
+	// Peer is the identity of the client, the common name of its certificate.
+	Peer string `json:"peer"`
+	// Method is the remote signer method called: Key, List or Sign.
+	Method string `json:"method"`
+	// Key is the name of the key, or the requested uid if it was not found.
+	Key      string `json:"key,omitempty"`
+	Address  []byte `json:"address,omitempty"`
+	SignMode string `json:"sign_mode,omitempty"`
+	// MsgHash is the SHA-256 hash of the message to sign.
+	MsgHash []byte `json:"msg_hash,omitempty"`
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+// RemoteAuditSink records the audit log of a remote signer. The remote signer
+// does not return the signatures whose request could not be recorded.
+type RemoteAuditSink interface {
+	Append(RemoteAuditEntry) error
+}
+
+// nopRemoteAuditSink is the audit sink of a remote signer without audit log.
+type nopRemoteAuditSink struct{}
+
+func (nopRemoteAuditSink) Append(RemoteAuditEntry) error { return nil }
+
+var _ RemoteAuditSink = &RemoteAuditFile{}
+
+// RemoteAuditFile is an append-only audit log file, holding an entry per line
+// as JSON. Each entry is synced to disk before Append returns.
+type RemoteAuditFile struct {
+	mu     sync.Mutex
+	f      *os.File
+	closed bool
+}
+
+// OpenRemoteAuditFile opens the audit log file at the given path, creating it
+// if needed. It is only ever appended to, the existing entries are kept.
+func OpenRemoteAuditFile(path string) (*RemoteAuditFile, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	if !info.Mode().IsRegular() {
+		return nil, errors.Join(fmt.Errorf("the audit log %s is not a regular file", path), f.Close())
+	}
+
+	return &RemoteAuditFile{f: f}, nil
+}
+
+// Append appends the entry to the audit log and syncs it to disk.
+func (a *RemoteAuditFile) Append(entry RemoteAuditEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return os.ErrClosed
+	}
+
+	if _, err := a.f.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+
+	return a.f.Sync()
+}
+
+// Close closes the audit log file.
+func (a *RemoteAuditFile) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return nil
+	}
+	a.closed = true
+
+	return a.f.Close()
+}
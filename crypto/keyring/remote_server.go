@@ -0,0 +1,274 @@
+package keyring
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// RemoteSignApproval is a sign request received by a remote signer, to be
+// approved before signing.
+type RemoteSignApproval struct {
+	// Peer is the identity of the client, the common name of its certificate.
+	Peer string
+	// Key is the key to sign with.
+	Key *Record
+	// Msg is the message to sign.
+	Msg      []byte
+	SignMode signing.SignMode
+}
+
+// RemoteSignApprover approves the sign requests of a remote signer, rejecting
+// them by returning an error.
+type RemoteSignApprover func(ctx context.Context, req RemoteSignApproval) error
+
+// RemoteSignerOption overrides the configuration of a remote signer.
+type RemoteSignerOption func(*remoteSignerServer)
+
+// WithRemoteSignApprover sets the approver of the sign requests of a remote
+// signer, all of them being approved by default.
+func WithRemoteSignApprover(approver RemoteSignApprover) RemoteSignerOption {
+	return func(s *remoteSignerServer) {
+		s.approver = approver
+	}
+}
+
+// WithRemoteSignerAuditSink sets the sink of the audit log of a remote signer,
+// which records all the requests along with the identity of their clients and
+// their outcome, see OpenRemoteAuditFile.
+func WithRemoteSignerAuditSink(sink RemoteAuditSink) RemoteSignerOption {
+	return func(s *remoteSignerServer) {
+		s.audit = sink
+	}
+}
+
+var _ RemoteSignerServer = &remoteSignerServer{}
+
+type remoteSignerServer struct {
+	kr       Keyring
+	cdc      codec.Codec
+	approver RemoteSignApprover
+	audit    RemoteAuditSink
+}
+
+// NewRemoteSignerServer returns the gRPC server of a remote signer serving the
+// keys of the given keyring, of any backend, to the clients of the remote
+// keyring backend. The private keys never leave the keyring.
+//
+// The clients are authenticated with the given TLS configuration, which
+// should require and verify client certificates, see NewRemoteServerTLSConfig.
+func NewRemoteSignerServer(kr Keyring, cdc codec.Codec, tlsConfig *tls.Config, opts ...RemoteSignerOption) *grpc.Server {
+	s := &remoteSignerServer{
+		kr:    kr,
+		cdc:   cdc,
+		audit: nopRemoteAuditSink{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ForceServerCodec(remoteSignerCodec(cdc)),
+	)
+	RegisterRemoteSignerServer(server, s)
+
+	return server
+}
+
+// NewRemoteServerTLSConfig returns the mutual TLS configuration of a remote
+// signer, which only accepts the clients with a certificate issued by the
+// given client certificate authority.
+func NewRemoteServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func (s *remoteSignerServer) Key(ctx context.Context, req *RemoteKeyRequest) (*RemoteKeyResponse, error) {
+	entry := newRemoteAuditEntry(ctx, "Key", req.Uid, req.Address)
+
+	k, err := s.key(req.Uid, req.Address)
+	if err != nil {
+		return nil, s.fail(entry, RemoteAuditOutcomeFailed, err)
+	}
+
+	entry.Key = k.Name
+	if err := s.succeed(entry); err != nil {
+		return nil, err
+	}
+
+	return &RemoteKeyResponse{Record: publicRecord(k)}, nil
+}
+
+func (s *remoteSignerServer) List(ctx context.Context, _ *RemoteListRequest) (*RemoteListResponse, error) {
+	entry := newRemoteAuditEntry(ctx, "List", "", nil)
+
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, s.fail(entry, RemoteAuditOutcomeFailed, err)
+	}
+
+	if err := s.succeed(entry); err != nil {
+		return nil, err
+	}
+
+	res := &RemoteListResponse{Records: make([]*Record, len(records))}
+	for i, k := range records {
+		res.Records[i] = publicRecord(k)
+	}
+
+	return res, nil
+}
+
+func (s *remoteSignerServer) Sign(ctx context.Context, req *RemoteSignRequest) (*RemoteSignResponse, error) {
+	entry := newRemoteAuditEntry(ctx, "Sign", req.Uid, req.Address)
+	msgHash := sha256.Sum256(req.Msg)
+	entry.MsgHash = msgHash[:]
+	entry.SignMode = req.SignMode.String()
+
+	k, err := s.key(req.Uid, req.Address)
+	if err != nil {
+		return nil, s.fail(entry, RemoteAuditOutcomeFailed, err)
+	}
+	entry.Key = k.Name
+
+	if s.approver != nil {
+		approval := RemoteSignApproval{
+			Peer:     entry.Peer,
+			Key:      k,
+			Msg:      req.Msg,
+			SignMode: req.SignMode,
+		}
+		if err := s.approver(ctx, approval); err != nil {
+			return nil, s.fail(entry, RemoteAuditOutcomeRejected, err)
+		}
+	}
+
+	sig, pubKey, err := s.kr.Sign(k.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, s.fail(entry, RemoteAuditOutcomeFailed, err)
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, s.fail(entry, RemoteAuditOutcomeFailed, err)
+	}
+
+	// the signature is only returned once its request is in the audit log
+	if err := s.succeed(entry); err != nil {
+		return nil, err
+	}
+
+	return &RemoteSignResponse{Signature: sig, PubKey: pubKeyAny}, nil
+}
+
+func (s *remoteSignerServer) key(uid string, address []byte) (*Record, error) {
+	if len(address) > 0 {
+		return s.kr.KeyByAddress(address)
+	}
+
+	return s.kr.Key(uid)
+}
+
+// succeed records a successful request in the audit log, returning an error if
+// it could not be recorded.
+func (s *remoteSignerServer) succeed(entry RemoteAuditEntry) error {
+	entry.Outcome = RemoteAuditOutcomeOK
+	if err := s.audit.Append(entry); err != nil {
+		return status.Errorf(codes.Internal, "failed to write the audit log: %v", err)
+	}
+
+	return nil
+}
+
+// fail records a failed or rejected request in the audit log and returns its
+// gRPC error.
+func (s *remoteSignerServer) fail(entry RemoteAuditEntry, outcome string, err error) error {
+	entry.Outcome = outcome
+	entry.Error = err.Error()
+	if auditErr := s.audit.Append(entry); auditErr != nil {
+		return status.Errorf(codes.Internal, "failed to write the audit log: %v", auditErr)
+	}
+
+	if outcome == RemoteAuditOutcomeRejected {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return toRemoteError(err)
+}
+
+// newRemoteAuditEntry returns the audit log entry of a remote signer request.
+func newRemoteAuditEntry(ctx context.Context, method, uid string, address []byte) RemoteAuditEntry {
+	return RemoteAuditEntry{
+		Time:    time.Now().UTC(),
+		Peer:    remotePeer(ctx),
+		Method:  method,
+		Key:     uid,
+		Address: address,
+	}
+}
+
+// publicRecord returns a copy of a record without its private key.
+func publicRecord(k *Record) *Record {
+	pub := *k
+	if pub.GetLocal() != nil {
+		pub.Item = &Record_Local_{Local: &Record_Local{}}
+	}
+
+	return &pub
+}
+
+// remotePeer returns the identity of the client of a remote signer request.
+func remotePeer(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	}
+
+	return p.Addr.String()
+}
+
+// toRemoteError converts the errors of the keyring of a remote signer to gRPC
+// errors.
+func toRemoteError(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrOfflineSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,48 @@
+package keyring
+
+import (
+	"google.golang.org/grpc/encoding"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &RemoteKeyResponse{}
+	_ codectypes.UnpackInterfacesMessage = &RemoteListResponse{}
+	_ codectypes.UnpackInterfacesMessage = &RemoteSignResponse{}
+)
+
+// remoteSignerCodec returns the gRPC codec of the remote signer service, which
+// unpacks the public keys of the records and signatures with the interface
+// registry of cdc.
+func remoteSignerCodec(cdc codec.Codec) encoding.Codec {
+	return codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *RemoteKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Record == nil {
+		return nil
+	}
+
+	return m.Record.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *RemoteListResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, k := range m.Records {
+		if err := k.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *RemoteSignResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pk)
+}
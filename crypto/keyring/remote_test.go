@@ -0,0 +1,287 @@
+package keyring
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testCA is a certificate authority issuing the test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCA{cert: cert, key: key}
+}
+
+// writeCA writes the certificate of the CA to dir/name.
+func (ca testCA) writeCA(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+	return file
+}
+
+// issue writes a certificate of the given common name issued by the CA, and
+// its key, to dir/name.crt and dir/name.key.
+func (ca testCA) issue(t *testing.T, dir, name, commonName string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.writeCA(t, dir, "ca.crt")
+
+	// the keyring of the remote signer
+	kb := NewInMemory(cdc)
+	local, _, err := kb.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	localAddr, err := local.GetAddress()
+	require.NoError(t, err)
+
+	serverCert, serverKey := ca.issue(t, dir, "server", "signer")
+	serverTLS, err := NewRemoteServerTLSConfig(serverCert, serverKey, caFile)
+	require.NoError(t, err)
+
+	auditFile := filepath.Join(dir, "audit.log")
+	audit, err := OpenRemoteAuditFile(auditFile)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, audit.Close()) })
+	approver := func(_ context.Context, req RemoteSignApproval) error {
+		if req.Peer != "relayer" || string(req.Msg) == "rejected" {
+			return errors.New("rejected by policy")
+		}
+		return nil
+	}
+	server := NewRemoteSignerServer(kb, cdc, serverTLS,
+		WithRemoteSignApprover(approver),
+		WithRemoteSignerAuditSink(audit),
+	)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	// the client configuration in its keyring directory
+	clientDir := t.TempDir()
+	remoteDir := filepath.Join(clientDir, keyringRemoteDirName)
+	require.NoError(t, os.MkdirAll(remoteDir, 0o700))
+	ca.writeCA(t, remoteDir, "ca.crt")
+	ca.issue(t, remoteDir, "client", "relayer")
+	bz, err := json.Marshal(RemoteConfig{
+		Address:  lis.Addr().String(),
+		CertFile: "client.crt",
+		KeyFile:  "client.key",
+		CAFile:   "ca.crt",
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(remoteDir, keyringRemoteConfigName), bz, 0o600))
+
+	kr, err := New("keybasename", BackendRemote, clientDir, nil, cdc)
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "local", records[0].Name)
+	require.Equal(t, TypeLocal, records[0].GetType())
+	// the private key never leaves the remote signer
+	require.Nil(t, records[0].GetLocal().PrivKey)
+
+	k, err := kr.KeyByAddress(localAddr)
+	require.NoError(t, err)
+	require.Equal(t, "local", k.Name)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+
+	_, err = kr.Key("missing")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("message")
+	sig, signPubKey, err := kr.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, signPubKey)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(localAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("local", []byte("rejected"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "rejected by policy")
+
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	_, err = kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+
+	// clients with a certificate of another authority are rejected
+	otherCA := newTestCA(t)
+	certFile, keyFile := otherCA.issue(t, dir, "other", "relayer")
+	untrusted, err := DialRemote(RemoteConfig{
+		Address:  lis.Addr().String(),
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		CAFile:   caFile,
+	}, cdc)
+	require.NoError(t, err)
+	_, err = untrusted.List()
+	require.Error(t, err)
+
+	// all the requests of the trusted client are in the audit log
+	server.GracefulStop()
+	entries := readRemoteAuditFile(t, auditFile)
+	require.Len(t, entries, 7)
+	for _, entry := range entries {
+		require.Equal(t, "relayer", entry.Peer)
+	}
+	require.Equal(t, "List", entries[0].Method)
+	require.Equal(t, RemoteAuditOutcomeOK, entries[0].Outcome)
+	require.Equal(t, RemoteAuditOutcomeFailed, entries[2].Outcome)
+	require.Equal(t, "missing", entries[2].Key)
+
+	signed, rejected := entries[3], entries[5]
+	msgHash := sha256.Sum256(msg)
+	require.Equal(t, "Sign", signed.Method)
+	require.Equal(t, "local", signed.Key)
+	require.Equal(t, msgHash[:], signed.MsgHash)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT.String(), signed.SignMode)
+	require.Equal(t, RemoteAuditOutcomeOK, signed.Outcome)
+	require.Equal(t, RemoteAuditOutcomeRejected, rejected.Outcome)
+	require.Equal(t, "rejected by policy", rejected.Error)
+}
+
+// failingAuditSink is an audit sink failing to record the requests.
+type failingAuditSink struct{}
+
+func (failingAuditSink) Append(RemoteAuditEntry) error { return errors.New("disk full") }
+
+func TestRemoteSignerAuditFailure(t *testing.T) {
+	cdc := getCodec()
+	kb := NewInMemory(cdc)
+	_, _, err := kb.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	s := &remoteSignerServer{kr: kb, cdc: cdc, audit: failingAuditSink{}}
+
+	// the signatures are not returned if their request cannot be audited
+	res, err := s.Sign(context.Background(), &RemoteSignRequest{Uid: "local", Msg: []byte("message")})
+	require.ErrorContains(t, err, "failed to write the audit log")
+	require.Nil(t, res)
+}
+
+func TestRemoteAuditFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+
+	audit, err := OpenRemoteAuditFile(file)
+	require.NoError(t, err)
+	require.NoError(t, audit.Append(RemoteAuditEntry{Method: "List", Outcome: RemoteAuditOutcomeOK}))
+	require.NoError(t, audit.Close())
+	require.ErrorIs(t, audit.Append(RemoteAuditEntry{}), os.ErrClosed)
+
+	// reopening the audit log keeps its entries
+	audit, err = OpenRemoteAuditFile(file)
+	require.NoError(t, err)
+	require.NoError(t, audit.Append(RemoteAuditEntry{Method: "Sign", Outcome: RemoteAuditOutcomeRejected}))
+	require.NoError(t, audit.Close())
+
+	entries := readRemoteAuditFile(t, file)
+	require.Len(t, entries, 2)
+	require.Equal(t, "List", entries[0].Method)
+	require.Equal(t, "Sign", entries[1].Method)
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = OpenRemoteAuditFile(t.TempDir())
+	require.Error(t, err)
+}
+
+// readRemoteAuditFile reads the entries of an audit log file.
+func readRemoteAuditFile(t *testing.T, file string) []RemoteAuditEntry {
+	t.Helper()
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []RemoteAuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry RemoteAuditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+
+	return entries
+}
@@ -0,0 +1,74 @@
+package keyring
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/99designs/keyring"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+// ThresholdShare is the state of a participant of a FROST threshold key. As the
+// key itself never exists in a single place, a threshold key is stored as an
+// offline record of the Ed25519 group public key, along with the share of the
+// participant stored under the `<uid>.threshold` keyring item.
+type ThresholdShare struct {
+	// DKG is the secret state of the participant while the threshold key is
+	// being generated.
+	DKG *frost.DKGSecret `json:"dkg,omitempty"`
+	// KeyShare is the share of the threshold key of the participant, once
+	// generated.
+	KeyShare *frost.KeyShare `json:"key_share,omitempty"`
+	// Nonces are the nonces of the participant for the signing in progress,
+	// deleted once used.
+	Nonces *frost.SigningNonces `json:"nonces,omitempty"`
+}
+
+// SetThresholdShare stores the threshold share of the key `uid`.
+func SetThresholdShare(kr Keyring, uid string, share *ThresholdShare) error {
+	bz, err := json.Marshal(share)
+	if err != nil {
+		return errorsmod.Wrap(ErrUnableToSerialize, err.Error())
+	}
+
+	return kr.DB().Set(keyring.Item{
+		Key:  thresholdKey(uid),
+		Data: bz,
+	})
+}
+
+// GetThresholdShare returns the threshold share of the key `uid`.
+func GetThresholdShare(kr Keyring, uid string) (*ThresholdShare, error) {
+	item, err := kr.DB().Get(thresholdKey(uid))
+	if err != nil {
+		return nil, wrapKeyNotFound(err, fmt.Sprintf("threshold share of %s not found", uid))
+	}
+
+	share := &ThresholdShare{}
+	if err := json.Unmarshal(item.Data, share); err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// SaveThresholdKey saves the threshold key `uid` generated by the participant,
+// as an offline record of the group public key along with its key share.
+func SaveThresholdKey(kr Keyring, uid string, keyShare *frost.KeyShare) (*Record, error) {
+	k, err := kr.SaveOfflineKey(uid, &ed25519.PubKey{Key: keyShare.GroupPublicKey})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SetThresholdShare(kr, uid, &ThresholdShare{KeyShare: keyShare}); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+func thresholdKey(name string) string { return fmt.Sprintf("%s.%s", name, thresholdSuffix) }
@@ -38,6 +38,7 @@ const (
 	defaultEntropySize = 256
 	addressSuffix      = "address"
 	infoSuffix         = "info"
+	thresholdSuffix    = "threshold"
 )
 
 // KeyType reflects a human-readable type for key listing.
@@ -4,9 +4,9 @@ const (
 	PrivKeyName = "cometbft/PrivKeyBls12_381"
 	PubKeyName  = "cometbft/PubKeyBls12_381"
 	// PubKeySize is the size, in bytes, of public keys as used in this package.
-	PubKeySize = 32
+	PubKeySize = 48
 	// PrivKeySize is the size, in bytes, of private keys as used in this package.
-	PrivKeySize = 64
+	PrivKeySize = 32
 	// SignatureLength defines the byte length of a BLS signature.
 	SignatureLength = 96
 	// SeedSize is the size, in bytes, of private key seeds. These are the
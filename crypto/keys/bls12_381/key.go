@@ -1,3 +1,5 @@
+//go:build !(((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381)
+
 package bls12_381
 
 import (
@@ -129,3 +131,21 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregated Signatures
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures, of distinct messages,
+// into a single signature verified by VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of the given
+// messages, each signed by the public key of the same index. The messages must
+// be distinct, as the public keys are not proven to be possessed by their
+// signers.
+func VerifyAggregateSignature(pubKeys []PubKey, msgs [][]byte, sig []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	bls12381 "github.com/cosmos/crypto/curves/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -36,13 +37,16 @@ func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
 	if err != nil {
 		return PrivKey{}, err
 	}
-	return secretKey.Marshal(), nil
+	return PrivKey{Key: secretKey.Marshal()}, nil
 }
 
 // GenPrivKey generates a new key.
 func GenPrivKey() (PrivKey, error) {
 	secretKey, err := bls12381.RandKey()
-	return PrivKey(secretKey.Marshal()), err
+	if err != nil {
+		return PrivKey{}, err
+	}
+	return PrivKey{Key: secretKey.Marshal()}, nil
 }
 
 // Bytes returns the byte representation of the Key.
@@ -58,7 +62,7 @@ func (privKey PrivKey) PubKey() cryptotypes.PubKey {
 		return nil
 	}
 
-	return PubKey(secretKey.PublicKey().Marshal())
+	return &PubKey{Key: secretKey.PublicKey().Marshal()}
 }
 
 // Equals returns true if two keys are equal and false otherwise.
@@ -68,7 +72,7 @@ func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 
 // Type returns the type.
 func (PrivKey) Type() string {
-	return keyType
+	return KeyType
 }
 
 // Sign signs the given byte array. If msg is larger than
@@ -167,7 +171,7 @@ func (pubKey PubKey) Bytes() []byte {
 
 // Type returns the key's type.
 func (PubKey) Type() string {
-	return keyType
+	return KeyType
 }
 
 // Equals returns true if the other's type is the same and their bytes are deeply equal.
@@ -179,3 +183,78 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregated Signatures
+// ===============================================================================================
+
+// signatureDST is the domain separation tag of the signatures, as used by
+// bls12381.VerifySignature.
+var signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// AggregateSignatures aggregates the given signatures, of distinct messages,
+// into a single signature verified by VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	for _, sig := range sigs {
+		if len(sig) != SignatureLength {
+			return nil, fmt.Errorf("invalid signature length %d", len(sig))
+		}
+	}
+
+	// the signatures are group checked when decompressed
+	var aggSig blst.P2Aggregate
+	if !aggSig.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return aggSig.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of the given
+// messages, each signed by the public key of the same index. The messages must
+// be distinct, as the public keys are not proven to be possessed by their
+// signers.
+func VerifyAggregateSignature(pubKeys []PubKey, msgs [][]byte, sig []byte) bool {
+	if len(sig) != SignatureLength || len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	pubKs := make([]*blst.P1Affine, len(pubKeys))
+	digests := make([]blst.Message, len(msgs))
+	seen := make(map[[MaxMsgLen]byte]struct{}, len(msgs))
+	for i, pubKey := range pubKeys {
+		pubK := new(blst.P1Affine).Uncompress(pubKey.Key)
+		if pubK == nil || !pubK.KeyValidate() { // invalid pubkey
+			return false
+		}
+		pubKs[i] = pubK
+
+		msg := msgs[i]
+		if len(msg) > MaxMsgLen {
+			hash := sha256.Sum256(msg)
+			msg = hash[:]
+		}
+		if len(msg) != MaxMsgLen {
+			return false
+		}
+
+		digest := [MaxMsgLen]byte(msg)
+		if _, ok := seen[digest]; ok {
+			return false
+		}
+		seen[digest] = struct{}{}
+		digests[i] = msg
+	}
+
+	aggSig := new(blst.P2Affine).Uncompress(sig)
+	if aggSig == nil { // bad signature
+		return false
+	}
+
+	// the signature is group checked, the public keys were validated above
+	return aggSig.AggregateVerify(true, pubKs, false, digests, signatureDST)
+}
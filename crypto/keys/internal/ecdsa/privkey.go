@@ -5,6 +5,8 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
 	"fmt"
 	"math/big"
 )
@@ -49,6 +51,25 @@ func signatureRaw(r, s *big.Int) []byte {
 	return sigBytes
 }
 
+// SignatureFromDER converts an ASN.1 DER encoded signature, as produced by
+// most ECDSA signers, to the low-s normalized raw encoding (R || S) expected
+// by PubKey.VerifySignature.
+func SignatureFromDER(der []byte) ([]byte, error) {
+	var sig signature
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing bytes after DER signature")
+	}
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(p256Order) >= 0 || sig.S.Cmp(p256Order) >= 0 {
+		return nil, errors.New("invalid DER signature values")
+	}
+
+	return signatureRaw(sig.R, NormalizeS(sig.S)), nil
+}
+
 // GenPrivKey generates a new secp256r1 private key. It uses operating
 // system randomness.
 func GenPrivKey(curve elliptic.Curve) (PrivKey, error) {
@@ -0,0 +1,146 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
+)
+
+const (
+	// webAuthnAssertionType is the client data type of a WebAuthn assertion.
+	webAuthnAssertionType = "webauthn.get"
+
+	// authenticatorDataMinSize is the size of the RP ID hash, flags and
+	// signature counter of the authenticator data.
+	authenticatorDataMinSize = 37
+	// authenticatorDataRPIDHashSize is the size of the SHA-256 hash of the RP
+	// ID which starts the authenticator data.
+	authenticatorDataRPIDHashSize = 32
+	// authenticatorDataFlagsIndex is the index of the flags in the
+	// authenticator data.
+	authenticatorDataFlagsIndex = 32
+	// flagUserPresent is the authenticator data flag set when the user was
+	// present during the assertion.
+	flagUserPresent = 0x01
+)
+
+// WebAuthnAssertion is a WebAuthn assertion, as returned by a passkey
+// authenticator, whose challenge is the WebAuthnChallenge of the signed bytes.
+// It is JSON encoded as the signature of a tx signed with a passkey.
+type WebAuthnAssertion struct {
+	AuthenticatorData []byte `json:"authenticator_data"`
+	ClientDataJSON    []byte `json:"client_data_json"`
+	// Signature is the raw (R || S) and low-s normalized signature of the
+	// authenticator data and the hash of the client data JSON.
+	Signature []byte `json:"signature"`
+}
+
+// WebAuthnRelyingParty is the WebAuthn relying party (RP) which passkey
+// assertions are requested by.
+type WebAuthnRelyingParty struct {
+	// ID is the RP ID, whose SHA-256 hash starts the authenticator data.
+	ID string
+	// Origins are the origins, e.g. "https://example.com", of the clients
+	// allowed to request assertions.
+	Origins []string
+}
+
+// webAuthnClientData holds the fields of the WebAuthn client data which are
+// verified.
+type webAuthnClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// NewWebAuthnAssertion returns the WebAuthnAssertion of the authenticator
+// data, client data JSON and ASN.1 DER encoded signature of an
+// AuthenticatorAssertionResponse.
+func NewWebAuthnAssertion(authenticatorData, clientDataJSON, derSignature []byte) (*WebAuthnAssertion, error) {
+	sig, err := ecdsa.SignatureFromDER(derSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebAuthnAssertion{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         sig,
+	}, nil
+}
+
+// WebAuthnChallenge returns the challenge of the WebAuthn assertion signing
+// the given bytes.
+func WebAuthnChallenge(msg []byte) []byte {
+	challenge := sha256.Sum256(msg)
+	return challenge[:]
+}
+
+// NewPubKey returns the PubKey of the given compressed public key bytes.
+func NewPubKey(bz []byte) (*PubKey, error) {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &PubKey{Key: pk}, nil
+}
+
+// VerifyWebAuthnAssertion verifies that the given JSON encoded
+// WebAuthnAssertion was requested by the relying party rp and signed by the key
+// with the WebAuthnChallenge of msg as challenge and the user present.
+func (m *PubKey) VerifyWebAuthnAssertion(rp WebAuthnRelyingParty, msg, assertionJSON []byte) error {
+	var assertion WebAuthnAssertion
+	if err := json.Unmarshal(assertionJSON, &assertion); err != nil {
+		return fmt.Errorf("invalid WebAuthn assertion: %w", err)
+	}
+
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid WebAuthn client data: %w", err)
+	}
+
+	if clientData.Type != webAuthnAssertionType {
+		return fmt.Errorf("invalid WebAuthn client data type: %s", clientData.Type)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(clientData.Challenge)
+	if err != nil {
+		return fmt.Errorf("invalid WebAuthn challenge: %w", err)
+	}
+
+	if !bytes.Equal(challenge, WebAuthnChallenge(msg)) {
+		return errors.New("WebAuthn challenge mismatch")
+	}
+
+	if !slices.Contains(rp.Origins, clientData.Origin) {
+		return fmt.Errorf("unexpected WebAuthn origin: %s", clientData.Origin)
+	}
+
+	if len(assertion.AuthenticatorData) < authenticatorDataMinSize {
+		return errors.New("invalid WebAuthn authenticator data")
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(assertion.AuthenticatorData[:authenticatorDataRPIDHashSize], rpIDHash[:]) {
+		return errors.New("WebAuthn RP ID hash mismatch")
+	}
+
+	if assertion.AuthenticatorData[authenticatorDataFlagsIndex]&flagUserPresent == 0 {
+		return errors.New("WebAuthn user not present")
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signedData := append(bytes.Clone(assertion.AuthenticatorData), clientDataHash[:]...)
+	if !m.Key.VerifySignature(signedData, assertion.Signature) {
+		return errors.New("invalid WebAuthn signature")
+	}
+
+	return nil
+}
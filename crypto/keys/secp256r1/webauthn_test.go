@@ -0,0 +1,174 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRelyingParty = WebAuthnRelyingParty{
+	ID:      "example.com",
+	Origins: []string{"https://example.com", "https://wallet.example.com"},
+}
+
+func webAuthnClientDataJSON(typ string, msg []byte, origin string) []byte {
+	challenge := base64.RawURLEncoding.EncodeToString(WebAuthnChallenge(msg))
+	return []byte(fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":%q,"crossOrigin":false}`, typ, challenge, origin))
+}
+
+func webAuthnAuthenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := make([]byte, authenticatorDataMinSize)
+	copy(authData, rpIDHash[:])
+	authData[authenticatorDataFlagsIndex] = flags
+	return authData
+}
+
+func webAuthnSign(t *testing.T, sk *PrivKey, authData, clientDataJSON []byte) []byte {
+	t.Helper()
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	der, err := ecdsa.SignASN1(rand.Reader, &sk.Secret.PrivateKey, digest[:])
+	require.NoError(t, err)
+	return der
+}
+
+func TestWebAuthnAssertion(t *testing.T) {
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+	pk, err := NewPubKey(sk.PubKey().Bytes())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(sk.PubKey()))
+
+	otherSk, err := GenPrivKey()
+	require.NoError(t, err)
+
+	msg := []byte("sign bytes")
+
+	testCases := []struct {
+		name           string
+		sk             *PrivKey
+		authData       []byte
+		clientDataJSON []byte
+		expErr         string
+	}{
+		{
+			name:           "valid assertion",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://example.com"),
+		},
+		{
+			name:           "signed by another key",
+			sk:             otherSk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://example.com"),
+			expErr:         "invalid WebAuthn signature",
+		},
+		{
+			name:           "challenge of other bytes",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, []byte("other sign bytes"), "https://example.com"),
+			expErr:         "WebAuthn challenge mismatch",
+		},
+		{
+			name:           "credential creation",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON("webauthn.create", msg, "https://example.com"),
+			expErr:         "invalid WebAuthn client data type",
+		},
+		{
+			name:           "user not present",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x04),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://example.com"),
+			expErr:         "WebAuthn user not present",
+		},
+		{
+			name:           "truncated authenticator data",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05)[:authenticatorDataMinSize-1],
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://example.com"),
+			expErr:         "invalid WebAuthn authenticator data",
+		},
+		{
+			name:           "other allowed origin",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://wallet.example.com"),
+		},
+		{
+			name:           "unexpected origin",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData(testRelyingParty.ID, 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://evil.example.org"),
+			expErr:         "unexpected WebAuthn origin",
+		},
+		{
+			name:           "RP ID of another relying party",
+			sk:             sk,
+			authData:       webAuthnAuthenticatorData("evil.example.org", 0x05),
+			clientDataJSON: webAuthnClientDataJSON(webAuthnAssertionType, msg, "https://example.com"),
+			expErr:         "WebAuthn RP ID hash mismatch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			der := webAuthnSign(t, tc.sk, tc.authData, tc.clientDataJSON)
+			assertion, err := NewWebAuthnAssertion(tc.authData, tc.clientDataJSON, der)
+			require.NoError(t, err)
+			assertionJSON, err := json.Marshal(assertion)
+			require.NoError(t, err)
+
+			err = pk.VerifyWebAuthnAssertion(testRelyingParty, msg, assertionJSON)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = NewWebAuthnAssertion(nil, nil, []byte("not DER"))
+	require.Error(t, err)
+	require.Error(t, pk.VerifyWebAuthnAssertion(testRelyingParty, msg, []byte("not JSON")))
+}
+
+func TestWebAuthnAssertionNormalizesS(t *testing.T) {
+	sk, err := GenPrivKey()
+	require.NoError(t, err)
+
+	authData := webAuthnAuthenticatorData(testRelyingParty.ID, 0x01)
+	clientDataJSON := webAuthnClientDataJSON(webAuthnAssertionType, []byte("sign bytes"), "https://example.com")
+	der := webAuthnSign(t, sk, authData, clientDataJSON)
+
+	var sig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(der, &sig)
+	require.NoError(t, err)
+	// (R, N - S) is the other valid signature of the same digest
+	sig.S = new(big.Int).Sub(secp256r1.Params().N, sig.S)
+	malleatedDER, err := asn1.Marshal(sig)
+	require.NoError(t, err)
+
+	assertion, err := NewWebAuthnAssertion(authData, clientDataJSON, der)
+	require.NoError(t, err)
+	malleatedAssertion, err := NewWebAuthnAssertion(authData, clientDataJSON, malleatedDER)
+	require.NoError(t, err)
+	require.Equal(t, assertion.Signature, malleatedAssertion.Signature)
+
+	assertionJSON, err := json.Marshal(malleatedAssertion)
+	require.NoError(t, err)
+	require.NoError(t, sk.PubKey().(*PubKey).VerifyWebAuthnAssertion(testRelyingParty, []byte("sign bytes"), assertionJSON))
+}
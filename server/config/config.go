@@ -88,6 +88,10 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// KVAccessTracing enables the attribution of the KV operations performed by
+	// the messages of each block to their type URL and store key.
+	KVAccessTracing bool `mapstructure:"kv-access-tracing"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -81,6 +81,12 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# KVAccessTracing enables the attribution of the KV operations performed by the
+# messages of each block to their type URL and store key. The counts, bytes and
+# gas are aggregated per block, exported through telemetry and returned as JSON
+# by the "/app/kvtrace" ABCI query. Default is false.
+kv-access-tracing = {{ .BaseConfig.KVAccessTracing }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
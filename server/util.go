@@ -551,6 +551,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetKVAccessTracing(cast.ToBool(appOpts.Get(FlagKVAccessTracing))),
 	}
 }
 
@@ -10,7 +10,10 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	_ "cosmossdk.io/api/amino" // Import amino.proto file for reflection
@@ -21,6 +24,10 @@ import (
 
 const serverName = "grpc-server"
 
+// queryProveHeader is the gRPC header requesting the merkle proofs of the keys
+// read by a query, which this server does not support.
+const queryProveHeader = "x-cosmos-query-prove"
+
 type GRPCServer struct {
 	logger log.Logger
 
@@ -47,6 +54,7 @@ func New(logger log.Logger, v *viper.Viper, interfaceRegistry appmanager.Interfa
 		grpc.ForceServerCodec(newProtoCodec(interfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
 		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.UnaryInterceptor(rejectQueryProofs),
 	)
 
 	app.RegisterGRPCServer(grpcSrv)
@@ -62,6 +70,19 @@ func New(logger log.Logger, v *viper.Viper, interfaceRegistry appmanager.Interfa
 	}, nil
 }
 
+// rejectQueryProofs refuses the queries requesting proofs, so that clients
+// do not mistake the absence of proofs for an empty set of reads.
+//
+// NOTE: The reads of the queries are not recorded by the server/v2 query
+// path, so the query proofs are only supported by the baseapp gRPC server.
+func rejectQueryProofs(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(queryProveHeader)) > 0 {
+		return nil, status.Error(codes.Unimplemented, "query proofs are not supported by server/v2, query a baseapp node instead")
+	}
+
+	return handler(ctx, req)
+}
+
 func (g GRPCServer) Name() string {
 	return serverName
 }
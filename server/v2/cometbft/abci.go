@@ -19,6 +19,7 @@ import (
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/cometbft/oe"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
 	"cosmossdk.io/server/v2/streaming"
@@ -54,6 +55,10 @@ type Consensus[T transaction.Tx] struct {
 	verifyVoteExt          handlers.VerifyVoteExtensionhandler
 	extendVote             handlers.ExtendVoteHandler
 
+	// optimisticExec executes accepted proposals while they are voted on, it is
+	// nil if optimistic execution is disabled.
+	optimisticExec *oe.OptimisticExecution[T]
+
 	chainID string
 }
 
@@ -65,7 +70,7 @@ func NewConsensus[T transaction.Tx](
 	txCodec transaction.Codec[T],
 	logger log.Logger,
 ) *Consensus[T] {
-	return &Consensus[T]{
+	c := &Consensus[T]{
 		mempool: mp,
 		store:   store,
 		app:     app,
@@ -73,6 +78,11 @@ func NewConsensus[T transaction.Tx](
 		txCodec: txCodec,
 		logger:  logger,
 	}
+	if cfg.OptimisticExecution {
+		c.optimisticExec = oe.NewOptimisticExecution(logger, app.DeliverBlock)
+	}
+
+	return c
 }
 
 func (c *Consensus[T]) SetMempool(mp mempool.Mempool[T]) {
@@ -350,6 +360,9 @@ func (c *Consensus[T]) ProcessProposal(
 	ctx context.Context,
 	req *abciproto.ProcessProposalRequest,
 ) (*abciproto.ProcessProposalResponse, error) {
+	// abort the execution of a proposal of a previous round
+	c.optimisticExec.Abort()
+
 	decodedTxs := make([]T, len(req.Txs))
 	for _, tx := range req.Txs {
 		decTx, err := c.txCodec.Decode(tx)
@@ -376,6 +389,12 @@ func (c *Consensus[T]) ProcessProposal(
 		}, nil
 	}
 
+	// the accepted proposal is executed optimistically, so that the response
+	// of FinalizeBlock is ready once the proposal is decided on.
+	if c.optimisticExec.Enabled() {
+		c.optimisticExecute(ciCtx, req)
+	}
+
 	return &abci.ProcessProposalResponse{
 		Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
 	}, nil
@@ -421,29 +440,7 @@ func (c *Consensus[T]) FinalizeBlock(
 		return nil, err
 	}
 
-	cid, err := c.store.LastCommitID()
-	if err != nil {
-		return nil, err
-	}
-
-	blockReq := &coreappmgr.BlockRequest[T]{
-		Height:  uint64(req.Height),
-		Time:    req.Time,
-		Hash:    req.Hash,
-		AppHash: cid.Hash,
-		ChainId: c.chainID,
-		Txs:     decodedTxs,
-		// ConsensusMessages: []transaction.Msg{cometInfo},
-	}
-
-	ciCtx := contextWithCometInfo(ctx, comet.Info{
-		Evidence:        toCoreEvidence(req.Misbehavior),
-		ValidatorsHash:  req.NextValidatorsHash,
-		ProposerAddress: req.ProposerAddress,
-		LastCommit:      toCoreCommitInfo(req.DecidedLastCommit),
-	})
-
-	resp, newState, err := c.app.DeliverBlock(ciCtx, blockReq)
+	resp, newState, err := c.deliverBlock(ctx, req, decodedTxs)
 	if err != nil {
 		return nil, err
 	}
@@ -493,6 +490,75 @@ func (c *Consensus[T]) FinalizeBlock(
 	return finalizeBlockResponse(resp, cp, appHash, c.cfg.IndexEvents)
 }
 
+// deliverBlock returns the result of the optimistic execution of the block if
+// one was run for it, otherwise it executes the block.
+func (c *Consensus[T]) deliverBlock(
+	ctx context.Context,
+	req *abciproto.FinalizeBlockRequest,
+	decodedTxs []T,
+) (*coreappmgr.BlockResponse, store.WriterMap, error) {
+	if c.optimisticExec.Initialized() {
+		// check if the hash we got is the same as the one we are executing
+		aborted := c.optimisticExec.AbortIfNeeded(req.Hash)
+		// Wait for the OE to finish, regardless of whether it was aborted or not
+		resp, newState, err := c.optimisticExec.WaitResult()
+		c.optimisticExec.Reset()
+		if !aborted {
+			return resp, newState, err
+		}
+	}
+
+	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
+	cid, err := c.store.LastCommitID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockReq := &coreappmgr.BlockRequest[T]{
+		Height:  uint64(req.Height),
+		Time:    req.Time,
+		Hash:    req.Hash,
+		AppHash: cid.Hash,
+		ChainId: c.chainID,
+		Txs:     decodedTxs,
+		// ConsensusMessages: []transaction.Msg{cometInfo},
+	}
+
+	ciCtx := contextWithCometInfo(ctx, comet.Info{
+		Evidence:        toCoreEvidence(req.Misbehavior),
+		ValidatorsHash:  req.NextValidatorsHash,
+		ProposerAddress: req.ProposerAddress,
+		LastCommit:      toCoreCommitInfo(req.DecidedLastCommit),
+	})
+
+	return c.app.DeliverBlock(ciCtx, blockReq)
+}
+
+// optimisticExecute starts the execution of an accepted proposal. ctx holds the
+// comet info of the proposal, which is the one of the block if it is decided on.
+func (c *Consensus[T]) optimisticExecute(ctx context.Context, req *abciproto.ProcessProposalRequest) {
+	decodedTxs, err := decodeTxs(req.Txs, c.txCodec)
+	if err != nil {
+		c.logger.Debug("skipping optimistic execution", "height", req.Height, "err", err)
+		return
+	}
+
+	cid, err := c.store.LastCommitID()
+	if err != nil {
+		c.logger.Error("skipping optimistic execution", "height", req.Height, "err", err)
+		return
+	}
+
+	c.optimisticExec.Execute(ctx, &coreappmgr.BlockRequest[T]{
+		Height:  uint64(req.Height),
+		Time:    req.Time,
+		Hash:    req.Hash,
+		AppHash: cid.Hash,
+		ChainId: c.chainID,
+		Txs:     decodedTxs,
+	})
+}
+
 // Commit implements types.Application.
 // It is called by cometbft to notify the application that a block was committed.
 func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (*abciproto.CommitResponse, error) {
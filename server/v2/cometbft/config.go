@@ -17,6 +17,9 @@ type Config struct {
 	IndexEvents     map[string]struct{} `mapstructure:"index_events" toml:"index_events"`
 	HaltHeight      uint64              `mapstructure:"halt_height" toml:"halt_height"`
 	HaltTime        uint64              `mapstructure:"halt_time" toml:"halt_time"`
+	// OptimisticExecution enables the execution of accepted proposals while they
+	// are voted on.
+	OptimisticExecution bool `mapstructure:"optimistic_execution" toml:"optimistic_execution"`
 	// end of app.toml config options
 
 	AddrPeerFilter types.PeerFilter // filter peers by address and port
@@ -73,34 +73,64 @@ func (h *DefaultProposalHandler[T]) PrepareHandler() PrepareHandler[T] {
 			return h.txSelector.SelectedTxs(ctx), nil
 		}
 
-		iterator := h.mempool.Select(ctx, txs)
-		for iterator != nil {
-			memTx := iterator.Tx()
-
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			_, err := app.ValidateTx(ctx, memTx)
-			if err != nil {
-				err := h.mempool.Remove([]T{memTx})
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(abciReq.MaxTxBytes), maxBlockGas, memTx)
-				if stop {
-					break
-				}
+		// A laned mempool is enumerated lane by lane, each lane being limited to
+		// its share of the block space.
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool[T]); ok {
+			if err := h.selectLanedTxs(ctx, app, txs, lanedMempool, uint64(abciReq.MaxTxBytes), maxBlockGas); err != nil {
+				return nil, err
 			}
 
-			iterator = iterator.Next()
+			return h.txSelector.SelectedTxs(ctx), nil
+		}
+
+		if err := h.selectTxs(ctx, app, h.mempool.Select(ctx, txs), uint64(abciReq.MaxTxBytes), maxBlockGas, nil); err != nil {
+			return nil, err
 		}
 
 		return h.txSelector.SelectedTxs(ctx), nil
 	}
 }
 
+// selectTxs enumerates the given mempool iterator, selecting the valid
+// transactions with the TxSelector until it halts. Transactions for which match
+// returns false are skipped, if match is not nil.
+func (h *DefaultProposalHandler[T]) selectTxs(
+	ctx context.Context,
+	app AppManager[T],
+	iterator mempool.Iterator[T],
+	maxTxBytes, maxBlockGas uint64,
+	match func(T) bool,
+) error {
+	for iterator != nil {
+		memTx := iterator.Tx()
+		if match != nil && !match(memTx) {
+			iterator = iterator.Next()
+			continue
+		}
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		_, err := app.ValidateTx(ctx, memTx)
+		if err != nil {
+			err := h.mempool.Remove([]T{memTx})
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return err
+			}
+		} else {
+			stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx)
+			if stop {
+				break
+			}
+		}
+
+		iterator = iterator.Next()
+	}
+
+	return nil
+}
+
 func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 	return func(ctx context.Context, app AppManager[T], txs []T, req proto.Message) error {
 		// If the mempool is nil we simply return ACCEPT,
@@ -110,7 +140,7 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 			return nil
 		}
 
-		_, ok := req.(*abci.PrepareProposalRequest)
+		_, ok := req.(*abci.ProcessProposalRequest)
 		if !ok {
 			return fmt.Errorf("invalid request type: %T", req)
 		}
@@ -125,9 +155,17 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 			return fmt.Errorf("unexpected consensus params response type; expected: %T, got: %T", &consensusv1.QueryParamsResponse{}, res)
 		}
 
-		var maxBlockGas uint64
+		var maxBlockGas, maxBlockBytes uint64
 		if b := paramsResp.GetParams().Block; b != nil {
 			maxBlockGas = uint64(b.MaxGas)
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
+		}
+
+		var lanes *laneChecker[T]
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool[T]); ok {
+			lanes = newLaneChecker(lanedMempool, maxBlockBytes, maxBlockGas)
 		}
 
 		var totalTxGas uint64
@@ -137,6 +175,12 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 				return fmt.Errorf("failed to validate tx: %w", err)
 			}
 
+			if lanes != nil {
+				if err := lanes.check(ctx, tx); err != nil {
+					return err
+				}
+			}
+
 			if maxBlockGas > 0 {
 				gaslimit, err := tx.GetGasLimit()
 				if err != nil {
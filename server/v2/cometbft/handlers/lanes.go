@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+// laneLimit returns the share of limit a lane may use.
+func laneLimit(limit uint64, maxBlockSpace math.LegacyDec) uint64 {
+	return maxBlockSpace.MulInt(math.NewIntFromUint64(limit)).TruncateInt().Uint64()
+}
+
+// selectLanedTxs selects the transactions of the lanes of the mempool, in order.
+// Each lane is limited to its share of the max tx bytes and max block gas, and
+// to the block space left by the previous lanes.
+func (h *DefaultProposalHandler[T]) selectLanedTxs(
+	ctx context.Context,
+	app AppManager[T],
+	txs []T,
+	mp *mempool.LanedMempool[T],
+	maxTxBytes, maxBlockGas uint64,
+) error {
+	var totalTxBytes, totalTxGas uint64
+	for i, lane := range mp.Lanes() {
+		maxLaneTxBytes := min(totalTxBytes+laneLimit(maxTxBytes, lane.MaxBlockSpace), maxTxBytes)
+		if maxLaneTxBytes <= totalTxBytes {
+			continue
+		}
+
+		var maxLaneBlockGas uint64
+		if maxBlockGas > 0 {
+			// a zero max block gas is unlimited for the TxSelector, lanes with no
+			// gas left are skipped
+			maxLaneBlockGas = min(totalTxGas+laneLimit(maxBlockGas, lane.MaxBlockSpace), maxBlockGas)
+			if maxLaneBlockGas <= totalTxGas {
+				continue
+			}
+		}
+
+		// the transactions are only selected from the lane they match first, as
+		// checked by the ProcessHandler
+		match := func(tx T) bool {
+			idx, err := mp.LaneIndex(ctx, tx)
+			return err == nil && idx == i
+		}
+
+		if err := h.selectTxs(ctx, app, lane.Mempool.Select(ctx, txs), maxLaneTxBytes, maxLaneBlockGas, match); err != nil {
+			return err
+		}
+
+		totalTxBytes, totalTxGas = 0, 0
+		for _, tx := range h.txSelector.SelectedTxs(ctx) {
+			totalTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+			if gasLimit, err := tx.GetGasLimit(); err == nil {
+				totalTxGas += gasLimit
+			}
+		}
+	}
+
+	return nil
+}
+
+// laneChecker verifies that the transactions of a proposal are ordered by lane,
+// the lane of a transaction being the first one it matches, and that each lane
+// does not use more than its share of the block space.
+//
+// As the max tx bytes of a proposal are not known to the ProcessHandler, the
+// share of the block max bytes is checked instead, which the PrepareHandler
+// never exceeds.
+type laneChecker[T transaction.Tx] struct {
+	mempool       *mempool.LanedMempool[T]
+	maxBlockBytes uint64
+	maxBlockGas   uint64
+
+	lane      int
+	laneBytes uint64
+	laneGas   uint64
+}
+
+func newLaneChecker[T transaction.Tx](mp *mempool.LanedMempool[T], maxBlockBytes, maxBlockGas uint64) *laneChecker[T] {
+	return &laneChecker[T]{
+		mempool:       mp,
+		maxBlockBytes: maxBlockBytes,
+		maxBlockGas:   maxBlockGas,
+	}
+}
+
+// check checks the next transaction of the proposal.
+func (c *laneChecker[T]) check(ctx context.Context, tx T) error {
+	idx, err := c.mempool.LaneIndex(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	lanes := c.mempool.Lanes()
+	switch {
+	case idx < c.lane:
+		return fmt.Errorf("tx of lane %s is after txs of lane %s", lanes[idx].Name, lanes[c.lane].Name)
+	case idx > c.lane:
+		c.lane, c.laneBytes, c.laneGas = idx, 0, 0
+	}
+	lane := lanes[idx]
+
+	c.laneBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+	if c.maxBlockBytes > 0 && c.laneBytes > laneLimit(c.maxBlockBytes, lane.MaxBlockSpace) {
+		return fmt.Errorf("txs of lane %s exceed its max block space", lane.Name)
+	}
+
+	if c.maxBlockGas > 0 {
+		gasLimit, err := tx.GetGasLimit()
+		if err != nil {
+			return fmt.Errorf("failed to get gas limit: %w", err)
+		}
+		c.laneGas += gasLimit
+		if c.laneGas > laneLimit(c.maxBlockGas, lane.MaxBlockSpace) {
+			return fmt.Errorf("txs of lane %s exceed its max block gas", lane.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	cmtv1 "buf.build/gen/go/cometbft/cometbft/protocolbuffers/go/cometbft/types/v1"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	consensusv1 "cosmossdk.io/api/cosmos/consensus/v1"
+	appmanager "cosmossdk.io/core/app"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+// testTx is a tx of 12 bytes once encoded in a proposal.
+type testTx struct {
+	lane string
+	id   string
+	gas  uint64
+}
+
+func (tx testTx) Hash() [32]byte                              { return sha256.Sum256(tx.Bytes()) }
+func (tx testTx) GetMessages() ([]transaction.Msg, error)     { return nil, nil }
+func (tx testTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (tx testTx) GetGasLimit() (uint64, error)                { return tx.gas, nil }
+
+func (tx testTx) Bytes() []byte {
+	bz := make([]byte, 10)
+	copy(bz, tx.lane+"/"+tx.id)
+	return bz
+}
+
+// testApp validates all the txs but the invalid ones, and returns the given
+// block params.
+type testApp struct {
+	block   *cmtv1.BlockParams
+	invalid map[testTx]bool
+}
+
+func (a testApp) ValidateTx(_ context.Context, tx testTx) (appmanager.TxResult, error) {
+	if a.invalid[tx] {
+		return appmanager.TxResult{}, errors.New("invalid tx")
+	}
+
+	return appmanager.TxResult{}, nil
+}
+
+func (a testApp) Query(context.Context, uint64, transaction.Msg) (transaction.Msg, error) {
+	return &consensusv1.QueryParamsResponse{Params: &cmtv1.ConsensusParams{Block: a.block}}, nil
+}
+
+// listMempool is a mempool holding its transactions in insertion order.
+type listMempool struct {
+	txs []testTx
+}
+
+func (mp *listMempool) Insert(_ context.Context, tx testTx) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func (mp *listMempool) Select(context.Context, []testTx) mempool.Iterator[testTx] {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+
+	return &listIterator{txs: mp.txs}
+}
+
+func (mp *listMempool) Remove(txs []testTx) error {
+	for _, tx := range txs {
+		found := false
+		for i, memTx := range mp.txs {
+			if memTx == tx {
+				mp.txs = append(mp.txs[:i:i], mp.txs[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return mempool.ErrTxNotFound
+		}
+	}
+
+	return nil
+}
+
+type listIterator struct {
+	txs []testTx
+}
+
+func (i *listIterator) Next() mempool.Iterator[testTx] {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+
+	return &listIterator{txs: i.txs[1:]}
+}
+
+func (i *listIterator) Tx() testTx {
+	return i.txs[0]
+}
+
+// newTestLanedMempool returns a mempool with an oracle lane limited to 30% of
+// the block space, before the default lane.
+func newTestLanedMempool(t *testing.T) *mempool.LanedMempool[testTx] {
+	t.Helper()
+
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane[testTx]{
+			Name:          "oracle",
+			Mempool:       &listMempool{},
+			Match:         func(_ context.Context, tx testTx) bool { return tx.lane == "oracle" },
+			MaxBlockSpace: math.LegacyNewDecWithPrec(3, 1),
+		},
+		mempool.Lane[testTx]{
+			Name:          "default",
+			Mempool:       &listMempool{},
+			Match:         mempool.MatchAll[testTx](),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	return mp
+}
+
+func TestLaneLimit(t *testing.T) {
+	require.Equal(t, uint64(30), laneLimit(100, math.LegacyNewDecWithPrec(3, 1)))
+	require.Equal(t, uint64(33), laneLimit(111, math.LegacyNewDecWithPrec(3, 1)))
+	require.Equal(t, uint64(100), laneLimit(100, math.LegacyOneDec()))
+}
+
+func TestPrepareHandlerLanes(t *testing.T) {
+	oracleTxs := []testTx{{lane: "oracle", id: "1", gas: 10}, {lane: "oracle", id: "2", gas: 10}, {lane: "oracle", id: "3", gas: 10}}
+	defaultTxs := []testTx{{lane: "default", id: "1", gas: 10}, {lane: "default", id: "2", gas: 10}, {lane: "default", id: "3", gas: 10}}
+
+	testCases := []struct {
+		name       string
+		maxTxBytes int64
+		block      *cmtv1.BlockParams
+		invalid    map[testTx]bool
+		expTxs     []testTx
+		expMempool []testTx
+	}{
+		{
+			name:       "oracle lane limited to its share of the max tx bytes",
+			maxTxBytes: 100,
+			expTxs:     []testTx{oracleTxs[0], oracleTxs[1], defaultTxs[0], defaultTxs[1], defaultTxs[2]},
+		},
+		{
+			name:       "oracle lane limited to its share of the max block gas",
+			maxTxBytes: 100,
+			block:      &cmtv1.BlockParams{MaxGas: 40},
+			expTxs:     []testTx{oracleTxs[0], defaultTxs[0], defaultTxs[1], defaultTxs[2]},
+		},
+		{
+			name:       "default lane limited to the block space left by the oracle lane",
+			maxTxBytes: 45,
+			expTxs:     []testTx{oracleTxs[0], defaultTxs[0], defaultTxs[1]},
+		},
+		{
+			name:       "oracle lane share smaller than a tx",
+			maxTxBytes: 24,
+			expTxs:     []testTx{defaultTxs[0], defaultTxs[1]},
+		},
+		{
+			name:       "invalid txs are removed from their lane",
+			maxTxBytes: 100,
+			invalid:    map[testTx]bool{oracleTxs[0]: true},
+			expTxs:     []testTx{oracleTxs[1], oracleTxs[2], defaultTxs[0], defaultTxs[1], defaultTxs[2]},
+			expMempool: []testTx{oracleTxs[1], oracleTxs[2], defaultTxs[0], defaultTxs[1], defaultTxs[2]},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mp := newTestLanedMempool(t)
+			for _, tx := range append(append([]testTx{}, defaultTxs...), oracleTxs...) {
+				require.NoError(t, mp.Insert(ctx, tx))
+			}
+
+			app := testApp{block: tc.block, invalid: tc.invalid}
+			handler := NewDefaultProposalHandler[testTx](mp).PrepareHandler()
+			txs, err := handler(ctx, app, nil, &abci.PrepareProposalRequest{MaxTxBytes: tc.maxTxBytes})
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, txs)
+
+			// the selected txs are accepted by the ProcessHandler
+			require.NoError(t, NewDefaultProposalHandler[testTx](mp).ProcessHandler()(ctx, app, txs, &abci.ProcessProposalRequest{}))
+
+			if tc.expMempool != nil {
+				var memTxs []testTx
+				for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+					memTxs = append(memTxs, iter.Tx())
+				}
+				require.Equal(t, tc.expMempool, memTxs)
+			}
+		})
+	}
+}
+
+func TestProcessHandlerLanes(t *testing.T) {
+	oracleTx1 := testTx{lane: "oracle", id: "1", gas: 10}
+	oracleTx2 := testTx{lane: "oracle", id: "2", gas: 10}
+	defaultTx := testTx{lane: "default", id: "1", gas: 10}
+
+	testCases := []struct {
+		name   string
+		txs    []testTx
+		block  *cmtv1.BlockParams
+		expErr string
+	}{
+		{
+			name:  "txs ordered by lane",
+			txs:   []testTx{oracleTx1, oracleTx2, defaultTx},
+			block: &cmtv1.BlockParams{MaxBytes: 100, MaxGas: 100},
+		},
+		{
+			name:   "tx of a lane after the txs of the next lane",
+			txs:    []testTx{oracleTx1, defaultTx, oracleTx2},
+			expErr: "tx of lane oracle is after txs of lane default",
+		},
+		{
+			name:   "txs exceeding the block space of their lane",
+			txs:    []testTx{oracleTx1, oracleTx2, defaultTx},
+			block:  &cmtv1.BlockParams{MaxBytes: 40},
+			expErr: "txs of lane oracle exceed its max block space",
+		},
+		{
+			name:   "txs exceeding the block gas of their lane",
+			txs:    []testTx{oracleTx1, oracleTx2, defaultTx},
+			block:  &cmtv1.BlockParams{MaxGas: 40},
+			expErr: "txs of lane oracle exceed its max block gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewDefaultProposalHandler[testTx](newTestLanedMempool(t)).ProcessHandler()
+			err := handler(context.Background(), testApp{block: tc.block}, tc.txs, &abci.ProcessProposalRequest{})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	// the txs matching no lane are rejected
+	mp, err := mempool.NewLanedMempool(mempool.Lane[testTx]{
+		Name:          "default",
+		Mempool:       &listMempool{},
+		Match:         func(_ context.Context, tx testTx) bool { return tx.lane == "default" },
+		MaxBlockSpace: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+
+	handler := NewDefaultProposalHandler[testTx](mp).ProcessHandler()
+	err = handler(context.Background(), testApp{}, []testTx{oracleTx1}, &abci.ProcessProposalRequest{})
+	require.ErrorIs(t, err, mempool.ErrNoLane)
+}
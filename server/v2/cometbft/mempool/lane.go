@@ -0,0 +1,162 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+)
+
+var _ Mempool[transaction.Tx] = (*LanedMempool[transaction.Tx])(nil)
+
+// ErrNoLane is returned when a transaction matches none of the lanes of a
+// LanedMempool.
+var ErrNoLane = errors.New("tx does not match any lane")
+
+// TxMatchFn reports whether a transaction belongs to a lane. It must be
+// deterministic, as it is used to verify proposals.
+type TxMatchFn[T transaction.Tx] func(ctx context.Context, tx T) bool
+
+// MatchAll returns a TxMatchFn matching every transaction, typically used by
+// the last, default, lane.
+func MatchAll[T transaction.Tx]() TxMatchFn[T] {
+	return func(context.Context, T) bool { return true }
+}
+
+// Lane is a partition of the block space holding the transactions matching a
+// predicate in its own mempool.
+type Lane[T transaction.Tx] struct {
+	// Name identifies the lane.
+	Name string
+	// Mempool holds the transactions of the lane.
+	Mempool Mempool[T]
+	// Match reports whether a transaction belongs to the lane.
+	Match TxMatchFn[T]
+	// MaxBlockSpace is the fraction, in (0, 1], of the max tx bytes and max
+	// block gas of a block the transactions of the lane may use.
+	MaxBlockSpace math.LegacyDec
+}
+
+// LanedMempool is a mempool made of ordered lanes. A transaction is inserted
+// into the first lane it matches, and proposals hold the transactions of each
+// lane in the lanes order.
+type LanedMempool[T transaction.Tx] struct {
+	lanes []Lane[T]
+}
+
+// NewLanedMempool creates a new LanedMempool from the given lanes, in order of
+// precedence.
+func NewLanedMempool[T transaction.Tx](lanes ...Lane[T]) (*LanedMempool[T], error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil || lane.Match == nil {
+			return nil, fmt.Errorf("lane %s must have a mempool and a match function", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be in (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LanedMempool[T]{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order of precedence.
+func (mp *LanedMempool[T]) Lanes() []Lane[T] {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the first lane the transaction matches.
+func (mp *LanedMempool[T]) LaneIndex(ctx context.Context, tx T) (int, error) {
+	for i, lane := range mp.lanes {
+		if lane.Match(ctx, tx) {
+			return i, nil
+		}
+	}
+
+	return -1, ErrNoLane
+}
+
+// Insert inserts the transaction into the first lane it matches.
+func (mp *LanedMempool[T]) Insert(ctx context.Context, tx T) error {
+	i, err := mp.LaneIndex(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of every lane, in the lanes
+// order.
+func (mp *LanedMempool[T]) Select(ctx context.Context, txs []T) Iterator[T] {
+	return newLanedIterator(ctx, mp.lanes, txs)
+}
+
+// Remove removes the transactions from the lanes holding them.
+func (mp *LanedMempool[T]) Remove(txs []T) error {
+	for _, tx := range txs {
+		if err := mp.remove(tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (mp *LanedMempool[T]) remove(tx T) error {
+	for _, lane := range mp.lanes {
+		err := lane.Mempool.Remove([]T{tx})
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return ErrTxNotFound
+}
+
+// lanedIterator chains the iterators of lanes.
+type lanedIterator[T transaction.Tx] struct {
+	ctx   context.Context
+	lanes []Lane[T]
+	txs   []T
+	iter  Iterator[T]
+}
+
+func newLanedIterator[T transaction.Tx](ctx context.Context, lanes []Lane[T], txs []T) Iterator[T] {
+	for i, lane := range lanes {
+		if iter := lane.Mempool.Select(ctx, txs); iter != nil {
+			return &lanedIterator[T]{ctx: ctx, lanes: lanes[i+1:], txs: txs, iter: iter}
+		}
+	}
+
+	return nil
+}
+
+func (i *lanedIterator[T]) Next() Iterator[T] {
+	if i.iter = i.iter.Next(); i.iter != nil {
+		return i
+	}
+
+	return newLanedIterator(i.ctx, i.lanes, i.txs)
+}
+
+func (i *lanedIterator[T]) Tx() T {
+	return i.iter.Tx()
+}
@@ -0,0 +1,238 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+)
+
+type testTx struct {
+	lane string
+	id   string
+}
+
+func (tx testTx) Hash() [32]byte                              { return sha256.Sum256(tx.Bytes()) }
+func (tx testTx) GetMessages() ([]transaction.Msg, error)     { return nil, nil }
+func (tx testTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (tx testTx) GetGasLimit() (uint64, error)                { return 0, nil }
+func (tx testTx) Bytes() []byte                               { return []byte(tx.lane + "/" + tx.id) }
+
+// listMempool is a mempool holding its transactions in insertion order.
+type listMempool struct {
+	txs []testTx
+}
+
+func (mp *listMempool) Insert(_ context.Context, tx testTx) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func (mp *listMempool) Select(context.Context, []testTx) Iterator[testTx] {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+
+	return &listIterator{txs: mp.txs}
+}
+
+func (mp *listMempool) Remove(txs []testTx) error {
+	for _, tx := range txs {
+		found := false
+		for i, memTx := range mp.txs {
+			if memTx == tx {
+				mp.txs = append(mp.txs[:i], mp.txs[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrTxNotFound
+		}
+	}
+
+	return nil
+}
+
+type listIterator struct {
+	txs []testTx
+}
+
+func (i *listIterator) Next() Iterator[testTx] {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+
+	return &listIterator{txs: i.txs[1:]}
+}
+
+func (i *listIterator) Tx() testTx {
+	return i.txs[0]
+}
+
+// matchLane matches the transactions of the named lane.
+func matchLane(name string) TxMatchFn[testTx] {
+	return func(_ context.Context, tx testTx) bool {
+		return tx.lane == name
+	}
+}
+
+func newTestLane(name string, match TxMatchFn[testTx]) Lane[testTx] {
+	return Lane[testTx]{
+		Name:          name,
+		Mempool:       &listMempool{},
+		Match:         match,
+		MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+	}
+}
+
+func selectAll(ctx context.Context, mp Mempool[testTx]) []testTx {
+	var txs []testTx
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		txs = append(txs, iter.Tx())
+	}
+
+	return txs
+}
+
+func TestNewLanedMempool(t *testing.T) {
+	valid := newTestLane("default", MatchAll[testTx]())
+
+	testCases := []struct {
+		name   string
+		lanes  func() []Lane[testTx]
+		expErr string
+	}{
+		{
+			name:   "no lanes",
+			lanes:  func() []Lane[testTx] { return nil },
+			expErr: "at least one lane is required",
+		},
+		{
+			name: "empty name",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.Name = ""
+				return []Lane[testTx]{lane}
+			},
+			expErr: "lane name cannot be empty",
+		},
+		{
+			name:   "duplicate lane",
+			lanes:  func() []Lane[testTx] { return []Lane[testTx]{valid, valid} },
+			expErr: "duplicate lane default",
+		},
+		{
+			name: "no mempool",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.Mempool = nil
+				return []Lane[testTx]{lane}
+			},
+			expErr: "must have a mempool and a match function",
+		},
+		{
+			name: "no match function",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.Match = nil
+				return []Lane[testTx]{lane}
+			},
+			expErr: "must have a mempool and a match function",
+		},
+		{
+			name: "nil max block space",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.MaxBlockSpace = math.LegacyDec{}
+				return []Lane[testTx]{lane}
+			},
+			expErr: "max block space must be in (0, 1]",
+		},
+		{
+			name: "zero max block space",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.MaxBlockSpace = math.LegacyZeroDec()
+				return []Lane[testTx]{lane}
+			},
+			expErr: "max block space must be in (0, 1]",
+		},
+		{
+			name: "max block space above one",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.MaxBlockSpace = math.LegacyNewDecWithPrec(11, 1)
+				return []Lane[testTx]{lane}
+			},
+			expErr: "max block space must be in (0, 1]",
+		},
+		{
+			name: "valid",
+			lanes: func() []Lane[testTx] {
+				lane := valid
+				lane.MaxBlockSpace = math.LegacyOneDec()
+				return []Lane[testTx]{newTestLane("oracle", matchLane("oracle")), lane}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp, err := NewLanedMempool(tc.lanes()...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, mp.Lanes(), 2)
+		})
+	}
+}
+
+func TestLanedMempool(t *testing.T) {
+	ctx := context.Background()
+
+	// the oracle txs also match the last lane, but are only inserted into the first
+	mp, err := NewLanedMempool(
+		newTestLane("oracle", matchLane("oracle")),
+		newTestLane("empty", matchLane("empty")),
+		newTestLane("default", func(_ context.Context, tx testTx) bool { return !strings.HasPrefix(tx.lane, "none") }),
+	)
+	require.NoError(t, err)
+
+	oracleTx := testTx{lane: "oracle", id: "1"}
+	defaultTx1 := testTx{lane: "default", id: "1"}
+	defaultTx2 := testTx{lane: "default", id: "2"}
+
+	idx, err := mp.LaneIndex(ctx, oracleTx)
+	require.NoError(t, err)
+	require.Equal(t, 0, idx)
+
+	idx, err = mp.LaneIndex(ctx, defaultTx1)
+	require.NoError(t, err)
+	require.Equal(t, 2, idx)
+
+	require.NoError(t, mp.Insert(ctx, defaultTx1))
+	require.NoError(t, mp.Insert(ctx, oracleTx))
+	require.NoError(t, mp.Insert(ctx, defaultTx2))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{lane: "none", id: "1"}), ErrNoLane)
+
+	// the txs are selected lane by lane, the empty lanes being skipped
+	require.Equal(t, []testTx{oracleTx, defaultTx1, defaultTx2}, selectAll(ctx, mp))
+	require.Equal(t, []testTx{oracleTx}, selectAll(ctx, mp.Lanes()[0].Mempool))
+
+	// the txs are removed from the lane holding them
+	require.NoError(t, mp.Remove([]testTx{oracleTx, defaultTx2}))
+	require.Equal(t, []testTx{defaultTx1}, selectAll(ctx, mp))
+	require.ErrorIs(t, mp.Remove([]testTx{oracleTx}), ErrTxNotFound)
+
+	require.NoError(t, mp.Remove([]testTx{defaultTx1}))
+	require.Nil(t, mp.Select(ctx, nil))
+}
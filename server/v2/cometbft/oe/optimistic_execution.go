@@ -0,0 +1,158 @@
+package oe
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/api/telemetry"
+)
+
+// DeliverBlockFunc is the function that is called by the OE to execute the
+// block, e.g. AppManager.DeliverBlock.
+type DeliverBlockFunc[T transaction.Tx] func(
+	context.Context,
+	*coreappmgr.BlockRequest[T],
+) (*coreappmgr.BlockResponse, store.WriterMap, error)
+
+// OptimisticExecution is a struct that contains the OE context. It is used to
+// execute a proposal in a goroutine while it is voted on, and to abort it if
+// another block gets finalized.
+//
+// As the state transition function does not write to the store, aborting an
+// execution only requires cancelling its context, which is checked by the STF
+// between the stages of the block and between transactions.
+type OptimisticExecution[T transaction.Tx] struct {
+	deliverBlockFunc DeliverBlockFunc[T]
+	logger           log.Logger
+
+	mtx         sync.Mutex
+	stopCh      chan struct{}
+	request     *coreappmgr.BlockRequest[T]
+	response    *coreappmgr.BlockResponse
+	state       store.WriterMap
+	err         error
+	cancelFunc  func() // cancel function for the context
+	initialized bool   // A boolean value indicating whether the struct has been initialized
+}
+
+// NewOptimisticExecution initializes the Optimistic Execution context but does not start it.
+func NewOptimisticExecution[T transaction.Tx](logger log.Logger, fn DeliverBlockFunc[T]) *OptimisticExecution[T] {
+	return &OptimisticExecution[T]{
+		logger:           logger.With(log.ModuleKey, "oe"),
+		deliverBlockFunc: fn,
+	}
+}
+
+// Reset resets the OE context. Must be called whenever we want to invalidate
+// the current OE.
+func (oe *OptimisticExecution[T]) Reset() {
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	oe.request = nil
+	oe.response = nil
+	oe.state = nil
+	oe.err = nil
+	oe.initialized = false
+}
+
+func (oe *OptimisticExecution[T]) Enabled() bool {
+	return oe != nil
+}
+
+// Initialized returns true if the OE was initialized, meaning that it contains
+// a request and it was run or it is running.
+func (oe *OptimisticExecution[T]) Initialized() bool {
+	if oe == nil {
+		return false
+	}
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	return oe.initialized
+}
+
+// Execute initializes the OE and starts it in a goroutine. The values of ctx,
+// such as the comet info, are passed to the execution, but not its
+// cancellation, as the execution outlives the ProcessProposal call.
+func (oe *OptimisticExecution[T]) Execute(ctx context.Context, req *coreappmgr.BlockRequest[T]) {
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	oe.stopCh = make(chan struct{})
+	oe.request = req
+
+	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	oe.cancelFunc = cancel
+	oe.initialized = true
+
+	go func() {
+		start := time.Now()
+		resp, state, err := oe.deliverBlockFunc(ctx, req)
+
+		oe.mtx.Lock()
+
+		executionTime := time.Since(start)
+		oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", req.Height, "hash", hex.EncodeToString(req.Hash))
+		metrics.MeasureSinceWithLabels([]string{"cometbft", "optimistic_execution", "duration"}, start, telemetry.GlobalLabels)
+		oe.response, oe.state, oe.err = resp, state, err
+
+		close(oe.stopCh)
+		oe.mtx.Unlock()
+	}()
+}
+
+// AbortIfNeeded aborts the OE if the request hash is not the same as the one in
+// the running OE. Returns true if the OE was aborted.
+func (oe *OptimisticExecution[T]) AbortIfNeeded(reqHash []byte) bool {
+	if oe == nil {
+		return false
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	if !bytes.Equal(oe.request.Hash, reqHash) {
+		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height)
+		oe.cancelFunc()
+		incrCounter("abort")
+		return true
+	}
+
+	incrCounter("hit")
+	return false
+}
+
+// Abort aborts the OE unconditionally, if it was initialized, waits for it to
+// finish and resets it.
+func (oe *OptimisticExecution[T]) Abort() {
+	if !oe.Initialized() {
+		return
+	}
+
+	oe.cancelFunc()
+	<-oe.stopCh
+	incrCounter("abort")
+	oe.Reset()
+}
+
+// WaitResult waits for the OE to finish and returns the result.
+func (oe *OptimisticExecution[T]) WaitResult() (*coreappmgr.BlockResponse, store.WriterMap, error) {
+	<-oe.stopCh
+	return oe.response, oe.state, oe.err
+}
+
+// incrCounter increments the OE counter of the given outcome. The hit rate of
+// the OE is the ratio of hits to hits and aborts.
+func incrCounter(outcome string) {
+	metrics.IncrCounterWithLabels([]string{"cometbft", "optimistic_execution", outcome}, 1, telemetry.GlobalLabels)
+}
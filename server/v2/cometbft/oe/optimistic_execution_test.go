@@ -0,0 +1,69 @@
+package oe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	coreappmgr "cosmossdk.io/core/app"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+func testDeliverBlock(_ context.Context, _ *coreappmgr.BlockRequest[transaction.Tx]) (*coreappmgr.BlockResponse, store.WriterMap, error) {
+	return nil, nil, errors.New("test error")
+}
+
+func TestOptimisticExecution(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testDeliverBlock)
+	assert.True(t, oe.Enabled())
+	oe.Execute(context.Background(), &coreappmgr.BlockRequest[transaction.Tx]{
+		Hash: []byte("test"),
+	})
+	assert.True(t, oe.Initialized())
+
+	resp, state, err := oe.WaitResult()
+	assert.Nil(t, resp)
+	assert.Nil(t, state)
+	assert.EqualError(t, err, "test error")
+
+	assert.False(t, oe.AbortIfNeeded([]byte("test")))
+	assert.True(t, oe.AbortIfNeeded([]byte("wrong_hash")))
+
+	oe.Reset()
+	assert.False(t, oe.Initialized())
+}
+
+func TestOptimisticExecutionAbort(t *testing.T) {
+	// the execution blocks until its context is cancelled, as the STF does
+	deliverBlock := func(ctx context.Context, _ *coreappmgr.BlockRequest[transaction.Tx]) (*coreappmgr.BlockResponse, store.WriterMap, error) {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
+
+	oe := NewOptimisticExecution(log.NewNopLogger(), deliverBlock)
+	ctx, cancel := context.WithCancel(context.Background())
+	oe.Execute(ctx, &coreappmgr.BlockRequest[transaction.Tx]{
+		Hash: []byte("test"),
+	})
+	cancel()
+	assert.True(t, oe.Initialized())
+
+	assert.True(t, oe.AbortIfNeeded([]byte("wrong_hash")))
+	_, _, err := oe.WaitResult()
+	assert.ErrorIs(t, err, context.Canceled)
+
+	oe.Execute(context.Background(), &coreappmgr.BlockRequest[transaction.Tx]{
+		Hash: []byte("test"),
+	})
+	oe.Abort()
+	assert.False(t, oe.Initialized())
+
+	var disabled *OptimisticExecution[transaction.Tx]
+	assert.False(t, disabled.Enabled())
+	assert.False(t, disabled.Initialized())
+	disabled.Abort()
+}
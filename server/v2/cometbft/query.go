@@ -108,21 +108,20 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	}
 
 	if req.Prove {
+		// all the ops are needed to prove the key against the app hash, from the
+		// store tree up to the commit info
+		res.ProofOps = &crypto.ProofOps{Ops: make([]crypto.ProofOp, 0, len(qRes.ProofOps))}
 		for _, proof := range qRes.ProofOps {
 			bz, err := proof.Proof.Marshal()
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "failed to marshal proof")
 			}
 
-			res.ProofOps = &crypto.ProofOps{
-				Ops: []crypto.ProofOp{
-					{
-						Type: proof.Type,
-						Key:  proof.Key,
-						Data: bz,
-					},
-				},
-			}
+			res.ProofOps.Ops = append(res.ProofOps.Ops, crypto.ProofOp{
+				Type: proof.Type,
+				Key:  proof.Key,
+				Data: bz,
+			})
 		}
 	}
 
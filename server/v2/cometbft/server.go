@@ -218,6 +218,7 @@ func (s *CometBFTServer[T]) CLICommands() serverv2.CLIConfig {
 			s.QueryBlockCmd(),
 			s.QueryBlocksCmd(),
 			s.QueryBlockResultsCmd(),
+			s.StoreCmd(),
 			cmtcmd.ResetAllCmd,
 			cmtcmd.ResetStateCmd,
 		},
@@ -0,0 +1,249 @@
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/db"
+)
+
+const (
+	flagHeight        = "height"
+	flagInitialHeight = "initial-height"
+	flagOutput        = "output"
+	flagDBBackend     = "db-backend"
+)
+
+// verifiableStore is implemented by the root stores supporting the integrity
+// verification, rebuild and compaction of their state.
+type verifiableStore interface {
+	store.Compactor
+	VerifyVersion(version uint64) (*commitment.IntegrityReport, error)
+	RebuildStateCommitment(initialVersion, version uint64, dst *commitment.CommitStore) (*commitment.IntegrityReport, error)
+	NewRebuildCommitStore(rootDir string, scRawDB corestore.KVStoreWithBatch) (*commitment.CommitStore, error)
+}
+
+// StoreCmd returns the store command group, used to verify the integrity of the
+// application state and to compact its databases. The commands must be run
+// while the node is stopped.
+func (s *CometBFTServer[T]) StoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Offline store integrity verification and maintenance commands",
+	}
+
+	cmd.AddCommand(
+		s.StoreVerifyCmd(),
+		s.StoreRebuildCmd(),
+		s.StoreCompactCmd(),
+	)
+
+	return cmd
+}
+
+// StoreVerifyCmd returns a command recomputing the commitment root of a height
+// from the storage layer and reporting the stores which do not match the
+// persisted commit info.
+func (s *CometBFTServer[T]) StoreVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the integrity of the application state at a given height",
+		Long: `Recompute the commitment root of the given height from the state storage (SS)
+and compare it, store by store, against the commit info persisted by the state commitment (SC).
+
+A store reporting mismatched values while its recomputed hash matches the committed one has a
+corrupted commitment tree and can be recovered with the rebuild command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, height, err := s.verifiableStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			report, err := rs.VerifyVersion(height)
+			if err != nil {
+				return err
+			}
+
+			printIntegrityReport(cmd, report)
+			if !report.OK() {
+				return fmt.Errorf("integrity verification failed at height %d", height)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagHeight, 0, "Height to verify, if not provided it uses the latest height in app state")
+
+	return cmd
+}
+
+// StoreRebuildCmd returns a command rebuilding the state commitment of a height
+// from the storage layer into a new database.
+func (s *CometBFTServer[T]) StoreRebuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the state commitment at a given height from the state storage",
+		Long: `Rebuild the state commitment (SC) of the given height from the state storage (SS) into a
+new application.db database located in the output directory, using the SC tree type of the node.
+The flat trees are written under the data/sc/flat directory of the output directory. Every height of the SS history, from the
+initial height of the chain up to the given one, is replayed, the SS must therefore not be pruned.
+
+The rebuilt database is only persisted if its root hash matches the committed one, in which case it
+can replace the existing SC database (and flat trees).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, height, err := s.verifiableStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			initialHeight, err := cmd.Flags().GetUint64(flagInitialHeight)
+			if err != nil {
+				return err
+			}
+			if initialHeight == 0 {
+				initialHeight = max(s.config.InitialHeight, 1)
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				return fmt.Errorf("--%s is required", flagOutput)
+			}
+
+			backend, err := cmd.Flags().GetString(flagDBBackend)
+			if err != nil {
+				return err
+			}
+			if backend == "" {
+				backend = s.config.CmtConfig.DBBackend
+			}
+
+			dbPath := filepath.Join(output, "application"+db.DBFileSuffix)
+			if _, err := os.Stat(dbPath); err == nil {
+				return fmt.Errorf("%s already contains an application database", output)
+			}
+			dataPath := filepath.Join(output, "data")
+			if _, err := os.Stat(dataPath); err == nil {
+				return fmt.Errorf("%s already contains a data directory", output)
+			}
+
+			scRawDB, err := db.NewDB(db.DBType(backend), "application", output, nil)
+			if err != nil {
+				return err
+			}
+
+			report, err := rebuildStateCommitment(rs, initialHeight, height, output, scRawDB)
+			if report != nil {
+				printIntegrityReport(cmd, report)
+			}
+			if err := errors.Join(err, scRawDB.Close()); err != nil {
+				// the partially rebuilt database and trees cannot be used and are discarded
+				return errors.Join(err, os.RemoveAll(dbPath), os.RemoveAll(dataPath))
+			}
+
+			cmd.Printf("rebuilt state commitment at height %d into %s\n", height, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagHeight, 0, "Height to rebuild, if not provided it uses the latest height in app state")
+	cmd.Flags().Uint64(flagInitialHeight, 0, "Initial height of the chain from which the state storage history is replayed, if not provided it uses the configured initial height")
+	cmd.Flags().String(flagOutput, "", "Directory in which the rebuilt application.db database is written")
+	cmd.Flags().String(flagDBBackend, "", "Database backend of the rebuilt application.db database, if not provided it uses the configured db_backend")
+
+	return cmd
+}
+
+// rebuildStateCommitment rebuilds the state commitment of the store into
+// scRawDB, or into the flat trees of the output directory, with the SC tree
+// type of the store.
+func rebuildStateCommitment(
+	rs verifiableStore,
+	initialHeight, height uint64,
+	output string,
+	scRawDB corestore.KVStoreWithBatch,
+) (_ *commitment.IntegrityReport, err error) {
+	dst, err := rs.NewRebuildCommitStore(output, scRawDB)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, dst.Close())
+	}()
+
+	return rs.RebuildStateCommitment(initialHeight, height, dst)
+}
+
+// StoreCompactCmd returns a command compacting the databases of the application
+// state, reclaiming the disk space left behind by pruning.
+func (s *CometBFTServer[T]) StoreCompactCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the databases of the application state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, ok := s.App.store.(verifiableStore)
+			if !ok {
+				return fmt.Errorf("store %T does not support compaction", s.App.store)
+			}
+
+			if err := rs.Compact(); err != nil {
+				return err
+			}
+
+			cmd.Println("compacted application state")
+			return nil
+		},
+	}
+}
+
+// verifiableStore returns the application store alongside the height provided
+// by the height flag, defaulting to the latest height.
+func (s *CometBFTServer[T]) verifiableStore(cmd *cobra.Command) (verifiableStore, uint64, error) {
+	rs, ok := s.App.store.(verifiableStore)
+	if !ok {
+		return nil, 0, fmt.Errorf("store %T does not support integrity verification", s.App.store)
+	}
+
+	height, err := cmd.Flags().GetUint64(flagHeight)
+	if err != nil {
+		return nil, 0, err
+	}
+	if height == 0 {
+		height, err = s.App.store.GetLatestVersion()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return rs, height, nil
+}
+
+func printIntegrityReport(cmd *cobra.Command, report *commitment.IntegrityReport) {
+	cmd.Printf("height: %d\ncommitted hash: %X\nrecomputed hash: %X\n", report.Version, report.CommittedHash, report.RecomputedHash)
+	for _, s := range report.Stores {
+		status := "OK"
+		if !s.OK() {
+			status = "MISMATCH"
+		}
+
+		cmd.Printf("%s\t%s\tcommitted=%X recomputed=%X missing=%d extra=%d mismatched=%d",
+			status, s.StoreKey, s.CommittedHash, s.RecomputedHash, s.MissingKeys, s.ExtraKeys, s.MismatchedValues)
+		if s.Error != "" {
+			cmd.Printf(" error=%q", s.Error)
+		}
+		cmd.Println()
+	}
+}
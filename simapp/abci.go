@@ -1,84 +1,35 @@
 package simapp
 
 import (
-	"bytes"
-	"crypto/rand"
-	"encoding/json"
-	"fmt"
-
-	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"cosmossdk.io/core/log"
+	oracleabci "cosmossdk.io/x/oracle/abci"
+	oraclekeeper "cosmossdk.io/x/oracle/keeper"
+	oracleprovider "cosmossdk.io/x/oracle/provider"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type (
-	// VoteExtensionHandler defines a dummy vote extension handler for SimApp.
-	//
-	// NOTE: This implementation is solely used for testing purposes. DO NOT use
-	// in a production application!
-	VoteExtensionHandler struct{}
-
-	// VoteExtension defines the structure used to create a dummy vote extension.
-	VoteExtension struct {
-		Hash   []byte
-		Height int64
-		Data   []byte
-	}
-)
-
-func NewVoteExtensionHandler() *VoteExtensionHandler {
-	return &VoteExtensionHandler{}
-}
-
-func (h *VoteExtensionHandler) SetHandlers(bApp *baseapp.BaseApp) {
-	bApp.SetExtendVoteHandler(h.ExtendVote())
-	bApp.SetVerifyVoteExtensionHandler(h.VerifyVoteExtension())
-}
-
-func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
-	return func(_ sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
-		buf := make([]byte, 1024)
-
-		_, err := rand.Read(buf)
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate random vote extension data: %w", err)
-		}
-
-		ve := VoteExtension{
-			Hash:   req.Hash,
-			Height: req.Height,
-			Data:   buf,
-		}
-
-		bz, err := json.Marshal(ve)
-		if err != nil {
-			return nil, fmt.Errorf("failed to encode vote extension: %w", err)
-		}
-
-		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
-	}
-}
-
-func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
-	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
-		var ve VoteExtension
-
-		if err := json.Unmarshal(req.VoteExtension, &ve); err != nil {
-			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
-		}
-
-		switch {
-		case req.Height != ve.Height:
-			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
-
-		case !bytes.Equal(req.Hash, ve.Hash):
-			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
-
-		case len(ve.Data) != 1024:
-			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
-		}
-
-		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
-	}
+// setOracleHandlers sets the vote extension handlers of the oracle, and wraps
+// the default proposal handlers and the given PreBlocker so that the prices
+// aggregated from the vote extensions are written to state.
+//
+// NOTE: SimApp validators have no price source, so they report no prices. A
+// production application should use a provider fetching prices from exchanges.
+func setOracleHandlers(bApp *baseapp.BaseApp, logger log.Logger, k *oraclekeeper.Keeper, valStore baseapp.ValidatorStore, preBlocker sdk.PreBlocker) {
+	voteExtHandler := oracleabci.NewVoteExtensionHandler(logger, k, oracleprovider.NewMockProvider(nil), 0)
+	bApp.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	bApp.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(bApp.Mempool(), bApp)
+	proposalHandler := oracleabci.NewProposalHandler(
+		logger,
+		k,
+		valStore,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	bApp.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	bApp.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	bApp.SetPreBlocker(proposalHandler.PreBlocker(preBlocker))
 }
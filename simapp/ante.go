@@ -4,17 +4,20 @@ import (
 	"errors"
 
 	"cosmossdk.io/x/auth/ante"
-	"cosmossdk.io/x/auth/ante/unorderedtx"
 	circuitante "cosmossdk.io/x/circuit/ante"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// gasRefundRatio is the fraction of the fees paid for the unused gas of a
+// transaction which is refunded by the post handler.
+const gasRefundRatio = "0.5"
+
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
 	CircuitKeeper circuitante.CircuitBreaker
-	TxManager     *unorderedtx.Manager
+	TxManager     ante.UnorderedTxManager
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -33,18 +36,26 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	sigVerificationDecorator := ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper)
+	if options.NonceLaneKeeper != nil {
+		sigVerificationDecorator = sigVerificationDecorator.WithNonceLanes()
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(options.Environment),
 		ante.NewTxTimeoutHeightDecorator(options.Environment),
-		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, options.TxManager, options.Environment),
+		ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, options.TxManager, options.Environment),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		sigVerificationDecorator,
+	}
+	if options.NonceLaneKeeper != nil {
+		anteDecorators = append(anteDecorators, ante.NewNonceLaneDecorator(options.NonceLaneKeeper))
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -23,6 +23,7 @@ import (
 	groupmodulev1 "cosmossdk.io/api/cosmos/group/module/v1"
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
+	oraclemodulev1 "cosmossdk.io/api/cosmos/oracle/module/v1"
 	poolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
@@ -58,7 +59,9 @@ import (
 	_ "cosmossdk.io/x/mint"         // import for side-effects
 	minttypes "cosmossdk.io/x/mint/types"
 	"cosmossdk.io/x/nft"
-	_ "cosmossdk.io/x/nft/module"   // import for side-effects
+	_ "cosmossdk.io/x/nft/module" // import for side-effects
+	_ "cosmossdk.io/x/oracle"     // import for side-effects
+	oracletypes "cosmossdk.io/x/oracle/types"
 	_ "cosmossdk.io/x/protocolpool" // import for side-effects
 	pooltypes "cosmossdk.io/x/protocolpool/types"
 	_ "cosmossdk.io/x/slashing" // import for side-effects
@@ -73,6 +76,11 @@ import (
 )
 
 var (
+	// passkeyRPID and passkeyOrigins are the WebAuthn relying party of the
+	// passkey accounts, a wallet served locally.
+	passkeyRPID    = "localhost"
+	passkeyOrigins = []string{"http://localhost"}
+
 	// module account permissions
 	moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
 		{Account: authtypes.FeeCollectorName},
@@ -81,7 +89,7 @@ var (
 		{Account: pooltypes.StreamAccount},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
-		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, authtypes.Minter, stakingtypes.ModuleName}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
 	}
@@ -126,6 +134,7 @@ var (
 					EndBlockers: []string{
 						govtypes.ModuleName,
 						stakingtypes.ModuleName,
+						authtypes.ModuleName,
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
@@ -164,6 +173,7 @@ var (
 						circuittypes.ModuleName,
 						pooltypes.ModuleName,
 						epochstypes.ModuleName,
+						oracletypes.ModuleName,
 					},
 					// When ExportGenesis is not specified, the export genesis module order
 					// is equal to the init genesis order
@@ -214,6 +224,7 @@ var (
 				Name: "tx",
 				Config: appconfig.WrapAny(&txconfigv1.Config{
 					SkipAnteHandler: true, // SimApp is using non default AnteHandler such as circuit and unorderedtx decorators
+					GasRefundRatio:  gasRefundRatio,
 				}),
 			},
 			{
@@ -273,12 +284,19 @@ var (
 			},
 			{
 				Name:   accounts.ModuleName,
-				Config: appconfig.WrapAny(&accountsmodulev1.Module{}),
+				Config: appconfig.WrapAny(&accountsmodulev1.Module{
+					PasskeyRpId:    passkeyRPID,
+					PasskeyOrigins: passkeyOrigins,
+				}),
 			},
 			{
 				Name:   epochstypes.ModuleName,
 				Config: appconfig.WrapAny(&epochsmodulev1.Module{}),
 			},
+			{
+				Name:   oracletypes.ModuleName,
+				Config: appconfig.WrapAny(&oraclemodulev1.Module{}),
+			},
 		},
 	})
 )
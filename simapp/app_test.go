@@ -23,7 +23,9 @@ import (
 	"cosmossdk.io/x/bank"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/distribution"
+	distrtypes "cosmossdk.io/x/distribution/types"
 	"cosmossdk.io/x/epochs"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	"cosmossdk.io/x/evidence"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"cosmossdk.io/x/gov"
@@ -285,6 +287,21 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	}
 }
 
+// TestEpochHooks tests that the distribution module receives the epoch hooks,
+// which drive its auto-compounding rounds.
+func TestEpochHooks(t *testing.T) {
+	app := Setup(t, false)
+
+	hooks, ok := app.EpochsKeeper.Hooks().(epochstypes.MultiEpochHooks)
+	require.True(t, ok)
+
+	var modules []string
+	for _, hook := range hooks {
+		modules = append(modules, hook.GetModuleName())
+	}
+	require.Contains(t, modules, distrtypes.ModuleName)
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
@@ -0,0 +1,19 @@
+package flat
+
+// Config is the configuration for the flat tree.
+type Config struct {
+	// SegmentSize is the size in bytes of the append-only log segments. A record
+	// which does not fit in the remaining space of the active segment is written
+	// to a new segment, larger than SegmentSize if the record requires it.
+	SegmentSize int64 `mapstructure:"segment_size"`
+	// SyncWrites defines whether the log is fsynced on every commit.
+	SyncWrites bool `mapstructure:"sync_writes"`
+}
+
+// DefaultConfig returns the default configuration for the flat tree.
+func DefaultConfig() *Config {
+	return &Config{
+		SegmentSize: 64 << 20, // 64MiB
+		SyncWrites:  true,
+	}
+}
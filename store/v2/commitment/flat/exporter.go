@@ -0,0 +1,92 @@
+package flat
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// exportBatchSize is the number of leaves read from the snapshot at once.
+const exportBatchSize = 256
+
+var _ commitment.Exporter = (*Exporter)(nil)
+
+// Exporter exports the leaves of a committed version of the tree, in order. The
+// leaves are exported as height 0 nodes of the given version, hence a flat tree
+// snapshot can only be restored into a flat tree.
+type Exporter struct {
+	tree    *Tree
+	version uint64
+	batch   []leaf
+	// last is the key of the last leaf read from the snapshot
+	last []byte
+	done bool
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	if len(e.batch) == 0 {
+		if err := e.fill(); err != nil {
+			return nil, err
+		}
+	}
+	if len(e.batch) == 0 {
+		return nil, commitment.ErrorExportDone
+	}
+
+	l := e.batch[0]
+	e.batch = e.batch[1:]
+
+	return &snapshotstypes.SnapshotIAVLItem{
+		Key:     l.key,
+		Value:   l.value,
+		Version: int64(e.version),
+		Height:  0,
+	}, nil
+}
+
+// fill reads the next batch of leaves from the snapshot. As the snapshot
+// aliases the mapped log, which may be compacted between two batches, it is
+// looked up again and the leaves are copied.
+func (e *Exporter) fill() error {
+	if e.done {
+		return nil
+	}
+
+	s, err := e.tree.acquireSnapshot(e.version)
+	if err != nil {
+		return err
+	}
+	defer e.tree.releaseSnapshot(s)
+
+	iter := func(l leaf) bool {
+		if e.last != nil && bytes.Equal(l.key, e.last) {
+			return true
+		}
+		e.batch = append(e.batch, leaf{key: bytes.Clone(l.key), value: bytes.Clone(l.value)})
+		return len(e.batch) < exportBatchSize
+	}
+	if e.last == nil {
+		s.leaves.Ascend(iter)
+	} else {
+		s.leaves.AscendGreaterOrEqual(leaf{key: e.last}, iter)
+	}
+
+	if len(e.batch) < exportBatchSize {
+		e.done = true
+	}
+	if len(e.batch) > 0 {
+		e.last = e.batch[len(e.batch)-1].key
+	}
+
+	return nil
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.batch = nil
+	e.done = true
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package flat
+
+import (
+	"bytes"
+	"runtime"
+	"slices"
+	"sort"
+	"sync"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/google/btree"
+
+	"cosmossdk.io/store/v2/proof"
+)
+
+// parallelHashThreshold is the number of inner nodes of a tree level above
+// which the level is hashed concurrently.
+const parallelHashThreshold = 1 << 12
+
+// innerPrefix is the prefix of the inner nodes of proof.SimpleMerkleSpec.
+var innerPrefix = []byte{1}
+
+// nextLevel hashes together the pairs of nodes of a tree level, the leftover
+// node of an odd level being promoted as is, as done by
+// proof.ProofFromByteSlices. Large levels are hashed concurrently.
+func nextLevel(level [][]byte) [][]byte {
+	pairs := len(level) / 2
+	next := make([][]byte, (len(level)+1)/2)
+
+	hashPairs := func(start, end int) {
+		for i := start; i < end; i++ {
+			next[i] = proof.InnerHash(level[2*i], level[2*i+1])
+		}
+	}
+
+	if pairs < parallelHashThreshold {
+		hashPairs(0, pairs)
+	} else {
+		workers := runtime.GOMAXPROCS(0)
+		chunk := (pairs + workers - 1) / workers
+		var wg sync.WaitGroup
+		for start := 0; start < pairs; start += chunk {
+			wg.Add(1)
+			go func(start, end int) {
+				defer wg.Done()
+				hashPairs(start, end)
+			}(start, min(start+chunk, pairs))
+		}
+		wg.Wait()
+	}
+
+	if len(level)&1 == 1 {
+		next[pairs] = level[len(level)-1]
+	}
+
+	return next
+}
+
+// rootHash computes the simple merkle root of the given leaf hashes.
+func rootHash(hashes [][]byte) []byte {
+	if len(hashes) == 0 {
+		root, _ := proof.ProofFromByteSlices(nil, 0)
+		return root
+	}
+
+	for len(hashes) > 1 {
+		hashes = nextLevel(hashes)
+	}
+
+	return hashes[0]
+}
+
+// merkleTree holds all the nodes of the simple merkle tree of a set of leaves,
+// in order to generate their proofs, or to update its root when some of them
+// change, without rehashing the whole tree.
+type merkleTree struct {
+	// snapshot is the committed version the tree was built from, if any.
+	snapshot *snapshot
+	leaves   []leaf
+	// levels holds the nodes of the tree by level, from the leaves to the root.
+	levels [][][]byte
+}
+
+func newMerkleTree(s *snapshot) *merkleTree {
+	t := &merkleTree{
+		snapshot: s,
+		leaves:   make([]leaf, 0, s.leaves.Len()),
+	}
+	s.leaves.Ascend(func(l leaf) bool {
+		t.leaves = append(t.leaves, l)
+		return true
+	})
+	t.rehash(nil, 0)
+
+	return t
+}
+
+// root returns the root hash of the tree.
+func (t *merkleTree) root() []byte {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return rootHash(nil)
+	}
+
+	return top[0]
+}
+
+// rehash updates the nodes of the tree after its leaves changed. The leaves at
+// the given changed indices, sorted in ascending order, were replaced in place
+// while the ones from the given index on may have shifted. Only the paths of
+// the changed leaves and the nodes covering the leaves from the given index on
+// are rehashed.
+func (t *merkleTree) rehash(changed []int, from int) {
+	if len(t.levels) == 0 {
+		t.levels = [][][]byte{nil}
+	}
+
+	level := t.levels[0]
+	from = min(from, len(level), len(t.leaves))
+	level = level[:from]
+	for _, l := range t.leaves[from:] {
+		level = append(level, l.hash)
+	}
+	for _, i := range changed {
+		level[i] = t.leaves[i].hash
+	}
+	t.levels[0] = level
+
+	depth := 0
+	for ; len(t.levels[depth]) > 1; depth++ {
+		prev := t.levels[depth]
+		if len(t.levels) == depth+1 {
+			t.levels = append(t.levels, nil)
+		}
+		next := t.levels[depth+1]
+
+		// the parents of the changed nodes, the ones covering shifted nodes being
+		// recomputed as a whole
+		from = min(from/2, len(next))
+		parents := changed[:0]
+		for _, i := range changed {
+			if p := i / 2; p < from && (len(parents) == 0 || parents[len(parents)-1] != p) {
+				parents = append(parents, p)
+			}
+		}
+		changed = parents
+
+		next = append(next[:from], nextLevel(prev[2*from:])...)
+		for _, i := range changed {
+			if 2*i+1 < len(prev) {
+				next[i] = proof.InnerHash(prev[2*i], prev[2*i+1])
+			} else {
+				next[i] = prev[2*i]
+			}
+		}
+		t.levels[depth+1] = next
+	}
+	t.levels = t.levels[:depth+1]
+}
+
+// workingTree incrementally maintains the merkle tree of the working leaves of
+// a tree, only the nodes depending on the leaves changed since the last hash
+// being rehashed.
+type workingTree struct {
+	merkleTree
+
+	// dirty holds the keys changed since the last hash.
+	dirty map[string]struct{}
+	// rebuild is set when the whole tree must be rehashed.
+	rebuild bool
+}
+
+func newWorkingTree() *workingTree {
+	return &workingTree{
+		dirty:   make(map[string]struct{}),
+		rebuild: true,
+	}
+}
+
+// markDirty marks the given key as changed since the last hash.
+func (w *workingTree) markDirty(key []byte) {
+	if !w.rebuild {
+		w.dirty[string(key)] = struct{}{}
+	}
+}
+
+// reset discards the tree, which is rebuilt on the next hash.
+func (w *workingTree) reset() {
+	w.leaves = nil
+	w.levels = nil
+	w.rebuild = true
+	clear(w.dirty)
+}
+
+// replace replaces the leaf of the same key as the given one, if any, without
+// changing its hash.
+func (w *workingTree) replace(l leaf) {
+	if i, ok := w.search(l.key); ok && !w.rebuild {
+		w.leaves[i] = l
+	}
+}
+
+// search returns the index of the first leaf whose key is greater or equal to
+// the given key, and whether it is equal.
+func (w *workingTree) search(key []byte) (int, bool) {
+	i := sort.Search(len(w.leaves), func(i int) bool {
+		return bytes.Compare(w.leaves[i].key, key) >= 0
+	})
+
+	return i, i < len(w.leaves) && bytes.Equal(w.leaves[i].key, key)
+}
+
+// hash returns the root hash of the given working leaves. The leaves updated in
+// place only require rehashing their paths, whereas inserting or removing a
+// leaf shifts the ones following it, whose nodes are rehashed.
+func (w *workingTree) hash(working *btree.BTreeG[leaf]) []byte {
+	if w.rebuild {
+		w.leaves = make([]leaf, 0, working.Len())
+		working.Ascend(func(l leaf) bool {
+			w.leaves = append(w.leaves, l)
+			return true
+		})
+		w.levels = nil
+		w.rehash(nil, 0)
+		w.rebuild = false
+		clear(w.dirty)
+
+		return w.root()
+	}
+	if len(w.dirty) == 0 {
+		return w.root()
+	}
+
+	keys := make([]string, 0, len(w.dirty))
+	for key := range w.dirty {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var (
+		changed []int
+		// from is the index of the first inserted or removed leaf, the leaves
+		// following it being merged with the changed keys
+		from = -1
+		tail []leaf
+		i    int
+	)
+	for _, k := range keys {
+		key := []byte(k)
+		l, exists := working.Get(leaf{key: key})
+
+		if from < 0 {
+			idx, existed := w.search(key)
+			if existed && exists {
+				w.leaves[idx] = l
+				changed = append(changed, idx)
+				continue
+			}
+			if !existed && !exists {
+				continue
+			}
+			from = idx
+			tail = slices.Clone(w.leaves[idx:])
+			w.leaves = w.leaves[:idx]
+		}
+
+		for ; i < len(tail) && bytes.Compare(tail[i].key, key) < 0; i++ {
+			w.leaves = append(w.leaves, tail[i])
+		}
+		if i < len(tail) && bytes.Equal(tail[i].key, key) {
+			i++
+		}
+		if exists {
+			w.leaves = append(w.leaves, l)
+		}
+	}
+	if from < 0 {
+		from = len(w.leaves)
+	} else {
+		w.leaves = append(w.leaves, tail[i:]...)
+	}
+
+	w.rehash(changed, from)
+	clear(w.dirty)
+
+	return w.root()
+}
+
+// createProof returns an existence proof of the given key if it exists in the
+// tree, or a non-existence proof built from its neighbors otherwise.
+func (t *merkleTree) createProof(key []byte) *ics23.CommitmentProof {
+	// index of the first leaf whose key is greater or equal to the given key
+	idx := sort.Search(len(t.leaves), func(i int) bool {
+		return bytes.Compare(t.leaves[i].key, key) >= 0
+	})
+
+	if idx < len(t.leaves) && bytes.Equal(t.leaves[idx].key, key) {
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{
+				Exist: t.existenceProof(idx),
+			},
+		}
+	}
+
+	nonExist := &ics23.NonExistenceProof{Key: key}
+	if idx > 0 {
+		nonExist.Left = t.existenceProof(idx - 1)
+	}
+	if idx < len(t.leaves) {
+		nonExist.Right = t.existenceProof(idx)
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{
+			Nonexist: nonExist,
+		},
+	}
+}
+
+// existenceProof returns the existence proof of the leaf at the given index,
+// the path being built as done by proof.ProofFromByteSlices.
+func (t *merkleTree) existenceProof(index int) *ics23.ExistenceProof {
+	l := t.leaves[index]
+
+	var inners []*ics23.InnerOp
+	for _, level := range t.levels[:len(t.levels)-1] {
+		// the leftover node of an odd level has no sibling
+		if index < len(level)-1 || index&1 == 1 {
+			inner := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+			if index&1 == 0 {
+				inner.Prefix = bytes.Clone(innerPrefix)
+				inner.Suffix = bytes.Clone(level[index+1])
+			} else {
+				inner.Prefix = append(bytes.Clone(innerPrefix), level[index-1]...)
+			}
+			inners = append(inners, inner)
+		}
+		index /= 2
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   bytes.Clone(l.key),
+		Value: bytes.Clone(l.value),
+		Leaf:  proof.SimpleMerkleSpec.LeafSpec,
+		Path:  inners,
+	}
+}
@@ -0,0 +1,58 @@
+package flat
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ commitment.Importer = (*Importer)(nil)
+
+// Importer imports the leaves of a snapshot into an empty tree, the inner nodes
+// of the snapshot are ignored.
+type Importer struct {
+	tree    *Tree
+	version uint64
+	closed  bool
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if i.closed {
+		return errors.New("importer is closed")
+	}
+	if item.Height != 0 {
+		return nil
+	}
+	if item.Value == nil {
+		item.Value = []byte{}
+	}
+
+	return i.tree.Set(item.Key, item.Value)
+}
+
+// Commit commits the imported leaves as the version of the importer.
+func (i *Importer) Commit() error {
+	if i.closed {
+		return errors.New("importer is closed")
+	}
+
+	if _, err := i.tree.commit(i.version); err != nil {
+		return fmt.Errorf("failed to commit imported version %d: %w", i.version, err)
+	}
+	i.closed = true
+
+	return nil
+}
+
+// Close closes the importer, discarding the leaves added since the last commit.
+func (i *Importer) Close() error {
+	if !i.closed {
+		i.closed = true
+		i.tree.discard()
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build !unix
+
+package flat
+
+import (
+	"io"
+	"os"
+)
+
+// sharedMapping reports whether writes to the segment files are visible
+// through their memory mapping. Platforms without mmap support read the segment
+// files in memory, hence appended records must be copied to the buffer.
+const sharedMapping = false
+
+// mmap reads the first size bytes of the given file in memory.
+func mmap(f *os.File, size int) ([]byte, error) {
+	data := make([]byte, size)
+	if _, err := f.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// munmap releases a buffer returned by mmap.
+func munmap([]byte) error {
+	return nil
+}
@@ -0,0 +1,30 @@
+//go:build unix
+
+package flat
+
+import (
+	"os"
+	"syscall"
+)
+
+// sharedMapping reports whether writes to the segment files are visible
+// through their memory mapping.
+const sharedMapping = true
+
+// mmap maps the first size bytes of the given file in memory, read-only.
+func mmap(f *os.File, size int) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	return syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// munmap unmaps a mapping returned by mmap.
+func munmap(data []byte) error {
+	if data == nil {
+		return nil
+	}
+
+	return syscall.Munmap(data)
+}
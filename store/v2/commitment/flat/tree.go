@@ -0,0 +1,644 @@
+package flat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/google/btree"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+var (
+	_ commitment.Tree                 = (*Tree)(nil)
+	_ commitment.CommitmentOpProvider = (*Tree)(nil)
+)
+
+// bTreeDegree is the degree of the in-memory B-tree indexing the leaves.
+const bTreeDegree = 32
+
+// leaf is a leaf of the tree. Once committed, its key and value alias the
+// memory mapped log.
+type leaf struct {
+	key   []byte
+	value []byte
+	hash  []byte
+}
+
+func lessLeaf(a, b leaf) bool {
+	return bytes.Compare(a.key, b.key) < 0
+}
+
+// setRecordSize returns the size of the set record of the given leaf.
+func setRecordSize(l leaf) int64 {
+	return int64(recordHeaderSize + binary.PutUvarint(make([]byte, binary.MaxVarintLen64), uint64(len(l.key))) + len(l.key) + len(l.value))
+}
+
+// snapshot is an immutable committed version of the tree.
+type snapshot struct {
+	version uint64
+	hash    []byte
+	leaves  *btree.BTreeG[leaf]
+	// mapping is the generation of the mapped log the leaves alias.
+	mapping *mapping
+	// pos is the position of the end of the commit record of the version.
+	pos position
+	// liveSize is the size of the set records of the leaves of the version.
+	liveSize int64
+}
+
+// mapping is a generation of the mapped log, which a compaction supersedes. The
+// segments rewritten by the compaction are only unmapped once the reads of the
+// snapshots of their generation are done, hence reads never wait for
+// compactions.
+type mapping struct {
+	// refs counts the reads in progress, plus one until the generation is
+	// superseded.
+	refs atomic.Int64
+	// retired holds the segments to unmap once refs drops to zero.
+	retired []*segment
+}
+
+func newMapping() *mapping {
+	m := &mapping{}
+	m.refs.Store(1)
+
+	return m
+}
+
+// acquire pins the generation, returning false if it was already released.
+func (m *mapping) acquire() bool {
+	for {
+		refs := m.refs.Load()
+		if refs == 0 {
+			return false
+		}
+		if m.refs.CompareAndSwap(refs, refs+1) {
+			return true
+		}
+	}
+}
+
+// release unpins the generation, unmapping its retired segments once it is
+// superseded and no longer read.
+func (m *mapping) release() error {
+	if m.refs.Add(-1) > 0 {
+		return nil
+	}
+
+	var err error
+	for _, seg := range m.retired {
+		err = errors.Join(err, munmap(seg.data))
+	}
+
+	return err
+}
+
+// Tree is a flat, versioned and append-only implementation of commitment.Tree.
+//
+// The leaves of the tree are kept sorted in an in-memory copy-on-write B-tree,
+// and the tree hash is the simple merkle root (see proof.SimpleMerkleSpec) of
+// the leaf hashes. The inner nodes of the working tree are kept in memory and
+// only the ones depending on the changed leaves are rehashed. Inner nodes are
+// never persisted: the only writes performed on commit are the changed
+// key-value pairs and a commit record, appended to a memory mapped log which
+// acts as a write-ahead log, whose records are replayed when the tree is
+// opened. Committed keys and values are read directly from the mapped log.
+//
+// Committed versions are immutable snapshots of the B-tree, hence reads of
+// committed versions (Get, GetProof and Export) are lock-free and can run
+// concurrently with writes, including compactions. Writes, and Close, must not
+// be called concurrently.
+//
+// Pruning releases the memory held by the pruned versions. Once most of the
+// log preceding the oldest version is superseded, the log is compacted by
+// rewriting the leaves of the oldest version followed by the records of the
+// later versions. The replayed snapshots then replace the previous ones, whose
+// segments are unmapped once the reads in progress are done.
+type Tree struct {
+	logger log.Logger
+
+	// wal is only accessed by writes.
+	wal *wal
+	// mapping is the current generation of the mapped log.
+	mapping atomic.Pointer[mapping]
+
+	working     *btree.BTreeG[leaf]
+	pending     []record
+	workingHash []byte
+	merkle      *workingTree
+	// liveSize is the size of the set records of the working leaves.
+	liveSize int64
+
+	initialVersion uint64
+	latest         atomic.Uint64
+	// versions holds the committed *snapshot by version
+	versions sync.Map
+	// proofTree caches the merkle tree of the last version proofs were
+	// generated for, queries usually targeting the same recent version.
+	proofTree atomic.Pointer[merkleTree]
+}
+
+func newTree(logger log.Logger) *Tree {
+	t := &Tree{
+		logger:  logger,
+		working: btree.NewG(bTreeDegree, lessLeaf),
+		merkle:  newWorkingTree(),
+	}
+	t.mapping.Store(newMapping())
+
+	return t
+}
+
+// NewTree opens the flat tree located in dir, creating it if needed, and
+// replays its log up to the latest committed version.
+func NewTree(dir string, logger log.Logger, cfg *Config) (*Tree, error) {
+	t := newTree(logger)
+
+	w, err := openWAL(dir, cfg, t.replay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open flat tree log: %w", err)
+	}
+	t.wal = w
+
+	// ensure the replayed leaves match the latest committed hash
+	if s, ok := t.snapshot(t.GetLatestVersion()); ok {
+		if hash := t.merkle.hash(t.working); !bytes.Equal(hash, s.hash) {
+			_ = w.close()
+			return nil, fmt.Errorf("replayed hash %X does not match committed hash %X at version %d", hash, s.hash, s.version)
+		}
+		logger.Info("loaded flat tree", "dir", dir, "version", s.version)
+	}
+
+	return t, nil
+}
+
+func (t *Tree) replay(r record, pos position) error {
+	switch r.typ {
+	case recordSet:
+		return t.set(r.key, r.value)
+
+	case recordRemove:
+		t.remove(r.key)
+
+	case recordCommit:
+		t.seal(r.version, r.hash, pos)
+
+	case recordLoad:
+		return t.rollback(r.version)
+
+	case recordPrune:
+		t.prune(r.version)
+	}
+
+	return nil
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *Tree) Set(key, value []byte) error {
+	if value == nil {
+		return errors.New("value must not be nil")
+	}
+
+	key, value = bytes.Clone(key), bytes.Clone(value)
+	if err := t.set(key, value); err != nil {
+		return err
+	}
+	t.pending = append(t.pending, record{typ: recordSet, key: key, value: value})
+
+	return nil
+}
+
+func (t *Tree) set(key, value []byte) error {
+	hash, err := proof.LeafHash(key, value)
+	if err != nil {
+		return err
+	}
+
+	l := leaf{key: key, value: value, hash: hash}
+	if old, ok := t.working.ReplaceOrInsert(l); ok {
+		t.liveSize -= setRecordSize(old)
+	}
+	t.liveSize += setRecordSize(l)
+	t.merkle.markDirty(key)
+	t.workingHash = nil
+
+	return nil
+}
+
+// Remove removes the given key from the tree.
+func (t *Tree) Remove(key []byte) error {
+	if t.remove(key) {
+		t.pending = append(t.pending, record{typ: recordRemove, key: bytes.Clone(key)})
+	}
+
+	return nil
+}
+
+func (t *Tree) remove(key []byte) bool {
+	old, ok := t.working.Delete(leaf{key: key})
+	if !ok {
+		return false
+	}
+	t.liveSize -= setRecordSize(old)
+	t.merkle.markDirty(key)
+	t.workingHash = nil
+
+	return true
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *Tree) GetLatestVersion() uint64 {
+	return t.latest.Load()
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *Tree) Hash() []byte {
+	if s, ok := t.snapshot(t.GetLatestVersion()); ok {
+		return s.hash
+	}
+
+	return rootHash(nil)
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *Tree) WorkingHash() []byte {
+	if t.workingHash == nil {
+		t.workingHash = t.merkle.hash(t.working)
+	}
+
+	return t.workingHash
+}
+
+// LoadVersion loads the state at the given version, discarding the uncommitted
+// changes and the versions after it.
+func (t *Tree) LoadVersion(version uint64) error {
+	if version == 0 && t.GetLatestVersion() == 0 {
+		t.discard()
+		return nil
+	}
+	if _, ok := t.snapshot(version); !ok {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+
+	if version < t.GetLatestVersion() {
+		if err := t.write(record{typ: recordLoad, version: version}); err != nil {
+			return err
+		}
+	}
+
+	return t.rollback(version)
+}
+
+func (t *Tree) rollback(version uint64) error {
+	s, ok := t.snapshot(version)
+	if !ok {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+
+	for v := t.GetLatestVersion(); v > version; v-- {
+		t.versions.Delete(v)
+	}
+	t.working = s.leaves.Clone()
+	t.pending = nil
+	t.workingHash = s.hash
+	t.merkle.reset()
+	t.liveSize = s.liveSize
+	t.latest.Store(version)
+
+	return nil
+}
+
+// discard discards the uncommitted changes.
+func (t *Tree) discard() {
+	if s, ok := t.snapshot(t.GetLatestVersion()); ok {
+		t.working = s.leaves.Clone()
+		t.liveSize = s.liveSize
+	} else {
+		t.working = btree.NewG(bTreeDegree, lessLeaf)
+		t.liveSize = 0
+	}
+	t.pending = nil
+	t.workingHash = nil
+	t.merkle.reset()
+}
+
+// Commit commits the current state to the tree.
+func (t *Tree) Commit() ([]byte, uint64, error) {
+	version := t.GetLatestVersion() + 1
+	if t.GetLatestVersion() == 0 && t.initialVersion > 0 {
+		version = t.initialVersion
+	}
+
+	hash, err := t.commit(version)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return hash, version, nil
+}
+
+func (t *Tree) commit(version uint64) ([]byte, error) {
+	hash := t.WorkingHash()
+
+	records := make([][]byte, 0, len(t.pending)+1)
+	for _, r := range t.pending {
+		records = append(records, r.encode(nil))
+	}
+	records = append(records, record{typ: recordCommit, version: version, hash: hash}.encode(nil))
+
+	regions, err := t.wal.append(records)
+	if err == nil && t.wal.cfg.SyncWrites {
+		err = t.wal.sync()
+	}
+	pos := t.wal.end()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write version %d: %w", version, err)
+	}
+
+	// point the committed leaves to the mapped log, releasing the memory held by
+	// the pending changes, only the last change of each key is kept
+	seen := make(map[string]struct{}, len(t.pending))
+	for i := len(t.pending) - 1; i >= 0; i-- {
+		r := t.pending[i]
+		if _, ok := seen[string(r.key)]; ok {
+			continue
+		}
+		seen[string(r.key)] = struct{}{}
+		if r.typ != recordSet {
+			continue
+		}
+
+		mapped, _, err := decodeRecord(regions[i])
+		if err != nil {
+			return nil, err
+		}
+		if l, ok := t.working.Get(leaf{key: r.key}); ok {
+			l = leaf{key: mapped.key, value: mapped.value, hash: l.hash}
+			t.working.ReplaceOrInsert(l)
+			t.merkle.replace(l)
+		}
+	}
+
+	t.seal(version, hash, pos)
+
+	return hash, nil
+}
+
+// seal snapshots the working tree as the given version, whose commit record
+// ends at the given position of the log.
+func (t *Tree) seal(version uint64, hash []byte, pos position) {
+	hash = bytes.Clone(hash)
+	t.versions.Store(version, &snapshot{
+		version:  version,
+		hash:     hash,
+		leaves:   t.working.Clone(),
+		mapping:  t.mapping.Load(),
+		pos:      pos,
+		liveSize: t.liveSize,
+	})
+	t.pending = nil
+	t.workingHash = hash
+	t.latest.Store(version)
+}
+
+// SetInitialVersion sets the initial version of the tree.
+func (t *Tree) SetInitialVersion(version uint64) error {
+	t.initialVersion = version
+	return nil
+}
+
+// GetProof returns a proof for the given key and version.
+func (t *Tree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	s, err := t.acquireSnapshot(version)
+	if err != nil {
+		return nil, err
+	}
+	defer t.releaseSnapshot(s)
+
+	mt := t.proofTree.Load()
+	if mt == nil || mt.snapshot != s {
+		mt = newMerkleTree(s)
+		t.proofTree.Store(mt)
+	}
+
+	return mt.createProof(key), nil
+}
+
+// CommitmentOp implements commitment.CommitmentOpProvider.
+func (t *Tree) CommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp {
+	return proof.NewSimpleMerkleCommitmentOp(key, p)
+}
+
+// Get returns the value of the given key at the given version.
+func (t *Tree) Get(version uint64, key []byte) ([]byte, error) {
+	s, err := t.acquireSnapshot(version)
+	if err != nil {
+		return nil, err
+	}
+	defer t.releaseSnapshot(s)
+
+	l, ok := s.leaves.Get(leaf{key: key})
+	if !ok {
+		return nil, nil
+	}
+
+	return bytes.Clone(l.value), nil
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *Tree) Prune(version uint64) error {
+	if version >= t.GetLatestVersion() {
+		return fmt.Errorf("cannot prune the latest version %d", t.GetLatestVersion())
+	}
+
+	if err := t.write(record{typ: recordPrune, version: version}); err != nil {
+		return err
+	}
+	t.prune(version)
+
+	return t.maybeCompact()
+}
+
+func (t *Tree) prune(version uint64) {
+	t.versions.Range(func(k, _ any) bool {
+		if k.(uint64) <= version {
+			t.versions.Delete(k)
+		}
+		return true
+	})
+}
+
+// maybeCompact compacts the log once the records preceding the commit of the
+// oldest version are mostly superseded, and larger than a segment. As the
+// uncommitted changes would be lost by the replay of the compacted log, the
+// compaction is deferred while there are any.
+func (t *Tree) maybeCompact() error {
+	if len(t.pending) > 0 {
+		return nil
+	}
+
+	var oldest *snapshot
+	t.versions.Range(func(_, v any) bool {
+		if s := v.(*snapshot); oldest == nil || s.version < oldest.version {
+			oldest = s
+		}
+		return true
+	})
+	if oldest == nil {
+		return nil
+	}
+
+	superseded := t.wal.sizeBefore(oldest.pos) - oldest.liveSize
+	if superseded <= oldest.liveSize || superseded <= t.wal.cfg.SegmentSize {
+		return nil
+	}
+
+	return t.compact(oldest)
+}
+
+// compact compacts the log, keeping the versions from the given oldest one on,
+// and replays the compacted log.
+func (t *Tree) compact(oldest *snapshot) error {
+	base := func(emit func([]byte) error) error {
+		var err error
+		oldest.leaves.Ascend(func(l leaf) bool {
+			err = emit(record{typ: recordSet, key: l.key, value: l.value}.encode(nil))
+			return err == nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return emit(record{typ: recordCommit, version: oldest.version, hash: oldest.hash}.encode(nil))
+	}
+	retired, err := t.wal.compact(base, oldest.pos)
+	if err != nil {
+		err = fmt.Errorf("failed to compact log: %w", err)
+	} else {
+		t.logger.Info("compacted flat tree log", "dir", t.wal.dir, "version", oldest.version)
+	}
+
+	// the retired segments stay mapped until the current generation is released
+	prev := t.mapping.Load()
+	prev.retired = append(prev.retired, retired...)
+
+	// whether the compaction succeeded or was reverted, the log is replayed from
+	// its current segments into a new generation, which then replaces the
+	// current one
+	replayed := newTree(t.logger)
+	if replayErr := t.wal.replay(replayed.replay); replayErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to replay compacted log: %w", replayErr), replayed.mapping.Load().release())
+	}
+	t.adopt(replayed)
+
+	return errors.Join(err, prev.release())
+}
+
+// adopt replaces the state of the tree by the one replayed into the given tree.
+// The replayed snapshots replace the previous ones before the new generation is
+// published, so that the reads of a replaced snapshot find its replayed copy.
+func (t *Tree) adopt(replayed *Tree) {
+	t.versions.Range(func(k, _ any) bool {
+		if _, ok := replayed.versions.Load(k); !ok {
+			t.versions.Delete(k)
+		}
+		return true
+	})
+	replayed.versions.Range(func(k, v any) bool {
+		t.versions.Store(k, v)
+		return true
+	})
+
+	t.working = replayed.working
+	t.pending = nil
+	t.workingHash = replayed.workingHash
+	t.merkle = replayed.merkle
+	t.liveSize = replayed.liveSize
+	t.latest.Store(replayed.GetLatestVersion())
+	t.mapping.Store(replayed.mapping.Load())
+	t.proofTree.Store(nil)
+}
+
+// Export exports the tree at the given version.
+func (t *Tree) Export(version uint64) (commitment.Exporter, error) {
+	if _, err := t.getSnapshot(version); err != nil {
+		return nil, err
+	}
+
+	return &Exporter{tree: t, version: version}, nil
+}
+
+// Import imports the tree at the given version, the tree must be empty.
+func (t *Tree) Import(version uint64) (commitment.Importer, error) {
+	if t.GetLatestVersion() != 0 || t.working.Len() != 0 {
+		return nil, errors.New("cannot import into a non-empty tree")
+	}
+
+	return &Importer{tree: t, version: version}, nil
+}
+
+// Close closes the tree. The tree must not be used after it is closed.
+func (t *Tree) Close() error {
+	return errors.Join(t.wal.close(), t.mapping.Load().release())
+}
+
+// write appends a standalone record to the log.
+func (t *Tree) write(r record) error {
+	if _, err := t.wal.append([][]byte{r.encode(nil)}); err != nil {
+		return err
+	}
+
+	return t.wal.sync()
+}
+
+func (t *Tree) snapshot(version uint64) (*snapshot, bool) {
+	s, ok := t.versions.Load(version)
+	if !ok {
+		return nil, false
+	}
+
+	return s.(*snapshot), true
+}
+
+// acquireSnapshot returns the snapshot of the given version, pinning the mapped
+// log its leaves alias until it is released.
+func (t *Tree) acquireSnapshot(version uint64) (*snapshot, error) {
+	for {
+		s, err := t.getSnapshot(version)
+		if err != nil {
+			return nil, err
+		}
+		if s.mapping.acquire() {
+			return s, nil
+		}
+		// the generation of the snapshot was released by a compaction, its
+		// replayed copy was stored before
+	}
+}
+
+func (t *Tree) releaseSnapshot(s *snapshot) {
+	if err := s.mapping.release(); err != nil {
+		t.logger.Error("failed to unmap compacted flat tree log", "err", err)
+	}
+}
+
+func (t *Tree) getSnapshot(version uint64) (*snapshot, error) {
+	if version > t.GetLatestVersion() {
+		return nil, fmt.Errorf("version %d does not exist, latest version is %d", version, t.GetLatestVersion())
+	}
+
+	s, ok := t.snapshot(version)
+	if !ok {
+		return nil, fmt.Errorf("version %d was pruned", version)
+	}
+
+	return s, nil
+}
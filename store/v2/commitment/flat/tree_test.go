@@ -0,0 +1,547 @@
+package flat
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db corestore.KVStoreWithBatch, storeKeys []string, logger log.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			cfg := DefaultConfig()
+			cfg.SyncWrites = false
+			dir := t.TempDir()
+			for _, storeKey := range storeKeys {
+				tree, err := NewTree(filepath.Join(dir, storeKey), logger, cfg)
+				if err != nil {
+					return nil, err
+				}
+				multiTrees[storeKey] = tree
+			}
+			return commitment.NewCommitStore(multiTrees, db, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree(t *testing.T, dir string) *Tree {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.SegmentSize = 1 << 10
+	tree, err := NewTree(dir, log.NewNopLogger(), cfg)
+	require.NoError(t, err)
+
+	return tree
+}
+
+func TestFlatTree(t *testing.T) {
+	// generate a new tree
+	tree := generateTree(t, t.TempDir())
+	require.NotNil(t, tree)
+
+	initVersion := tree.GetLatestVersion()
+	require.Equal(t, uint64(0), initVersion)
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// commit the batch
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(1))
+	require.Equal(t, workingHash, commitHash)
+	require.Equal(t, uint64(1), tree.GetLatestVersion())
+
+	// ensure we can get expected values
+	bz, err := tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	bz, err = tree.Get(2, []byte("key1"))
+	require.Error(t, err)
+	require.Nil(t, bz)
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Set([]byte("key6"), []byte("value6")))
+	require.NoError(t, tree.Remove([]byte("key1"))) // delete key1
+	version2Hash := tree.WorkingHash()
+	require.NotNil(t, version2Hash)
+	commitHash, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(2))
+	require.Equal(t, version2Hash, commitHash)
+
+	// get proof for key1
+	p, err := tree.GetProof(1, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, p.GetExist())
+
+	p, err = tree.GetProof(2, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, p.GetNonexist())
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key7"), []byte("value7")))
+	require.NoError(t, tree.Set([]byte("key8"), []byte("value8")))
+	_, _, err = tree.Commit()
+	require.NoError(t, err)
+
+	// prune version 1
+	require.NoError(t, tree.Prune(1))
+	require.Equal(t, uint64(3), tree.GetLatestVersion())
+	_, err = tree.Get(1, []byte("key2"))
+	require.Error(t, err)
+
+	// load version 2
+	require.NoError(t, tree.LoadVersion(2))
+	require.Equal(t, version2Hash, tree.WorkingHash())
+	require.Equal(t, uint64(2), tree.GetLatestVersion())
+
+	// close the tree
+	require.NoError(t, tree.Close())
+}
+
+func TestFlatTree_Proofs(t *testing.T) {
+	tree := generateTree(t, t.TempDir())
+	defer tree.Close()
+
+	for i := 0; i < 100; i += 2 {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i))))
+	}
+	root, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	for i := 0; i < 101; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		p, err := tree.GetProof(1, key)
+		require.NoError(t, err)
+
+		op := tree.CommitmentOp(key, p)
+		require.Equal(t, proof.ProofOpSimpleMerkleCommitment, op.Type)
+
+		if i%2 == 0 && i < 100 {
+			require.True(t, ics23.VerifyMembership(op.Spec, root, p, key, []byte(fmt.Sprintf("value%03d", i))), "key %s", key)
+		} else {
+			require.True(t, ics23.VerifyNonMembership(op.Spec, root, p, key), "key %s", key)
+		}
+	}
+}
+
+func TestFlatTree_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	tree := generateTree(t, dir)
+
+	hashes := make(map[uint64][]byte)
+	for v := 1; v <= 20; v++ {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i*v)), []byte(fmt.Sprintf("value%03d", v))))
+		}
+		require.NoError(t, tree.Remove([]byte(fmt.Sprintf("key%03d", v-1))))
+		hash, version, err := tree.Commit()
+		require.NoError(t, err)
+		hashes[version] = hash
+	}
+	require.NoError(t, tree.Prune(5))
+	require.NoError(t, tree.LoadVersion(15))
+
+	// uncommitted changes are discarded on reopen
+	require.NoError(t, tree.Set([]byte("uncommitted"), []byte("value")))
+	require.NoError(t, tree.Close())
+
+	segments, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Greater(t, len(segments), 1)
+
+	tree = generateTree(t, dir)
+	require.Equal(t, uint64(15), tree.GetLatestVersion())
+	require.Equal(t, hashes[15], tree.Hash())
+	require.Equal(t, hashes[15], tree.WorkingHash())
+
+	for v := uint64(1); v <= 20; v++ {
+		_, err := tree.Get(v, []byte("key000"))
+		if v <= 5 || v > 15 {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		p, err := tree.GetProof(v, []byte("key010"))
+		require.NoError(t, err)
+		root, err := p.Calculate()
+		require.NoError(t, err)
+		require.Equal(t, hashes[v], []byte(root))
+	}
+
+	// commit on top of the loaded version
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+	_, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), version)
+	require.NoError(t, tree.Close())
+
+	tree = generateTree(t, dir)
+	defer tree.Close()
+	require.Equal(t, uint64(16), tree.GetLatestVersion())
+	bz, err := tree.Get(16, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), bz)
+}
+
+func TestFlatTree_TornWrite(t *testing.T) {
+	dir := t.TempDir()
+	tree := generateTree(t, dir)
+
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	hash, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	// simulate a crash in the middle of the commit of version 2
+	_, err = tree.wal.append([][]byte{
+		record{typ: recordSet, key: []byte("key2"), value: []byte("value2")}.encode(nil),
+		record{typ: recordCommit, version: 2, hash: hash}.encode(nil)[:10],
+	})
+	require.NoError(t, err)
+	require.NoError(t, tree.Close())
+
+	tree = generateTree(t, dir)
+	require.Equal(t, uint64(1), tree.GetLatestVersion())
+	bz, err := tree.Get(1, []byte("key2"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// the torn tail is overwritten by the next commit
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+	hash, _, err = tree.Commit()
+	require.NoError(t, err)
+	require.NoError(t, tree.Close())
+
+	tree = generateTree(t, dir)
+	defer tree.Close()
+	require.Equal(t, uint64(2), tree.GetLatestVersion())
+	require.Equal(t, hash, tree.Hash())
+}
+
+func TestFlatTree_ExportImport(t *testing.T) {
+	tree := generateTree(t, t.TempDir())
+	defer tree.Close()
+
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%04d", i))))
+	}
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	exporter, err := tree.Export(version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	target := generateTree(t, t.TempDir())
+	defer target.Close()
+	importer, err := target.Import(version)
+	require.NoError(t, err)
+
+	count := 0
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, commitment.ErrorExportDone) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("key%04d", count)), item.Key)
+		require.NoError(t, importer.Add(item))
+		count++
+	}
+	require.Equal(t, 1000, count)
+	require.NoError(t, importer.Commit())
+	require.NoError(t, importer.Close())
+
+	require.Equal(t, version, target.GetLatestVersion())
+	require.Equal(t, hash, target.Hash())
+
+	_, err = target.Import(version)
+	require.Error(t, err)
+}
+
+func TestRootHash(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 2*parallelHashThreshold - 1, 2 * parallelHashThreshold, 5*parallelHashThreshold + 3} {
+		hashes := make([][]byte, n)
+		for i := range hashes {
+			hash, err := proof.LeafHash([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+			require.NoError(t, err)
+			hashes[i] = hash
+		}
+
+		expected, _ := proof.ProofFromByteSlices(append([][]byte(nil), hashes...), 0)
+		require.Equal(t, expected, rootHash(hashes), "n=%d", n)
+
+		// the proofs match the ones of proof.ProofFromByteSlices
+		if n == 0 {
+			continue
+		}
+		mt := &merkleTree{levels: [][][]byte{hashes}, leaves: make([]leaf, n)}
+		for len(mt.levels[len(mt.levels)-1]) > 1 {
+			mt.levels = append(mt.levels, nextLevel(mt.levels[len(mt.levels)-1]))
+		}
+		for _, idx := range []int{0, n / 2, n - 1} {
+			_, inners := proof.ProofFromByteSlices(append([][]byte(nil), hashes...), idx)
+			require.Equal(t, inners, mt.existenceProof(idx).Path, "n=%d idx=%d", n, idx)
+		}
+	}
+}
+
+func TestFlatTree_ConcurrentReads(t *testing.T) {
+	tree := generateTree(t, t.TempDir())
+	defer tree.Close()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte("value")))
+	}
+	hash, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			bz, err := tree.Get(1, []byte(fmt.Sprintf("key%03d", i)))
+			require.NoError(t, err)
+			require.Equal(t, []byte("value"), bz)
+
+			p, err := tree.GetProof(1, []byte(fmt.Sprintf("key%03d", i)))
+			require.NoError(t, err)
+			require.True(t, ics23.VerifyMembership(proof.SimpleMerkleSpec, hash, p, []byte(fmt.Sprintf("key%03d", i)), []byte("value")))
+		}
+	}()
+
+	// the committed version is not affected by the subsequent writes
+	for v := 0; v < 10; v++ {
+		for i := 0; i < 100; i++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", v))))
+		}
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+	<-done
+}
+
+func TestFlatTree_IncrementalHash(t *testing.T) {
+	tree := generateTree(t, t.TempDir())
+	defer tree.Close()
+
+	r := rand.New(rand.NewSource(1))
+	for v := 0; v < 50; v++ {
+		// updates, insertions and removals at random positions, or appended
+		for i := 0; i < r.Intn(20); i++ {
+			key := []byte(fmt.Sprintf("key%03d", r.Intn(200)))
+			if r.Intn(4) == 0 {
+				require.NoError(t, tree.Remove(key))
+			} else {
+				require.NoError(t, tree.Set(key, []byte(fmt.Sprintf("value%d", r.Int()))))
+			}
+		}
+		if v%5 == 0 {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("last%03d", v)), []byte("value")))
+		}
+
+		hashes := make([][]byte, 0, tree.working.Len())
+		tree.working.Ascend(func(l leaf) bool {
+			hashes = append(hashes, l.hash)
+			return true
+		})
+		require.Equal(t, rootHash(hashes), tree.WorkingHash(), "version %d", v)
+
+		if r.Intn(3) > 0 {
+			_, _, err := tree.Commit()
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestFlatTree_Compaction(t *testing.T) {
+	dir := t.TempDir()
+	tree := generateTree(t, dir)
+
+	logSize := func() int64 {
+		return tree.wal.sizeBefore(tree.wal.end())
+	}
+
+	// overwrite the same keys at every version
+	hashes := make(map[uint64][]byte)
+	for v := 1; v <= 100; v++ {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", v))))
+		}
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("version%03d", v)), []byte("value")))
+		hash, version, err := tree.Commit()
+		require.NoError(t, err)
+		hashes[version] = hash
+	}
+	require.NoError(t, tree.LoadVersion(98))
+	before := logSize()
+
+	// pruning most versions compacts the log
+	require.NoError(t, tree.Prune(95))
+	require.Less(t, logSize(), before/2)
+
+	check := func(tree *Tree) {
+		t.Helper()
+		require.Equal(t, uint64(98), tree.GetLatestVersion())
+		require.Equal(t, hashes[98], tree.WorkingHash())
+		for v := uint64(1); v <= 98; v++ {
+			bz, err := tree.Get(v, []byte("key000"))
+			if v <= 95 {
+				require.Error(t, err)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%03d", v)), bz)
+
+			p, err := tree.GetProof(v, []byte("version050"))
+			require.NoError(t, err)
+			require.True(t, ics23.VerifyMembership(proof.SimpleMerkleSpec, hashes[v], p, []byte("version050"), []byte("value")))
+		}
+	}
+	check(tree)
+
+	// the versions are committed on top of the compacted log
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(99), version)
+	require.NoError(t, tree.LoadVersion(98))
+	require.NoError(t, tree.Close())
+
+	tree = generateTree(t, dir)
+	check(tree)
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+	require.NoError(t, tree.Prune(96))
+	newHash, _, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, hash, newHash)
+	require.NoError(t, tree.Close())
+}
+
+func TestFlatTree_ReadsDuringCompaction(t *testing.T) {
+	tree := generateTree(t, t.TempDir())
+	defer tree.Close()
+
+	hashes := make(map[uint64][]byte)
+	for v := 1; v <= 100; v++ {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", v))))
+		}
+		hash, version, err := tree.Commit()
+		require.NoError(t, err)
+		hashes[version] = hash
+	}
+
+	// a read in progress keeps the leaves of its snapshot mapped
+	pinned, err := tree.acquireSnapshot(100)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			key := []byte(fmt.Sprintf("key%03d", i%10))
+			bz, err := tree.Get(100, key)
+			require.NoError(t, err)
+			require.Equal(t, []byte("value100"), bz)
+
+			p, err := tree.GetProof(99, key)
+			require.NoError(t, err)
+			require.True(t, ics23.VerifyMembership(proof.SimpleMerkleSpec, hashes[99], p, key, []byte("value099")))
+		}
+	}()
+
+	require.NoError(t, tree.Prune(98))
+	<-done
+
+	// the pinned snapshot was replaced by its replayed copy, but its leaves
+	// remain readable until it is released
+	require.NotSame(t, pinned.mapping, tree.mapping.Load())
+	l, ok := pinned.leaves.Get(leaf{key: []byte("key000")})
+	require.True(t, ok)
+	require.Equal(t, []byte("value100"), l.value)
+	tree.releaseSnapshot(pinned)
+	require.Zero(t, pinned.mapping.refs.Load())
+	require.False(t, pinned.mapping.acquire())
+
+	bz, err := tree.Get(100, []byte("key000"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value100"), bz)
+}
+
+func TestFlatTree_InterruptedCompaction(t *testing.T) {
+	for _, completed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("completed=%t", completed), func(t *testing.T) {
+			dir := t.TempDir()
+			tree := generateTree(t, dir)
+			for v := 1; v <= 10; v++ {
+				require.NoError(t, tree.Set([]byte("key"), []byte(fmt.Sprintf("value%03d", v))))
+				_, _, err := tree.Commit()
+				require.NoError(t, err)
+			}
+			hash := tree.Hash()
+			s, ok := tree.snapshot(tree.GetLatestVersion())
+			require.True(t, ok)
+			start := tree.wal.active().id + 1
+
+			// simulate a crash during the compaction, before or after its
+			// checkpoint was written
+			require.NoError(t, writeFileSync(filepath.Join(dir, compactMarker), []byte(strconv.FormatUint(start, 10))))
+			_, err := tree.wal.rollover(0)
+			require.NoError(t, err)
+			records := [][]byte{
+				record{typ: recordSet, key: []byte("key"), value: []byte("value010")}.encode(nil),
+				record{typ: recordCommit, version: 10, hash: s.hash}.encode(nil),
+			}
+			if completed {
+				records = append(records, record{typ: recordCheckpoint, segment: start}.encode(nil))
+			} else {
+				records = records[:1]
+			}
+			_, err = tree.wal.append(records)
+			require.NoError(t, err)
+			require.NoError(t, tree.Close())
+
+			tree = generateTree(t, dir)
+			defer tree.Close()
+			require.Equal(t, uint64(10), tree.GetLatestVersion())
+			require.Equal(t, hash, tree.Hash())
+			bz, err := tree.Get(10, []byte("key"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("value010"), bz)
+
+			// only the segments of the compacted log are kept once it completed
+			_, err = tree.Get(1, []byte("key"))
+			require.Equal(t, completed, err != nil)
+			_, err = os.Stat(filepath.Join(dir, compactMarker))
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
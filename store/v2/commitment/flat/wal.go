@@ -0,0 +1,664 @@
+package flat
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	segmentExt = ".seg"
+
+	// recordHeaderSize is the size of the record header: crc32 (4 bytes),
+	// type (1 byte) and payload length (4 bytes).
+	recordHeaderSize = 9
+)
+
+// recordType is the type of a log record. The zero value is reserved to detect
+// the unwritten, zeroed, tail of a segment.
+type recordType byte
+
+const (
+	recordSet recordType = iota + 1
+	recordRemove
+	// recordCommit seals the records preceding it as the given version.
+	recordCommit
+	// recordLoad rolls the tree back to the given version.
+	recordLoad
+	// recordPrune prunes all the versions up to and including the given version.
+	recordPrune
+	// recordCheckpoint ends the compacted log starting at the given segment,
+	// which supersedes the segments preceding it.
+	recordCheckpoint
+)
+
+// compactMarker is the file holding the id of the first segment of the
+// compacted log while a compaction is in progress.
+const compactMarker = "COMPACTING"
+
+// compactBatchSize is the number of bytes written at once to the compacted log.
+const compactBatchSize = 1 << 20
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+var errCorruptedRecord = errors.New("corrupted record")
+
+// record is a decoded log record. Key and value alias the segment they were
+// read from.
+type record struct {
+	typ     recordType
+	key     []byte
+	value   []byte
+	version uint64
+	hash    []byte
+	segment uint64
+}
+
+// position is the position in the log of the end of a record.
+type position struct {
+	segment uint64
+	offset  int
+}
+
+// encode appends the encoded record to dst.
+func (r record) encode(dst []byte) []byte {
+	start := len(dst)
+	dst = append(dst, make([]byte, recordHeaderSize)...)
+
+	switch r.typ {
+	case recordSet:
+		dst = binary.AppendUvarint(dst, uint64(len(r.key)))
+		dst = append(dst, r.key...)
+		dst = append(dst, r.value...)
+	case recordRemove:
+		dst = append(dst, r.key...)
+	case recordCommit:
+		dst = binary.BigEndian.AppendUint64(dst, r.version)
+		dst = append(dst, r.hash...)
+	case recordLoad, recordPrune:
+		dst = binary.BigEndian.AppendUint64(dst, r.version)
+	case recordCheckpoint:
+		dst = binary.BigEndian.AppendUint64(dst, r.segment)
+	}
+
+	hdr := dst[start : start+recordHeaderSize]
+	hdr[4] = byte(r.typ)
+	binary.BigEndian.PutUint32(hdr[5:], uint32(len(dst)-start-recordHeaderSize))
+	binary.BigEndian.PutUint32(hdr[:4], crc32.Checksum(dst[start+4:], crcTable))
+
+	return dst
+}
+
+// decodeRecord decodes the record located at the beginning of data and returns
+// its encoded size. A zero size without error denotes the unwritten tail of a
+// segment.
+func decodeRecord(data []byte) (record, int, error) {
+	if len(data) < recordHeaderSize || data[4] == 0 {
+		return record{}, 0, nil
+	}
+
+	size := recordHeaderSize + int(binary.BigEndian.Uint32(data[5:recordHeaderSize]))
+	if size > len(data) || binary.BigEndian.Uint32(data[:4]) != crc32.Checksum(data[4:size], crcTable) {
+		return record{}, 0, errCorruptedRecord
+	}
+
+	r := record{typ: recordType(data[4])}
+	payload := data[recordHeaderSize:size:size]
+	switch r.typ {
+	case recordSet:
+		klen, n := binary.Uvarint(payload)
+		if n <= 0 || uint64(len(payload)-n) < klen {
+			return record{}, 0, errCorruptedRecord
+		}
+		r.key = payload[n : n+int(klen) : n+int(klen)]
+		r.value = payload[n+int(klen):]
+	case recordRemove:
+		r.key = payload
+	case recordCommit:
+		if len(payload) < 8 {
+			return record{}, 0, errCorruptedRecord
+		}
+		r.version = binary.BigEndian.Uint64(payload)
+		r.hash = payload[8:]
+	case recordLoad, recordPrune:
+		if len(payload) != 8 {
+			return record{}, 0, errCorruptedRecord
+		}
+		r.version = binary.BigEndian.Uint64(payload)
+	case recordCheckpoint:
+		if len(payload) != 8 {
+			return record{}, 0, errCorruptedRecord
+		}
+		r.segment = binary.BigEndian.Uint64(payload)
+	default:
+		return record{}, 0, errCorruptedRecord
+	}
+
+	return r, size, nil
+}
+
+// segment is a preallocated, append-only, file of the log which is memory
+// mapped for reading.
+type segment struct {
+	id   uint64
+	file *os.File
+	data []byte
+	// size is the number of bytes written to the segment.
+	size int
+}
+
+func segmentPath(dir string, id uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", id, segmentExt))
+}
+
+func createSegment(dir string, id uint64, capacity int) (*segment, error) {
+	f, err := os.OpenFile(segmentPath(dir, id), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Truncate(int64(capacity)); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return mapSegment(id, f, capacity)
+}
+
+func openSegment(dir string, id uint64) (*segment, error) {
+	f, err := os.OpenFile(segmentPath(dir, id), os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return mapSegment(id, f, int(info.Size()))
+}
+
+func mapSegment(id uint64, f *os.File, capacity int) (*segment, error) {
+	data, err := mmap(f, capacity)
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to map segment %d: %w", id, err)
+	}
+
+	return &segment{id: id, file: f, data: data}, nil
+}
+
+// write writes buf at the end of the segment and returns the mapped region the
+// bytes were written to.
+func (s *segment) write(buf []byte) ([]byte, error) {
+	if _, err := s.file.WriteAt(buf, int64(s.size)); err != nil {
+		return nil, err
+	}
+	dst := s.data[s.size : s.size+len(buf) : s.size+len(buf)]
+	if !sharedMapping {
+		copy(dst, buf)
+	}
+	s.size += len(buf)
+
+	return dst, nil
+}
+
+// truncate discards the bytes written after the given offset.
+func (s *segment) truncate(offset int) error {
+	if offset >= s.size {
+		return nil
+	}
+	if _, err := s.file.WriteAt(make([]byte, s.size-offset), int64(offset)); err != nil {
+		return err
+	}
+	if !sharedMapping {
+		clear(s.data[offset:s.size])
+	}
+	s.size = offset
+
+	return nil
+}
+
+func (s *segment) close() error {
+	return errors.Join(munmap(s.data), s.file.Close())
+}
+
+// wal is the append-only log backing the flat tree. Every change is written to
+// the log on commit alongside a commit record, the records following the last
+// commit record are discarded when the log is replayed. The log is compacted
+// by rewriting the state it holds at a given position, followed by the records
+// written after it.
+type wal struct {
+	dir      string
+	cfg      *Config
+	segments []*segment
+	// dirty holds the segments written to since the last sync.
+	dirty map[*segment]struct{}
+}
+
+// openWAL opens the log located in dir, creating it if needed, and replays its
+// records with fn alongside their position. The records which are not followed
+// by a commit, load or prune record are discarded.
+func openWAL(dir string, cfg *Config, fn func(record, position) error) (*wal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment file %s: %w", name, err)
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	w := &wal{dir: dir, cfg: cfg, dirty: make(map[*segment]struct{})}
+	for _, id := range ids {
+		seg, err := openSegment(dir, id)
+		if err != nil {
+			_ = w.close()
+			return nil, err
+		}
+		w.segments = append(w.segments, seg)
+	}
+
+	if err := w.recoverCompaction(); err != nil {
+		_ = w.close()
+		return nil, fmt.Errorf("failed to recover compaction: %w", err)
+	}
+
+	if err := w.replay(fn); err != nil {
+		_ = w.close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *wal) replay(fn func(record, position) error) error {
+	var (
+		pending []record
+		// position of the end of the last sealing record
+		lastSeg, lastOffset int
+	)
+
+	for i, seg := range w.segments {
+		offset := 0
+		for offset < len(seg.data) {
+			r, n, err := decodeRecord(seg.data[offset:])
+			if err != nil {
+				// a torn write can only happen at the tail of the log
+				if i != len(w.segments)-1 {
+					return fmt.Errorf("segment %d at offset %d: %w", seg.id, offset, err)
+				}
+				break
+			}
+			if n == 0 {
+				break
+			}
+			offset += n
+
+			pos := position{segment: seg.id, offset: offset}
+			switch r.typ {
+			case recordSet, recordRemove:
+				pending = append(pending, r)
+				continue
+
+			case recordCheckpoint:
+				// the records superseded by the compaction were removed
+				pending = pending[:0]
+				lastSeg, lastOffset = i, offset
+				continue
+			}
+
+			for _, p := range pending {
+				if err := fn(p, pos); err != nil {
+					return err
+				}
+			}
+			pending = pending[:0]
+			if err := fn(r, pos); err != nil {
+				return err
+			}
+			lastSeg, lastOffset = i, offset
+		}
+		seg.size = offset
+	}
+
+	// discard the uncommitted tail of the log
+	for i := len(w.segments) - 1; i > lastSeg; i-- {
+		seg := w.segments[i]
+		if err := seg.close(); err != nil {
+			return err
+		}
+		if err := os.Remove(segmentPath(w.dir, seg.id)); err != nil {
+			return err
+		}
+		w.segments = w.segments[:i]
+	}
+	if len(w.segments) > 0 {
+		return w.segments[lastSeg].truncate(lastOffset)
+	}
+
+	return nil
+}
+
+// append writes the given encoded records to the log and returns the mapped
+// regions they were written to, in order. Records are grouped in a single write
+// per segment.
+func (w *wal) append(records [][]byte) ([][]byte, error) {
+	res := make([][]byte, 0, len(records))
+	for len(records) > 0 {
+		seg := w.active()
+		if seg == nil || len(seg.data)-seg.size < len(records[0]) {
+			var err error
+			if seg, err = w.rollover(len(records[0])); err != nil {
+				return nil, err
+			}
+		}
+
+		// batch the records fitting in the active segment
+		var (
+			n   int
+			buf []byte
+		)
+		for ; n < len(records) && len(buf)+len(records[n]) <= len(seg.data)-seg.size; n++ {
+			buf = append(buf, records[n]...)
+		}
+
+		region, err := seg.write(buf)
+		if err != nil {
+			return nil, err
+		}
+		w.dirty[seg] = struct{}{}
+
+		for _, r := range records[:n] {
+			res = append(res, region[:len(r):len(r)])
+			region = region[len(r):]
+		}
+		records = records[n:]
+	}
+
+	return res, nil
+}
+
+// end returns the position of the end of the log.
+func (w *wal) end() position {
+	seg := w.active()
+	if seg == nil {
+		return position{}
+	}
+
+	return position{segment: seg.id, offset: seg.size}
+}
+
+// sizeBefore returns the number of bytes written to the log before the given
+// position.
+func (w *wal) sizeBefore(pos position) int64 {
+	size := int64(pos.offset)
+	for _, seg := range w.segments {
+		if seg.id < pos.segment {
+			size += int64(seg.size)
+		}
+	}
+
+	return size
+}
+
+func (w *wal) active() *segment {
+	if len(w.segments) == 0 {
+		return nil
+	}
+
+	return w.segments[len(w.segments)-1]
+}
+
+// rollover creates a new active segment large enough to hold size bytes.
+func (w *wal) rollover(size int) (*segment, error) {
+	var id uint64
+	if seg := w.active(); seg != nil {
+		id = seg.id + 1
+	}
+
+	capacity := int(w.cfg.SegmentSize)
+	if size > capacity {
+		capacity = size
+	}
+
+	seg, err := createSegment(w.dir, id, capacity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create segment %d: %w", id, err)
+	}
+	w.segments = append(w.segments, seg)
+
+	return seg, syncDir(w.dir)
+}
+
+// compact rewrites the log as the records emitted by base, which must hold the
+// state of the log at the given position, followed by the records written
+// after it. The compacted log is written to new segments, which supersede the
+// current ones once its checkpoint record is written. The superseded segments
+// are removed from the log but left mapped, to be unmapped by the caller once
+// the records read from them are no longer used.
+func (w *wal) compact(base func(emit func([]byte) error) error, from position) (retired []*segment, err error) {
+	var start uint64
+	if seg := w.active(); seg != nil {
+		start = seg.id + 1
+	}
+	superseded := w.segments
+	checkpointed := false
+
+	if err := writeFileSync(filepath.Join(w.dir, compactMarker), []byte(strconv.FormatUint(start, 10))); err != nil {
+		return nil, err
+	}
+	defer func() {
+		// revert the partially written compacted log
+		if err != nil && !checkpointed {
+			err = errors.Join(err, w.removeSegments(func(id uint64) bool { return id >= start }), w.removeMarker())
+		}
+	}()
+
+	if _, err := w.rollover(0); err != nil {
+		return nil, err
+	}
+
+	var (
+		batch [][]byte
+		size  int
+	)
+	flush := func() error {
+		if _, err := w.append(batch); err != nil {
+			return err
+		}
+		batch, size = batch[:0], 0
+		return nil
+	}
+	emit := func(r []byte) error {
+		batch = append(batch, r)
+		if size += len(r); size >= compactBatchSize {
+			return flush()
+		}
+		return nil
+	}
+
+	if err := base(emit); err != nil {
+		return nil, err
+	}
+	// the records written after the position are copied as is
+	for _, seg := range superseded {
+		var err error
+		switch {
+		case seg.id == from.segment && from.offset < seg.size:
+			err = emit(seg.data[from.offset:seg.size])
+		case seg.id > from.segment && seg.size > 0:
+			err = emit(seg.data[:seg.size])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := emit(record{typ: recordCheckpoint, segment: start}.encode(nil)); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if err := w.sync(); err != nil {
+		return nil, err
+	}
+	checkpointed = true
+
+	// the compaction is complete, a failure to remove the superseded segments is
+	// recovered when the log is opened
+	retired, err = w.detachSegments(func(id uint64) bool { return id < start })
+	if err != nil {
+		return retired, err
+	}
+
+	return retired, w.removeMarker()
+}
+
+// recoverCompaction completes or reverts the compaction interrupted by a
+// crash. The compaction completed if its checkpoint record was written, in
+// which case the segments it supersedes are removed, otherwise the segments of
+// the compacted log are.
+func (w *wal) recoverCompaction() error {
+	bz, err := os.ReadFile(filepath.Join(w.dir, compactMarker))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	start, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid compaction marker: %w", err)
+	}
+
+	completed := false
+	for _, seg := range w.segments {
+		if seg.id < start {
+			continue
+		}
+		for offset := 0; offset < len(seg.data); {
+			r, n, err := decodeRecord(seg.data[offset:])
+			if err != nil || n == 0 {
+				break
+			}
+			offset += n
+			if r.typ == recordCheckpoint && r.segment == start {
+				completed = true
+			}
+		}
+	}
+
+	if err := w.removeSegments(func(id uint64) bool { return (id < start) == completed }); err != nil {
+		return err
+	}
+
+	return w.removeMarker()
+}
+
+// removeSegments closes and removes the segments whose id matches.
+func (w *wal) removeSegments(match func(id uint64) bool) error {
+	removed, err := w.detachSegments(match)
+	for _, seg := range removed {
+		err = errors.Join(err, munmap(seg.data))
+	}
+
+	return err
+}
+
+// detachSegments closes and removes the files of the segments whose id matches,
+// returning the segments, which are still mapped.
+func (w *wal) detachSegments(match func(id uint64) bool) ([]*segment, error) {
+	var (
+		kept, detached []*segment
+		err            error
+	)
+	for _, seg := range w.segments {
+		if !match(seg.id) {
+			kept = append(kept, seg)
+			continue
+		}
+		delete(w.dirty, seg)
+		detached = append(detached, seg)
+		err = errors.Join(err, seg.file.Close(), os.Remove(segmentPath(w.dir, seg.id)))
+	}
+	w.segments = kept
+
+	return detached, errors.Join(err, syncDir(w.dir))
+}
+
+func (w *wal) removeMarker() error {
+	if err := os.Remove(filepath.Join(w.dir, compactMarker)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return syncDir(w.dir)
+}
+
+// sync flushes the segments written to since the last sync to disk.
+func (w *wal) sync() error {
+	for seg := range w.dirty {
+		if err := seg.file.Sync(); err != nil {
+			return err
+		}
+		delete(w.dirty, seg)
+	}
+
+	return nil
+}
+
+func (w *wal) close() (err error) {
+	for _, seg := range w.segments {
+		err = errors.Join(err, seg.close())
+	}
+	w.segments = nil
+
+	return err
+}
+
+// writeFileSync writes the given file and flushes it, and its directory, to
+// disk.
+func writeFileSync(path string, data []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := errors.Join(f.Sync(), f.Close()); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(f.Sync(), f.Close())
+}
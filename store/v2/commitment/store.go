@@ -29,6 +29,7 @@ var (
 	_ store.Committer             = (*CommitStore)(nil)
 	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
 	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Compactor             = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -282,6 +283,9 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
 	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	if provider, ok := tree.(CommitmentOpProvider); ok {
+		commitOp = provider.CommitmentOp(key, iProof)
+	}
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -340,6 +344,17 @@ func (c *CommitStore) PausePruning(pause bool) {
 	}
 }
 
+// Compact implements store.Compactor. The trees share the underlying database
+// of the CommitStore, hence compacting it compacts every tree.
+func (c *CommitStore) Compact() error {
+	compactor, ok := c.db.(store.Compactor)
+	if !ok {
+		return fmt.Errorf("compaction is not supported by the %T backend", c.db)
+	}
+
+	return compactor.Compact()
+}
+
 // Snapshot implements snapshotstypes.CommitSnapshotter.
 func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error {
 	if version == 0 {
@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,14 @@ type Tree interface {
 	io.Closer
 }
 
+// CommitmentOpProvider is an optional interface of Tree, implemented by the
+// backends whose proofs do not follow the IAVL proof spec.
+type CommitmentOpProvider interface {
+	// CommitmentOp wraps a proof returned by GetProof into the CommitmentOp of
+	// the proof spec of the tree.
+	CommitmentOp(key []byte, proof *ics23.CommitmentProof) proof.CommitmentOp
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
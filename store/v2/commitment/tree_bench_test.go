@@ -0,0 +1,117 @@
+package commitment_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/flat"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+var backends = map[string]func(b *testing.B) (commitment.Tree, error){
+	"iavl_goleveldb": func(b *testing.B) (commitment.Tree, error) {
+		db, err := dbm.NewGoLevelDB("iavl", b.TempDir(), nil)
+		if err != nil {
+			return nil, err
+		}
+		b.Cleanup(func() {
+			_ = db.Close()
+		})
+		return iavl.NewIavlTree(db, log.NewNopLogger(), iavl.DefaultConfig()), nil
+	},
+	"flat_default_opts": func(b *testing.B) (commitment.Tree, error) {
+		return flat.NewTree(b.TempDir(), log.NewNopLogger(), flat.DefaultConfig())
+	},
+}
+
+type change struct {
+	key, value []byte
+	remove     bool
+}
+
+// generateChangesets returns the changesets of numVersions versions writing
+// numChanges keys each, out of a key space of numKeys keys, 10% of the changes
+// being removals.
+func generateChangesets(numVersions, numChanges, numKeys int) [][]change {
+	rng := rand.New(rand.NewSource(567320))
+	changesets := make([][]change, numVersions)
+	for v := range changesets {
+		changesets[v] = make([]change, numChanges)
+		for i := range changesets[v] {
+			c := change{key: []byte(fmt.Sprintf("key%012d", rng.Intn(numKeys)))}
+			if rng.Intn(10) == 0 {
+				c.remove = true
+			} else {
+				c.value = make([]byte, 128)
+				_, _ = rng.Read(c.value)
+			}
+			changesets[v][i] = c
+		}
+	}
+
+	return changesets
+}
+
+func applyChangeset(b *testing.B, tree commitment.Tree, cs []change) {
+	b.Helper()
+
+	for _, c := range cs {
+		if c.remove {
+			require.NoError(b, tree.Remove(c.key))
+		} else {
+			require.NoError(b, tree.Set(c.key, c.value))
+		}
+	}
+	_, _, err := tree.Commit()
+	require.NoError(b, err)
+}
+
+func BenchmarkCommit(b *testing.B) {
+	for _, numKeys := range []int{10_000, 100_000} {
+		initial := generateChangesets(1, numKeys, numKeys)[0]
+		changesets := generateChangesets(100, 1_000, numKeys)
+
+		for ty, fn := range backends {
+			b.Run(fmt.Sprintf("backend_%s_keys_%d", ty, numKeys), func(b *testing.B) {
+				tree, err := fn(b)
+				require.NoError(b, err)
+				defer tree.Close()
+
+				applyChangeset(b, tree, initial)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					applyChangeset(b, tree, changesets[i%len(changesets)])
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkGetProof(b *testing.B) {
+	numKeys := 100_000
+	initial := generateChangesets(1, numKeys, numKeys)[0]
+
+	for ty, fn := range backends {
+		b.Run(fmt.Sprintf("backend_%s", ty), func(b *testing.B) {
+			tree, err := fn(b)
+			require.NoError(b, err)
+			defer tree.Close()
+
+			applyChangeset(b, tree, initial)
+			version := tree.GetLatestVersion()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := tree.GetProof(version, initial[i%len(initial)].key)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package commitment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// TreeFactory creates a new, empty Tree for the given store key, replacing the
+// given tree of the CommitStore, typically of the same backend.
+type TreeFactory func(storeKey string, tree Tree) (Tree, error)
+
+// StoreIntegrity reports the result of checking a single store key of the
+// CommitStore against the state storage (SS) layer at a given version.
+type StoreIntegrity struct {
+	StoreKey string
+	// CommittedHash is the store hash persisted in the CommitInfo of the version.
+	CommittedHash []byte
+	// RecomputedHash is the store hash recomputed from SS, either from the shape
+	// of the SC tree (keys, node versions and heights) and the values read from
+	// SS, see Verify, or from the SS history alone, see Rebuild.
+	RecomputedHash []byte
+	// MissingKeys is the number of leaves of the SC tree that are absent in SS.
+	MissingKeys uint64
+	// ExtraKeys is the number of keys present in SS that are absent in the SC tree.
+	ExtraKeys uint64
+	// MismatchedValues is the number of keys whose SS value differs from the
+	// value stored in the SC tree leaf.
+	MismatchedValues uint64
+	// Error is set when the store could not be checked at all, e.g. because the
+	// SC tree nodes of the version cannot be read.
+	Error string
+}
+
+// OK returns true if the recomputed hash matches the committed one and SS is
+// consistent with the SC tree leaves.
+func (s StoreIntegrity) OK() bool {
+	return s.Error == "" &&
+		bytes.Equal(s.CommittedHash, s.RecomputedHash) &&
+		s.MissingKeys == 0 && s.ExtraKeys == 0 && s.MismatchedValues == 0
+}
+
+// IntegrityReport is the result of verifying a version of the CommitStore.
+type IntegrityReport struct {
+	Version uint64
+	// CommittedHash is the hash of the CommitInfo persisted for the version.
+	CommittedHash []byte
+	// RecomputedHash is the hash of the CommitInfo built from the recomputed
+	// store hashes.
+	RecomputedHash []byte
+	Stores         []StoreIntegrity
+}
+
+// OK returns true if every store of the report is OK and the recomputed root
+// hash matches the committed one.
+func (r *IntegrityReport) OK() bool {
+	for _, s := range r.Stores {
+		if !s.OK() {
+			return false
+		}
+	}
+
+	return bytes.Equal(r.CommittedHash, r.RecomputedHash)
+}
+
+// Mismatches returns the stores of the report that are not OK.
+func (r *IntegrityReport) Mismatches() []StoreIntegrity {
+	var res []StoreIntegrity
+	for _, s := range r.Stores {
+		if !s.OK() {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+// Verify recomputes the commitment root of the given version from the state
+// storage and compares it against the CommitInfo persisted by the CommitStore.
+//
+// Each tree is exported at the given version and re-imported into a scratch
+// tree created by newTree, with the leaf values substituted by the values read
+// from SS. As a consequence, a store whose recomputed hash matches the committed
+// one while reporting value mismatches has corrupted SC leaves, whereas a store
+// whose recomputed hash differs has a corrupted SS (or SC tree structure). In
+// the former case, the SC can be recovered from SS using Rebuild.
+func (c *CommitStore) Verify(version uint64, ss store.VersionedDatabase, newTree TreeFactory) (*IntegrityReport, error) {
+	cInfo, err := c.committedInfo(version)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &IntegrityReport{
+		Version:       version,
+		CommittedHash: cInfo.Hash(),
+		Stores:        make([]StoreIntegrity, 0, len(cInfo.StoreInfos)),
+	}
+	recomputed := &proof.CommitInfo{Version: version}
+
+	// cInfo.Hash() sorts the store infos, which keeps the report deterministic
+	for _, si := range cInfo.StoreInfos {
+		res := StoreIntegrity{
+			StoreKey:      string(si.Name),
+			CommittedHash: si.GetHash(),
+		}
+
+		if err := c.verifyStore(version, si.Name, ss, newTree, &res); err != nil {
+			res.Error = err.Error()
+		}
+
+		report.Stores = append(report.Stores, res)
+		recomputed.StoreInfos = append(recomputed.StoreInfos, proof.StoreInfo{
+			Name: si.Name,
+			CommitID: proof.CommitID{
+				Version: version,
+				Hash:    res.RecomputedHash,
+			},
+		})
+	}
+	report.RecomputedHash = recomputed.Hash()
+
+	return report, nil
+}
+
+// NewEmpty creates an empty CommitStore holding the same store keys, whose trees
+// are created by newTree and whose commit infos are stored in db. It is meant to
+// create the destination of Rebuild.
+func (c *CommitStore) NewEmpty(db corestore.KVStoreWithBatch, newTree TreeFactory) (*CommitStore, error) {
+	trees := make(map[string]Tree, len(c.multiTrees))
+	for storeKey, tree := range c.multiTrees {
+		dst, err := newTree(storeKey, tree)
+		if err != nil {
+			for _, t := range trees {
+				err = errors.Join(err, t.Close())
+			}
+			return nil, fmt.Errorf("failed to create the tree of store %s: %w", storeKey, err)
+		}
+		trees[storeKey] = dst
+	}
+
+	return NewCommitStore(trees, db, c.logger)
+}
+
+// Rebuild rebuilds the CommitStore into dst, which must be empty, from the state
+// storage alone, without reading the SC trees. The changes written to SS at every
+// version from initialVersion up to the given version are committed to dst in
+// order, so that the rebuilt trees, including the versions of their nodes and
+// hence their hashes, match the original ones. SS must therefore hold every
+// version since initialVersion, i.e. it must not have been pruned.
+//
+// The rebuilt store hashes of the version are reported against its CommitInfo
+// persisted by the CommitStore. An error is returned alongside the report if the
+// rebuilt root hash does not match the committed one, in which case dst must be
+// discarded.
+func (c *CommitStore) Rebuild(initialVersion, version uint64, ss store.VersionChangesReader, dst *CommitStore) (*IntegrityReport, error) {
+	if initialVersion == 0 || initialVersion > version {
+		return nil, fmt.Errorf("invalid initial version %d for version %d", initialVersion, version)
+	}
+
+	cInfo, err := c.committedInfo(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if initialVersion > 1 {
+		if err := dst.SetInitialVersion(initialVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	var rebuilt *proof.CommitInfo
+	for v := initialVersion; v <= version; v++ {
+		cs := corestore.NewChangeset()
+		for _, si := range cInfo.StoreInfos {
+			changes, err := ss.VersionChanges(si.Name, v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the changes of store %s at version %d: %w", si.Name, v, err)
+			}
+			for _, change := range changes {
+				cs.AddKVPair(si.Name, change)
+			}
+		}
+
+		if err := dst.WriteChangeset(cs); err != nil {
+			return nil, err
+		}
+		if rebuilt, err = dst.Commit(v); err != nil {
+			return nil, fmt.Errorf("failed to commit version %d: %w", v, err)
+		}
+	}
+
+	rebuiltHashes := make(map[string][]byte, len(rebuilt.StoreInfos))
+	for _, si := range rebuilt.StoreInfos {
+		rebuiltHashes[string(si.Name)] = si.GetHash()
+	}
+
+	report := &IntegrityReport{
+		Version:        version,
+		CommittedHash:  cInfo.Hash(),
+		RecomputedHash: rebuilt.Hash(),
+		Stores:         make([]StoreIntegrity, 0, len(cInfo.StoreInfos)),
+	}
+	for _, si := range cInfo.StoreInfos {
+		report.Stores = append(report.Stores, StoreIntegrity{
+			StoreKey:       string(si.Name),
+			CommittedHash:  si.GetHash(),
+			RecomputedHash: rebuiltHashes[string(si.Name)],
+		})
+	}
+
+	if !report.OK() {
+		return report, fmt.Errorf("rebuilt commit hash %X does not match committed hash %X", report.RecomputedHash, report.CommittedHash)
+	}
+
+	return report, nil
+}
+
+// committedInfo returns the CommitInfo persisted for the given version.
+func (c *CommitStore) committedInfo(version uint64) (*proof.CommitInfo, error) {
+	cInfo, err := c.GetCommitInfo(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info for version %d: %w", version, err)
+	}
+	if cInfo == nil {
+		return nil, fmt.Errorf("commit info not found for version %d", version)
+	}
+
+	return cInfo, nil
+}
+
+func (c *CommitStore) verifyStore(
+	version uint64,
+	storeKey []byte,
+	ss store.VersionedDatabase,
+	newTree TreeFactory,
+	res *StoreIntegrity,
+) (err error) {
+	tree, ok := c.multiTrees[string(storeKey)]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	dst, err := newTree(string(storeKey), tree)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, dst.Close())
+	}()
+
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
+
+	importer, err := dst.Import(version)
+	if err != nil {
+		return fmt.Errorf("failed to import tree for version %d: %w", version, err)
+	}
+	defer importer.Close()
+
+	itr, err := ss.Iterator(storeKey, version, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create SS iterator: %w", err)
+	}
+	defer itr.Close()
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		if item.Height == 0 {
+			item.Value = mergeLeaf(item, itr, res)
+		}
+		if item.Key == nil {
+			item.Key = []byte{}
+		}
+
+		if err := importer.Add(item); err != nil {
+			return fmt.Errorf("failed to add node to importer: %w", err)
+		}
+	}
+
+	// any key left in SS does not exist in the SC tree
+	for ; itr.Valid(); itr.Next() {
+		res.ExtraKeys++
+	}
+	if err := itr.Error(); err != nil {
+		return fmt.Errorf("failed to iterate SS: %w", err)
+	}
+
+	if err := importer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit importer: %w", err)
+	}
+	res.RecomputedHash = dst.Hash()
+
+	return nil
+}
+
+// mergeLeaf advances the (sorted) SS iterator up to the key of the given SC
+// leaf, accounting for keys only present in SS, and returns the SS value of the
+// leaf. Leaves missing from SS are replaced by an empty value so that the
+// recomputed hash reflects the SS view of the state.
+func mergeLeaf(leaf *snapshotstypes.SnapshotIAVLItem, itr corestore.Iterator, res *StoreIntegrity) []byte {
+	for ; itr.Valid(); itr.Next() {
+		switch cmp := bytes.Compare(itr.Key(), leaf.Key); {
+		case cmp < 0:
+			res.ExtraKeys++
+			continue
+
+		case cmp == 0:
+			value := bytes.Clone(itr.Value())
+			if !bytes.Equal(value, leaf.Value) {
+				res.MismatchedValues++
+			}
+			itr.Next()
+			if value == nil {
+				value = []byte{}
+			}
+			return value
+		}
+
+		break
+	}
+
+	res.MissingKeys++
+	return []byte{}
+}
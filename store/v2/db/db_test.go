@@ -135,3 +135,35 @@ func TestPrefixDBSuite(t *testing.T) {
 		db: NewPrefixDB(NewMemDB(), []byte("prefix")),
 	})
 }
+
+func TestCompactPebble(t *testing.T) {
+	db, err := NewPebbleDB("test", t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Set([]byte("key0"), []byte("value0")))
+	require.NoError(t, db.Set([]byte("key9"), []byte("value9")))
+	require.NoError(t, db.storage.Flush())
+
+	// the last key is deleted so that its tombstone is only reachable past the
+	// last live key
+	require.NoError(t, db.Delete([]byte("key9")))
+	require.NoError(t, db.storage.Flush())
+
+	// no table holds the deleted key anymore once compacted
+	require.NoError(t, db.Compact())
+	tables, err := db.storage.SSTables()
+	require.NoError(t, err)
+	for _, level := range tables {
+		for _, table := range level {
+			require.Equal(t, []byte("key0"), table.Largest.UserKey)
+		}
+	}
+
+	value, err := db.Get([]byte("key0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value0"), value)
+	has, err := db.Has([]byte("key9"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -19,7 +19,10 @@ import (
 	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
-var _ corestore.KVStoreWithBatch = (*GoLevelDB)(nil)
+var (
+	_ corestore.KVStoreWithBatch = (*GoLevelDB)(nil)
+	_ store.Compactor            = (*GoLevelDB)(nil)
+)
 
 // GoLevelDB implements corestore.KVStore using github.com/syndtr/goleveldb/leveldb.
 // It is used for only store v2 migration, since some clients use goleveldb as
@@ -162,6 +165,11 @@ func (db *GoLevelDB) ForceCompact(start, limit []byte) error {
 	return db.db.CompactRange(util.Range{Start: start, Limit: limit})
 }
 
+// Compact implements store.Compactor.
+func (db *GoLevelDB) Compact() error {
+	return db.ForceCompact(nil, nil)
+}
+
 // NewBatch implements corestore.BatchCreator.
 func (db *GoLevelDB) NewBatch() corestore.Batch {
 	return newGoLevelDBBatch(db)
@@ -9,6 +9,7 @@ import (
 	"github.com/google/btree"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/errors"
 )
 
@@ -40,7 +41,10 @@ func newPair(key, value []byte) item {
 	return item{key: key, value: value}
 }
 
-var _ corestore.KVStoreWithBatch = (*MemDB)(nil)
+var (
+	_ corestore.KVStoreWithBatch = (*MemDB)(nil)
+	_ store.Compactor            = (*MemDB)(nil)
+)
 
 // MemDB is an in-memory database backend using a B-tree for storage.
 //
@@ -142,6 +146,12 @@ func (db *MemDB) Close() error {
 	return nil
 }
 
+// Compact implements store.Compactor. It is a noop since an in-memory database
+// has no on-disk representation to compact.
+func (db *MemDB) Compact() error {
+	return nil
+}
+
 // Print implements DB.
 func (db *MemDB) Print() error {
 	db.mtx.RLock()
@@ -15,7 +15,10 @@ import (
 	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
-var _ corestore.KVStoreWithBatch = (*PebbleDB)(nil)
+var (
+	_ corestore.KVStoreWithBatch = (*PebbleDB)(nil)
+	_ store.Compactor            = (*PebbleDB)(nil)
+)
 
 // PebbleDB implements `corestore.KVStoreWithBatch` using PebbleDB as the underlying storage engine.
 // It is used for only store v2 migration, since some clients use PebbleDB as
@@ -106,6 +109,47 @@ func (db *PebbleDB) Delete(key []byte) error {
 	return db.storage.Delete(key, &pebble.WriteOptions{Sync: false})
 }
 
+// Compact implements store.Compactor.
+func (db *PebbleDB) Compact() error {
+	return CompactPebble(db.storage)
+}
+
+// CompactPebble compacts the whole key range of the given PebbleDB instance,
+// reclaiming the disk space left by deleted and overwritten keys.
+func CompactPebble(storage *pebble.DB) error {
+	// flush the memtable first so that the bounds of the tables cover every key,
+	// including the deleted ones which an iterator would not see
+	if err := storage.Flush(); err != nil {
+		return err
+	}
+
+	tables, err := storage.SSTables()
+	if err != nil {
+		return err
+	}
+
+	var start, end []byte
+	for _, level := range tables {
+		for _, table := range level {
+			if start == nil || bytes.Compare(table.Smallest.UserKey, start) < 0 {
+				start = table.Smallest.UserKey
+			}
+			if end == nil || bytes.Compare(table.Largest.UserKey, end) > 0 {
+				end = table.Largest.UserKey
+			}
+		}
+	}
+
+	// nothing to compact in an empty database
+	if start == nil {
+		return nil
+	}
+
+	// the end bound must be strictly greater than the largest key for the table
+	// holding it to always be compacted
+	return storage.Compact(slices.Clone(start), append(slices.Clone(end), 0), true)
+}
+
 func (db *PebbleDB) Iterator(start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -3,11 +3,13 @@ package root
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/flat"
 	"cosmossdk.io/store/v2/commitment/iavl"
 	"cosmossdk.io/store/v2/commitment/mem"
 	"cosmossdk.io/store/v2/db"
@@ -29,6 +31,7 @@ const (
 	SSTypeRocks  SSType = 2
 	SCTypeIavl   SCType = 0
 	SCTypeIavlV2 SCType = 1
+	SCTypeFlat   SCType = 2
 )
 
 type FactoryOptions struct {
@@ -39,8 +42,19 @@ type FactoryOptions struct {
 	SSPruneOptions *store.PruneOptions
 	SCPruneOptions *store.PruneOptions
 	IavlConfig     *iavl.Config
+	FlatConfig     *flat.Config
 	StoreKeys      []string
 	SCRawDB        corestore.KVStoreWithBatch
+	// AsyncCommit enables the asynchronous commit pipeline of the SS backend if
+	// set, see CommitPipeline.
+	AsyncCommit *AsyncCommitOptions
+}
+
+// AsyncCommitOptions defines the options of the asynchronous commit pipeline.
+type AsyncCommitOptions struct {
+	// QueueSize is the number of changesets held in memory before commits block,
+	// DefaultCommitQueueSize is used if zero.
+	QueueSize int
 }
 
 // CreateRootStore is a convenience function to create a root store based on the
@@ -83,6 +97,40 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	}
 	ss = storage.NewStorageStore(ssDb, opts.Logger)
 
+	sc, err = CreateCommitStore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.AsyncCommit == nil {
+		pm := pruning.NewManager(sc, ss, opts.SCPruneOptions, opts.SSPruneOptions)
+		return New(opts.Logger, ss, sc, pm, nil, nil)
+	}
+
+	// the changesets not committed to SC are discarded by the pipeline recovery
+	scLatest, err := sc.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	pipeline, err := NewCommitPipeline(opts.Logger, ss, filepath.Join(opts.RootDir, "data", "ss", "wal"), opts.AsyncCommit.QueueSize, scLatest)
+	if err != nil {
+		return nil, err
+	}
+	pm := pruning.NewManager(sc, pipeline, opts.SCPruneOptions, opts.SSPruneOptions)
+
+	return New(opts.Logger, pipeline, sc, pm, nil, nil)
+}
+
+// FlatTreeDir returns the directory of the flat tree of the given store key,
+// under the given root directory.
+func FlatTreeDir(rootDir, storeKey string) string {
+	return filepath.Join(rootDir, "data", "sc", "flat", storeKey)
+}
+
+// CreateCommitStore creates the state commitment (SC) backend of a root store
+// based on the provided FactoryOptions. The IAVL trees of all the store keys are
+// backed by opts.SCRawDB, whereas flat trees are stored under opts.RootDir.
+func CreateCommitStore(opts *FactoryOptions) (*commitment.CommitStore, error) {
 	trees := make(map[string]commitment.Tree)
 	for _, key := range opts.StoreKeys {
 		if internal.IsMemoryStoreKey(key) {
@@ -93,15 +141,19 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 				trees[key] = iavl.NewIavlTree(db.NewPrefixDB(opts.SCRawDB, []byte(key)), opts.Logger, opts.IavlConfig)
 			case SCTypeIavlV2:
 				return nil, fmt.Errorf("iavl v2 not supported")
+			case SCTypeFlat:
+				cfg := opts.FlatConfig
+				if cfg == nil {
+					cfg = flat.DefaultConfig()
+				}
+				tree, err := flat.NewTree(FlatTreeDir(opts.RootDir, key), opts.Logger, cfg)
+				if err != nil {
+					return nil, err
+				}
+				trees[key] = tree
 			}
 		}
 	}
-	sc, err = commitment.NewCommitStore(trees, opts.SCRawDB, opts.Logger)
-	if err != nil {
-		return nil, err
-	}
-
-	pm := pruning.NewManager(sc, ss, opts.SCPruneOptions, opts.SSPruneOptions)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	return commitment.NewCommitStore(trees, opts.SCRawDB, opts.Logger)
 }
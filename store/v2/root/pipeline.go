@@ -0,0 +1,470 @@
+package root
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// DefaultCommitQueueSize is the default number of changesets the CommitPipeline
+// holds in memory before ApplyChangeset blocks.
+const DefaultCommitQueueSize = 8
+
+var (
+	_ store.VersionedDatabase = (*CommitPipeline)(nil)
+	_ store.Pruner            = (*CommitPipeline)(nil)
+	_ store.Compactor         = (*CommitPipeline)(nil)
+)
+
+// PipelinedStorage defines the SS backend wrapped by a CommitPipeline.
+type PipelinedStorage interface {
+	store.VersionedDatabase
+	store.Pruner
+}
+
+// pendingChangeset is a changeset which has not been flushed to SS yet. Its
+// pairs are indexed by store key, sorted by key and deduplicated.
+type pendingChangeset struct {
+	version uint64
+	pairs   map[string][]corestore.KVPair
+}
+
+func newPendingChangeset(version uint64, cs *corestore.Changeset) *pendingChangeset {
+	p := &pendingChangeset{
+		version: version,
+		pairs:   make(map[string][]corestore.KVPair, len(cs.Changes)),
+	}
+
+	for _, changes := range cs.Changes {
+		byKey := make(map[string]corestore.KVPair, len(changes.StateChanges))
+		for _, kv := range p.pairs[string(changes.Actor)] {
+			byKey[string(kv.Key)] = kv
+		}
+		// the last write of a key wins
+		for _, kv := range changes.StateChanges {
+			byKey[string(kv.Key)] = kv
+		}
+
+		pairs := make([]corestore.KVPair, 0, len(byKey))
+		for _, kv := range byKey {
+			pairs = append(pairs, kv)
+		}
+		sort.Slice(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0 })
+		p.pairs[string(changes.Actor)] = pairs
+	}
+
+	return p
+}
+
+// get returns the pair of the given key, if it was written in the changeset.
+func (p *pendingChangeset) get(storeKey, key []byte) (corestore.KVPair, bool) {
+	pairs := p.pairs[string(storeKey)]
+	i := sort.Search(len(pairs), func(i int) bool { return bytes.Compare(pairs[i].Key, key) >= 0 })
+	if i < len(pairs) && bytes.Equal(pairs[i].Key, key) {
+		return pairs[i], true
+	}
+
+	return corestore.KVPair{}, false
+}
+
+// pipelineTask is a task run by the background worker of the pipeline, i.e.
+// either flushing a changeset, pruning the SS backend or signaling a flush.
+type pipelineTask struct {
+	pending *pendingChangeset
+	cs      *corestore.Changeset
+
+	prune   bool
+	pruneTo uint64
+
+	// flushed is closed once the preceding tasks ran
+	flushed chan struct{}
+}
+
+// CommitPipeline wraps a SS backend so that writes to it happen asynchronously.
+//
+// ApplyChangeset durably writes the changeset to a write-ahead log (WAL) and
+// queues it, the changesets being applied to the SS backend, and removed from
+// the WAL, in order by a background goroutine. Once the queue is full,
+// ApplyChangeset blocks until a changeset is flushed.
+//
+// Reads remain consistent: the changesets which have not been flushed yet are
+// served from memory, on top of the SS backend.
+//
+// On restart, the changesets left in the WAL are replayed to the SS backend, up
+// to the latest version committed to SC, the following ones being discarded as
+// their blocks are executed again.
+type CommitPipeline struct {
+	logger log.Logger
+	ss     PipelinedStorage
+	wal    *changesetWAL
+
+	queue chan pipelineTask
+	done  chan struct{}
+	// queueMtx guards the queue against being closed while tasks are sent to it,
+	// the senders holding its read lock
+	queueMtx sync.RWMutex
+	closed   bool
+
+	mtx     sync.RWMutex
+	pending []*pendingChangeset
+	// err is the error the worker stopped on, if any
+	err error
+}
+
+// NewCommitPipeline creates a new CommitPipeline wrapping the given SS backend,
+// whose WAL is located in walDir. The changesets left in the WAL whose version
+// is greater than maxVersion, i.e. the latest version committed to SC, are
+// discarded, whereas the other ones are applied to the SS backend before
+// returning.
+func NewCommitPipeline(
+	logger log.Logger,
+	ss PipelinedStorage,
+	walDir string,
+	queueSize int,
+	maxVersion uint64,
+) (*CommitPipeline, error) {
+	if queueSize <= 0 {
+		queueSize = DefaultCommitQueueSize
+	}
+
+	wal, err := openChangesetWAL(walDir)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &CommitPipeline{
+		logger: logger.With("module", "commit_pipeline"),
+		ss:     ss,
+		wal:    wal,
+		queue:  make(chan pipelineTask, queueSize),
+		done:   make(chan struct{}),
+	}
+
+	if err := p.recover(maxVersion); err != nil {
+		return nil, fmt.Errorf("failed to recover commit pipeline: %w", err)
+	}
+
+	go p.run()
+
+	return p, nil
+}
+
+// recover replays the changesets of the WAL to the SS backend.
+func (p *CommitPipeline) recover(maxVersion uint64) error {
+	versions, err := p.wal.versions()
+	if err != nil {
+		return err
+	}
+
+	latest, err := p.ss.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		switch {
+		case version <= latest:
+			// already flushed, the WAL entry was not removed yet
+
+		case version > maxVersion:
+			p.logger.Info("discarding changeset not committed to SC", "version", version)
+
+		default:
+			cs, err := p.wal.read(version)
+			if err != nil {
+				return err
+			}
+			if err := p.ss.ApplyChangeset(version, cs); err != nil {
+				return fmt.Errorf("failed to replay changeset of version %d: %w", version, err)
+			}
+			p.logger.Info("replayed changeset", "version", version)
+		}
+
+		if err := p.wal.remove(version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run flushes the queued changesets to the SS backend in order.
+func (p *CommitPipeline) run() {
+	defer close(p.done)
+
+	for task := range p.queue {
+		if task.flushed != nil {
+			close(task.flushed)
+			continue
+		}
+
+		// once the worker failed, the queue is drained without applying tasks
+		p.mtx.RLock()
+		failed := p.err != nil
+		p.mtx.RUnlock()
+		if failed {
+			continue
+		}
+
+		if err := p.runTask(task); err != nil {
+			p.logger.Error("commit pipeline stopped", "err", err)
+			p.mtx.Lock()
+			p.err = err
+			p.mtx.Unlock()
+		}
+	}
+}
+
+func (p *CommitPipeline) runTask(task pipelineTask) error {
+	if task.prune {
+		if err := p.ss.Prune(task.pruneTo); err != nil {
+			return fmt.Errorf("failed to prune SS to version %d: %w", task.pruneTo, err)
+		}
+		return nil
+	}
+
+	version := task.pending.version
+	if err := p.ss.ApplyChangeset(version, task.cs); err != nil {
+		return fmt.Errorf("failed to flush changeset of version %d: %w", version, err)
+	}
+	if err := p.wal.remove(version); err != nil {
+		return fmt.Errorf("failed to remove WAL entry of version %d: %w", version, err)
+	}
+
+	// the changeset is popped once flushed so that it is always readable
+	p.mtx.Lock()
+	p.pending = p.pending[1:]
+	p.mtx.Unlock()
+
+	return nil
+}
+
+// Err returns the error the background worker stopped on, if any.
+func (p *CommitPipeline) Err() error {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.err
+}
+
+// ApplyChangeset writes the changeset to the WAL and queues it to be flushed to
+// the SS backend. It blocks while the queue is full.
+func (p *CommitPipeline) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	p.queueMtx.RLock()
+	defer p.queueMtx.RUnlock()
+
+	if p.closed {
+		return storeerrors.ErrClosed
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+
+	if err := p.wal.write(version, cs); err != nil {
+		return fmt.Errorf("failed to write changeset of version %d to WAL: %w", version, err)
+	}
+
+	pending := newPendingChangeset(version, cs)
+	p.mtx.Lock()
+	p.pending = append(p.pending, pending)
+	p.mtx.Unlock()
+
+	p.queue <- pipelineTask{pending: pending, cs: cs}
+
+	return nil
+}
+
+// Prune queues the pruning of the SS backend up to and including the given
+// version, so that it is ordered with the queued changesets.
+func (p *CommitPipeline) Prune(version uint64) error {
+	p.queueMtx.RLock()
+	defer p.queueMtx.RUnlock()
+
+	if p.closed {
+		return storeerrors.ErrClosed
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+
+	p.queue <- pipelineTask{prune: true, pruneTo: version}
+
+	return nil
+}
+
+// Flush blocks until all the queued changesets are flushed to the SS backend.
+func (p *CommitPipeline) Flush() error {
+	flushed := make(chan struct{})
+	p.queueMtx.RLock()
+	if p.closed {
+		p.queueMtx.RUnlock()
+		return storeerrors.ErrClosed
+	}
+	p.queue <- pipelineTask{flushed: flushed}
+	p.queueMtx.RUnlock()
+	<-flushed
+
+	return p.Err()
+}
+
+// Compact flushes the pipeline and compacts the SS backend.
+func (p *CommitPipeline) Compact() error {
+	if err := p.Flush(); err != nil {
+		return err
+	}
+
+	compactor, ok := p.ss.(store.Compactor)
+	if !ok {
+		return fmt.Errorf("SS backend %T does not support compaction", p.ss)
+	}
+
+	return compactor.Compact()
+}
+
+// snapshot returns the pending changesets whose version is lower or equal to
+// the given version.
+func (p *CommitPipeline) snapshot(version uint64) []*pendingChangeset {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	i := sort.Search(len(p.pending), func(i int) bool { return p.pending[i].version > version })
+
+	return p.pending[:i:i]
+}
+
+// getPending returns the latest pair of the given key written by the pending
+// changesets up to the given version.
+func (p *CommitPipeline) getPending(storeKey []byte, version uint64, key []byte) (corestore.KVPair, bool) {
+	pending := p.snapshot(version)
+	for i := len(pending) - 1; i >= 0; i-- {
+		if kv, ok := pending[i].get(storeKey, key); ok {
+			return kv, true
+		}
+	}
+
+	return corestore.KVPair{}, false
+}
+
+// Has returns true if the key exists at the given version.
+func (p *CommitPipeline) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	if kv, ok := p.getPending(storeKey, version, key); ok {
+		return !kv.Remove, nil
+	}
+
+	return p.ss.Has(storeKey, version, key)
+}
+
+// Get returns the value of the key at the given version.
+func (p *CommitPipeline) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	if kv, ok := p.getPending(storeKey, version, key); ok {
+		if kv.Remove {
+			return nil, nil
+		}
+		return kv.Value, nil
+	}
+
+	return p.ss.Get(storeKey, version, key)
+}
+
+// GetLatestVersion returns the latest version, including the pending ones.
+func (p *CommitPipeline) GetLatestVersion() (uint64, error) {
+	p.mtx.RLock()
+	var latest uint64
+	if len(p.pending) > 0 {
+		latest = p.pending[len(p.pending)-1].version
+	}
+	p.mtx.RUnlock()
+
+	ssLatest, err := p.ss.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	return max(latest, ssLatest), nil
+}
+
+// SetLatestVersion flushes the pipeline and sets the latest version of the SS
+// backend.
+func (p *CommitPipeline) SetLatestVersion(version uint64) error {
+	if err := p.Flush(); err != nil {
+		return err
+	}
+
+	return p.ss.SetLatestVersion(version)
+}
+
+// Iterator returns an iterator over the domain at the given version, merging
+// the pending changesets with the SS backend.
+func (p *CommitPipeline) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	overlay := p.overlay(storeKey, version, start, end, false)
+
+	parent, err := p.ss.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMergedIterator(parent, overlay, start, end, false), nil
+}
+
+// ReverseIterator returns a reverse iterator over the domain at the given
+// version, merging the pending changesets with the SS backend.
+func (p *CommitPipeline) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	overlay := p.overlay(storeKey, version, start, end, true)
+
+	parent, err := p.ss.ReverseIterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMergedIterator(parent, overlay, start, end, true), nil
+}
+
+// overlay returns the latest pairs of the domain written by the pending
+// changesets up to the given version, in iteration order.
+func (p *CommitPipeline) overlay(storeKey []byte, version uint64, start, end []byte, reverse bool) []corestore.KVPair {
+	byKey := make(map[string]corestore.KVPair)
+	for _, pending := range p.snapshot(version) {
+		for _, kv := range pending.pairs[string(storeKey)] {
+			if (start != nil && bytes.Compare(kv.Key, start) < 0) || (end != nil && bytes.Compare(kv.Key, end) >= 0) {
+				continue
+			}
+			byKey[string(kv.Key)] = kv
+		}
+	}
+
+	pairs := make([]corestore.KVPair, 0, len(byKey))
+	for _, kv := range byKey {
+		pairs = append(pairs, kv)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if reverse {
+			return bytes.Compare(pairs[i].Key, pairs[j].Key) > 0
+		}
+		return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0
+	})
+
+	return pairs
+}
+
+// Close flushes the pipeline and closes the SS backend. The pending sends to the
+// queue complete first, whereas the following ones fail with ErrClosed.
+func (p *CommitPipeline) Close() error {
+	p.queueMtx.Lock()
+	if p.closed {
+		p.queueMtx.Unlock()
+		return storeerrors.ErrClosed
+	}
+	p.closed = true
+	close(p.queue)
+	p.queueMtx.Unlock()
+	<-p.done
+
+	return errors.Join(p.Err(), p.ss.Close())
+}
@@ -0,0 +1,146 @@
+package root
+
+import (
+	"bytes"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.Iterator = (*mergedIterator)(nil)
+
+// mergedIterator merges an iterator of the SS backend with the pairs written by
+// the pending changesets of a CommitPipeline, which take precedence. Removed
+// keys are skipped.
+type mergedIterator struct {
+	parent  corestore.Iterator
+	overlay []corestore.KVPair
+
+	start, end []byte
+	reverse    bool
+
+	// fromOverlay reports whether the current pair is overlay[0]
+	fromOverlay bool
+	valid       bool
+}
+
+func newMergedIterator(parent corestore.Iterator, overlay []corestore.KVPair, start, end []byte, reverse bool) *mergedIterator {
+	itr := &mergedIterator{
+		parent:  parent,
+		overlay: overlay,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	itr.seek()
+
+	return itr
+}
+
+// compare compares two keys in iteration order.
+func (itr *mergedIterator) compare(a, b []byte) int {
+	if itr.reverse {
+		return bytes.Compare(b, a)
+	}
+
+	return bytes.Compare(a, b)
+}
+
+// seek moves to the next pair which is not removed.
+func (itr *mergedIterator) seek() {
+	for {
+		parentValid := itr.parent.Valid()
+		if !parentValid && len(itr.overlay) == 0 {
+			itr.valid = false
+			return
+		}
+
+		if len(itr.overlay) == 0 {
+			itr.fromOverlay = false
+			itr.valid = true
+			return
+		}
+
+		kv := itr.overlay[0]
+		if parentValid {
+			switch c := itr.compare(itr.parent.Key(), kv.Key); {
+			case c < 0:
+				itr.fromOverlay = false
+				itr.valid = true
+				return
+
+			case c == 0:
+				// the pending pair shadows the flushed one
+				itr.parent.Next()
+			}
+		}
+
+		if !kv.Remove {
+			itr.fromOverlay = true
+			itr.valid = true
+			return
+		}
+		itr.overlay = itr.overlay[1:]
+	}
+}
+
+// Domain implements corestore.Iterator.
+func (itr *mergedIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements corestore.Iterator.
+func (itr *mergedIterator) Valid() bool {
+	return itr.valid
+}
+
+// Next implements corestore.Iterator.
+func (itr *mergedIterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	if itr.fromOverlay {
+		itr.overlay = itr.overlay[1:]
+	} else {
+		itr.parent.Next()
+	}
+	itr.seek()
+}
+
+// Key implements corestore.Iterator.
+func (itr *mergedIterator) Key() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	if itr.fromOverlay {
+		return itr.overlay[0].Key
+	}
+
+	return itr.parent.Key()
+}
+
+// Value implements corestore.Iterator.
+func (itr *mergedIterator) Value() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	if itr.fromOverlay {
+		return itr.overlay[0].Value
+	}
+
+	return itr.parent.Value()
+}
+
+// Error implements corestore.Iterator.
+func (itr *mergedIterator) Error() error {
+	return itr.parent.Error()
+}
+
+// Close implements corestore.Iterator.
+func (itr *mergedIterator) Close() error {
+	itr.valid = false
+
+	return itr.parent.Close()
+}
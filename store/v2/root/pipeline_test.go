@@ -0,0 +1,306 @@
+package root
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// gatedStorage blocks the writes to the wrapped SS backend until released, and
+// records the writes in order.
+type gatedStorage struct {
+	PipelinedStorage
+
+	gate chan struct{}
+
+	mtx sync.Mutex
+	ops []string
+}
+
+func (g *gatedStorage) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	<-g.gate
+	g.record(fmt.Sprintf("apply %d", version))
+	return g.PipelinedStorage.ApplyChangeset(version, cs)
+}
+
+func (g *gatedStorage) Prune(version uint64) error {
+	<-g.gate
+	g.record(fmt.Sprintf("prune %d", version))
+	return g.PipelinedStorage.Prune(version)
+}
+
+func (g *gatedStorage) record(op string) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.ops = append(g.ops, op)
+}
+
+func newTestStorage(t *testing.T) *storage.StorageStore {
+	t.Helper()
+
+	db, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+
+	return storage.NewStorageStore(db, log.NewNopLogger())
+}
+
+func newGatedPipeline(t *testing.T, queueSize int) (*CommitPipeline, *gatedStorage) {
+	t.Helper()
+
+	gated := &gatedStorage{PipelinedStorage: newTestStorage(t), gate: make(chan struct{})}
+	p, err := NewCommitPipeline(log.NewNopLogger(), gated, t.TempDir(), queueSize, 0)
+	require.NoError(t, err)
+
+	return p, gated
+}
+
+func collect(t *testing.T, itr corestore.Iterator) []string {
+	t.Helper()
+	defer itr.Close()
+
+	var res []string
+	for ; itr.Valid(); itr.Next() {
+		res = append(res, fmt.Sprintf("%s=%s", itr.Key(), itr.Value()))
+	}
+
+	return res
+}
+
+func TestCommitPipeline_PendingReads(t *testing.T) {
+	p, gated := newGatedPipeline(t, 4)
+
+	cs1 := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Value: []byte("1")},
+			{Key: []byte("d"), Value: []byte("1")},
+		},
+	})
+	cs2 := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {
+			{Key: []byte("a"), Remove: true},
+			{Key: []byte("c"), Value: []byte("2")},
+			{Key: []byte("d"), Value: []byte("2")},
+		},
+	})
+	require.NoError(t, p.ApplyChangeset(1, cs1))
+	require.NoError(t, p.ApplyChangeset(2, cs2))
+
+	check := func() {
+		latest, err := p.GetLatestVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), latest)
+
+		val, err := p.Get(testStoreKeyBytes, 1, []byte("a"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("1"), val)
+		val, err = p.Get(testStoreKeyBytes, 2, []byte("a"))
+		require.NoError(t, err)
+		require.Nil(t, val)
+		ok, err := p.Has(testStoreKeyBytes, 2, []byte("b"))
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = p.Has(testStoreKeyBytes, 1, []byte("c"))
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		itr, err := p.Iterator(testStoreKeyBytes, 1, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a=1", "b=1", "d=1"}, collect(t, itr))
+		itr, err = p.Iterator(testStoreKeyBytes, 2, []byte("b"), []byte("d"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"b=1", "c=2"}, collect(t, itr))
+		itr, err = p.ReverseIterator(testStoreKeyBytes, 2, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"d=2", "c=2", "b=1"}, collect(t, itr))
+	}
+
+	// served from memory
+	check()
+
+	// served from SS, partially then fully
+	gated.gate <- struct{}{}
+	check()
+	close(gated.gate)
+	require.NoError(t, p.Flush())
+	check()
+
+	entries, err := os.ReadDir(p.wal.dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, p.Close())
+}
+
+func TestCommitPipeline_Backpressure(t *testing.T) {
+	p, gated := newGatedPipeline(t, 1)
+
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {{Key: []byte("key"), Value: []byte("value")}},
+	})
+
+	// the first changeset is picked by the worker, the second one is queued
+	require.NoError(t, p.ApplyChangeset(1, cs))
+	require.Eventually(t, func() bool { return len(p.queue) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, p.ApplyChangeset(2, cs))
+
+	applied := make(chan error)
+	go func() {
+		applied <- p.ApplyChangeset(3, cs)
+	}()
+
+	select {
+	case <-applied:
+		t.Fatal("ApplyChangeset did not block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	gated.gate <- struct{}{}
+	require.NoError(t, <-applied)
+
+	close(gated.gate)
+	require.NoError(t, p.Flush())
+	require.Equal(t, []string{"apply 1", "apply 2", "apply 3"}, gated.ops)
+	require.NoError(t, p.Close())
+}
+
+func TestCommitPipeline_PruneOrdering(t *testing.T) {
+	p, gated := newGatedPipeline(t, 4)
+	close(gated.gate)
+
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {{Key: []byte("key"), Value: []byte("value")}},
+	})
+	require.NoError(t, p.ApplyChangeset(1, cs))
+	require.NoError(t, p.ApplyChangeset(2, cs))
+	require.NoError(t, p.Prune(1))
+	require.NoError(t, p.ApplyChangeset(3, cs))
+	require.NoError(t, p.Flush())
+
+	require.Equal(t, []string{"apply 1", "apply 2", "prune 1", "apply 3"}, gated.ops)
+	require.NoError(t, p.Close())
+}
+
+func TestCommitPipeline_ConcurrentClose(t *testing.T) {
+	p, gated := newGatedPipeline(t, 1)
+	close(gated.gate)
+
+	cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+		testStoreKey: {{Key: []byte("key"), Value: []byte("value")}},
+	})
+
+	// the writers racing with Close either complete or fail with ErrClosed
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for v := uint64(1); v <= 50; v++ {
+				var err error
+				switch i {
+				case 0:
+					err = p.ApplyChangeset(v, cs)
+				case 1:
+					err = p.Prune(v)
+				default:
+					err = p.Flush()
+				}
+				if err != nil {
+					require.ErrorIs(t, err, storeerrors.ErrClosed)
+					return
+				}
+			}
+		}(i)
+	}
+
+	require.NoError(t, p.Close())
+	wg.Wait()
+
+	require.ErrorIs(t, p.ApplyChangeset(1000, cs), storeerrors.ErrClosed)
+	require.ErrorIs(t, p.Flush(), storeerrors.ErrClosed)
+	require.ErrorIs(t, p.Close(), storeerrors.ErrClosed)
+}
+
+func TestCommitPipeline_Recovery(t *testing.T) {
+	walDir := t.TempDir()
+	ss := newTestStorage(t)
+
+	// simulate a crash leaving flushed and un-flushed changesets in the WAL
+	wal, err := openChangesetWAL(walDir)
+	require.NoError(t, err)
+	for v := uint64(1); v <= 4; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			testStoreKey: {{Key: []byte(fmt.Sprintf("key%d", v)), Value: []byte(fmt.Sprintf("value%d", v))}},
+		})
+		require.NoError(t, wal.write(v, cs))
+		if v == 1 {
+			require.NoError(t, ss.ApplyChangeset(v, cs))
+		}
+	}
+	require.NoError(t, os.WriteFile(wal.path(5)+walTmpExt, []byte("torn"), 0o600))
+
+	// version 4 was not committed to SC
+	p, err := NewCommitPipeline(log.NewNopLogger(), ss, walDir, 0, 3)
+	require.NoError(t, err)
+
+	latest, err := p.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latest)
+	for v := 1; v <= 3; v++ {
+		val, err := p.Get(testStoreKeyBytes, 3, []byte(fmt.Sprintf("key%d", v)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", v)), val)
+	}
+	val, err := p.Get(testStoreKeyBytes, 4, []byte("key4"))
+	require.NoError(t, err)
+	require.Nil(t, val)
+
+	entries, err := os.ReadDir(walDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, p.Close())
+}
+
+func TestCommitPipeline_RootStore(t *testing.T) {
+	noopLog := log.NewNopLogger()
+	p, err := NewCommitPipeline(noopLog, newTestStorage(t), t.TempDir(), 2, 0)
+	require.NoError(t, err)
+
+	tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{testStoreKey: tree}, dbm.NewMemDB(), noopLog)
+	require.NoError(t, err)
+
+	rs, err := New(noopLog, p, sc, pruning.NewManager(sc, p, nil, nil), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	for v := uint64(1); v <= 10; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			testStoreKey: {{Key: []byte("key"), Value: []byte(fmt.Sprintf("value%d", v))}},
+		})
+		_, err := rs.Commit(cs)
+		require.NoError(t, err)
+
+		// the committed version is readable right away
+		res, err := rs.Query(testStoreKeyBytes, v, []byte("key"), false)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", v)), res.Value)
+	}
+
+	require.NoError(t, rs.Close())
+}
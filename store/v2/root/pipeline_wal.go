@@ -0,0 +1,241 @@
+package root
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+const (
+	walExt    = ".wal"
+	walTmpExt = ".tmp"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// changesetWAL is a write-ahead log of the changesets committed to SC but not
+// yet flushed to SS. Every changeset is written to its own file, which is
+// removed once the changeset is flushed.
+type changesetWAL struct {
+	dir string
+}
+
+func openChangesetWAL(dir string) (*changesetWAL, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory %s: %w", dir, err)
+	}
+
+	return &changesetWAL{dir: dir}, nil
+}
+
+func (w *changesetWAL) path(version uint64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%020d%s", version, walExt))
+}
+
+// write durably writes the changeset of the given version.
+func (w *changesetWAL) write(version uint64, cs *corestore.Changeset) error {
+	buf := encodeChangeset(version, cs)
+
+	tmp := w.path(version) + walTmpExt
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	// the rename makes the entry visible atomically
+	if err := os.Rename(tmp, w.path(version)); err != nil {
+		return err
+	}
+
+	return syncDir(w.dir)
+}
+
+// remove removes the changeset of the given version.
+func (w *changesetWAL) remove(version uint64) error {
+	if err := os.Remove(w.path(version)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// versions returns the versions of the changesets of the log in ascending
+// order, removing the partially written entries.
+func (w *changesetWAL) versions() ([]uint64, error) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []uint64
+	for _, e := range entries {
+		name := e.Name()
+		switch {
+		case strings.HasSuffix(name, walTmpExt):
+			if err := os.Remove(filepath.Join(w.dir, name)); err != nil {
+				return nil, err
+			}
+
+		case strings.HasSuffix(name, walExt):
+			v, err := strconv.ParseUint(strings.TrimSuffix(name, walExt), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WAL file %s: %w", name, err)
+			}
+			versions = append(versions, v)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, nil
+}
+
+// read reads the changeset of the given version.
+func (w *changesetWAL) read(version uint64) (*corestore.Changeset, error) {
+	buf, err := os.ReadFile(w.path(version))
+	if err != nil {
+		return nil, err
+	}
+
+	v, cs, err := decodeChangeset(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode WAL entry of version %d: %w", version, err)
+	}
+	if v != version {
+		return nil, fmt.Errorf("WAL entry of version %d contains version %d", version, v)
+	}
+
+	return cs, nil
+}
+
+// encodeChangeset encodes the changeset of the given version, prefixed by the
+// CRC32 checksum of the encoding.
+func encodeChangeset(version uint64, cs *corestore.Changeset) []byte {
+	buf := make([]byte, 4, 64)
+	buf = binary.AppendUvarint(buf, version)
+	buf = binary.AppendUvarint(buf, uint64(len(cs.Changes)))
+	for _, changes := range cs.Changes {
+		buf = appendBytes(buf, changes.Actor)
+		buf = binary.AppendUvarint(buf, uint64(len(changes.StateChanges)))
+		for _, kv := range changes.StateChanges {
+			if kv.Remove {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+			buf = appendBytes(buf, kv.Key)
+			buf = appendBytes(buf, kv.Value)
+		}
+	}
+	binary.BigEndian.PutUint32(buf, crc32.Checksum(buf[4:], crcTable))
+
+	return buf
+}
+
+func appendBytes(buf, bz []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(bz)))
+	return append(buf, bz...)
+}
+
+var errInvalidWALEntry = errors.New("invalid WAL entry")
+
+func decodeChangeset(buf []byte) (uint64, *corestore.Changeset, error) {
+	if len(buf) < 4 || binary.BigEndian.Uint32(buf) != crc32.Checksum(buf[4:], crcTable) {
+		return 0, nil, errInvalidWALEntry
+	}
+	d := &walDecoder{buf: buf[4:]}
+
+	version := d.uvarint()
+	cs := corestore.NewChangeset()
+	numActors := d.uvarint()
+	for i := uint64(0); i < numActors && d.err == nil; i++ {
+		changes := corestore.StateChanges{Actor: d.bytes()}
+		numPairs := d.uvarint()
+		for j := uint64(0); j < numPairs && d.err == nil; j++ {
+			remove := d.byte() == 1
+			kv := corestore.KVPair{Key: d.bytes(), Value: d.bytes(), Remove: remove}
+			changes.StateChanges = append(changes.StateChanges, kv)
+		}
+		cs.Changes = append(cs.Changes, changes)
+	}
+	if d.err == nil && len(d.buf) != 0 {
+		d.err = errInvalidWALEntry
+	}
+
+	return version, cs, d.err
+}
+
+type walDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *walDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errInvalidWALEntry
+		return 0
+	}
+	d.buf = d.buf[n:]
+
+	return v
+}
+
+func (d *walDecoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) == 0 {
+		d.err = errInvalidWALEntry
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+
+	return b
+}
+
+func (d *walDecoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.buf)) < n {
+		d.err = errInvalidWALEntry
+		return nil
+	}
+	bz := d.buf[:n:n]
+	d.buf = d.buf[n:]
+
+	return bz
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(f.Sync(), f.Close())
+}
@@ -0,0 +1,126 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/flat"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/mem"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+var _ store.Compactor = (*Store)(nil)
+
+// VerifyVersion recomputes the commitment root of the given version from the SS
+// backend and compares it, store by store, against the CommitInfo persisted by
+// the SC backend. The commitment is recomputed using scratch trees of the backend
+// of each store, in memory for IAVL and in a temporary directory for flat trees,
+// so the store is left untouched.
+func (s *Store) VerifyVersion(version uint64) (*commitment.IntegrityReport, error) {
+	sc, ok := s.stateCommitment.(*commitment.CommitStore)
+	if !ok {
+		return nil, fmt.Errorf("state commitment %T does not support verification", s.stateCommitment)
+	}
+
+	return sc.Verify(version, s.stateStorage, func(_ string, tree commitment.Tree) (commitment.Tree, error) {
+		if _, ok := tree.(*flat.Tree); !ok {
+			return iavl.NewIavlTree(dbm.NewMemDB(), s.logger, iavl.DefaultConfig()), nil
+		}
+
+		dir, err := os.MkdirTemp("", "flat-verify-")
+		if err != nil {
+			return nil, err
+		}
+
+		cfg := flat.DefaultConfig()
+		cfg.SyncWrites = false
+		scratch, err := flat.NewTree(dir, s.logger, cfg)
+		if err != nil {
+			return nil, errors.Join(err, os.RemoveAll(dir))
+		}
+
+		return &scratchFlatTree{Tree: scratch, dir: dir}, nil
+	})
+}
+
+// scratchFlatTree is a flat tree stored in a temporary directory, which is
+// removed when the tree is closed.
+type scratchFlatTree struct {
+	*flat.Tree
+	dir string
+}
+
+func (t *scratchFlatTree) Close() error {
+	return errors.Join(t.Tree.Close(), os.RemoveAll(t.dir))
+}
+
+// NewRebuildCommitStore creates an empty SC backend to rebuild the state
+// commitment into, see RebuildStateCommitment. Its trees are of the same
+// backends as the current ones: the IAVL trees and the commit infos are stored
+// in scRawDB, and the flat trees under rootDir, see FlatTreeDir.
+func (s *Store) NewRebuildCommitStore(rootDir string, scRawDB corestore.KVStoreWithBatch) (*commitment.CommitStore, error) {
+	sc, ok := s.stateCommitment.(*commitment.CommitStore)
+	if !ok {
+		return nil, fmt.Errorf("state commitment %T does not support rebuilding", s.stateCommitment)
+	}
+
+	return sc.NewEmpty(scRawDB, func(storeKey string, tree commitment.Tree) (commitment.Tree, error) {
+		switch tree.(type) {
+		case *iavl.IavlTree:
+			return iavl.NewIavlTree(dbm.NewPrefixDB(scRawDB, []byte(storeKey)), s.logger, iavl.DefaultConfig()), nil
+		case *flat.Tree:
+			return flat.NewTree(FlatTreeDir(rootDir, storeKey), s.logger, flat.DefaultConfig())
+		case *mem.Tree:
+			return mem.New(), nil
+		default:
+			return nil, fmt.Errorf("unsupported state commitment tree %T", tree)
+		}
+	})
+}
+
+// RebuildStateCommitment rebuilds the SC backend into dst from the history of
+// the SS backend, from initialVersion up to the given version, see
+// commitment.CommitStore.Rebuild. dst must be empty and contain the same store
+// keys as the current SC backend, see NewRebuildCommitStore. It is meant to be used offline to recover from
+// a corrupted commitment tree, the caller is responsible for swapping the
+// databases once the rebuild succeeded.
+func (s *Store) RebuildStateCommitment(initialVersion, version uint64, dst *commitment.CommitStore) (*commitment.IntegrityReport, error) {
+	sc, ok := s.stateCommitment.(*commitment.CommitStore)
+	if !ok {
+		return nil, fmt.Errorf("state commitment %T does not support rebuilding", s.stateCommitment)
+	}
+
+	ss, ok := s.stateStorage.(store.VersionChangesReader)
+	if !ok {
+		return nil, fmt.Errorf("state storage %T does not support rebuilding", s.stateStorage)
+	}
+
+	return sc.Rebuild(initialVersion, version, ss, dst)
+}
+
+// Compact compacts both the SS and SC backends, reclaiming the disk space left
+// behind by pruning.
+func (s *Store) Compact() (err error) {
+	if compactor, ok := s.stateStorage.(store.Compactor); ok {
+		if cErr := compactor.Compact(); cErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to compact SS: %w", cErr))
+		}
+	} else {
+		err = errors.Join(err, fmt.Errorf("SS backend %T does not support compaction", s.stateStorage))
+	}
+
+	if compactor, ok := s.stateCommitment.(store.Compactor); ok {
+		if cErr := compactor.Compact(); cErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to compact SC: %w", cErr))
+		}
+	} else {
+		err = errors.Join(err, fmt.Errorf("SC backend %T does not support compaction", s.stateCommitment))
+	}
+
+	return err
+}
@@ -0,0 +1,196 @@
+package root
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func (s *RootStoreTestSuite) commitVersions(n int) {
+	for v := 1; v <= n; v++ {
+		cs := corestore.NewChangeset()
+		for i := 0; i < 10; i++ {
+			key := []byte(fmt.Sprintf("key%03d", i))
+			cs.Add(testStoreKeyBytes, key, []byte(fmt.Sprintf("val%03d_%d", i, v)), false)
+			cs.Add(testStoreKey2Bytes, key, []byte(fmt.Sprintf("val%03d", i)), false)
+		}
+		cs.Add(testStoreKey3Bytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *RootStoreTestSuite) TestVerifyVersion() {
+	s.commitVersions(5)
+
+	rs := s.rootStore.(*Store)
+	for v := uint64(1); v <= 5; v++ {
+		report, err := rs.VerifyVersion(v)
+		s.Require().NoError(err)
+		s.Require().True(report.OK(), "version %d: %+v", v, report.Mismatches())
+		s.Require().Len(report.Stores, 3)
+	}
+
+	// corrupt SS by writing a different value at the latest version
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKey2Bytes, []byte("key005"), []byte("corrupted"), false)
+	cs.Add(testStoreKey2Bytes, []byte("key999"), []byte("extra"), false)
+	s.Require().NoError(rs.stateStorage.ApplyChangeset(5, cs))
+
+	report, err := rs.VerifyVersion(5)
+	s.Require().NoError(err)
+	s.Require().False(report.OK())
+	s.Require().NotEqual(report.CommittedHash, report.RecomputedHash)
+
+	mismatches := report.Mismatches()
+	s.Require().Len(mismatches, 1)
+	s.Require().Equal(testStoreKey2, mismatches[0].StoreKey)
+	s.Require().Equal(uint64(1), mismatches[0].MismatchedValues)
+	s.Require().Equal(uint64(1), mismatches[0].ExtraKeys)
+	s.Require().Zero(mismatches[0].MissingKeys)
+
+	// previous versions are unaffected
+	report, err = rs.VerifyVersion(4)
+	s.Require().NoError(err)
+	s.Require().True(report.OK())
+
+	_, err = rs.VerifyVersion(6)
+	s.Require().Error(err)
+}
+
+func (s *RootStoreTestSuite) newEmptyCommitStore() *commitment.CommitStore {
+	noopLog := log.NewNopLogger()
+	trees := map[string]commitment.Tree{}
+	for _, key := range []string{testStoreKey, testStoreKey2, testStoreKey3} {
+		trees[key] = iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	}
+	dst, err := commitment.NewCommitStore(trees, dbm.NewMemDB(), noopLog)
+	s.Require().NoError(err)
+
+	return dst
+}
+
+func (s *RootStoreTestSuite) TestRebuildStateCommitment() {
+	s.commitVersions(3)
+
+	// version 4 removes a key and leaves a store untouched
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("key003"), nil, true)
+	cs.Add(testStoreKey3Bytes, []byte("key"), []byte("val004"), false)
+	_, err := s.rootStore.Commit(cs)
+	s.Require().NoError(err)
+
+	rs := s.rootStore.(*Store)
+	dst := s.newEmptyCommitStore()
+	report, err := rs.RebuildStateCommitment(1, 4, dst)
+	s.Require().NoError(err)
+	s.Require().True(report.OK())
+
+	for v := uint64(1); v <= 4; v++ {
+		expected, err := rs.stateCommitment.GetCommitInfo(v)
+		s.Require().NoError(err)
+		actual, err := dst.GetCommitInfo(v)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Hash(), actual.Hash(), "version %d", v)
+	}
+
+	latest, err := dst.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), latest)
+
+	_, err = rs.RebuildStateCommitment(5, 4, s.newEmptyCommitStore())
+	s.Require().Error(err)
+
+	// a corrupted SS cannot rebuild the committed hash
+	cs = corestore.NewChangeset()
+	cs.Add(testStoreKey2Bytes, []byte("key005"), []byte("corrupted"), false)
+	s.Require().NoError(rs.stateStorage.ApplyChangeset(4, cs))
+
+	report, err = rs.RebuildStateCommitment(1, 4, s.newEmptyCommitStore())
+	s.Require().Error(err)
+	s.Require().NotEqual(report.CommittedHash, report.RecomputedHash)
+	mismatches := report.Mismatches()
+	s.Require().Len(mismatches, 1)
+	s.Require().Equal(testStoreKey2, mismatches[0].StoreKey)
+}
+
+// setupFlatStore replaces the root store of the suite by one whose SC backend
+// is made of flat trees.
+func (s *RootStoreTestSuite) setupFlatStore() {
+	rs, err := CreateRootStore(&FactoryOptions{
+		Logger:    log.NewNopLogger(),
+		RootDir:   s.T().TempDir(),
+		SSType:    SSTypeSQLite,
+		SCType:    SCTypeFlat,
+		StoreKeys: []string{testStoreKey, testStoreKey2, testStoreKey3},
+		SCRawDB:   dbm.NewMemDB(),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.rootStore.Close())
+	s.rootStore = rs
+}
+
+func (s *RootStoreTestSuite) TestVerifyVersionFlat() {
+	s.setupFlatStore()
+	s.commitVersions(3)
+
+	rs := s.rootStore.(*Store)
+	for v := uint64(1); v <= 3; v++ {
+		report, err := rs.VerifyVersion(v)
+		s.Require().NoError(err)
+		s.Require().True(report.OK(), "version %d: %+v", v, report.Mismatches())
+		s.Require().Len(report.Stores, 3)
+	}
+
+	// corrupt SS by removing a key at the latest version
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("key005"), nil, true)
+	s.Require().NoError(rs.stateStorage.ApplyChangeset(3, cs))
+
+	report, err := rs.VerifyVersion(3)
+	s.Require().NoError(err)
+	s.Require().False(report.OK())
+
+	mismatches := report.Mismatches()
+	s.Require().Len(mismatches, 1)
+	s.Require().Equal(testStoreKey, mismatches[0].StoreKey)
+	s.Require().Equal(uint64(1), mismatches[0].MissingKeys)
+	s.Require().NotEqual(mismatches[0].CommittedHash, mismatches[0].RecomputedHash)
+}
+
+func (s *RootStoreTestSuite) TestRebuildStateCommitmentFlat() {
+	s.setupFlatStore()
+	s.commitVersions(3)
+
+	rs := s.rootStore.(*Store)
+	rootDir := s.T().TempDir()
+	dst, err := rs.NewRebuildCommitStore(rootDir, dbm.NewMemDB())
+	s.Require().NoError(err)
+	defer dst.Close()
+
+	report, err := rs.RebuildStateCommitment(1, 3, dst)
+	s.Require().NoError(err)
+	s.Require().True(report.OK())
+
+	for v := uint64(1); v <= 3; v++ {
+		expected, err := rs.stateCommitment.GetCommitInfo(v)
+		s.Require().NoError(err)
+		actual, err := dst.GetCommitInfo(v)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Hash(), actual.Hash(), "version %d", v)
+	}
+
+	// the rebuilt trees are flat trees
+	s.Require().DirExists(FlatTreeDir(rootDir, testStoreKey))
+}
+
+func (s *RootStoreTestSuite) TestCompact() {
+	s.commitVersions(2)
+	s.Require().NoError(s.rootStore.(*Store).Compact())
+}
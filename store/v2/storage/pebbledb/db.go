@@ -12,8 +12,10 @@ import (
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
 	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 const (
@@ -28,7 +30,11 @@ const (
 	tombstoneVal     = "TOMBSTONE"
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ store.VersionChangesReader = (*Database)(nil)
+	_ storage.Database           = (*Database)(nil)
+	_ store.Compactor            = (*Database)(nil)
+)
 
 type Database struct {
 	storage *pebble.DB
@@ -267,6 +273,66 @@ func (db *Database) Prune(version uint64) error {
 	return db.setPruneHeight(version)
 }
 
+// Compact performs a manual compaction of the entire key space, which physically
+// removes the entries deleted by pruning.
+func (db *Database) Compact() error {
+	return dbm.CompactPebble(db.storage)
+}
+
+// VersionChanges returns the changes written to the given store at exactly the
+// given version. As the versions of a key are sorted, it seeks the given version
+// of every key of the store.
+func (db *Database) VersionChanges(storeKey []byte, version uint64) (corestore.KVPairs, error) {
+	if version < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: version}
+	}
+
+	prefix := storePrefix(storeKey)
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: prefix, UpperBound: util.CopyIncr(prefix)})
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var changes corestore.KVPairs
+	for valid := itr.First(); valid; {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return nil, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		var keyVersion uint64
+		if len(verBz) > 0 {
+			if keyVersion, err = decodeUint64Ascending(verBz); err != nil {
+				return nil, fmt.Errorf("failed to decode key version: %w", err)
+			}
+		}
+
+		if keyVersion < version {
+			valid = itr.SeekGE(MVCCEncode(keyBz, version))
+			continue
+		}
+
+		if keyVersion == version {
+			valBz, tombBz, ok := SplitMVCCKey(itr.Value())
+			if !ok {
+				return nil, fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.Value())
+			}
+
+			key := bytes.Clone(keyBz[len(prefix):])
+			if len(tombBz) > 0 {
+				changes = append(changes, corestore.KVPair{Key: key, Remove: true})
+			} else {
+				changes = append(changes, corestore.KVPair{Key: key, Value: valBz})
+			}
+		}
+
+		valid = itr.NextPrefix()
+	}
+
+	return changes, itr.Error()
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -27,6 +27,7 @@ const (
 
 var (
 	_ storage.Database = (*Database)(nil)
+	_ store.Compactor  = (*Database)(nil)
 
 	defaultWriteOpts = grocksdb.NewDefaultWriteOptions()
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
@@ -164,6 +165,15 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// Compact performs a manual compaction of the entire key space.
+func (db *Database) Compact() error {
+	compactOpts := grocksdb.NewCompactRangeOptions()
+	defer compactOpts.Destroy()
+
+	db.storage.CompactRangeCFOpt(db.cfHandle, grocksdb.Range{}, compactOpts)
+	return nil
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, errors.ErrKeyEmpty
@@ -43,7 +43,11 @@ const (
 	`
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database           = (*Database)(nil)
+	_ store.Compactor            = (*Database)(nil)
+	_ store.VersionChangesReader = (*Database)(nil)
+)
 
 type Database struct {
 	storage *sql.DB
@@ -216,6 +220,66 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// Compact rebuilds the database file, reclaiming the space of the rows deleted
+// by pruning.
+func (db *Database) Compact() error {
+	if _, err := db.storage.Exec("VACUUM;"); err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	return nil
+}
+
+// VersionChanges returns the changes written to the given store at exactly the
+// given version, i.e. the rows written or tombstoned at the version.
+func (db *Database) VersionChanges(storeKey []byte, version uint64) (corestore.KVPairs, error) {
+	if version < db.earliestVersion {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: version}
+	}
+
+	stmt, err := db.storage.Prepare(`
+	SELECT key, value, tombstone FROM state_storage
+	WHERE store_key = ? AND (version = ? OR tombstone = ?)
+	ORDER BY key ASC, version ASC;
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query(storeKey, version, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rows: %w", err)
+	}
+	defer rows.Close()
+
+	var changes corestore.KVPairs
+	for rows.Next() {
+		var (
+			key, value []byte
+			tomb       uint64
+		)
+		if err := rows.Scan(&key, &value, &tomb); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		change := corestore.KVPair{Key: key, Value: value}
+		if tomb == version {
+			change = corestore.KVPair{Key: key, Remove: true}
+		}
+
+		// a key tombstoned then written again at the version has two rows, the
+		// row of the version comes last and holds the change
+		if n := len(changes); n > 0 && bytes.Equal(changes[n-1].Key, key) {
+			changes[n-1] = change
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
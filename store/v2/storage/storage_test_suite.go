@@ -183,6 +183,50 @@ func (s *StorageTestSuite) TestDatabase_ApplyChangeset() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_VersionChanges() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	changes := []corestore.KVPairs{
+		{
+			{Key: []byte("key000"), Value: []byte("value")},
+			{Key: []byte("key001"), Value: []byte("value")},
+			{Key: []byte("key002"), Value: []byte("value")},
+		},
+		{
+			// rewriting the same value is still a change of the version
+			{Key: []byte("key000"), Value: []byte("value")},
+			{Key: []byte("key001"), Remove: true},
+		},
+		{},
+		{
+			{Key: []byte("key001"), Value: []byte("value004")},
+		},
+	}
+	for i, pairs := range changes {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{storeKey1: pairs})
+		s.Require().NoError(db.ApplyChangeset(uint64(i+1), cs))
+	}
+
+	for i, expected := range changes {
+		pairs, err := db.VersionChanges(storeKey1Bytes, uint64(i+1))
+		s.Require().NoError(err)
+		s.Require().Len(pairs, len(expected), "version %d", i+1)
+		for j, pair := range pairs {
+			s.Require().Equal(expected[j].Key, pair.Key)
+			s.Require().Equal(expected[j].Remove, pair.Remove)
+			if !pair.Remove {
+				s.Require().Equal(expected[j].Value, pair.Value)
+			}
+		}
+	}
+
+	pairs, err := db.VersionChanges([]byte("store2"), 1)
+	s.Require().NoError(err)
+	s.Require().Empty(pairs)
+}
+
 func (s *StorageTestSuite) TestDatabase_IteratorEmptyDomain() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -18,6 +18,8 @@ var (
 	_ store.VersionedDatabase      = (*StorageStore)(nil)
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
 	_ store.Pruner                 = (*StorageStore)(nil)
+	_ store.Compactor              = (*StorageStore)(nil)
+	_ store.VersionChangesReader   = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
@@ -97,6 +99,27 @@ func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
 }
 
+// Compact compacts the underlying database if it supports manual compaction.
+func (ss *StorageStore) Compact() error {
+	compactor, ok := ss.db.(store.Compactor)
+	if !ok {
+		return fmt.Errorf("compaction is not supported by the %T backend", ss.db)
+	}
+
+	return compactor.Compact()
+}
+
+// VersionChanges returns the changes written to the given store at exactly the
+// given version, if supported by the backend.
+func (ss *StorageStore) VersionChanges(storeKey []byte, version uint64) (corestore.KVPairs, error) {
+	reader, ok := ss.db.(store.VersionChangesReader)
+	if !ok {
+		return nil, fmt.Errorf("reading the changes of a version is not supported by the %T backend", ss.db)
+	}
+
+	return reader.VersionChanges(storeKey, version)
+}
+
 // Restore restores the store from the given channel.
 func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	latestVersion, err := ss.db.GetLatestVersion()
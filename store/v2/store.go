@@ -95,6 +95,23 @@ type PausablePruner interface {
 	PausePruning(pause bool)
 }
 
+// Compactor defines the interface for databases which support manually
+// compacting their on-disk representation, e.g. to reclaim the space left
+// behind by pruning.
+type Compactor interface {
+	// Compact compacts the entire key space of the database.
+	Compact() error
+}
+
+// VersionChangesReader defines the interface for state storage backends able to
+// read back the changes written at a given version, as needed to rebuild the
+// state commitment from the state storage.
+type VersionChangesReader interface {
+	// VersionChanges returns the changes written to the given store at exactly
+	// the given version, sorted by key.
+	VersionChanges(storeKey []byte, version uint64) (corestore.KVPairs, error)
+}
+
 // QueryResult defines the response type to performing a query on a RootStore.
 type QueryResult struct {
 	Key      []byte
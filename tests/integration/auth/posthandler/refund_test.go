@@ -0,0 +1,131 @@
+package posthandler_test
+
+import (
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	_ "cosmossdk.io/x/accounts"
+	_ "cosmossdk.io/x/auth"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	_ "cosmossdk.io/x/auth/tx/config"
+	authtypes "cosmossdk.io/x/auth/types"
+	_ "cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
+	_ "cosmossdk.io/x/consensus"
+	_ "cosmossdk.io/x/staking"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txModuleWithGasRefund configures the tx module to refund the given fraction
+// of the fees paid for unused gas.
+func txModuleWithGasRefund(ratio string) configurator.ModuleOption {
+	return func(config *configurator.Config) {
+		config.ModuleConfigs["tx"] = &appv1alpha1.ModuleConfig{
+			Name:   "tx",
+			Config: appconfig.WrapAny(&txconfigv1.Config{GasRefundRatio: ratio}),
+		}
+	}
+}
+
+func TestRefundUnusedGas(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		accountKeeper authkeeper.AccountKeeper
+		txConfig      client.TxConfig
+	)
+
+	priv := secp256k1.GenPrivKey()
+	payer := sdk.AccAddress(priv.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = []simtestutil.GenesisAccount{{
+		GenesisAccount: &authtypes.BaseAccount{Address: payer.String()},
+		Coins:          sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)),
+	}}
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AccountsModule(),
+				configurator.AuthModule(),
+				configurator.StakingModule(),
+				txModuleWithGasRefund("0.5"),
+				configurator.ConsensusModule(),
+				configurator.BankModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		startupCfg, &bankKeeper, &accountKeeper, &txConfig)
+	require.NoError(t, err)
+
+	acc := accountKeeper.GetAccount(app.BaseApp.NewContext(true), payer)
+	require.NotNil(t, acc)
+
+	const gasLimit = 1_000_000
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+	msg := banktypes.NewMsgSend(payer.String(), recipient.String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)))
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		txConfig,
+		[]sdk.Msg{msg},
+		fee,
+		gasLimit,
+		app.BaseApp.ChainID(),
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		priv,
+	)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := app.BaseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: app.BaseApp.LastBlockHeight() + 1,
+		Txs:    [][]byte{txBytes},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	txResult := res.TxResults[0]
+	require.Equal(t, uint32(0), txResult.Code, txResult.Log)
+	_, err = app.BaseApp.Commit()
+	require.NoError(t, err)
+
+	// half of the fees paid for the unused gas are refunded
+	gasUsed := sdkmath.NewInt(txResult.GasUsed)
+	require.True(t, gasUsed.LT(sdkmath.NewInt(gasLimit)))
+	refund := fee.AmountOf(sdk.DefaultBondDenom).Mul(sdkmath.NewInt(gasLimit).Sub(gasUsed)).QuoRaw(2 * gasLimit)
+	require.True(t, refund.IsPositive())
+
+	expected := sdkmath.NewInt(1_000_000 - 1_000 - 100_000).Add(refund)
+	require.Equal(t, expected, bankKeeper.GetBalance(app.BaseApp.NewContext(true), payer, sdk.DefaultBondDenom).Amount)
+
+	var found bool
+	for _, event := range txResult.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyFeeRefund {
+				require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, refund).String(), attr.Value)
+				found = true
+			}
+		}
+	}
+	require.True(t, found, "refund event not emitted")
+}
@@ -799,10 +799,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:                       communityTax,
+					BaseProposerReward:                 math.LegacyZeroDec(),
+					BonusProposerReward:                math.LegacyZeroDec(),
+					WithdrawAddrEnabled:                withdrawAddrEnabled,
+					AutoCompoundEpochIdentifier:        distrtypes.DefaultAutoCompoundEpochIdentifier,
+					AutoCompoundMaxDelegationsPerBlock: distrtypes.DefaultAutoCompoundMaxDelegationsPerBlock,
 				},
 			},
 			expErr: false,
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/golang/mock/gomock"
 	"gotest.tools/v3/assert"
 
@@ -153,7 +155,8 @@ func initFixture(tb testing.TB) *fixture {
 
 	evidenceKeeper := keeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(grpcQueryRouter), runtime.EnvWithMsgRouterService(msgRouter)), stakingKeeper, slashingKeeper, addresscodec.NewBech32Codec(sdk.Bech32PrefixAccAddr))
 	router := evidencetypes.NewRouter()
-	router = router.AddRoute(evidencetypes.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
+	router = router.AddRoute(evidencetypes.RouteEquivocation, testEquivocationHandler(evidenceKeeper)).
+		AddRoute(evidencetypes.RouteVoteExtensionEquivocation, evidenceKeeper.VoteExtensionEquivocationHandler())
 	evidenceKeeper.SetRouter(router)
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts)
@@ -470,6 +473,104 @@ func TestHandleDoubleSignAfterRotation(t *testing.T) {
 	assert.Assert(t, len(evidences) == 1)
 }
 
+func TestHandleVoteExtensionEquivocation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithHeaderInfo(header.Info{Height: 10, ChainID: "test-chain", Time: time.Now()})
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, valPrivKey := valAddresses[0], ed25519.GenPrivKey()
+	valpubkey := valPrivKey.PubKey()
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(operatorAddr)))
+	tstaking.CreateValidatorWithValPower(operatorAddr, valpubkey, power, true)
+
+	// execute end-blocker and set the signing info
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	consAddrStr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(valpubkey.Address())
+	assert.NilError(t, err)
+	info := slashingtypes.NewValidatorSigningInfo(consAddrStr, ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(valpubkey.Address()), info))
+
+	val, err := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	oldTokens := val.GetTokens()
+
+	sign := func(signBytes []byte, err error) []byte {
+		assert.NilError(t, err)
+		sig, err := valPrivKey.Sign(signBytes)
+		assert.NilError(t, err)
+		return sig
+	}
+	blockID := func(block string) cmtproto.BlockID {
+		return cmtproto.BlockID{Hash: tmhash.Sum([]byte(block)), PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block))}}
+	}
+	precommitTime := time.Now().UTC()
+
+	// a precommit carrying two conflicting vote extensions
+	evidence := &evidencetypes.VoteExtensionEquivocation{
+		Height:              9,
+		Round:               0,
+		ConsensusAddress:    consAddrStr,
+		BlockID:             blockID("block"),
+		Timestamp:           precommitTime,
+		Signature:           sign(evidencetypes.PrecommitSignBytes("test-chain", 9, 0, blockID("other block"), precommitTime)),
+		VoteExtensionA:      []byte("extension a"),
+		ExtensionSignatureA: sign(evidencetypes.VoteExtensionSignBytes("test-chain", 9, 0, []byte("extension a"))),
+		VoteExtensionB:      []byte("extension b"),
+		ExtensionSignatureB: sign(evidencetypes.VoteExtensionSignBytes("test-chain", 9, 0, []byte("extension a"))),
+	}
+
+	// the precommit signature does not match the block
+	err = f.evidenceKeeper.SubmitEvidence(ctx, evidence)
+	assert.ErrorContains(t, err, "failed to verify precommit signature")
+
+	// the second vote extension signature does not match the second vote extension
+	evidence.Signature = sign(evidencetypes.PrecommitSignBytes("test-chain", 9, 0, blockID("block"), precommitTime))
+	err = f.evidenceKeeper.SubmitEvidence(ctx, evidence)
+	assert.ErrorContains(t, err, "failed to verify vote extension signature")
+
+	// the vote extensions are not signed in the future
+	evidence.ExtensionSignatureB = sign(evidencetypes.VoteExtensionSignBytes("test-chain", 9, 0, []byte("extension b")))
+	err = f.evidenceKeeper.SubmitEvidence(ctx.WithHeaderInfo(header.Info{Height: 9, ChainID: "test-chain"}), evidence)
+	assert.ErrorContains(t, err, "must be lower than the current height")
+
+	assert.NilError(t, f.evidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// should be jailed and tombstoned
+	val, err = f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, val.IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(valpubkey.Address())))
+
+	// tokens should be decreased
+	assert.Assert(t, val.GetTokens().LT(oldTokens))
+
+	// the same equivocation with swapped vote extensions is rejected
+	swapped := &evidencetypes.VoteExtensionEquivocation{
+		Height:              evidence.Height,
+		Round:               evidence.Round,
+		ConsensusAddress:    evidence.ConsensusAddress,
+		BlockID:             evidence.BlockID,
+		Timestamp:           evidence.Timestamp,
+		Signature:           evidence.Signature,
+		VoteExtensionA:      evidence.VoteExtensionB,
+		ExtensionSignatureA: evidence.ExtensionSignatureB,
+		VoteExtensionB:      evidence.VoteExtensionA,
+		ExtensionSignatureB: evidence.ExtensionSignatureA,
+	}
+	err = f.evidenceKeeper.SubmitEvidence(ctx, swapped)
+	assert.ErrorContains(t, err, "already tombstoned")
+
+	// query evidence from store
+	_, err = f.evidenceKeeper.Evidences.Get(ctx, evidence.Hash())
+	assert.NilError(t, err)
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
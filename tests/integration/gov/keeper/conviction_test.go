@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+)
+
+func TestSlashRedelegationIntoConvictionLockedDelegation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.ctx
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.govKeeper.ConvictionHooks()))
+
+	params := v1.DefaultParams()
+	params.ConvictionPeriods = []v1.ConvictionPeriod{{Duration: time.Hour, Multiplier: "2"}}
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	addrs, valAddrs := createValidators(t, f, []int64{5, 5, 5})
+	delAddr, srcAddr, dstAddr := addrs[3], valAddrs[0], valAddrs[1]
+
+	// delegate to the source validator, then redelegate everything to the destination one
+	srcValidator, err := f.stakingKeeper.GetValidator(ctx, srcAddr)
+	assert.NilError(t, err)
+	shares, err := f.stakingKeeper.Delegate(ctx, delAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 5), stakingtypes.Unbonded, srcValidator, true)
+	assert.NilError(t, err)
+	_, err = f.stakingKeeper.BeginRedelegation(ctx, delAddr, srcAddr, dstAddr, shares)
+	assert.NilError(t, err)
+
+	// lock the redelegated shares
+	lock, err := f.govKeeper.LockConviction(ctx, delAddr, dstAddr, time.Hour)
+	assert.NilError(t, err)
+	assert.Equal(t, shares.String(), lock.Shares)
+
+	// slashing the source validator for an infraction committed before the redelegation
+	// unbonds from the locked delegation, which must not fail
+	consAddr, err := srcValidator.GetConsAddr()
+	assert.NilError(t, err)
+	header := ctx.HeaderInfo()
+	header.Height++
+	power := f.stakingKeeper.TokensToConsensusPower(ctx, srcValidator.GetTokens())
+	_, err = f.stakingKeeper.Slash(ctx.WithHeaderInfo(header), consAddr, ctx.HeaderInfo().Height, power, math.LegacyNewDecWithPrec(5, 1))
+	assert.NilError(t, err)
+
+	// the locked shares are reduced to the remaining shares of the delegation
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, dstAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, delegation.Shares.LT(shares))
+	lock, err = f.govKeeper.ConvictionLocks.Get(ctx, collections.Join(delAddr, dstAddr))
+	assert.NilError(t, err)
+	assert.Equal(t, delegation.Shares.String(), lock.Shares)
+
+	// the delegator still cannot undelegate the locked shares
+	_, _, err = f.stakingKeeper.Undelegate(ctx, delAddr, dstAddr, delegation.Shares)
+	assert.ErrorContains(t, err, "locked")
+}
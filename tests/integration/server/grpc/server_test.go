@@ -105,6 +105,38 @@ func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_QueryProofs() {
+	val0 := s.network.GetValidators()[0]
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	denom := fmt.Sprintf("%stoken", val0.GetMoniker())
+	bankClient := banktypes.NewQueryClient(s.conn)
+	var header metadata.MD
+	_, err := bankClient.Balance(
+		client.WithQueryProofs(context.Background()),
+		&banktypes.QueryBalanceRequest{Address: val0.GetAddress().String(), Denom: denom},
+		grpc.Header(&header),
+	)
+	s.Require().NoError(err)
+
+	proofs, err := client.QueryProofsFromHeader(header)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(proofs)
+	s.Require().Equal(banktypes.StoreKey, proofs[0].StoreName)
+
+	// the app hash of the next block commits to the queried state
+	s.Require().NoError(s.network.WaitForNextBlock())
+	nextHeight := proofs[0].Height + 1
+	block, err := val0.GetClientCtx().Client.Block(context.Background(), &nextHeight)
+	s.Require().NoError(err)
+	trusted := *block.Block.Header.ToProto()
+	s.Require().NoError(client.VerifyQueryProofs(trusted, proofs))
+
+	// a tampered value must not verify
+	proofs[0].Value = []byte("tampered")
+	s.Require().Error(client.VerifyQueryProofs(trusted, proofs))
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	// Test server reflection
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -131,7 +131,7 @@ func initFixture(tb testing.TB) *fixture {
 		minttypes.ModuleName:    {authtypes.Minter},
 		types.ModuleName:        {authtypes.Minter},
 		types.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		types.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		types.NotBondedPoolName: {authtypes.Burner, authtypes.Minter, authtypes.Staking},
 	}
 
 	// gomock initializations
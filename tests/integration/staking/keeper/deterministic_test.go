@@ -472,7 +472,9 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 14637, false)
+	// the params are read 3 times, the 88 bytes of the liquid staking, instant
+	// unbonding and commission change params costing 264 gas (3 gas per byte) per read
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.ValidatorDelegations, 15429, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -562,7 +564,8 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4689, false)
+	// the params are read once, see TestGRPCValidatorDelegations
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Delegation, 4953, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -645,7 +648,8 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4292, false)
+	// the params are read once, see TestGRPCValidatorDelegations
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DelegatorDelegations, 4556, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -821,7 +825,8 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(t, f)
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6296, false)
+	// the params are read once, see TestGRPCValidatorDelegations
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6560, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -929,5 +934,5 @@ func TestGRPCParams(t *testing.T) {
 	err := f.stakingKeeper.Params.Set(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1162, false)
+	testdata.DeterministicIterations(t, f.ctx, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1213, false)
 }
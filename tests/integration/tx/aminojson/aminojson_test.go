@@ -344,8 +344,12 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			pulsar: &gov_v1_api.MsgSubmitProposal{},
 		},
 		"slashing/params/empty_dec": {
-			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
-			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}},
+			gogo: &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
+			pulsar: &slashingapi.Params{
+				DowntimeJailDuration:    &durationpb.Duration{Seconds: 1, Nanos: 7},
+				DowntimeOffenseWindow:   &durationpb.Duration{},
+				MaxDowntimeJailDuration: &durationpb.Duration{},
+			},
 		},
 		// This test cases demonstrates the expected contract and proper way to set a cosmos.Dec field represented
 		// as bytes in protobuf message, namely:
@@ -356,8 +360,10 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 				MinSignedPerWindow:   math.LegacyNewDec(10),
 			},
 			pulsar: &slashingapi.Params{
-				DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7},
-				MinSignedPerWindow:   dec10bz,
+				DowntimeJailDuration:    &durationpb.Duration{Seconds: 1, Nanos: 7},
+				MinSignedPerWindow:      dec10bz,
+				DowntimeOffenseWindow:   &durationpb.Duration{},
+				MaxDowntimeJailDuration: &durationpb.Duration{},
 			},
 		},
 		"staking/msg_update_params": {
@@ -369,8 +375,9 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			},
 			pulsar: &stakingapi.MsgUpdateParams{
 				Params: &stakingapi.Params{
-					UnbondingTime:  &durationpb.Duration{Seconds: 0},
-					KeyRotationFee: &v1beta1.Coin{},
+					UnbondingTime:                &durationpb.Duration{Seconds: 0},
+					KeyRotationFee:               &v1beta1.Coin{},
+					CommissionChangeNoticePeriod: &durationpb.Duration{},
 				},
 			},
 		},
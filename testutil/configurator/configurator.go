@@ -162,7 +162,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.DistributionModuleName},
 					{Account: testutil.MintModuleName, Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
-					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
+					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "minter", testutil.StakingModuleName}},
 					{Account: testutil.GovModuleName, Permissions: []string{"burner"}},
 					{Account: testutil.NFTModuleName},
 					{Account: testutil.ProtocolPoolModuleName},
@@ -259,6 +259,8 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyFeeRefund       = "fee_refund"
+	AttributeKeyFeeRefundee     = "fee_refundee"
 
 	EventTypeMessage = "message"
 
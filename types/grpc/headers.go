@@ -3,4 +3,13 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCQueryProveHeader is the gRPC header requesting the merkle proofs of
+	// the keys read by a query, its value must be "true".
+	GRPCQueryProveHeader = "x-cosmos-query-prove"
+
+	// GRPCQueryProofHeader is the gRPC response header holding the merkle
+	// proofs of the keys read by a query, one encoded abci.QueryResponse per
+	// value.
+	GRPCQueryProofHeader = "x-cosmos-query-proof-bin"
 )
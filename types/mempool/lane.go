@@ -0,0 +1,164 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LanedMempool)(nil)
+	_ Iterator = (*lanedIterator)(nil)
+)
+
+// ErrNoLane is returned when a transaction matches none of the lanes of a
+// LanedMempool.
+var ErrNoLane = errors.New("tx does not match any lane")
+
+// TxMatchFn reports whether a transaction belongs to a lane. It must be
+// deterministic, as it is used to verify proposals.
+type TxMatchFn func(ctx context.Context, tx sdk.Tx) bool
+
+// MatchAll is a TxMatchFn matching every transaction, typically used by the
+// last, default, lane.
+func MatchAll(context.Context, sdk.Tx) bool { return true }
+
+// Lane is a partition of the block space holding the transactions matching a
+// predicate in its own mempool.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+	// Mempool holds the transactions of the lane.
+	Mempool Mempool
+	// Match reports whether a transaction belongs to the lane.
+	Match TxMatchFn
+	// MaxBlockSpace is the fraction, in (0, 1], of the max tx bytes and max
+	// block gas of a block the transactions of the lane may use.
+	MaxBlockSpace math.LegacyDec
+}
+
+// LanedMempool is a mempool made of ordered lanes. A transaction is inserted
+// into the first lane it matches, and proposals hold the transactions of each
+// lane in the lanes order.
+type LanedMempool struct {
+	lanes []Lane
+}
+
+// NewLanedMempool creates a new LanedMempool from the given lanes, in order of
+// precedence.
+func NewLanedMempool(lanes ...Lane) (*LanedMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil || lane.Match == nil {
+			return nil, fmt.Errorf("lane %s must have a mempool and a match function", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be in (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LanedMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order of precedence.
+func (mp *LanedMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the first lane the transaction matches.
+func (mp *LanedMempool) LaneIndex(ctx context.Context, tx sdk.Tx) (int, error) {
+	for i, lane := range mp.lanes {
+		if lane.Match(ctx, tx) {
+			return i, nil
+		}
+	}
+
+	return -1, ErrNoLane
+}
+
+// Insert inserts the transaction into the first lane it matches.
+func (mp *LanedMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i, err := mp.LaneIndex(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of every lane, in the lanes
+// order.
+func (mp *LanedMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return newLanedIterator(ctx, mp.lanes, txs)
+}
+
+// CountTx returns the number of transactions of every lane.
+func (mp *LanedMempool) CountTx() int {
+	var n int
+	for _, lane := range mp.lanes {
+		n += lane.Mempool.CountTx()
+	}
+
+	return n
+}
+
+// Remove removes the transaction from the lane holding it.
+func (mp *LanedMempool) Remove(tx sdk.Tx) error {
+	for _, lane := range mp.lanes {
+		err := lane.Mempool.Remove(tx)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return ErrTxNotFound
+}
+
+// lanedIterator chains the iterators of lanes.
+type lanedIterator struct {
+	ctx   context.Context
+	lanes []Lane
+	txs   [][]byte
+	iter  Iterator
+}
+
+func newLanedIterator(ctx context.Context, lanes []Lane, txs [][]byte) Iterator {
+	for i, lane := range lanes {
+		if iter := lane.Mempool.Select(ctx, txs); iter != nil {
+			return &lanedIterator{ctx: ctx, lanes: lanes[i+1:], txs: txs, iter: iter}
+		}
+	}
+
+	return nil
+}
+
+func (i *lanedIterator) Next() Iterator {
+	if i.iter = i.iter.Next(); i.iter != nil {
+		return i
+	}
+
+	return newLanedIterator(i.ctx, i.lanes, i.txs)
+}
+
+func (i *lanedIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
@@ -0,0 +1,64 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLanedMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+
+	// odd ids go to the first lane
+	isOdd := func(_ context.Context, tx sdk.Tx) bool { return tx.(testTx).id%2 == 1 }
+	lanes := []mempool.Lane{
+		{Name: "odd", Mempool: mempool.DefaultPriorityMempool(), Match: isOdd, MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1)},
+		{Name: "default", Mempool: mempool.DefaultPriorityMempool(), Match: mempool.MatchAll, MaxBlockSpace: math.LegacyOneDec()},
+	}
+
+	_, err := mempool.NewLanedMempool()
+	require.Error(t, err)
+	_, err = mempool.NewLanedMempool(lanes[0], lanes[0])
+	require.ErrorContains(t, err, "duplicate lane")
+	_, err = mempool.NewLanedMempool(mempool.Lane{Name: "none", Mempool: mempool.NoOpMempool{}, Match: mempool.MatchAll, MaxBlockSpace: math.LegacyZeroDec()})
+	require.ErrorContains(t, err, "max block space")
+
+	mp, err := mempool.NewLanedMempool(lanes...)
+	require.NoError(t, err)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	txs := make([]testTx, len(accounts))
+	for i, acc := range accounts {
+		txs[i] = testTx{id: i, priority: int64(i), address: acc.Address}
+		require.NoError(t, mp.Insert(ctx.WithPriority(txs[i].priority), txs[i]))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, lanes[0].Mempool.CountTx())
+
+	idx, err := mp.LaneIndex(ctx, txs[1])
+	require.NoError(t, err)
+	require.Equal(t, 0, idx)
+
+	// the lanes are iterated in order, each one by priority
+	selected := fetchTxs(mp.Select(ctx, nil), 10)
+	require.Equal(t, []sdk.Tx{txs[3], txs[1], txs[2], txs[0]}, selected)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{txs[3], txs[0]}, fetchTxs(mp.Select(ctx, nil), 10))
+
+	noLane, err := mempool.NewLanedMempool(lanes[0])
+	require.NoError(t, err)
+	require.ErrorIs(t, noLane.Insert(ctx, txs[0]), mempool.ErrNoLane)
+}
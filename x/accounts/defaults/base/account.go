@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -33,49 +34,99 @@ var (
 
 func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
 	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		pubKey := collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec))
 		return name, Account{
-			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
-			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
-			addrCodec:       deps.AddressCodec,
-			signingHandlers: handlerMap,
-			hs:              deps.Environment.HeaderService,
+			Authenticator: NewAuthenticator(deps, handlerMap, secp256k1PubKey{item: pubKey}),
+			PubKey:        pubKey,
 		}, nil
 	}
 }
 
 // Account implements a base account.
 type Account struct {
-	PubKey   collections.Item[secp256k1.PubKey]
+	Authenticator
+	PubKey collections.Item[secp256k1.PubKey]
+}
+
+// PubKeyHandler stores the public key of an account and verifies the
+// signatures made with it.
+type PubKeyHandler interface {
+	// SetPubKey validates and stores the given public key bytes.
+	SetPubKey(ctx context.Context, key []byte) error
+	// GetPubKey returns the stored public key.
+	GetPubKey(ctx context.Context) (cryptotypes.PubKey, error)
+	// VerifySignature verifies the signature of the sign bytes by the public key.
+	VerifySignature(pubKey cryptotypes.PubKey, signBytes, signature []byte) error
+}
+
+// secp256k1PubKey is the PubKeyHandler of the base account.
+type secp256k1PubKey struct {
+	item collections.Item[secp256k1.PubKey]
+}
+
+func (h secp256k1PubKey) SetPubKey(ctx context.Context, key []byte) error {
+	_, err := dcrd_secp256k1.ParsePubKey(key)
+	if err != nil {
+		return err
+	}
+	return h.item.Set(ctx, secp256k1.PubKey{Key: key})
+}
+
+func (h secp256k1PubKey) GetPubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	pk, err := h.item.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pk, nil
+}
+
+func (h secp256k1PubKey) VerifySignature(pubKey cryptotypes.PubKey, signBytes, signature []byte) error {
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// Authenticator implements the messages of the base account: the public key,
+// sequence and signature based authentication of an account. The public key is
+// stored and verified by a PubKeyHandler, which lets other accounts embed it
+// with a different kind of key.
+type Authenticator struct {
 	Sequence collections.Sequence
 
+	pubKey    PubKeyHandler
 	addrCodec address.Codec
 	hs        header.Service
 
 	signingHandlers *signing.HandlerMap
 }
 
-func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
-	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+// NewAuthenticator returns the Authenticator of an account, whose public key
+// is handled by pubKey.
+func NewAuthenticator(deps accountstd.Dependencies, handlerMap *signing.HandlerMap, pubKey PubKeyHandler) Authenticator {
+	return Authenticator{
+		Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+		pubKey:          pubKey,
+		addrCodec:       deps.AddressCodec,
+		hs:              deps.Environment.HeaderService,
+		signingHandlers: handlerMap,
+	}
+}
+
+func (a Authenticator) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	return &v1.MsgInitResponse{}, a.pubKey.SetPubKey(ctx, msg.PubKey)
 }
 
-func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+func (a Authenticator) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
 	if !accountstd.SenderIsSelf(ctx) {
 		return nil, errors.New("unauthorized")
 	}
 
-	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
-}
-
-func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
-	_, err := dcrd_secp256k1.ParsePubKey(key)
-	if err != nil {
-		return err
-	}
-	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+	return &v1.MsgSwapPubKeyResponse{}, a.pubKey.SetPubKey(ctx, msg.NewPubKey)
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
-func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+func (a Authenticator) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
 	if !accountstd.SenderIsAccountsModule(ctx) {
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
@@ -107,8 +158,8 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, err
 	}
 
-	if !pubKey.VerifySignature(signBytes, signature) {
-		return nil, errors.New("signature verification failed")
+	if err := a.pubKey.VerifySignature(pubKey, signBytes, signature); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %w", err)
 	}
 
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
@@ -123,31 +174,31 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 }
 
 // computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+func (a Authenticator) computeSignerData(ctx context.Context) (cryptotypes.PubKey, signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pk, err := a.PubKey.Get(ctx)
+	pk, err := a.pubKey.GetPubKey(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	pkAny, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	return pk, signing.SignerData{
@@ -162,7 +213,7 @@ func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signi
 	}, nil
 }
 
-func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+func (a Authenticator) getNumber(ctx context.Context, addrStr string) (uint64, error) {
 	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
 	if err != nil {
 		return 0, err
@@ -171,7 +222,7 @@ func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error)
 	return accNum.Number, nil
 }
 
-func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+func (a Authenticator) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
 	// TODO: add a faster way to do this, we can avoid unmarshalling but we need
 	// to write a function that converts this into the protov2 counterparty.
 	txBody := new(txv1beta1.TxBody)
@@ -195,7 +246,7 @@ func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData
 	}, nil
 }
 
-func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+func (a Authenticator) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
 	seq, err := a.Sequence.Peek(ctx)
 	if err != nil {
 		return nil, err
@@ -203,15 +254,15 @@ func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.Qu
 	return &v1.QuerySequenceResponse{Sequence: seq}, nil
 }
 
-func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+func (a Authenticator) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, a.Init)
 }
 
-func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+func (a Authenticator) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
 }
 
-func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+func (a Authenticator) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 }
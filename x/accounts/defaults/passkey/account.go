@@ -0,0 +1,78 @@
+package passkey
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var PASSKEY_ACCOUNT = "passkey-account"
+
+var (
+	PubKeyPrefix   = collections.NewPrefix(0)
+	SequencePrefix = base.SequencePrefix
+)
+
+// NewAccount returns the creator of passkey accounts whose assertions are
+// requested by the relying party rp.
+func NewAccount(name string, handlerMap *signing.HandlerMap, rp secp256r1.WebAuthnRelyingParty) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		if rp.ID == "" || len(rp.Origins) == 0 {
+			return "", nil, errors.New("the passkey account requires a relying party ID and origins")
+		}
+
+		pubKey := collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256r1.PubKey](deps.LegacyStateCodec))
+		return name, Account{
+			Authenticator: base.NewAuthenticator(deps, handlerMap, webAuthnPubKey{item: pubKey, rp: rp}),
+			PubKey:        pubKey,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by a passkey. Its txs are signed
+// with WebAuthn assertions, whose challenge is the hash of the sign bytes, see
+// secp256r1.WebAuthnAssertion. It uses the messages of the base account, with
+// the compressed secp256r1 public key of the passkey as public key.
+type Account struct {
+	base.Authenticator
+	PubKey collections.Item[secp256r1.PubKey]
+}
+
+// webAuthnPubKey is the base.PubKeyHandler of the passkey account, which
+// verifies the WebAuthn assertions requested by the relying party rp.
+type webAuthnPubKey struct {
+	item collections.Item[secp256r1.PubKey]
+	rp   secp256r1.WebAuthnRelyingParty
+}
+
+func (h webAuthnPubKey) SetPubKey(ctx context.Context, key []byte) error {
+	pk, err := secp256r1.NewPubKey(key)
+	if err != nil {
+		return err
+	}
+	return h.item.Set(ctx, *pk)
+}
+
+func (h webAuthnPubKey) GetPubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	pk, err := h.item.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pk, nil
+}
+
+func (h webAuthnPubKey) VerifySignature(pubKey cryptotypes.PubKey, signBytes, signature []byte) error {
+	pk, ok := pubKey.(*secp256r1.PubKey)
+	if !ok {
+		return errors.New("not a secp256r1 public key")
+	}
+	return pk.VerifyWebAuthnAssertion(h.rp, signBytes, signature)
+}
@@ -0,0 +1,268 @@
+package passkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+const (
+	testChainID       = "test-chain"
+	testAccountNumber = 7
+)
+
+var (
+	testAccountAddr  = []byte("mock_passkey_account")
+	testRelyingParty = secp256r1.WebAuthnRelyingParty{
+		ID:      "localhost",
+		Origins: []string{"http://localhost"},
+	}
+)
+
+// mock address codec
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{ChainID: testChainID}
+}
+
+func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		0, testAccountAddr, []byte("sender"), nil, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			_, ok := req.(*accountsv1.AccountNumberRequest)
+			require.True(t, ok)
+			gogoproto.Merge(resp.(gogoproto.Message), &accountsv1.AccountNumberResponse{Number: testAccountNumber})
+			return nil
+		},
+	)
+}
+
+func setup(t *testing.T, ctx context.Context, ss store.KVStoreService, sk *ecdsa.PrivateKey) Account {
+	t.Helper()
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		Environment: appmodule.Environment{
+			HeaderService: headerService{},
+		},
+	}
+
+	_, acc, err := NewAccount(PASSKEY_ACCOUNT, signing.NewHandlerMap(direct.SignModeHandler{}), testRelyingParty)(deps)
+	require.NoError(t, err)
+	account := acc.(Account)
+
+	_, err = account.Init(ctx, &v1.MsgInit{PubKey: elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y)})
+	require.NoError(t, err)
+	return account
+}
+
+// webAuthnAssertion returns the JSON encoded assertion of the challenge, in the
+// format of a browser and of a platform authenticator.
+func webAuthnAssertion(t *testing.T, sk *ecdsa.PrivateKey, rpID, origin string, challenge []byte) []byte {
+	t.Helper()
+	// RP ID hash || flags (user present and verified) || signature counter
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authenticatorData := binary.BigEndian.AppendUint32(append(rpIDHash[:], 0x05), 1)
+	clientDataJSON := []byte(fmt.Sprintf(`{"type":"webauthn.get","challenge":%q,"origin":%q,"crossOrigin":false}`,
+		base64.RawURLEncoding.EncodeToString(challenge), origin))
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authenticatorData, clientDataHash[:]...))
+	derSignature, err := ecdsa.SignASN1(rand.Reader, sk, digest[:])
+	require.NoError(t, err)
+
+	assertion, err := secp256r1.NewWebAuthnAssertion(authenticatorData, clientDataJSON, derSignature)
+	require.NoError(t, err)
+	assertionJSON, err := json.Marshal(assertion)
+	require.NoError(t, err)
+	return assertionJSON
+}
+
+// newMsgAuthenticate returns the MsgAuthenticate of a tx signed by the passkey
+// sk with the given sequence, in an assertion requested by rpID from origin.
+func newMsgAuthenticate(t *testing.T, ctx context.Context, sk *ecdsa.PrivateKey, sequence uint64, rpID, origin string) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	body := &tx.TxBody{Memo: "passkey"}
+	authInfo := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT}}},
+			Sequence: sequence,
+		}},
+		Fee: &tx.Fee{GasLimit: 100_000},
+	}
+	bodyBytes, err := gogoproto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBytes, err := gogoproto.Marshal(authInfo)
+	require.NoError(t, err)
+
+	signBytes, err := direct.SignModeHandler{}.GetSignBytes(ctx, signing.SignerData{
+		ChainID:       testChainID,
+		AccountNumber: testAccountNumber,
+		Sequence:      sequence,
+	}, signing.TxData{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes})
+	require.NoError(t, err)
+
+	signature := webAuthnAssertion(t, sk, rpID, origin, secp256r1.WebAuthnChallenge(signBytes))
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{signature}},
+		Tx:    &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: [][]byte{signature}},
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherSk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		sk       *ecdsa.PrivateKey
+		sequence uint64
+		rpID     string
+		origin   string
+		expErr   string
+	}{
+		{
+			name:   "valid assertion",
+			sk:     sk,
+			rpID:   testRelyingParty.ID,
+			origin: "http://localhost",
+		},
+		{
+			name:   "signed by another passkey",
+			sk:     otherSk,
+			rpID:   testRelyingParty.ID,
+			origin: "http://localhost",
+			expErr: "invalid WebAuthn signature",
+		},
+		{
+			name:     "unexpected sequence",
+			sk:       sk,
+			sequence: 1,
+			rpID:     testRelyingParty.ID,
+			origin:   "http://localhost",
+			expErr:   "unexpected sequence number",
+		},
+		{
+			name:   "assertion requested from another origin",
+			sk:     sk,
+			rpID:   testRelyingParty.ID,
+			origin: "https://evil.example.com",
+			expErr: "unexpected WebAuthn origin",
+		},
+		{
+			name:   "credential of another relying party",
+			sk:     sk,
+			rpID:   "evil.example.com",
+			origin: "http://localhost",
+			expErr: "WebAuthn RP ID hash mismatch",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setup(t, ctx, ss, sk)
+
+			msg := newMsgAuthenticate(t, ctx, tc.sk, tc.sequence, tc.rpID, tc.origin)
+			_, err := acc.Authenticate(ctx, msg)
+			require.ErrorContains(t, err, "unauthorized")
+
+			ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+			_, err = acc.Authenticate(ctx, msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the sequence is incremented, so the tx cannot be replayed
+			seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), seq.Sequence)
+			_, err = acc.Authenticate(ctx, msg)
+			require.ErrorContains(t, err, "unexpected sequence number")
+		})
+	}
+}
+
+func TestNewAccountRequiresRelyingParty(t *testing.T) {
+	_, ss := newMockContext(t)
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+
+	_, _, err := NewAccount(PASSKEY_ACCOUNT, signing.NewHandlerMap(direct.SignModeHandler{}), secp256r1.WebAuthnRelyingParty{ID: "localhost"})(deps)
+	require.Error(t, err)
+}
+
+func TestSwapPubKey(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newSk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ctx, ss, sk)
+	newPubKey := elliptic.MarshalCompressed(elliptic.P256(), newSk.X, newSk.Y)
+
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "unauthorized")
+
+	ctx = accountstd.SetSender(ctx, testAccountAddr)
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: []byte("not a key")})
+	require.Error(t, err)
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	_, err = acc.Authenticate(ctx, newMsgAuthenticate(t, ctx, sk, 0, testRelyingParty.ID, "http://localhost"))
+	require.ErrorContains(t, err, "invalid WebAuthn signature")
+	_, err = acc.Authenticate(ctx, newMsgAuthenticate(t, ctx, newSk, 1, testRelyingParty.ID, "http://localhost"))
+	require.NoError(t, err)
+}
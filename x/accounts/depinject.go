@@ -13,10 +13,12 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/passkey"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -34,6 +36,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	Cdc          codec.Codec
 	Environment  appmodule.Environment
 	AddressCodec address.Codec
@@ -64,15 +67,23 @@ func (s directHandler) GetSignBytes(_ context.Context, _ signing.SignerData, _ s
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	handler := directHandler{}
-	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
-	accountskeeper, err := NewKeeper(
-		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
+	handlerMap := signing.NewHandlerMap(handler)
+	account := baseaccount.NewAccount("base", handlerMap)
+	accounts := []accountstd.AccountCreatorFunc{
+		account,
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		accountstd.AddAccount(multisig.MULTISIG_ACCOUNT, multisig.NewAccount),
-	)
+	}
+	if in.Config.PasskeyRpId != "" {
+		accounts = append(accounts, passkey.NewAccount(passkey.PASSKEY_ACCOUNT, handlerMap, secp256r1.WebAuthnRelyingParty{
+			ID:      in.Config.PasskeyRpId,
+			Origins: in.Config.PasskeyOrigins,
+		}))
+	}
+	accountskeeper, err := NewKeeper(in.Cdc, in.Environment, in.AddressCodec, in.Registry, accounts...)
 	if err != nil {
 		panic(err)
 	}
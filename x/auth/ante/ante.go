@@ -23,6 +23,8 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// NonceLaneKeeper enables nonce lanes when set, see NonceLaneDecorator.
+	NonceLaneKeeper NonceLaneKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -41,6 +43,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	sigVerificationDecorator := NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper)
+	if options.NonceLaneKeeper != nil {
+		sigVerificationDecorator = sigVerificationDecorator.WithNonceLanes()
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(options.Environment), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -50,7 +57,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		sigVerificationDecorator,
+	}
+	if options.NonceLaneKeeper != nil {
+		anteDecorators = append(anteDecorators, NewNonceLaneDecorator(options.NonceLaneKeeper))
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
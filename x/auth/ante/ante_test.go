@@ -1311,6 +1311,32 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	}
 }
 
+func TestAnteHandlerEd25519Account(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv, _, addr := testdata.KeyTestPubAddrED25519()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	tc := TestCase{desc: "ed25519 account", expPass: true}
+	suite.RunTestCase(t, tc, TestCaseArgs{
+		chainID:   suite.ctx.ChainID(),
+		accNums:   []uint64{1000},
+		accSeqs:   []uint64{0},
+		feeAmount: testdata.NewTestFeeAmount(),
+		gasLimit:  testdata.NewTestGasLimit(),
+		msgs:      []sdk.Msg{testdata.NewTestMsg(addr)},
+		privs:     []cryptotypes.PrivKey{priv},
+	})
+
+	// the public key of the account is set
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.Equal(t, priv.PubKey(), acc.GetPubKey())
+}
+
 func TestAnteHandlerReCheck(t *testing.T) {
 	suite := SetupTestSuite(t, false)
 	// Set recheck=true
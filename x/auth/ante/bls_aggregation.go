@@ -0,0 +1,103 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// IsAggregatedBLSSignature returns true if the given tx signatures are an
+// aggregated BLS signature: the signature of the first signer is the
+// aggregation of the bls12_381 signatures of all the signers, whose own
+// signatures are empty. Such signatures are verified at once by the
+// SigVerificationDecorator, which is cheaper than verifying each of them.
+func IsAggregatedBLSSignature(sigs []signing.SignatureV2) bool {
+	if len(sigs) < 2 {
+		return false
+	}
+
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return false
+		}
+
+		if i == 0 && len(data.Signature) != bls12_381.SignatureLength {
+			return false
+		}
+
+		if i > 0 && len(data.Signature) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// blsAggregate collects the public keys and sign bytes of the signers of a tx
+// with an aggregated BLS signature, to verify it once all were collected.
+type blsAggregate struct {
+	signature []byte
+	signers   uint64
+	pubKeys   []bls12_381.PubKey
+	msgs      [][]byte
+}
+
+func newBLSAggregate(sigs []signing.SignatureV2) *blsAggregate {
+	return &blsAggregate{
+		signature: sigs[0].Data.(*signing.SingleSignatureData).Signature,
+		signers:   uint64(len(sigs)),
+	}
+}
+
+// add collects the public key and the sign bytes of a signer.
+func (agg *blsAggregate) add(
+	ctx sdk.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	blsPubKey, ok := pubKey.(*bls12_381.PubKey)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "aggregated BLS signature signer has a %T public key", pubKey)
+	}
+
+	// IsAggregatedBLSSignature ensures the signature data is a single one
+	signBytes, err := authsigning.GetSingleSignBytes(ctx, signerData, sigData.(*signing.SingleSignatureData), handler, txData)
+	if err != nil {
+		return err
+	}
+
+	agg.pubKeys = append(agg.pubKeys, *blsPubKey)
+	agg.msgs = append(agg.msgs, signBytes)
+	return nil
+}
+
+// verifyAggregatedSig consumes the gas of the verification of the aggregated
+// BLS signature of a tx, and verifies it against the collected signers.
+func (svd SigVerificationDecorator) verifyAggregatedSig(ctx sdk.Context, agg *blsAggregate) error {
+	params := svd.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostBls12381Aggregated(agg.signers), "ante verify: bls12_381 aggregated")
+
+	if svd.skipSigVerification(ctx) {
+		return nil
+	}
+
+	if uint64(len(agg.pubKeys)) != agg.signers {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "aggregated BLS signature: expected %d signers, got %d", agg.signers, len(agg.pubKeys))
+	}
+
+	if !bls12_381.VerifyAggregateSignature(agg.pubKeys, agg.msgs, agg.signature) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "aggregated BLS signature verification failed")
+	}
+
+	return nil
+}
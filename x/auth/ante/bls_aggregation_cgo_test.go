@@ -0,0 +1,195 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	xauthsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func genBLSPrivKey(t *testing.T) cryptotypes.PrivKey {
+	t.Helper()
+	priv, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	return &priv
+}
+
+// createBLSAggregatedTx creates a tx signed by privs, whose bls12_381
+// signatures are aggregated by tx.AggregateBLSSignatures. The signature of the
+// signer of index i is made by forgers[i] instead, if set.
+func (suite *AnteTestSuite) createBLSAggregatedTx(
+	t *testing.T, ctx sdk.Context, privs []cryptotypes.PrivKey, accNums []uint64, forgers map[int]cryptotypes.PrivKey,
+) xauthsigning.Tx {
+	t.Helper()
+	accSeqs := make([]uint64, len(privs))
+	sigTx, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+
+	for i, forger := range forgers {
+		forged, err := tx.SignWithPrivKey(ctx, signing.SignMode_SIGN_MODE_DIRECT, xauthsigning.SignerData{
+			Address:       sdk.AccAddress(privs[i].PubKey().Address()).String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNums[i],
+			Sequence:      accSeqs[i],
+			PubKey:        privs[i].PubKey(),
+		}, suite.txBuilder, forger, suite.clientCtx.TxConfig, accSeqs[i])
+		require.NoError(t, err)
+		sigs[i].Data = forged.Data
+	}
+
+	// aggregate the bls12_381 signatures, the other signers keep an empty one
+	var blsSigs []signing.SignatureV2
+	for _, sig := range sigs {
+		if _, ok := sig.PubKey.(*bls12_381.PubKey); ok {
+			blsSigs = append(blsSigs, sig)
+		}
+	}
+	require.NoError(t, tx.AggregateBLSSignatures(suite.txBuilder, blsSigs...))
+	aggSigs, err := suite.txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	for i := range sigs {
+		if i < len(aggSigs) {
+			sigs[i] = aggSigs[i]
+			continue
+		}
+		sigs[i].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte{}}
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+
+	aggTx := suite.txBuilder.GetTx()
+	aggTxSigs, err := aggTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.True(t, ante.IsAggregatedBLSSignature(aggTxSigs))
+	return aggTx
+}
+
+func TestSigVerificationAggregatedBLSSignature(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	bls1, bls2, bls3 := genBLSPrivKey(t), genBLSPrivKey(t), genBLSPrivKey(t)
+	secp := secp256k1.GenPrivKey()
+
+	testCases := []struct {
+		name    string
+		privs   []cryptotypes.PrivKey
+		forgers map[int]cryptotypes.PrivKey
+		expErr  error
+		errMsg  string
+	}{
+		{
+			name:  "valid aggregated signature",
+			privs: []cryptotypes.PrivKey{bls1, bls2, bls3},
+		},
+		{
+			name:    "signature of a wrong signer",
+			privs:   []cryptotypes.PrivKey{bls1, bls2, bls3},
+			forgers: map[int]cryptotypes.PrivKey{1: genBLSPrivKey(t)},
+			expErr:  sdkerrors.ErrUnauthorized,
+			errMsg:  "aggregated BLS signature verification failed",
+		},
+		{
+			name:   "non BLS signer",
+			privs:  []cryptotypes.PrivKey{bls1, bls2, secp},
+			expErr: sdkerrors.ErrInvalidPubKey,
+			errMsg: "aggregated BLS signature signer has a *secp256k1.PubKey public key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			addrs := make([]sdk.AccAddress, len(tc.privs))
+			accNums := make([]uint64, len(tc.privs))
+			for i, priv := range tc.privs {
+				addrs[i] = sdk.AccAddress(priv.PubKey().Address())
+				acc := suite.accountKeeper.NewAccountWithAddress(ctx, addrs[i])
+				suite.accountKeeper.SetAccount(ctx, acc)
+				accNums[i] = acc.GetAccountNumber()
+			}
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addrs...)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			aggTx := suite.createBLSAggregatedTx(t, ctx, tc.privs, accNums, tc.forgers)
+			_, err := antehandler(ctx, aggTx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			for i, addr := range addrs {
+				acc := suite.accountKeeper.GetAccount(ctx, addr)
+				require.Equal(t, uint64(1), acc.GetSequence())
+				require.True(t, tc.privs[i].PubKey().Equals(acc.GetPubKey()))
+			}
+		})
+	}
+}
+
+func TestSigVerificationAggregatedBLSSignatureGas(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	privs := []cryptotypes.PrivKey{genBLSPrivKey(t), genBLSPrivKey(t), genBLSPrivKey(t)}
+	addrs := make([]sdk.AccAddress, len(privs))
+	accNums := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addrs[i] = sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addrs[i])
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		accNums[i] = acc.GetAccountNumber()
+	}
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addrs...)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	aggTx := suite.createBLSAggregatedTx(t, suite.ctx, privs, accNums, nil)
+
+	// runs the decorator with the given params and returns the gas consumed
+	runWithParams := func(params types.Params) storetypes.Gas {
+		ctx, _ := suite.ctx.CacheContext()
+		require.NoError(t, suite.accountKeeper.Params.Set(ctx, params))
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := antehandler(ctx, aggTx, false)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	params := types.DefaultParams()
+	initialCost := runWithParams(params)
+	doubledParams := params
+	doubledParams.SigVerifyCostSecp256k1 *= 2
+	doubledCost := runWithParams(doubledParams)
+
+	// the aggregated signature is charged once for all the signers, instead of
+	// SigVerifyCostBls12381 per signer
+	signers := uint64(len(privs))
+	require.Equal(t,
+		doubledParams.SigVerifyCostBls12381Aggregated(signers)-params.SigVerifyCostBls12381Aggregated(signers),
+		doubledCost-initialCost,
+	)
+	require.Less(t, params.SigVerifyCostBls12381Aggregated(signers), signers*params.SigVerifyCostBls12381())
+}
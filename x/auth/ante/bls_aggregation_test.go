@@ -0,0 +1,37 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestIsAggregatedBLSSignature(t *testing.T) {
+	single := func(sig []byte) signing.SignatureV2 {
+		return signing.SignatureV2{Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig}}
+	}
+	aggSig := make([]byte, bls12_381.SignatureLength)
+
+	testCases := []struct {
+		name string
+		sigs []signing.SignatureV2
+		exp  bool
+	}{
+		{"aggregated signature", []signing.SignatureV2{single(aggSig), single(nil), single([]byte{})}, true},
+		{"single signer", []signing.SignatureV2{single(aggSig)}, false},
+		{"no aggregated signature", []signing.SignatureV2{single(aggSig[:64]), single(nil)}, false},
+		{"individual signatures", []signing.SignatureV2{single(aggSig), single(aggSig)}, false},
+		{"multisig signer", []signing.SignatureV2{single(aggSig), {Data: &signing.MultiSignatureData{}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, ante.IsAggregatedBLSSignature(tc.sigs))
+		})
+	}
+}
@@ -23,6 +23,20 @@ type AccountKeeper interface {
 	GetEnvironment() appmodule.Environment
 }
 
+// UnorderedTxManager defines the contract needed to keep the set of included
+// unordered txs, used by the UnorderedTxDecorator for replay protection.
+type UnorderedTxManager interface {
+	ContainsUnorderedTx(ctx context.Context, timeout uint64, txHash []byte) (bool, error)
+	AddUnorderedTx(ctx context.Context, timeout uint64, txHash []byte) error
+}
+
+// NonceLaneKeeper defines the contract needed to track the sequences of the
+// nonce lanes of accounts, used by the NonceLaneDecorator.
+type NonceLaneKeeper interface {
+	GetNonceLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint32) (uint32, error)
+	SetNonceLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, seq uint32) error
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -0,0 +1,90 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.AnteDecorator = NonceLaneDecorator{}
+
+// NonceLaneDecorator verifies and increments the sequences of the nonce lanes
+// used by the signers of a tx, replacing the account sequence increment of the
+// SigVerificationDecorator for them.
+//
+// A signer uses a nonce lane by setting a laned sequence, see
+// types.NewLanedSequence, of a lane other than lane 0 as the sequence of its
+// signer info. Each lane of an account has its own sequence, so the txs of an
+// account using different lanes do not depend on each other and can be
+// included in any order, while being ordered within each lane. Lane 0 is the
+// account sequence, verified and incremented by the SigVerificationDecorator.
+//
+// The NonceLaneDecorator must be placed after a SigVerificationDecorator with
+// nonce lanes enabled, see SigVerificationDecorator.WithNonceLanes.
+type NonceLaneDecorator struct {
+	nk NonceLaneKeeper
+}
+
+func NewNonceLaneDecorator(nk NonceLaneKeeper) NonceLaneDecorator {
+	return NonceLaneDecorator{nk: nk}
+}
+
+func (nld NonceLaneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// unordered txs do not use sequences
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return next(ctx, tx, false)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(signatures) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(signatures))
+	}
+
+	for i, sig := range signatures {
+		lane, seq := types.ParseLanedSequence(sig.Sequence)
+		if lane == 0 {
+			continue
+		}
+
+		laneSeq, err := nld.nk.GetNonceLaneSequence(ctx, signers[i], lane)
+		if err != nil {
+			return ctx, err
+		}
+
+		if seq != laneSeq {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"nonce lane %d sequence mismatch, expected %d, got %d", lane, laneSeq, seq,
+			)
+		}
+
+		if laneSeq == math.MaxUint32 {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrWrongSequence, "nonce lane %d is exhausted", lane)
+		}
+
+		if err := nld.nk.SetNonceLaneSequence(ctx, signers[i], lane, laneSeq+1); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, false)
+}
@@ -0,0 +1,73 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNonceLaneDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	acc := suite.CreateTestAccounts(1)[0]
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	lanesChain := sdk.ChainAnteDecorators(svd.WithNonceLanes(), ante.NewNonceLaneDecorator(suite.accountKeeper))
+	noLanesChain := sdk.ChainAnteDecorators(svd)
+
+	deliver := func(chain sdk.AnteHandler, lane, seq uint32) error {
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{acc.priv}, []uint64{acc.acc.GetAccountNumber()},
+			[]uint64{types.NewLanedSequence(lane, seq)}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		_, err = chain(suite.ctx, tx, false)
+		return err
+	}
+	requireSequences := func(accSeq uint64, laneSeqs map[uint32]uint32) {
+		require.Equal(t, accSeq, suite.accountKeeper.GetAccount(suite.ctx, acc.acc.GetAddress()).GetSequence())
+		for lane, expSeq := range laneSeqs {
+			seq, err := suite.accountKeeper.GetNonceLaneSequence(suite.ctx, acc.acc.GetAddress(), lane)
+			require.NoError(t, err)
+			require.Equal(t, expSeq, seq)
+		}
+	}
+
+	// the lanes are independent of each other and of the account sequence
+	require.NoError(t, deliver(lanesChain, 1, 0))
+	requireSequences(0, map[uint32]uint32{1: 1, 2: 0})
+	require.NoError(t, deliver(lanesChain, 2, 0))
+	require.NoError(t, deliver(lanesChain, 0, 0))
+	requireSequences(1, map[uint32]uint32{1: 1, 2: 1})
+
+	// replay protection within a lane
+	require.ErrorIs(t, deliver(lanesChain, 1, 0), sdkerrors.ErrWrongSequence)
+	require.ErrorIs(t, deliver(lanesChain, 1, 2), sdkerrors.ErrWrongSequence)
+	require.NoError(t, deliver(lanesChain, 1, 1))
+	requireSequences(1, map[uint32]uint32{1: 2, 2: 1})
+
+	// the lane 0 sequence is the account sequence
+	require.ErrorIs(t, deliver(lanesChain, 0, 0), sdkerrors.ErrWrongSequence)
+	require.NoError(t, deliver(lanesChain, 0, 1))
+	requireSequences(2, map[uint32]uint32{1: 2, 2: 1})
+
+	// without nonce lanes, laned sequences are account sequences
+	require.ErrorIs(t, deliver(noLanesChain, 1, 2), sdkerrors.ErrWrongSequence)
+	require.NoError(t, deliver(noLanesChain, 0, 2))
+	requireSequences(3, map[uint32]uint32{1: 2, 2: 1})
+
+	// the sequence of an exhausted lane cannot wrap around
+	require.NoError(t, suite.accountKeeper.SetNonceLaneSequence(suite.ctx, acc.acc.GetAddress(), 3, 1<<32-1))
+	require.ErrorIs(t, deliver(lanesChain, 3, 1<<32-1), sdkerrors.ErrWrongSequence)
+}
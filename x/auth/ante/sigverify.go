@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"filippo.io/edwards25519"
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -19,6 +20,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -63,9 +65,14 @@ type AccountAbstractionKeeper interface {
 // signatures on ReCheckTx. It will also increase the sequence number, and consume
 // gas for signature verification.
 //
+// The signatures of a tx with an aggregated BLS signature, see
+// IsAggregatedBLSSignature, are verified at once after all its signers were
+// authenticated.
+//
 // In cases where unordered or parallel transactions are desired, it is recommended
 // to set unordered=true with a reasonable timeout_height value, in which case
-// this nonce verification and increment will be skipped.
+// this nonce verification and increment will be skipped, or to use nonce lanes,
+// see WithNonceLanes.
 //
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
@@ -73,6 +80,7 @@ type SigVerificationDecorator struct {
 	aaKeeper        AccountAbstractionKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+	nonceLanes      bool
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
@@ -84,6 +92,14 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithNonceLanes returns a copy of the decorator which leaves the verification
+// and increment of the sequences of nonce lanes other than lane 0 to the
+// NonceLaneDecorator, which must follow it in the AnteHandler chain.
+func (svd SigVerificationDecorator) WithNonceLanes() SigVerificationDecorator {
+	svd.nonceLanes = true
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -124,12 +140,23 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
 		}
 
+	case *ed25519.PubKey:
+		if len(typedPubKey.Key) != ed25519.PubKeySize {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid ed25519 key size")
+		}
+		if _, err := new(edwards25519.Point).SetBytes(typedPubKey.Key); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ed25519 key is not on curve")
+		}
+
 	case *secp256r1.PubKey:
 		pubKeyObject := typedPubKey.Key.PublicKey
 		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
 		}
 
+	case *bls12_381.PubKey:
+		// bls12_381 keys are validated when decoded to verify their signatures
+
 	case multisig.PubKey:
 		pubKeysObjects := typedPubKey.GetPubKeys()
 		ok := true
@@ -186,13 +213,24 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of pubkeys; expected %d, got %d", len(signers), len(pubKeys))
 	}
 
+	var blsAgg *blsAggregate
+	if IsAggregatedBLSSignature(signatures) {
+		blsAgg = newBLSAggregate(signatures)
+	}
+
 	for i := range signers {
-		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i, blsAgg)
 		if err != nil {
 			return ctx, err
 		}
 	}
 
+	if blsAgg != nil {
+		if err := svd.verifyAggregatedSig(ctx, blsAgg); err != nil {
+			return ctx, err
+		}
+	}
+
 	var events sdk.Events
 	for i, sig := range signatures {
 		signerStr, err := svd.ak.AddressCodec().BytesToString(signers[i])
@@ -219,8 +257,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 	return next(ctx, tx, false)
 }
 
-// authenticate the authentication of the TX for a specific tx signer.
-func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) error {
+// authenticate the authentication of the TX for a specific tx signer. If the tx
+// has an aggregated BLS signature, the signer is collected in blsAgg instead of
+// having its signature verified.
+func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int, blsAgg *blsAggregate) error {
 	// first we check if it's an AA
 	if svd.aaKeeper != nil {
 		isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
@@ -228,6 +268,9 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 			return err
 		}
 		if isAa {
+			if blsAgg != nil {
+				return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "abstracted accounts cannot sign with an aggregated BLS signature")
+			}
 			return svd.authenticateAbstractedAccount(ctx, tx, signer, signerIndex)
 		}
 	}
@@ -256,17 +299,20 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		}
 	}
 
-	err := svd.consumeSignatureGas(ctx, acc.GetPubKey(), sig)
-	if err != nil {
-		return err
+	// the gas of an aggregated BLS signature is consumed once for all signers
+	if blsAgg == nil {
+		err := svd.consumeSignatureGas(ctx, acc.GetPubKey(), sig)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
+	err := svd.verifySig(ctx, tx, acc, sig, newlyCreated, blsAgg)
 	if err != nil {
 		return err
 	}
 
-	err = svd.increaseSequence(tx, acc)
+	err = svd.increaseSequence(tx, acc, sig)
 	if err != nil {
 		return err
 	}
@@ -299,19 +345,22 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	return nil
 }
 
-// verifySig will verify the signature of the provided signer account.
-func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
-	if sig.Sequence != acc.GetSequence() {
+// verifySig will verify the signature of the provided signer account, or
+// collect it in blsAgg if the tx has an aggregated BLS signature.
+func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool, blsAgg *blsAggregate) error {
+	// the sequence of a nonce lane is verified by the NonceLaneDecorator, and
+	// the signature is over the laned sequence
+	sequence := acc.GetSequence()
+	if svd.isNonceLaneSequence(sig) {
+		sequence = sig.Sequence
+	} else if sig.Sequence != sequence {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
 			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
 		)
 	}
 
-	// we're in simulation mode, or in ReCheckTx, or context is not
-	// on sig verify tx, then we do not need to verify the signatures
-	// in the tx.
-	if svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+	if svd.skipSigVerification(ctx) {
 		return nil
 	}
 
@@ -344,7 +393,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		Address:       acc.GetAddress().String(),
 		ChainID:       chainID,
 		AccountNumber: accNum,
-		Sequence:      acc.GetSequence(),
+		Sequence:      sequence,
 		PubKey: &anypb.Any{
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
@@ -355,13 +404,17 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
 	txData := adaptableTx.GetSigningTxData()
+	if blsAgg != nil {
+		return blsAgg.add(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	}
+
 	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 	if err != nil {
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
 			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 			// and therefore communicate sequence number as a potential cause of error.
-			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 		} else {
 			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 		}
@@ -371,6 +424,13 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 	return nil
 }
 
+// skipSigVerification returns true if we're in simulation mode, or in
+// ReCheckTx, or context is not on sig verify tx, in which case we do not need
+// to verify the signatures in the tx.
+func (svd SigVerificationDecorator) skipSigVerification(ctx sdk.Context) bool {
+	return svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx()
+}
+
 // setPubKey will attempt to set the pubkey for the account given the list of available public keys.
 // This must be called only in case the account has not a pubkey set yet.
 func (svd SigVerificationDecorator) setPubKey(ctx sdk.Context, acc sdk.AccountI, txPubKey cryptotypes.PubKey) error {
@@ -412,8 +472,8 @@ func (svd SigVerificationDecorator) setPubKey(ctx sdk.Context, acc sdk.AccountI,
 }
 
 // increaseSequence will increase the provided account interface sequence, unless
-// the tx is unordered.
-func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.AccountI) error {
+// the tx is unordered or the signature uses a nonce lane.
+func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.AccountI, sig signing.SignatureV2) error {
 	// Bypass incrementing sequence for transactions with unordered set to true.
 	// The actual parameters of the un-ordered tx will be checked in a separate
 	// decorator.
@@ -422,9 +482,25 @@ func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.
 		return nil
 	}
 
+	// the sequences of nonce lanes are incremented by the NonceLaneDecorator
+	if svd.isNonceLaneSequence(sig) {
+		return nil
+	}
+
 	return acc.SetSequence(acc.GetSequence() + 1)
 }
 
+// isNonceLaneSequence returns true if nonce lanes are enabled and the signature
+// sequence is the one of a nonce lane other than lane 0.
+func (svd SigVerificationDecorator) isNonceLaneSequence(sig signing.SignatureV2) bool {
+	if !svd.nonceLanes {
+		return false
+	}
+
+	lane, _ := types.ParseLanedSequence(sig.Sequence)
+	return lane != 0
+}
+
 // authenticateAbstractedAccount computes an AA authentication instruction and invokes the auth flow on the AA.
 func (svd SigVerificationDecorator) authenticateAbstractedAccount(ctx sdk.Context, authTx authsigning.Tx, signer []byte, index int) error {
 	// the bundler is the AA itself.
@@ -502,7 +578,7 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 	switch pubkey := pubkey.(type) {
 	case *ed25519.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		return nil
 
 	case *secp256k1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
@@ -512,6 +588,10 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -6,12 +6,15 @@ import (
 	"cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/x/auth/ante/unorderedtx"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultMaxUnOrderedTTL defines the default maximum TTL an un-ordered transaction
+// can set.
+const DefaultMaxUnOrderedTTL = 1024
+
 var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
 
 // UnorderedTxDecorator defines an AnteHandler decorator that is responsible for
@@ -22,18 +25,20 @@ var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
 //
 // The transaction sender must ensure that unordered=true and a timeout_height
 // is appropriately set. The AnteHandler will check that the transaction is not
-// a duplicate and will evict it from memory when the timeout is reached.
+// a duplicate. The hashes of the included unordered transactions are kept in
+// state until their timeout is reached, and then removed in the x/auth end
+// blocker.
 //
 // The UnorderedTxDecorator should be placed as early as possible in the AnteHandler
 // chain to ensure that during DeliverTx, the transaction is added to the UnorderedTxManager.
 type UnorderedTxDecorator struct {
 	// maxUnOrderedTTL defines the maximum TTL a transaction can define.
 	maxUnOrderedTTL uint64
-	txManager       *unorderedtx.Manager
+	txManager       UnorderedTxManager
 	env             appmodule.Environment
 }
 
-func NewUnorderedTxDecorator(maxTTL uint64, m *unorderedtx.Manager, env appmodule.Environment) *UnorderedTxDecorator {
+func NewUnorderedTxDecorator(maxTTL uint64, m UnorderedTxManager, env appmodule.Environment) *UnorderedTxDecorator {
 	return &UnorderedTxDecorator{
 		maxUnOrderedTTL: maxTTL,
 		txManager:       m,
@@ -65,13 +70,19 @@ func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, ne
 	txHash := sha256.Sum256(ctx.TxBytes())
 
 	// check for duplicates
-	if d.txManager.Contains(txHash) {
-		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx %X is duplicated")
+	contains, err := d.txManager.ContainsUnorderedTx(ctx, ttl, txHash[:])
+	if err != nil {
+		return ctx, err
+	}
+	if contains {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
 	}
 
 	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
 		// a new tx included in the block, add the hash to the unordered tx manager
-		d.txManager.Add(txHash, ttl)
+		if err := d.txManager.AddUnorderedTx(ctx, ttl, txHash[:]); err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, false)
@@ -7,7 +7,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/auth/ante"
-	"cosmossdk.io/x/auth/ante/unorderedtx"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -16,123 +15,87 @@ import (
 )
 
 func TestUnorderedTxDecorator_OrderedTx(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
 	tx, txBz := genUnorderedTx(t, false, 0)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100)
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
 }
 
 func TestUnorderedTxDecorator_UnorderedTx_NoTTL(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
 	tx, txBz := genUnorderedTx(t, true, 0)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100)
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
 }
 
 func TestUnorderedTxDecorator_UnorderedTx_InvalidTTL(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
-	tx, txBz := genUnorderedTx(t, true, 100+unorderedtx.DefaultMaxUnOrderedTTL+1)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	tx, txBz := genUnorderedTx(t, true, 100+ante.DefaultMaxUnOrderedTTL+1)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100)
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
 }
 
 func TestUnorderedTxDecorator_UnorderedTx_AlreadyExists(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
 	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100)
 
 	txHash := sha256.Sum256(txBz)
-	txm.Add(txHash, 150)
+	require.NoError(t, suite.accountKeeper.AddUnorderedTx(ctx, 150, txHash[:]))
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
 }
 
 func TestUnorderedTxDecorator_UnorderedTx_ValidCheckTx(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
 	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeCheck)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeCheck)
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
 }
 
 func TestUnorderedTxDecorator_UnorderedTx_ValidDeliverTx(t *testing.T) {
-	txm := unorderedtx.NewManager(t.TempDir())
-	defer func() {
-		require.NoError(t, txm.Close())
-	}()
-
-	txm.Start()
-
 	suite := SetupTestSuite(t, false)
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm, suite.accountKeeper.GetEnvironment()))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnOrderedTTL, suite.accountKeeper, suite.accountKeeper.GetEnvironment()))
 
 	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeFinalize)
+	ctx := suite.ctx.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeFinalize)
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
 
 	txHash := sha256.Sum256(txBz)
-	require.True(t, txm.Contains(txHash))
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, 150, txHash[:])
+	require.NoError(t, err)
+	require.True(t, contains)
+
+	// the tx is rejected once included
+	_, err = chain(ctx, tx, false)
+	require.Error(t, err)
 }
 
 func genUnorderedTx(t *testing.T, unordered bool, ttl uint64) (sdk.Tx, []byte) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +39,12 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, utx := range data.UnorderedTxs {
+		if err := ak.AddUnorderedTx(ctx, utx.TimeoutHeight, utx.TxHash); err != nil {
+			return err
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -55,5 +62,17 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	err = ak.UnorderedTxs.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+		genState.UnorderedTxs = append(genState.UnorderedTxs, types.UnorderedTx{
+			TimeoutHeight: key.K1(),
+			TxHash:        key.K2(),
+		})
+		return false, nil
+	})
+	return genState, err
 }
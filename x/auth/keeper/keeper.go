@@ -108,6 +108,12 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// UnorderedTxs key: TimeoutHeight | TxHash, the unordered txs included and not
+	// yet expired, used for replay protection
+	UnorderedTxs collections.KeySet[collections.Pair[uint64, []byte]]
+	// NonceLanes key: AccAddr | Lane | value: Sequence, the next sequence of the
+	// nonce lanes of accounts, other than lane 0 which is the account sequence
+	NonceLanes collections.Map[collections.Pair[sdk.AccAddress, uint32], uint32]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -141,6 +147,8 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		UnorderedTxs:      collections.NewKeySet(sb, types.UnorderedTxsKeyPrefix, "unordered_txs", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+		NonceLanes:        collections.NewMap(sb, types.NonceLanesKeyPrefix, "nonce_lanes", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint32Key), collections.Uint32Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNonceLaneSequence returns the next sequence of the given nonce lane of an
+// account.
+func (ak AccountKeeper) GetNonceLaneSequence(ctx context.Context, addr sdk.AccAddress, lane uint32) (uint32, error) {
+	seq, err := ak.NonceLanes.Get(ctx, collections.Join(addr, lane))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return seq, err
+}
+
+// SetNonceLaneSequence sets the next sequence of the given nonce lane of an
+// account.
+func (ak AccountKeeper) SetNonceLaneSequence(ctx context.Context, addr sdk.AccAddress, lane, seq uint32) error {
+	return ak.NonceLanes.Set(ctx, collections.Join(addr, lane), seq)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+)
+
+// ContainsUnorderedTx returns true if the unordered tx of the given hash and
+// timeout height was included and has not expired yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx context.Context, timeout uint64, txHash []byte) (bool, error) {
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeout, txHash))
+}
+
+// AddUnorderedTx adds the unordered tx of the given hash and timeout height to
+// the set of included unordered txs, until it expires.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, timeout uint64, txHash []byte) error {
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeout, txHash))
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs whose timeout height is
+// lower than the current block height, which can no longer be included.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	height := ak.HeaderService.HeaderInfo(ctx).Height
+	if height <= 0 {
+		return nil
+	}
+
+	return ak.UnorderedTxs.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, []byte](uint64(height)-1))
+}
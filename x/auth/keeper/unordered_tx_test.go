@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRemoveExpiredUnorderedTxs() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	hashes := [][]byte{[]byte("hash0"), []byte("hash1"), []byte("hash2")}
+	timeouts := []uint64{9, 10, 11}
+	for i, hash := range hashes {
+		suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, timeouts[i], hash))
+	}
+
+	// the timeout height is part of the key
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, 11, hashes[0])
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	// only the txs with a timeout height lower than the block height are removed
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+	for i, hash := range hashes {
+		contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, timeouts[i], hash)
+		suite.Require().NoError(err)
+		suite.Require().Equal(i > 0, contains)
+	}
+
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithHeaderInfo(header.Info{Height: 12})))
+	for i, hash := range hashes {
+		contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, timeouts[i], hash)
+		suite.Require().NoError(err)
+		suite.Require().False(contains)
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnorderedTxsGenesis() {
+	ctx := suite.ctx
+	hash := sha256.Sum256([]byte("tx"))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, 10, hash[:]))
+
+	// the unordered txs are exported and imported with the genesis state
+	genState, err := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedTx{{TimeoutHeight: 10, TxHash: hash[:]}}, genState.UnorderedTxs)
+
+	suite.Require().NoError(suite.accountKeeper.UnorderedTxs.Clear(ctx, nil))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(ctx, *genState))
+
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, 10, hash[:])
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+}
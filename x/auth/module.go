@@ -39,6 +39,7 @@ var (
 	_ appmodulev2.AppModule     = AppModule{}
 	_ appmodule.HasServices     = AppModule{}
 	_ appmodulev2.HasMigrations = AppModule{}
+	_ appmodulev2.HasEndBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -176,6 +177,11 @@ func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	return nil
 }
 
+// EndBlock removes the expired unordered txs.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
+
 // ConsensusVersion implements appmodule.HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -0,0 +1,25 @@
+package posthandler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the contract needed for the account related APIs used
+// by the PostHandler's decorators.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the contract needed for the bank related APIs used by the
+// PostHandler's decorators.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeegrantKeeper defines the contract of the fee grant keeper, whose presence
+// means fee grants are enabled.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
@@ -1,15 +1,43 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	AccountKeeper  AccountKeeper
+	BankKeeper     BankKeeper
+	FeegrantKeeper FeegrantKeeper
+	// GasRefundRatio is the fraction, between 0 and 1, of the fees paid for the
+	// unused gas that is refunded. Unused gas is not refunded if nil or zero.
+	GasRefundRatio sdkmath.LegacyDec
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler refunding the fees paid for unused gas
+// if a GasRefundRatio is set, and an empty PostHandler chain otherwise.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if !options.GasRefundRatio.IsNil() && !options.GasRefundRatio.IsZero() {
+		if options.GasRefundRatio.IsNegative() || options.GasRefundRatio.GT(sdkmath.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "gas refund ratio must be between 0 and 1, got %s", options.GasRefundRatio)
+		}
+
+		if options.AccountKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for gas refunds")
+		}
+
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for gas refunds")
+		}
+
+		postDecorators = append(postDecorators, NewRefundGasDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.GasRefundRatio))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
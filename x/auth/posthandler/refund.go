@@ -0,0 +1,115 @@
+package posthandler
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RefundGasDecorator refunds a fraction of the fees paid for the gas left unused
+// by a transaction. The refund is sent from the fee collector module account to
+// the account the fees were deducted from, i.e. the fee granter if specified or
+// the fee payer.
+//
+// For each fee denom, the amount refunded is
+// floor(fee * refundRatio * (gasLimit - gasUsed) / gasLimit).
+//
+// Transactions whose messages failed are not refunded, as the state changes of
+// the PostHandler are discarded along with the ones of the messages. Refunds are
+// not performed in simulation mode either, and their own execution consumes no
+// gas, so that the gas estimated by simulations matches the gas used.
+//
+// NOTE: the amount granted by a fee allowance is not restored by a refund, the
+// refunded coins are sent to the granter.
+//
+// CONTRACT: The Tx must implement the FeeTx interface and the fees deducted by
+// the AnteHandler must be the ones of the tx, as deducted by the
+// DeductFeeDecorator with the default TxFeeChecker.
+type RefundGasDecorator struct {
+	accountKeeper  AccountKeeper
+	bankKeeper     BankKeeper
+	feegrantKeeper FeegrantKeeper
+	refundRatio    sdkmath.LegacyDec
+}
+
+func NewRefundGasDecorator(ak AccountKeeper, bk BankKeeper, fk FeegrantKeeper, refundRatio sdkmath.LegacyDec) RefundGasDecorator {
+	return RefundGasDecorator{
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+		refundRatio:    refundRatio,
+	}
+}
+
+func (rgd RefundGasDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the FeeTx interface")
+	}
+
+	if simulate || !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refund := computeGasRefund(feeTx.GetFee(), feeTx.GetGas(), ctx.GasMeter().GasConsumed(), rgd.refundRatio)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if addr := rgd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
+		return ctx, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+	}
+
+	// the fees are refunded to the account they were deducted from, as done by
+	// the DeductFeeDecorator
+	refundee := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil && !bytes.Equal(feeGranter, refundee) {
+		if rgd.feegrantKeeper == nil {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		}
+		refundee = feeGranter
+	}
+
+	// the refund is part of the fee accounting, it must not alter the gas used
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := rgd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundee, refund); err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to refund fees to %s", refundee)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefundee, refundee.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// computeGasRefund returns the part of the fees refunded for the given unused
+// gas.
+func computeGasRefund(fee sdk.Coins, gasLimit, gasUsed uint64, refundRatio sdkmath.LegacyDec) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit || !refundRatio.IsPositive() {
+		return sdk.Coins{}
+	}
+
+	unused := sdkmath.NewIntFromUint64(gasLimit - gasUsed)
+	limit := sdkmath.NewIntFromUint64(gasLimit)
+
+	refund := make([]sdk.Coin, 0, len(fee))
+	for _, coin := range fee {
+		amount := refundRatio.MulInt(coin.Amount.Mul(unused)).QuoInt(limit).TruncateInt()
+		refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+	}
+
+	// zero amounts are removed
+	return sdk.NewCoins(refund...)
+}
@@ -0,0 +1,211 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/posthandler"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type refund struct {
+	module    string
+	recipient sdk.AccAddress
+	amount    sdk.Coins
+}
+
+type mockBankKeeper struct {
+	refunds []refund
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.refunds = append(bk.refunds, refund{module: senderModule, recipient: recipientAddr, amount: amt})
+	return nil
+}
+
+type mockAccountKeeper struct {
+	moduleAddrs map[string]sdk.AccAddress
+}
+
+func (ak mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return ak.moduleAddrs[moduleName]
+}
+
+type mockFeegrantKeeper struct{}
+
+func (mockFeegrantKeeper) UseGrantedFees(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins, []sdk.Msg) error {
+	return nil
+}
+
+type feeTx struct {
+	sdk.Tx
+
+	gas        uint64
+	fee        sdk.Coins
+	feePayer   []byte
+	feeGranter []byte
+}
+
+func (tx feeTx) GetGas() uint64     { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx feeTx) FeePayer() []byte   { return tx.feePayer }
+func (tx feeTx) FeeGranter() []byte { return tx.feeGranter }
+
+func TestRefundGasDecorator(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 7))
+	ak := mockAccountKeeper{moduleAddrs: map[string]sdk.AccAddress{types.FeeCollectorName: types.NewModuleAddress(types.FeeCollectorName)}}
+
+	testCases := []struct {
+		name        string
+		ratio       sdkmath.LegacyDec
+		gasUsed     uint64
+		feeGranter  sdk.AccAddress
+		noFeegrant  bool
+		noCollector bool
+		simulate    bool
+		success     bool
+		expRefund   sdk.Coins
+		expRefundee sdk.AccAddress
+		expErr      string
+	}{
+		{
+			name:        "refund to fee payer",
+			ratio:       sdkmath.LegacyOneDec(),
+			gasUsed:     40_000,
+			success:     true,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("stake", 4)),
+			expRefundee: payer,
+		},
+		{
+			name:        "partial refund to fee granter",
+			ratio:       sdkmath.LegacyNewDecWithPrec(5, 1),
+			gasUsed:     40_000,
+			feeGranter:  granter,
+			success:     true,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 2)),
+			expRefundee: granter,
+		},
+		{
+			name:        "fee granter is the fee payer",
+			ratio:       sdkmath.LegacyOneDec(),
+			gasUsed:     40_000,
+			feeGranter:  payer,
+			noFeegrant:  true,
+			success:     true,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("stake", 4)),
+			expRefundee: payer,
+		},
+		{
+			name:       "fee grants not enabled",
+			ratio:      sdkmath.LegacyOneDec(),
+			gasUsed:    40_000,
+			feeGranter: granter,
+			noFeegrant: true,
+			success:    true,
+			expErr:     "fee grants are not enabled",
+		},
+		{
+			name:        "fee collector not set",
+			ratio:       sdkmath.LegacyOneDec(),
+			gasUsed:     40_000,
+			noCollector: true,
+			success:     true,
+			expErr:      "fee collector module account (fee_collector) has not been set",
+		},
+		{
+			name:        "truncated amounts are dropped",
+			ratio:       sdkmath.LegacyOneDec(),
+			gasUsed:     95_000,
+			success:     true,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+			expRefundee: payer,
+		},
+		{
+			name:    "all gas used",
+			ratio:   sdkmath.LegacyOneDec(),
+			gasUsed: 100_000,
+			success: true,
+		},
+		{
+			name:    "failed tx",
+			ratio:   sdkmath.LegacyOneDec(),
+			gasUsed: 40_000,
+		},
+		{
+			name:     "simulation",
+			ratio:    sdkmath.LegacyOneDec(),
+			gasUsed:  40_000,
+			simulate: true,
+			success:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+			if tc.simulate {
+				ctx = ctx.WithExecMode(sdk.ExecModeSimulate)
+			}
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100_000))
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "test")
+
+			options := posthandler.HandlerOptions{
+				AccountKeeper:  ak,
+				BankKeeper:     &mockBankKeeper{},
+				FeegrantKeeper: mockFeegrantKeeper{},
+				GasRefundRatio: tc.ratio,
+			}
+			if tc.noFeegrant {
+				options.FeegrantKeeper = nil
+			}
+			if tc.noCollector {
+				options.AccountKeeper = mockAccountKeeper{}
+			}
+			bk := options.BankKeeper.(*mockBankKeeper)
+			postHandler, err := posthandler.NewPostHandler(options)
+			require.NoError(t, err)
+
+			tx := feeTx{gas: 100_000, fee: fee, feePayer: payer, feeGranter: tc.feeGranter}
+			newCtx, err := postHandler(ctx, tx, tc.simulate, tc.success)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Empty(t, bk.refunds)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.gasUsed, newCtx.GasMeter().GasConsumed())
+
+			if tc.expRefund == nil {
+				require.Empty(t, bk.refunds)
+				return
+			}
+			require.Equal(t, []refund{{module: types.FeeCollectorName, recipient: tc.expRefundee, amount: tc.expRefund}}, bk.refunds)
+		})
+	}
+}
+
+func TestNewPostHandler(t *testing.T) {
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+	require.Nil(t, postHandler)
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{GasRefundRatio: sdkmath.LegacyOneDec()})
+	require.ErrorContains(t, err, "account keeper is required")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{AccountKeeper: mockAccountKeeper{}, GasRefundRatio: sdkmath.LegacyOneDec()})
+	require.ErrorContains(t, err, "bank keeper is required")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{AccountKeeper: mockAccountKeeper{}, BankKeeper: &mockBankKeeper{}, GasRefundRatio: sdkmath.LegacyNewDec(2)})
+	require.ErrorContains(t, err, "must be between 0 and 1")
+}
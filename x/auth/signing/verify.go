@@ -69,11 +69,7 @@ func VerifySignature(
 ) error {
 	switch data := signatureData.(type) {
 	case *signing.SingleSignatureData:
-		signMode, err := internalSignModeToAPI(data.SignMode)
-		if err != nil {
-			return err
-		}
-		signBytes, err := handler.GetSignBytes(ctx, signMode, signerData, txData)
+		signBytes, err := GetSingleSignBytes(ctx, signerData, data, handler, txData)
 		if err != nil {
 			return err
 		}
@@ -102,3 +98,19 @@ func VerifySignature(
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// GetSingleSignBytes returns the bytes signed by a single signer signature, in
+// its sign mode.
+func GetSingleSignBytes(
+	ctx context.Context,
+	signerData txsigning.SignerData,
+	signatureData *signing.SingleSignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	signMode, err := internalSignModeToAPI(signatureData.SignMode)
+	if err != nil {
+		return nil, err
+	}
+	return handler.GetSignBytes(ctx, signMode, signerData, txData)
+}
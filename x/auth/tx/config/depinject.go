@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/posthandler"
 	"cosmossdk.io/x/auth/tx"
@@ -125,9 +126,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			// Please note that changing any of the anteHandler or postHandler chain is
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
-			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
-			)
+			postHandler, err := newPostHandler(in)
 			if err != nil {
 				panic(err)
 			}
@@ -142,6 +141,27 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	return ModuleOutputs{TxConfig: txConfig, TxConfigOptions: txConfigOptions, BaseAppOption: baseAppOption}
 }
 
+func newPostHandler(in ModuleInputs) (sdk.PostHandler, error) {
+	options := posthandler.HandlerOptions{
+		AccountKeeper:  in.AccountKeeper,
+		FeegrantKeeper: in.FeeGrantKeeper,
+	}
+	// the refunds are sent from the fee collector module account
+	if bk, ok := in.BankKeeper.(posthandler.BankKeeper); ok {
+		options.BankKeeper = bk
+	}
+
+	if in.Config.GasRefundRatio != "" {
+		ratio, err := math.LegacyNewDecFromStr(in.Config.GasRefundRatio)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas refund ratio: %w", err)
+		}
+		options.GasRefundRatio = ratio
+	}
+
+	return posthandler.NewPostHandler(options)
+}
+
 func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler, error) {
 	if in.BankKeeper == nil {
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
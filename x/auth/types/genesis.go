@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -72,7 +73,11 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return ValidateUnorderedTxs(data.UnorderedTxs)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -143,6 +148,28 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 	return nil
 }
 
+// ValidateUnorderedTxs checks that the unordered txs have a timeout height and
+// a SHA256 hash, and are not duplicated.
+func ValidateUnorderedTxs(txs []UnorderedTx) error {
+	txMap := make(map[string]bool, len(txs))
+
+	for _, tx := range txs {
+		if tx.TimeoutHeight == 0 {
+			return fmt.Errorf("unordered tx %X found in genesis state has no timeout height", tx.TxHash)
+		}
+		if len(tx.TxHash) != sha256.Size {
+			return fmt.Errorf("invalid unordered tx hash found in genesis state; expected %d bytes, got %d", sha256.Size, len(tx.TxHash))
+		}
+
+		key := fmt.Sprintf("%d/%X", tx.TimeoutHeight, tx.TxHash)
+		if txMap[key] {
+			return fmt.Errorf("duplicate unordered tx found in genesis state; hash: %X, timeout height: %d", tx.TxHash, tx.TimeoutHeight)
+		}
+		txMap[key] = true
+	}
+	return nil
+}
+
 // GenesisAccountIterator implements genesis account iteration.
 type GenesisAccountIterator struct{}
 
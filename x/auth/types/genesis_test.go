@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"testing"
 
@@ -54,6 +55,15 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateUnorderedTxs(t *testing.T) {
+	hash := sha256.Sum256([]byte("tx"))
+
+	require.NoError(t, types.ValidateUnorderedTxs([]types.UnorderedTx{{TimeoutHeight: 1, TxHash: hash[:]}, {TimeoutHeight: 2, TxHash: hash[:]}}))
+	require.ErrorContains(t, types.ValidateUnorderedTxs([]types.UnorderedTx{{TxHash: hash[:]}}), "has no timeout height")
+	require.ErrorContains(t, types.ValidateUnorderedTxs([]types.UnorderedTx{{TimeoutHeight: 1, TxHash: hash[:16]}}), "invalid unordered tx hash")
+	require.ErrorContains(t, types.ValidateUnorderedTxs([]types.UnorderedTx{{TimeoutHeight: 1, TxHash: hash[:]}, {TimeoutHeight: 1, TxHash: hash[:]}}), "duplicate unordered tx")
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
 	cdc := encodingConfig.Codec
@@ -28,4 +28,12 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// UnorderedTxsKeyPrefix prefix for the unordered tx hashes store, keyed by
+	// timeout height then tx hash
+	UnorderedTxsKeyPrefix = collections.NewPrefix(3)
+
+	// NonceLanesKeyPrefix prefix for the nonce lanes sequences store, keyed by
+	// address then lane
+	NonceLanesKeyPrefix = collections.NewPrefix(4)
 )
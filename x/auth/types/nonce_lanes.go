@@ -0,0 +1,20 @@
+package types
+
+// A laned sequence is the sequence of a signer info split into a nonce lane, in
+// its high 32 bits, and a sequence within that lane, in its low 32 bits. Lane 0
+// is the account sequence, so the sequences of txs not using nonce lanes are
+// laned sequences of lane 0. The other lanes have sequences of their own, which
+// allows the txs of an account using different lanes to be included in any
+// order.
+
+// NewLanedSequence returns the laned sequence of the given lane and sequence
+// within that lane.
+func NewLanedSequence(lane, sequence uint32) uint64 {
+	return uint64(lane)<<32 | uint64(sequence)
+}
+
+// ParseLanedSequence returns the lane and the sequence within that lane of a
+// laned sequence.
+func ParseLanedSequence(lanedSequence uint64) (lane, sequence uint32) {
+	return uint32(lanedSequence >> 32), uint32(lanedSequence)
+}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/types"
+)
+
+func TestLanedSequence(t *testing.T) {
+	lane, seq := types.ParseLanedSequence(types.NewLanedSequence(7, 42))
+	require.Equal(t, uint32(7), lane)
+	require.Equal(t, uint32(42), seq)
+
+	// lane 0 sequences are plain account sequences
+	require.Equal(t, uint64(42), types.NewLanedSequence(0, 42))
+
+	lane, seq = types.ParseLanedSequence(math.MaxUint64)
+	require.Equal(t, uint32(math.MaxUint32), lane)
+	require.Equal(t, uint32(math.MaxUint32), seq)
+}
@@ -47,6 +47,20 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBls12381 returns gas fee of bls12_381 signature verification,
+// which is dominated by its two pairings.
+func (p Params) SigVerifyCostBls12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 4
+}
+
+// SigVerifyCostBls12381Aggregated returns gas fee of the verification of a
+// bls12_381 signature aggregating the signatures of the given number of
+// signers. It computes a pairing per signer plus one for the aggregated
+// signature, instead of two pairings per signature.
+func (p Params) SigVerifyCostBls12381Aggregated(signers uint64) uint64 {
+	return p.SigVerifyCostBls12381() / 2 * (signers + 1)
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
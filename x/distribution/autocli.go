@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "auto-compound [delegator-addr]",
+					Short:     "Query whether the rewards of a delegator are auto-compounded",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -125,6 +134,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of all the tokenize share records owned by an address",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [enabled]",
+					Short:     "Opt in to, or out of, the auto-compounding of the staking rewards of an address",
+					Long:      "Opt in to, or out of, the auto-compounding of the staking rewards of an address. The rewards in the bond denom are restaked to the same validator once per auto-compound epoch, as long as the withdraw address is the delegator address.",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
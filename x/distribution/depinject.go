@@ -9,6 +9,7 @@ import (
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	staking "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,6 +46,7 @@ type ModuleOutputs struct {
 	DistrKeeper keeper.Keeper
 	Module      appmodule.AppModule
 	Hooks       staking.StakingHooksWrapper
+	EpochHooks  epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -82,5 +84,6 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		DistrKeeper: k,
 		Module:      m,
 		Hooks:       staking.StakingHooksWrapper{StakingHooks: k.Hooks()},
+		EpochHooks:  epochstypes.EpochHooksWrapper{EpochHooks: m},
 	}
 }
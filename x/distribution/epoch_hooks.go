@@ -0,0 +1,34 @@
+package distribution
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = AppModule{}
+
+// GetModuleName implements types.EpochHooks.
+func (am AppModule) GetModuleName() string {
+	return am.Name()
+}
+
+// BeforeEpochStart starts an auto-compounding round at the start of the auto-compound epoch.
+func (am AppModule) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := am.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// an empty epoch identifier disables auto-compounding
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return am.keeper.StartAutoCompoundRound(ctx)
+}
+
+// AfterEpochEnd is a noop
+func (am AppModule) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
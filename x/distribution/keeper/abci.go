@@ -38,6 +38,11 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
+	// restake the rewards of the delegators of the auto-compounding round in progress
+	if err := k.ProcessAutoCompound(ctx); err != nil {
+		return err
+	}
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(ci.ProposerAddress)
 	return k.PreviousProposer.Set(ctx, consAddr)
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound opts a delegator in to, or out of, the auto-compounding of its staking rewards.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	if enabled {
+		if err := k.AutoCompoundDelegators.Set(ctx, delAddr); err != nil {
+			return err
+		}
+	} else {
+		// the cursor of a round in progress may point to the removed delegator, which
+		// is fine since the round resumes from the next delegator in that case
+		if err := k.AutoCompoundDelegators.Remove(ctx, delAddr); err != nil {
+			return err
+		}
+	}
+
+	addr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, addr),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// StartAutoCompoundRound starts a new auto-compounding round from the first opted-in
+// delegator. The epoch is skipped if the round of the previous epoch is still in progress.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context) error {
+	inProgress, err := k.AutoCompoundCursor.Has(ctx)
+	if err != nil {
+		return err
+	}
+
+	if inProgress {
+		k.Logger.Info("skipping auto-compounding round, previous round still in progress")
+		return nil
+	}
+
+	iter, err := k.AutoCompoundDelegators.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	first, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, collections.Join(first, sdk.ValAddress{}))
+}
+
+// ProcessAutoCompound auto-compounds the rewards of at most AutoCompoundMaxDelegationsPerBlock
+// delegations of the round in progress, and moves the cursor of the round to the next delegation.
+func (k Keeper) ProcessAutoCompound(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// collect the delegations of this block, plus the next one to resume from
+	limit := int(params.AutoCompoundMaxDelegationsPerBlock)
+	var delegations []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	rng := new(collections.Range[sdk.AccAddress]).StartInclusive(cursor.K1())
+	err = k.AutoCompoundDelegators.Walk(ctx, rng, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		// the round resumes from the first delegation of the delegator if it is not the
+		// one of the cursor, which happens when the latter opted out in the meantime
+		var fromVal sdk.ValAddress
+		if delAddr.Equals(cursor.K1()) {
+			fromVal = cursor.K2()
+		}
+
+		next, err := k.autoCompoundDelegations(ctx, delAddr, fromVal, limit+1-len(delegations))
+		if err != nil {
+			return true, err
+		}
+
+		delegations = append(delegations, next...)
+		return len(delegations) > limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(delegations) > limit {
+		if err := k.AutoCompoundCursor.Set(ctx, delegations[limit]); err != nil {
+			return err
+		}
+		delegations = delegations[:limit]
+	} else if err := k.AutoCompoundCursor.Remove(ctx); err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		delAddr, valAddr := delegation.K1(), delegation.K2()
+		if len(valAddr) == 0 {
+			continue
+		}
+
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompoundDelegation(ctx, delAddr, valAddr, bondDenom)
+		}); err != nil {
+			// purposely ignoring the error here not to halt the chain if a delegation cannot be compounded
+			k.Logger.Error(
+				"failed to auto-compound delegation rewards",
+				"delegator", delAddr.String(),
+				"validator", valAddr.String(),
+				"err", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// autoCompoundDelegations returns at most limit delegations of a delegator to auto-compound, in
+// validator address order from the given validator. A delegator without delegations to
+// auto-compound has a single one without validator, so that it also counts towards the
+// delegations processed in a block.
+func (k Keeper) autoCompoundDelegations(ctx context.Context, delAddr sdk.AccAddress, fromVal sdk.ValAddress, limit int) ([]collections.Pair[sdk.AccAddress, sdk.ValAddress], error) {
+	none := []collections.Pair[sdk.AccAddress, sdk.ValAddress]{collections.Join(delAddr, sdk.ValAddress{})}
+
+	// rewards sent to another withdraw address are not the delegator's to restake
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return none, nil
+	}
+
+	var (
+		delegations []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+		iterErr     error
+	)
+	err = k.stakingKeeper.IterateDelegatorDelegationsFrom(ctx, delAddr, fromVal, func(delegation stakingtypes.Delegation) (stop bool) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		delegations = append(delegations, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+		return len(delegations) >= limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	if len(delegations) == 0 {
+		return none, nil
+	}
+
+	return delegations, nil
+}
+
+// autoCompoundDelegation withdraws the rewards of a delegation and delegates those in the
+// bond denom to the same validator.
+func (k Keeper) autoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) error {
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+	)
+}
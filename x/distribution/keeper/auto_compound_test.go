@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	disttypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	delAddr := addrs[0]
+	delAddrStr, err := address.NewBech32Codec("cosmos").BytesToString(delAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, true))
+	res, err := queryServer.DelegatorAutoCompound(ctx, &disttypes.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddrStr})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, false))
+	res, err = queryServer.DelegatorAutoCompound(ctx, &disttypes.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddrStr})
+	require.NoError(t, err)
+	require.False(t, res.Enabled)
+
+	// opting out twice is a noop
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, false))
+}
+
+func TestProcessAutoCompound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	params := disttypes.DefaultParams()
+	params.AutoCompoundMaxDelegationsPerBlock = 1
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	valAddrStr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addrStr, valAddrStr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+
+	// the delegator also delegates to a validator sorted after the first one, which is
+	// gone, so that the rewards of this delegation cannot be compounded
+	goneValAddr := sdk.ValAddress(bytes.Repeat([]byte{0xff}, 20))
+	goneValAddrStr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(goneValAddr)
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), goneValAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
+
+	delegations := []stakingtypes.Delegation{del, stakingtypes.NewDelegation(addrStr, goneValAddrStr, math.LegacyOneDec())}
+	stakingKeeper.EXPECT().IterateDelegatorDelegationsFrom(gomock.Any(), addr, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fromVal sdk.ValAddress, fn func(stakingtypes.Delegation) bool) error {
+			for _, delegation := range delegations {
+				delValAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+				require.NoError(t, err)
+				if bytes.Compare(delValAddr, fromVal) >= 0 && fn(delegation) {
+					break
+				}
+			}
+			return nil
+		},
+	).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// the rewards of the second delegator go to another withdraw address, so it is skipped
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{0xff}, 20))
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, otherAddr, sdk.AccAddress([]byte("withdraw-address"))))
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, true))
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, otherAddr, true))
+
+	// nothing happens before a round is started
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx))
+	cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(addr, sdk.ValAddress{}), cursor)
+
+	// the rewards are withdrawn and delegated back to the validator
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, initial.QuoRaw(2), stakingtypes.Unbonded, val, true).Return(math.LegacyNewDecFromInt(initial.QuoRaw(2)), nil)
+
+	// a single delegation is processed per block, the round resuming from the next
+	// delegation of the same delegator
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	cursor, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(addr, goneValAddr), cursor)
+
+	// a new round is not started while one is in progress
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx))
+	next, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cursor, next)
+
+	// the delegation which cannot be compounded is skipped
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	cursor, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(otherAddr, sdk.ValAddress{}), cursor)
+
+	// the skipped delegator counts as a single delegation and ends the round
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	_, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
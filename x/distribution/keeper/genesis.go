@@ -126,6 +126,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(del)
+		if err != nil {
+			return err
+		}
+
+		if err := k.AutoCompoundDelegators.Set(ctx, delegatorAddress); err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -303,9 +314,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var autoCompoundDelegators []string
+	err = k.AutoCompoundDelegators.Walk(ctx, nil, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		autoCompoundDelegators = append(autoCompoundDelegators, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	ppAddr, err := k.stakingKeeper.ConsensusAddressCodec().BytesToString(pp)
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes), nil
+
+	genState := types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompoundDelegators
+	return genState, nil
 }
@@ -54,6 +54,11 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegators key: delAddr | the delegators whose rewards are auto-compounded
+	AutoCompoundDelegators collections.KeySet[sdk.AccAddress]
+	// AutoCompoundCursor is the next delegation of the auto-compounding round in progress, if any,
+	// an empty validator address pointing to the first delegation of the delegator
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -138,6 +143,13 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegators: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegatorsPrefix,
+			"auto_compound_delegators",
+			sdk.AccAddressKey,
+		),
+		AutoCompoundCursor: collections.NewItem(sb, types.AutoCompoundCursorKey, "auto_compound_cursor", collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey))),
 	}
 
 	schema, err := sb.Build()
@@ -217,6 +229,74 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations of all
+// the tokenize share records owned by the owner, and sends them to the owner's
+// withdraw address.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the rewards are withdrawn to the record module account, which never sets
+		// a withdraw address, and forwarded from there with any reward it already
+		// received when its delegation was modified
+		recordAddr := record.GetModuleAddress()
+		_, err = k.stakingKeeper.Delegation(ctx, recordAddr, valAddr)
+		switch {
+		case err == nil:
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+				return nil, err
+			}
+		case !errors.Is(err, collections.ErrNotFound):
+			return nil, err
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, withdrawAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().BytesToString(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawTokenizeShareReward,
+		event.NewAttribute(types.AttributeKeyOwner, owner),
+		event.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/math"
@@ -16,6 +17,7 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -139,6 +141,35 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	ownerAddr := addrs[0]
+	valAddr := sdk.ValAddress(addrs[1])
+	valAddrStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// no records owned
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), ownerAddr).Return(nil, nil)
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr)
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecords)
+
+	// the delegation of the record was fully redeemed, the rewards already paid
+	// to the record account are forwarded to the owner
+	record := stakingtypes.NewTokenizeShareRecord(1, ownerAddr.String(), valAddrStr)
+	recordAddr := record.GetModuleAddress()
+	rewards := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), ownerAddr).Return([]stakingtypes.TokenizeShareRecord{record}, nil)
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(nil, collections.ErrNotFound)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	// if SendCoins is called, we know that the withdraw was successful
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, ownerAddr, rewards).Return(nil)
+
+	amount, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr)
+	require.NoError(t, err)
+	require.Equal(t, rewards, amount)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 
@@ -0,0 +1,33 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var ParamsKey = collections.NewPrefix(9)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+// It sets the auto-compounding params to their default value.
+func MigrateStore(ctx context.Context, env appmodule.Environment, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	params := collections.NewItem(sb, ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.AutoCompoundEpochIdentifier = types.DefaultAutoCompoundEpochIdentifier
+	p.AutoCompoundMaxDelegationsPerBlock = types.DefaultAutoCompoundMaxDelegationsPerBlock
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,53 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	v5 "cosmossdk.io/x/distribution/migrations/v5"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey("distribution")
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
+
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, v5.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	oldParams := types.Params{
+		CommunityTax:        math.LegacyNewDecWithPrec(3, 2),
+		BaseProposerReward:  math.LegacyZeroDec(),
+		BonusProposerReward: math.LegacyZeroDec(),
+		WithdrawAddrEnabled: true,
+	}
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, env, cdc))
+
+	newParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, oldParams.CommunityTax, newParams.CommunityTax)
+	require.Equal(t, oldParams.WithdrawAddrEnabled, newParams.WithdrawAddrEnabled)
+	require.Equal(t, types.DefaultAutoCompoundEpochIdentifier, newParams.AutoCompoundEpochIdentifier)
+	require.Equal(t, types.DefaultAutoCompoundMaxDelegationsPerBlock, newParams.AutoCompoundMaxDelegationsPerBlock)
+}
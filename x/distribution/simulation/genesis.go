@@ -40,6 +40,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		Params: types.Params{
 			CommunityTax:        communityTax,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			AutoCompoundEpochIdentifier:        types.DefaultAutoCompoundEpochIdentifier,
+			AutoCompoundMaxDelegationsPerBlock: types.DefaultAutoCompoundMaxDelegationsPerBlock,
 		},
 	}
 
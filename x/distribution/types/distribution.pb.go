@@ -38,6 +38,14 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the identifier of the x/epochs epoch at the start of
+	// which a round restaking the rewards of the auto-compounding delegators begins, empty
+	// disables auto-compounding.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_max_delegations_per_block is the maximum number of delegations of the
+	// auto-compounding delegators whose rewards are restaked in a block, it must be positive
+	// when auto-compounding is enabled.
+	AutoCompoundMaxDelegationsPerBlock uint32 `protobuf:"varint,6,opt,name=auto_compound_max_delegations_per_block,json=autoCompoundMaxDelegationsPerBlock,proto3" json:"auto_compound_max_delegations_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +88,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundMaxDelegationsPerBlock() uint32 {
+	if m != nil {
+		return m.AutoCompoundMaxDelegationsPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +679,77 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xa4, 0x8e, 0xd3, 0x4c, 0xbe, 0xda, 0xcd, 0x47, 0x1d, 0xa7, 0xd8, 0xee, 0x8a, 0xaa,
+	0x26, 0x10, 0x9b, 0xb4, 0x12, 0x42, 0xb9, 0xa0, 0xc6, 0x49, 0x45, 0xa5, 0x16, 0xa2, 0x0d, 0x02,
+	0x09, 0x0e, 0xab, 0xf1, 0xec, 0xc4, 0x1e, 0xb2, 0xbb, 0xb3, 0xcc, 0xcc, 0x3a, 0xc9, 0x09, 0x8e,
+	0x85, 0x03, 0x70, 0x03, 0x71, 0xaa, 0xe0, 0x52, 0x71, 0xca, 0x21, 0x7f, 0x44, 0xc5, 0xa9, 0xaa,
+	0x00, 0x21, 0x0e, 0x01, 0x92, 0x43, 0x10, 0x7f, 0x05, 0x9a, 0x9d, 0xf1, 0xda, 0x0e, 0x01, 0x85,
+	0x54, 0x51, 0x2f, 0x96, 0xe7, 0xbd, 0xb7, 0xef, 0xf7, 0x7b, 0x6f, 0xde, 0xc7, 0xc0, 0x2a, 0x66,
+	0x22, 0x60, 0xa2, 0xe6, 0x51, 0x21, 0x39, 0x6d, 0xc4, 0x92, 0xb2, 0xb0, 0xd6, 0x5e, 0x6c, 0x10,
+	0x89, 0x16, 0xfb, 0x84, 0xd5, 0x88, 0x33, 0xc9, 0xac, 0x39, 0x6d, 0x5f, 0xed, 0x53, 0x19, 0xfb,
+	0xc2, 0x54, 0x93, 0x35, 0x59, 0x62, 0x57, 0x53, 0xff, 0xf4, 0x27, 0x85, 0xa2, 0x81, 0x68, 0x20,
+	0x41, 0x52, 0xd7, 0x98, 0x51, 0xe3, 0xb2, 0x30, 0xab, 0xf5, 0xae, 0xfe, 0xd0, 0xf8, 0xd7, 0xaa,
+	0xcb, 0x28, 0xa0, 0x21, 0xab, 0x25, 0xbf, 0x5a, 0x64, 0xef, 0x65, 0x61, 0x6e, 0x0d, 0x71, 0x14,
+	0x08, 0xeb, 0x03, 0x38, 0x86, 0x59, 0x10, 0xc4, 0x21, 0x95, 0x3b, 0xae, 0x44, 0xdb, 0x79, 0x50,
+	0x06, 0x95, 0xe1, 0xe5, 0xd7, 0x1e, 0xef, 0x97, 0x32, 0xbf, 0xee, 0x97, 0x0c, 0x55, 0xe1, 0x6d,
+	0x56, 0x29, 0xab, 0x05, 0x48, 0xb6, 0xaa, 0xf7, 0x48, 0x13, 0xe1, 0x9d, 0x15, 0x82, 0x9f, 0xee,
+	0x2d, 0x40, 0x83, 0xb4, 0x42, 0xf0, 0xa3, 0xa3, 0xdd, 0x79, 0xe0, 0x8c, 0xa6, 0xce, 0xde, 0x41,
+	0xdb, 0xd6, 0x87, 0x70, 0x4a, 0x11, 0x56, 0xac, 0x22, 0x26, 0x08, 0x77, 0x39, 0xd9, 0x42, 0xdc,
+	0xcb, 0x0f, 0x24, 0x18, 0xaf, 0x9f, 0x0d, 0x23, 0x0f, 0x1c, 0x4b, 0x79, 0x5d, 0x33, 0x4e, 0x9d,
+	0xc4, 0xa7, 0xe5, 0xc3, 0xe9, 0x06, 0x0b, 0x63, 0xf1, 0x0f, 0xb0, 0x0b, 0xcf, 0x08, 0x36, 0x99,
+	0xb8, 0x3d, 0x86, 0x76, 0x13, 0x4e, 0x6f, 0x51, 0xd9, 0xf2, 0x38, 0xda, 0x72, 0x91, 0xe7, 0x71,
+	0x97, 0x84, 0xa8, 0xe1, 0x13, 0x2f, 0x9f, 0x2d, 0x83, 0xca, 0x45, 0x67, 0xb2, 0xa3, 0xbc, 0xed,
+	0x79, 0x7c, 0x55, 0xab, 0xac, 0x3a, 0x2c, 0xa2, 0x58, 0x32, 0x17, 0xb3, 0x20, 0x62, 0x71, 0xe8,
+	0xb9, 0x24, 0x62, 0xb8, 0xe5, 0x52, 0x8f, 0x84, 0x92, 0x6e, 0x50, 0xc2, 0xf3, 0x83, 0x8a, 0xaa,
+	0x33, 0xa7, 0xac, 0xea, 0xc6, 0x68, 0x55, 0xd9, 0xdc, 0x4d, 0x4d, 0xac, 0x75, 0x78, 0xa3, 0xdf,
+	0x49, 0x80, 0xb6, 0x5d, 0x8f, 0xf8, 0xa4, 0x89, 0x54, 0x19, 0x09, 0x37, 0x22, 0xdc, 0x6d, 0xf8,
+	0x0c, 0x6f, 0xe6, 0x73, 0x65, 0x50, 0x19, 0x73, 0xec, 0x5e, 0x6f, 0xf7, 0xd1, 0xf6, 0x4a, 0xd7,
+	0x76, 0x8d, 0xf0, 0x65, 0x65, 0xb9, 0x74, 0xfd, 0xb3, 0xa3, 0xdd, 0xf9, 0xb2, 0x0e, 0x7d, 0x41,
+	0x78, 0x9b, 0xb5, 0xed, 0xfe, 0x5a, 0xd6, 0xb5, 0x62, 0xff, 0x0c, 0x60, 0xe1, 0x5d, 0xe4, 0x53,
+	0x0f, 0x49, 0xc6, 0xdf, 0xa4, 0x42, 0x32, 0x4e, 0x31, 0xf2, 0x75, 0x4a, 0x84, 0xf5, 0x39, 0x80,
+	0x57, 0x70, 0x1c, 0xc4, 0x3e, 0x92, 0xb4, 0x4d, 0x4c, 0xfa, 0x5d, 0xae, 0xb0, 0xf2, 0xa0, 0x7c,
+	0xa1, 0x32, 0x72, 0xf3, 0xaa, 0xe9, 0x94, 0xaa, 0xba, 0xbf, 0x4e, 0xc5, 0xab, 0x5c, 0xd7, 0x19,
+	0x0d, 0xf5, 0x15, 0x7d, 0xff, 0x5b, 0xe9, 0xe5, 0x26, 0x95, 0xad, 0xb8, 0x51, 0xc5, 0x2c, 0x30,
+	0x95, 0x5c, 0xeb, 0xa1, 0x26, 0x77, 0x22, 0x22, 0x3a, 0xdf, 0x08, 0x5d, 0x75, 0xd3, 0x5d, 0x58,
+	0x4d, 0xc6, 0x51, 0xa0, 0xd6, 0x0d, 0x38, 0xc1, 0xc9, 0x06, 0xe1, 0x24, 0xc4, 0xc4, 0xc5, 0x2c,
+	0x0e, 0x65, 0x52, 0x79, 0x63, 0xce, 0x78, 0x2a, 0xae, 0x2b, 0xa9, 0xfd, 0x1d, 0x80, 0x57, 0xd2,
+	0xc0, 0xea, 0x31, 0xe7, 0x24, 0x94, 0x9d, 0xa8, 0x22, 0x38, 0xa4, 0x23, 0x11, 0xe7, 0x1c, 0x44,
+	0x07, 0xc6, 0x9a, 0x81, 0xb9, 0x88, 0x70, 0xca, 0x74, 0x9f, 0x64, 0x1d, 0x73, 0xb2, 0xbf, 0x06,
+	0xb0, 0x98, 0xb2, 0xbc, 0x8d, 0x4d, 0xcc, 0xc4, 0xab, 0xb3, 0x20, 0xa0, 0x42, 0x50, 0x16, 0x5a,
+	0x6d, 0x08, 0x71, 0x7a, 0x3a, 0x67, 0xbe, 0x3d, 0x48, 0xf6, 0x17, 0x00, 0xce, 0xa5, 0xd4, 0xde,
+	0x8e, 0xa5, 0x90, 0x28, 0xf4, 0x68, 0xd8, 0x7c, 0x6e, 0x49, 0x54, 0x8c, 0x26, 0x53, 0x46, 0xeb,
+	0x3e, 0x12, 0xad, 0xd5, 0x36, 0x09, 0xa5, 0xf5, 0x12, 0xbc, 0xd4, 0xee, 0x88, 0x5d, 0x93, 0x66,
+	0x90, 0xa4, 0x79, 0x22, 0x95, 0xaf, 0x25, 0x62, 0xeb, 0x3e, 0xbc, 0xb8, 0xc1, 0x11, 0x56, 0x1d,
+	0x60, 0x26, 0xd6, 0xe2, 0xff, 0x1e, 0x22, 0x4e, 0xea, 0xc2, 0xfe, 0x14, 0xc0, 0xa9, 0x13, 0x18,
+	0x09, 0xeb, 0x23, 0x38, 0xd3, 0xa5, 0x24, 0x94, 0xc2, 0x25, 0x89, 0xc6, 0xe4, 0xea, 0xd5, 0xea,
+	0x7f, 0xec, 0x8b, 0xea, 0x09, 0x2e, 0x97, 0x87, 0x15, 0x4f, 0x9d, 0x90, 0xa9, 0xf6, 0x09, 0x90,
+	0xf6, 0x27, 0x03, 0x70, 0xe8, 0x0e, 0x21, 0x6b, 0x8c, 0xf9, 0xd6, 0xc7, 0x70, 0xbc, 0xbb, 0x01,
+	0x22, 0xc6, 0xfc, 0x53, 0x5d, 0xd1, 0xd2, 0x59, 0xaf, 0x28, 0x0f, 0x9c, 0xee, 0xc6, 0x49, 0x08,
+	0x48, 0x38, 0xea, 0x11, 0x4c, 0x03, 0xe4, 0x6b, 0xf8, 0x81, 0x53, 0xc0, 0xdf, 0x3a, 0x03, 0xbc,
+	0x33, 0x62, 0x60, 0x14, 0xaa, 0xfd, 0xd5, 0x00, 0x2c, 0xd4, 0x7b, 0x79, 0xac, 0x47, 0x24, 0xf4,
+	0xf4, 0x98, 0x47, 0xbe, 0x35, 0x05, 0x07, 0x25, 0x95, 0x3e, 0xd1, 0xfb, 0xd0, 0xd1, 0x07, 0xab,
+	0x0c, 0x47, 0x3c, 0x22, 0x30, 0xa7, 0x51, 0xb7, 0x2a, 0x9c, 0x5e, 0x91, 0x75, 0x15, 0x0e, 0x73,
+	0x82, 0x69, 0x44, 0x49, 0x28, 0xf5, 0xea, 0x71, 0xba, 0x02, 0x6b, 0x07, 0xe6, 0x50, 0x90, 0x0c,
+	0xa2, 0x6c, 0x12, 0xe4, 0xec, 0x89, 0x41, 0x26, 0x11, 0xde, 0x31, 0x11, 0x56, 0x4e, 0x11, 0x61,
+	0x12, 0xde, 0x37, 0x47, 0xbb, 0xf3, 0xa3, 0x7e, 0x52, 0x86, 0x2e, 0xee, 0x76, 0x84, 0x01, 0x5c,
+	0xaa, 0x3c, 0x78, 0x58, 0xca, 0xfc, 0xf9, 0xb0, 0x94, 0xf9, 0x61, 0x6f, 0xa1, 0x60, 0x50, 0x9b,
+	0xac, 0xdd, 0x03, 0x1a, 0x4a, 0xc5, 0x19, 0xd8, 0x3f, 0x02, 0x38, 0x6d, 0xb6, 0x04, 0xe3, 0xeb,
+	0x12, 0x71, 0x49, 0xc3, 0xe6, 0xdd, 0x70, 0x23, 0x19, 0xa8, 0x11, 0x27, 0x6d, 0xca, 0x62, 0xd1,
+	0xdf, 0x3b, 0xe3, 0x1d, 0xb1, 0x69, 0x9d, 0x7b, 0x70, 0x50, 0x48, 0xb4, 0x49, 0xf2, 0x03, 0xcf,
+	0xf4, 0x9a, 0xd0, 0x4e, 0xac, 0x15, 0x98, 0x6b, 0x11, 0xda, 0x6c, 0xe9, 0x84, 0x66, 0x97, 0x5f,
+	0xf9, 0x6b, 0xbf, 0x34, 0x81, 0x39, 0x49, 0xb6, 0x98, 0xab, 0x55, 0xdf, 0x1e, 0xed, 0xce, 0x1f,
+	0x97, 0x99, 0x04, 0xe8, 0x83, 0xfd, 0x07, 0x80, 0xb3, 0xdd, 0xe5, 0x97, 0x06, 0x68, 0x16, 0xfa,
+	0x5b, 0xf0, 0x72, 0xb7, 0x09, 0xd5, 0x46, 0x27, 0x42, 0x98, 0xb7, 0xd0, 0xb5, 0xa7, 0x7b, 0x0b,
+	0x2f, 0x18, 0x6a, 0xdd, 0xf9, 0xab, 0x4d, 0xd6, 0x25, 0x57, 0x63, 0xee, 0x52, 0xfb, 0x98, 0xdc,
+	0x0a, 0x61, 0x2e, 0x7d, 0xec, 0x9c, 0xe7, 0xc0, 0x33, 0x28, 0x4b, 0x59, 0x75, 0xbd, 0xf6, 0x4f,
+	0x00, 0x5e, 0xff, 0xf7, 0xa2, 0x7e, 0x8f, 0xca, 0xd6, 0x0a, 0x89, 0x98, 0xa0, 0xf2, 0x9c, 0xea,
+	0x7b, 0xa6, 0xa7, 0xbe, 0x95, 0xca, 0x9c, 0xac, 0x3c, 0x1c, 0xf2, 0x34, 0xb0, 0x79, 0xe3, 0x74,
+	0x8e, 0x4b, 0x2f, 0x3e, 0x38, 0x45, 0x49, 0x2e, 0xbf, 0xf1, 0xe8, 0xa0, 0x08, 0x1e, 0x1f, 0x14,
+	0xc1, 0x93, 0x83, 0x22, 0xf8, 0xfd, 0xa0, 0x08, 0xbe, 0x3c, 0x2c, 0x66, 0x9e, 0x1c, 0x16, 0x33,
+	0xbf, 0x1c, 0x16, 0x33, 0xef, 0x5f, 0xeb, 0x2b, 0xab, 0x63, 0x6f, 0x97, 0x24, 0x69, 0x8d, 0x5c,
+	0xf2, 0xf0, 0xbd, 0xf5, 0xf7, 0x00, 0x8e, 0x43, 0x4b, 0x65, 0xab, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +783,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundMaxDelegationsPerBlock != that1.AutoCompoundMaxDelegationsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1124,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxDelegationsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxDelegationsPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1692,13 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundMaxDelegationsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxDelegationsPerBlock))
+	}
 	return n
 }
 
@@ -2016,6 +2067,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxDelegationsPerBlock", wireType)
+			}
+			m.AutoCompoundMaxDelegationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxDelegationsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
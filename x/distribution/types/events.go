@@ -2,14 +2,19 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeRewards                     = "rewards"
+	EventTypeCommission                  = "commission"
+	EventTypeWithdrawRewards             = "withdraw_rewards"
+	EventTypeWithdrawCommission          = "withdraw_commission"
+	EventTypeProposerReward              = "proposer_reward"
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyEnabled         = "enabled"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp string, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -40,5 +42,14 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	autoCompoundDelegators := make(map[string]struct{}, len(gs.AutoCompoundDelegators))
+	for _, del := range gs.AutoCompoundDelegators {
+		if _, ok := autoCompoundDelegators[del]; ok {
+			return fmt.Errorf("duplicate auto-compound delegator %s", del)
+		}
+		autoCompoundDelegators[del] = struct{}{}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -348,6 +348,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators whose staking rewards are auto-compounded at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +401,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc6, 0x45, 0x34, 0xd3, 0xd4, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x0d, 0x08, 0xaa, 0x22, 0x40, 0x8d, 0xdb, 0xf2, 0xe3, 0x00, 0x91, 0x23, 0x81,
+	0x40, 0x48, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x6a, 0xef, 0xac, 0x66, 0x66, 0xd7, 0x80, 0xc4, 0x81,
+	0x53, 0x11, 0x27, 0x4e, 0x88, 0x63, 0xc5, 0xa9, 0x42, 0x42, 0xe2, 0xd0, 0x3f, 0xa2, 0xc7, 0xaa,
+	0xe2, 0xc0, 0x09, 0xaa, 0xe4, 0x00, 0xe2, 0xc8, 0x5f, 0x80, 0x76, 0x66, 0x76, 0x77, 0x9c, 0xdd,
+	0x6e, 0xdc, 0xd0, 0x5c, 0x92, 0x78, 0xe6, 0xfd, 0xf8, 0xbe, 0xef, 0xbd, 0x79, 0xcf, 0x01, 0x2f,
+	0xba, 0x94, 0x8f, 0x29, 0x6f, 0x7b, 0x84, 0x0b, 0x46, 0xfa, 0xa1, 0x20, 0xd4, 0x6f, 0x47, 0x97,
+	0xfb, 0x58, 0xa0, 0xcb, 0xed, 0x01, 0xf6, 0x31, 0x27, 0xdc, 0x09, 0x18, 0x15, 0x14, 0x9e, 0x55,
+	0xa6, 0x8e, 0x69, 0xea, 0x68, 0xd3, 0xd5, 0xe5, 0x01, 0x1d, 0x50, 0x69, 0xd7, 0x8e, 0xff, 0x52,
+	0x2e, 0xab, 0x4d, 0x1d, 0xbd, 0x8f, 0x38, 0x4e, 0xa3, 0xba, 0x94, 0xf8, 0xfa, 0xde, 0x29, 0xcb,
+	0x3e, 0x95, 0x47, 0xd9, 0xaf, 0x28, 0xfb, 0x9e, 0x4a, 0xa4, 0xf1, 0xa8, 0xab, 0x25, 0x34, 0x26,
+	0x3e, 0x6d, 0xcb, 0x9f, 0xea, 0xe8, 0xc2, 0x2f, 0x16, 0x38, 0x73, 0x1d, 0x8f, 0xf0, 0x00, 0x09,
+	0xca, 0x3e, 0x21, 0x62, 0xe8, 0x31, 0x34, 0x79, 0xdf, 0xdf, 0xa5, 0xf0, 0x06, 0x58, 0xf2, 0x92,
+	0x8b, 0x1e, 0xf2, 0x3c, 0x86, 0x39, 0xb7, 0xad, 0x35, 0xeb, 0xd2, 0xe2, 0x96, 0xfd, 0xf0, 0xde,
+	0xc6, 0xb2, 0x8e, 0x7c, 0x4d, 0xdd, 0xec, 0x08, 0x46, 0xfc, 0x41, 0xf7, 0x54, 0xea, 0xa2, 0xcf,
+	0x61, 0x07, 0x9c, 0x9a, 0xe8, 0xb0, 0x69, 0x94, 0xea, 0x21, 0x51, 0x9e, 0x4b, 0x3c, 0xf4, 0xf1,
+	0xd5, 0x85, 0x6f, 0xef, 0xb4, 0x2a, 0x7f, 0xdf, 0x69, 0x55, 0x2e, 0xdc, 0xae, 0x82, 0xf5, 0x8f,
+	0xd1, 0x88, 0x78, 0x71, 0x8e, 0x8f, 0x42, 0xc1, 0x05, 0xf2, 0xbd, 0xd8, 0x07, 0x4f, 0x10, 0xf3,
+	0x78, 0x17, 0xbb, 0x94, 0x79, 0xf0, 0x43, 0xb0, 0x14, 0x25, 0x46, 0x07, 0xb0, 0xaf, 0x3f, 0xbc,
+	0xb7, 0x71, 0x5e, 0x67, 0x4d, 0x03, 0x1d, 0x20, 0x11, 0x1d, 0x38, 0x87, 0xb7, 0x2d, 0x70, 0x9a,
+	0x66, 0xc9, 0x7a, 0x4c, 0x65, 0xb3, 0xab, 0x6b, 0xb5, 0x4b, 0xf5, 0xcd, 0x73, 0xba, 0x44, 0x4e,
+	0x5c, 0xc2, 0xa4, 0xda, 0xce, 0x75, 0xec, 0x76, 0x28, 0xf1, 0xb7, 0xae, 0xdc, 0xff, 0xa3, 0x55,
+	0xf9, 0xf9, 0xcf, 0xd6, 0xcb, 0x03, 0x22, 0x86, 0x61, 0xdf, 0x71, 0xe9, 0x58, 0x57, 0x45, 0xff,
+	0xda, 0xe0, 0xde, 0xad, 0xb6, 0xf8, 0x32, 0xc0, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfa, 0xf5, 0x25,
+	0xab, 0x0b, 0x69, 0x8e, 0x9f, 0xa1, 0xc4, 0x3f, 0x16, 0xb8, 0x98, 0x11, 0x70, 0xdd, 0x70, 0x1c,
+	0x8e, 0x90, 0xc0, 0x5e, 0x87, 0x8e, 0xc7, 0x84, 0x73, 0x42, 0xfd, 0x63, 0x12, 0x63, 0x08, 0xea,
+	0x28, 0x4b, 0x27, 0x8b, 0x59, 0xdf, 0x7c, 0xd3, 0x29, 0xe9, 0x7c, 0xa7, 0x1c, 0xe7, 0xd6, 0x62,
+	0x2c, 0x91, 0xe2, 0x6c, 0x86, 0x36, 0xc8, 0xfe, 0x6b, 0x81, 0xb5, 0x34, 0xc8, 0x7b, 0x84, 0x0b,
+	0xca, 0x88, 0x8b, 0x46, 0xc7, 0x5b, 0xf5, 0x06, 0x98, 0x0f, 0x30, 0x23, 0x54, 0x71, 0x9c, 0xeb,
+	0xea, 0x4f, 0xf0, 0x73, 0x70, 0x22, 0x69, 0x80, 0x9a, 0x24, 0xff, 0xc6, 0x6c, 0xe4, 0x73, 0xb8,
+	0x4d, 0xe2, 0x49, 0x48, 0x83, 0xf4, 0x6f, 0x16, 0x38, 0x9f, 0x3a, 0x77, 0x42, 0xc6, 0xb0, 0x2f,
+	0x8e, 0x97, 0xf1, 0xa7, 0x19, 0x33, 0x55, 0xd6, 0xd7, 0x66, 0x63, 0x36, 0x0d, 0xee, 0x10, 0x5a,
+	0x3f, 0x55, 0xc1, 0xd9, 0x74, 0xe4, 0xec, 0x08, 0xc4, 0x04, 0xf1, 0x07, 0xf1, 0xc8, 0xd1, 0xa4,
+	0x9e, 0xd2, 0xe0, 0x29, 0xd4, 0xa6, 0x7a, 0x74, 0x6d, 0xfa, 0xe0, 0x59, 0xae, 0xc1, 0xf6, 0x88,
+	0xbf, 0x4b, 0x75, 0xed, 0x37, 0x4b, 0x15, 0x2a, 0xe4, 0x69, 0xea, 0x73, 0x92, 0x1b, 0x17, 0x86,
+	0x48, 0x3f, 0x54, 0xc1, 0x4a, 0x0a, 0x6d, 0x67, 0x84, 0xf8, 0xf0, 0x46, 0x24, 0x15, 0x3e, 0xae,
+	0x4e, 0x1f, 0x62, 0x32, 0x18, 0x8a, 0xa4, 0xd3, 0xd5, 0x27, 0xe3, 0x05, 0xd4, 0xa6, 0x5e, 0x00,
+	0x05, 0x67, 0xb2, 0xfc, 0x3c, 0x46, 0xd7, 0xc3, 0x31, 0x3c, 0x7b, 0x4e, 0x6a, 0xf2, 0xca, 0x6c,
+	0x5d, 0x93, 0xd1, 0x32, 0x15, 0x39, 0x1d, 0xe5, 0xef, 0x0d, 0x61, 0x1e, 0x2d, 0x82, 0x93, 0xef,
+	0xaa, 0x9d, 0xbb, 0x23, 0x90, 0xc0, 0xf0, 0x26, 0x98, 0x0f, 0x10, 0x43, 0x63, 0x25, 0x40, 0x7d,
+	0xf3, 0x85, 0xd2, 0xe4, 0xdb, 0xd2, 0xd4, 0xcc, 0xa7, 0xbd, 0xe1, 0x07, 0x60, 0x61, 0x17, 0xe3,
+	0x5e, 0x40, 0xe9, 0x48, 0x37, 0xff, 0xc5, 0xd2, 0x48, 0x37, 0x31, 0xde, 0xa6, 0x74, 0x34, 0xd5,
+	0xec, 0xbb, 0xea, 0x0c, 0x4e, 0x80, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89, 0x47, 0x46,
+	0x6d, 0xf6, 0xb6, 0x31, 0x37, 0xb2, 0x99, 0xa9, 0xe1, 0x15, 0x59, 0xf0, 0xf8, 0xed, 0x04, 0x0c,
+	0x47, 0x84, 0x86, 0xf2, 0x0b, 0x40, 0x40, 0x39, 0x66, 0xf6, 0xdc, 0x61, 0x6f, 0x27, 0x71, 0xd9,
+	0xd6, 0x1e, 0xf0, 0xab, 0xe2, 0x75, 0xf7, 0x8c, 0x84, 0xfe, 0xf6, 0x6c, 0xd5, 0x7d, 0xdc, 0x72,
+	0x36, 0x69, 0x14, 0x6c, 0x38, 0xf8, 0xa3, 0x05, 0xd6, 0x8d, 0xe6, 0xce, 0xd6, 0x41, 0xcf, 0x4d,
+	0x37, 0x06, 0xb7, 0xe7, 0x25, 0x94, 0x6b, 0xff, 0x63, 0xeb, 0xe4, 0xd1, 0xb4, 0xa2, 0x52, 0x07,
+	0x0e, 0xbf, 0xb3, 0xc0, 0xb9, 0x0c, 0xda, 0x30, 0x1d, 0xe7, 0xa9, 0x40, 0x27, 0x24, 0xaa, 0xb7,
+	0x8e, 0xb8, 0x0e, 0xf2, 0x88, 0x56, 0xa3, 0xc7, 0x1a, 0xc3, 0x6f, 0x2c, 0xb0, 0x92, 0x81, 0x71,
+	0xd5, 0x04, 0x4e, 0x91, 0x2c, 0x48, 0x24, 0x57, 0x8f, 0x32, 0xbe, 0xf3, 0x30, 0x9e, 0x8f, 0x8a,
+	0x2d, 0xe1, 0xd7, 0x66, 0x9f, 0x4f, 0x4d, 0x47, 0x6e, 0x2f, 0x4a, 0x04, 0x57, 0x9e, 0x7c, 0x3c,
+	0xe6, 0xf3, 0x37, 0xbc, 0x22, 0x3b, 0x0e, 0x27, 0xa0, 0x51, 0x38, 0x86, 0xb8, 0x0d, 0x64, 0xf2,
+	0xd7, 0x9f, 0x74, 0x0e, 0xe5, 0x53, 0x2f, 0x17, 0x4c, 0x23, 0x0e, 0xbb, 0xc0, 0x46, 0xa1, 0xa0,
+	0x71, 0x47, 0x06, 0x34, 0xf4, 0xbd, 0x5e, 0x0a, 0x90, 0xdb, 0xf5, 0xb5, 0x5a, 0xe9, 0x6b, 0x6b,
+	0xc4, 0x9e, 0x1d, 0xed, 0x98, 0x0a, 0x60, 0x2c, 0xc8, 0xad, 0x77, 0xee, 0xee, 0x35, 0xad, 0xfb,
+	0x7b, 0x4d, 0xeb, 0xc1, 0x5e, 0xd3, 0x7a, 0xb4, 0xd7, 0xb4, 0xbe, 0xdf, 0x6f, 0x56, 0x1e, 0xec,
+	0x37, 0x2b, 0xbf, 0xef, 0x37, 0x2b, 0x9f, 0xad, 0xab, 0xa8, 0xdc, 0xbb, 0xe5, 0x10, 0xda, 0xfe,
+	0x62, 0xfa, 0xff, 0x02, 0xf9, 0x35, 0xb2, 0x3f, 0x2f, 0xbf, 0xdb, 0xbf, 0xfa, 0xdf, 0x00, 0x2e,
+	0x1c, 0x4d, 0xd6, 0xb9, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +788,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1116,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2371,6 +2390,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -50,17 +50,23 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddr_Bytes>: AutoCompoundDelegators
+//
+// - 0x0b: sdk.AccAddress
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ProposerKey                          = collections.NewPrefix(1) // key for the proposer operator address
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                           = collections.NewPrefix(0)  // key for global distribution state
+	ProposerKey                          = collections.NewPrefix(1)  // key for the proposer operator address
+	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix          = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix            = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                            = collections.NewPrefix(9)  // key for distribution module params
+	AutoCompoundDelegatorsPrefix         = collections.NewPrefix(10) // key for the delegators opted in to auto-compounding
+	AutoCompoundCursorKey                = collections.NewPrefix(11) // key for the next delegation of the auto-compounding round
 )
 
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
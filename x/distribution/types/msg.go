@@ -13,6 +13,8 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -53,3 +55,20 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new MsgWithdrawTokenizeShareRecordReward
+// withdrawing the rewards of the tokenize share records owned by the owner.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting the delegator in to,
+// or out of, the auto-compounding of its staking rewards.
+func NewMsgSetAutoCompound(delAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
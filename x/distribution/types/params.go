@@ -1,11 +1,20 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/math"
 )
 
+const (
+	// DefaultAutoCompoundEpochIdentifier restakes the auto-compounded rewards daily
+	DefaultAutoCompoundEpochIdentifier = "day"
+
+	// DefaultAutoCompoundMaxDelegationsPerBlock bounds the auto-compounding work of a block
+	DefaultAutoCompoundMaxDelegationsPerBlock uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
@@ -13,12 +22,24 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundEpochIdentifier:        DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundMaxDelegationsPerBlock: DefaultAutoCompoundMaxDelegationsPerBlock,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	// the per-block bound only matters when auto-compounding is enabled
+	if p.AutoCompoundEpochIdentifier == "" {
+		return nil
+	}
+
+	return validateAutoCompoundMaxDelegationsPerBlock(p.AutoCompoundMaxDelegationsPerBlock)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +60,16 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundMaxDelegationsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("auto-compound max delegations per block must be positive")
+	}
+
+	return nil
+}
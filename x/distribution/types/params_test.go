@@ -47,3 +47,13 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_ValidateBasicAutoCompound(t *testing.T) {
+	params := types.DefaultParams()
+	params.AutoCompoundMaxDelegationsPerBlock = 0
+	require.Error(t, params.ValidateBasic())
+
+	// the per-block bound is not checked when auto-compounding is disabled
+	params.AutoCompoundEpochIdentifier = ""
+	require.NoError(t, params.ValidateBasic())
+}
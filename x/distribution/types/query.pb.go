@@ -960,6 +960,93 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// enabled defines whether the staking rewards of the delegator are auto-compounded.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -981,6 +1068,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
 }
 
 func init() {
@@ -988,89 +1077,94 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0xe9, 0xdf, 0x2b, 0x25, 0xc9, 0xb4, 0x45, 0xee, 0xb6, 0x75, 0xdc, 0x0d, 0x6d,
+	0xa2, 0x56, 0xf1, 0xba, 0xa9, 0x28, 0x25, 0xa1, 0x94, 0xd8, 0x49, 0x69, 0xd5, 0xaa, 0x3f, 0x6e,
+	0xa1, 0xfc, 0xa8, 0xb2, 0xd6, 0xde, 0x8d, 0xb3, 0xd4, 0xde, 0x71, 0xf6, 0x27, 0x26, 0xaa, 0x7a,
+	0x29, 0x97, 0x52, 0x71, 0x40, 0x70, 0xe1, 0x06, 0x47, 0xc4, 0x89, 0x43, 0xee, 0x70, 0xac, 0xca,
+	0xa5, 0x0a, 0x12, 0xe2, 0x04, 0x55, 0x82, 0x44, 0x39, 0x80, 0xb8, 0x71, 0x45, 0x3b, 0x33, 0x6b,
+	0xef, 0xda, 0xbb, 0xeb, 0x3f, 0x7c, 0x49, 0x36, 0x6f, 0xe7, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
+	0xfd, 0x26, 0x30, 0x59, 0x24, 0x66, 0x85, 0x98, 0x92, 0xa2, 0x99, 0x96, 0xa1, 0x15, 0x6c, 0x4b,
+	0x23, 0xba, 0xb4, 0x7a, 0xaa, 0xa0, 0x5a, 0xf2, 0x29, 0x69, 0xc5, 0x56, 0x8d, 0xb5, 0x54, 0xd5,
+	0x20, 0x16, 0xc1, 0x87, 0xd8, 0xc2, 0x94, 0x77, 0x61, 0x8a, 0x2f, 0x14, 0x4e, 0x70, 0x94, 0x82,
+	0x6c, 0xaa, 0xcc, 0xab, 0x8e, 0x51, 0x95, 0x4b, 0x9a, 0x2e, 0xd3, 0xd5, 0x14, 0x48, 0xd8, 0x5f,
+	0x22, 0x25, 0x42, 0x1f, 0x25, 0xe7, 0x89, 0x5b, 0x0f, 0x97, 0x08, 0x29, 0x95, 0x55, 0x49, 0xae,
+	0x6a, 0x92, 0xac, 0xeb, 0xc4, 0xa2, 0x2e, 0x26, 0x7f, 0x9b, 0xf0, 0xe2, 0xbb, 0xc8, 0x45, 0xa2,
+	0xb9, 0x98, 0xa9, 0x28, 0x16, 0xbe, 0x8c, 0xd9, 0xfa, 0x83, 0x6c, 0x7d, 0x9e, 0xa5, 0xc1, 0x99,
+	0xb1, 0x57, 0x63, 0x72, 0x45, 0xd3, 0x89, 0x44, 0x7f, 0x32, 0x93, 0xb8, 0x1f, 0xf0, 0x0d, 0x87,
+	0xd3, 0x75, 0xd9, 0x90, 0x2b, 0x66, 0x4e, 0x5d, 0xb1, 0x55, 0xd3, 0x12, 0xef, 0xc0, 0x3e, 0x9f,
+	0xd5, 0xac, 0x12, 0xdd, 0x54, 0xf1, 0x05, 0xd8, 0x51, 0xa5, 0x96, 0x38, 0x4a, 0xa2, 0xa9, 0x3d,
+	0x33, 0x13, 0xa9, 0x88, 0xc2, 0xa5, 0x98, 0x73, 0x66, 0xf7, 0xe3, 0x5f, 0xc7, 0x87, 0xbe, 0xf9,
+	0xe3, 0xbb, 0x13, 0x28, 0xc7, 0xbd, 0xc5, 0x1a, 0x1c, 0xa3, 0xf0, 0xef, 0xc8, 0x65, 0x4d, 0x91,
+	0x2d, 0x62, 0x2c, 0x78, 0xfc, 0x2f, 0xe9, 0x4b, 0x84, 0xe7, 0x81, 0xaf, 0xc2, 0xd8, 0xaa, 0xbb,
+	0x26, 0x2f, 0x2b, 0x8a, 0xa1, 0x9a, 0x2c, 0xf6, 0xee, 0xcc, 0xd1, 0x8d, 0xf5, 0xe9, 0x23, 0x3c,
+	0x7c, 0x1d, 0x67, 0x9e, 0x2d, 0xb9, 0x69, 0x19, 0x9a, 0x5e, 0xca, 0x8d, 0xae, 0x36, 0xd9, 0xc5,
+	0xbf, 0x63, 0x70, 0xbc, 0x5d, 0x64, 0xce, 0xf5, 0x0a, 0x8c, 0x92, 0xaa, 0x6a, 0xf4, 0x16, 0x79,
+	0xc4, 0x75, 0xe5, 0x66, 0xfc, 0x00, 0xc1, 0x98, 0xa9, 0x96, 0x97, 0xf2, 0x05, 0xa2, 0x2b, 0x79,
+	0x43, 0xad, 0xc9, 0x86, 0x62, 0xc6, 0x63, 0xc9, 0x6d, 0x53, 0x7b, 0x66, 0x0e, 0xbb, 0x55, 0x74,
+	0x26, 0xa0, 0x5e, 0xbd, 0x05, 0xb5, 0x98, 0x25, 0x9a, 0x9e, 0x39, 0xeb, 0x94, 0xef, 0xdb, 0xdf,
+	0xc6, 0x4f, 0x96, 0x34, 0x6b, 0xd9, 0x2e, 0xa4, 0x8a, 0xa4, 0xc2, 0x9b, 0xca, 0x7f, 0x4d, 0x9b,
+	0xca, 0x5d, 0xc9, 0x5a, 0xab, 0xaa, 0xa6, 0xeb, 0x63, 0xb2, 0x6a, 0x8f, 0x38, 0x01, 0x33, 0x44,
+	0x57, 0x72, 0x2c, 0x1c, 0x5e, 0x01, 0x28, 0x92, 0x4a, 0x45, 0x33, 0x4d, 0x8d, 0xe8, 0xf1, 0x6d,
+	0x1d, 0x04, 0x3f, 0xdd, 0x43, 0xf0, 0x9c, 0x27, 0x88, 0xb8, 0x06, 0x93, 0xfe, 0x7a, 0x5f, 0xb3,
+	0x2d, 0xd3, 0x92, 0x75, 0xc5, 0xa9, 0x12, 0x4b, 0x6b, 0x50, 0xbd, 0xfe, 0x04, 0xc1, 0x54, 0xfb,
+	0xd8, 0xbc, 0xdb, 0x77, 0x60, 0xa7, 0xdb, 0x14, 0x36, 0xda, 0x67, 0x23, 0x47, 0x3b, 0x02, 0xd2,
+	0x3b, 0xef, 0x2e, 0xa6, 0xb8, 0x02, 0xe3, 0xfe, 0x54, 0xb2, 0xf5, 0x12, 0x0d, 0x8a, 0xfe, 0x23,
+	0x04, 0xc9, 0xf0, 0x98, 0x9c, 0xf6, 0x92, 0x6f, 0x22, 0x18, 0xf3, 0xb9, 0xce, 0x98, 0xcf, 0x17,
+	0x8b, 0x76, 0xc5, 0x2e, 0xcb, 0x96, 0xaa, 0x34, 0x80, 0xbd, 0xe4, 0xbd, 0x63, 0xf0, 0x28, 0x06,
+	0x87, 0xfd, 0xc9, 0xdc, 0x2c, 0xcb, 0xe6, 0xb2, 0x3a, 0xa8, 0xe6, 0xe3, 0x49, 0x18, 0x31, 0x2d,
+	0xd9, 0xb0, 0x34, 0xbd, 0x94, 0x5f, 0x56, 0xb5, 0xd2, 0xb2, 0x15, 0x8f, 0x25, 0xd1, 0xd4, 0x70,
+	0xee, 0x45, 0xd7, 0x7c, 0x91, 0x5a, 0xf1, 0x04, 0xec, 0x55, 0x75, 0xc5, 0xb3, 0x6c, 0x1b, 0x5d,
+	0xf6, 0x02, 0x33, 0xf2, 0x45, 0x17, 0x00, 0x1a, 0x47, 0x7d, 0x7c, 0x98, 0x96, 0xe9, 0xb8, 0x6f,
+	0xe3, 0xb0, 0xaf, 0x49, 0xe3, 0xe4, 0x2b, 0xa9, 0x9c, 0x59, 0xce, 0xe3, 0x39, 0x3b, 0xfc, 0xf0,
+	0xeb, 0xf1, 0x21, 0xf1, 0x7b, 0x04, 0x47, 0x42, 0x8a, 0xc1, 0xdb, 0xf2, 0x36, 0xec, 0x34, 0x99,
+	0x29, 0x8e, 0xe8, 0x2e, 0x4d, 0x77, 0xd6, 0x13, 0x8a, 0xb3, 0xb8, 0xaa, 0xea, 0x96, 0x6f, 0x0a,
+	0x39, 0x16, 0x7e, 0xcb, 0x47, 0x23, 0x46, 0x69, 0x4c, 0xb6, 0xa5, 0xc1, 0x72, 0xf2, 0xf2, 0x10,
+	0x7f, 0x70, 0x19, 0x2c, 0xa8, 0x65, 0xb5, 0x44, 0x6d, 0x4d, 0x9b, 0x79, 0x11, 0xc6, 0x14, 0xf6,
+	0xae, 0xa5, 0x9f, 0xf1, 0x8d, 0xf5, 0xe9, 0xfd, 0x3c, 0x68, 0x53, 0x1b, 0xeb, 0x2e, 0x6e, 0x1b,
+	0x03, 0xc7, 0x22, 0xd6, 0xf3, 0x58, 0xcc, 0xee, 0x72, 0x1a, 0xf0, 0xdc, 0x69, 0xc2, 0xe7, 0x08,
+	0x12, 0x61, 0x14, 0x78, 0x17, 0xaa, 0xde, 0x33, 0x61, 0x90, 0x07, 0x75, 0xfd, 0x98, 0xb0, 0x41,
+	0x6c, 0xca, 0xe9, 0x16, 0xb1, 0xe4, 0xf2, 0x40, 0x6a, 0xeb, 0xa9, 0xc5, 0x3f, 0x08, 0x26, 0x22,
+	0xe3, 0xf2, 0x82, 0x7c, 0xd0, 0x5c, 0x90, 0x33, 0x91, 0x63, 0xd9, 0x40, 0x5b, 0x70, 0x63, 0x33,
+	0xc4, 0xa0, 0x23, 0x12, 0x97, 0x61, 0xbb, 0xe5, 0x04, 0x1d, 0xf0, 0x47, 0x91, 0x05, 0x11, 0x0d,
+	0x7e, 0x20, 0xd7, 0x33, 0xab, 0x8f, 0xd0, 0xe0, 0xca, 0x7c, 0x05, 0x92, 0xe1, 0x31, 0x79, 0x89,
+	0x13, 0x00, 0xf5, 0xa1, 0x65, 0x55, 0xde, 0x9d, 0xf3, 0x58, 0x3c, 0x68, 0x35, 0x78, 0xd9, 0x8f,
+	0x76, 0x5b, 0xb3, 0x96, 0x15, 0x43, 0xae, 0xf1, 0xc0, 0x03, 0xa3, 0xb1, 0x0a, 0xc7, 0xda, 0x04,
+	0xe6, 0x5c, 0xb2, 0x30, 0x5a, 0xe3, 0xaf, 0x3a, 0x0e, 0x3c, 0x52, 0xf3, 0x83, 0x79, 0xe2, 0xce,
+	0xc0, 0x41, 0x1a, 0xd7, 0xf9, 0xda, 0xd8, 0xba, 0x66, 0xad, 0x5d, 0x27, 0xa4, 0xcc, 0x59, 0xce,
+	0x1e, 0xd8, 0x58, 0x9f, 0x1e, 0x69, 0xb4, 0x3e, 0x99, 0x4e, 0xbd, 0x92, 0x8e, 0x23, 0xf1, 0x2b,
+	0x04, 0x42, 0x90, 0x13, 0xcf, 0xf0, 0x43, 0x18, 0xae, 0x12, 0x52, 0x1e, 0xf0, 0xf6, 0xa6, 0x31,
+	0xc2, 0x32, 0xfc, 0x14, 0xc1, 0x51, 0x7f, 0x39, 0xe7, 0x6d, 0x8b, 0x64, 0x49, 0xa5, 0x4a, 0x6c,
+	0x5d, 0xe1, 0xf4, 0xfe, 0xaf, 0x26, 0x26, 0xdd, 0x62, 0x3a, 0x3e, 0x1f, 0xf9, 0x2e, 0x0f, 0xc9,
+	0x53, 0xa9, 0x74, 0x2a, 0x2d, 0xbe, 0x0b, 0x62, 0x54, 0x36, 0xbc, 0x6e, 0x71, 0xd8, 0xa9, 0xea,
+	0x72, 0xa1, 0xac, 0x2a, 0x34, 0x89, 0x5d, 0x39, 0xf7, 0xcf, 0xd9, 0x78, 0x18, 0xf2, 0xcc, 0xb3,
+	0x7d, 0xb0, 0x9d, 0x42, 0xe3, 0x2f, 0x11, 0xec, 0x60, 0x77, 0x03, 0x2c, 0x45, 0x1e, 0x20, 0xad,
+	0x17, 0x13, 0x21, 0xdd, 0xb9, 0x03, 0xcb, 0x55, 0x3c, 0xf9, 0xe0, 0xa7, 0xdf, 0xbf, 0x88, 0x1d,
+	0xc3, 0x13, 0x52, 0xd4, 0x3d, 0x8a, 0x5d, 0x4c, 0xf0, 0x9f, 0x08, 0x0e, 0x86, 0x5e, 0x0d, 0x70,
+	0xa6, 0x7d, 0xf0, 0x76, 0x37, 0x1a, 0x21, 0xdb, 0x17, 0x06, 0xe7, 0x94, 0xa5, 0x9c, 0xce, 0xe1,
+	0xb9, 0x48, 0x4e, 0x8d, 0x63, 0x43, 0xba, 0xd7, 0xf2, 0x15, 0xbd, 0x8f, 0x3f, 0x8e, 0xc1, 0xa1,
+	0x08, 0x1d, 0x8b, 0x17, 0xba, 0xc8, 0x34, 0x54, 0xd5, 0x0b, 0x8b, 0x7d, 0xa2, 0x70, 0xc6, 0xb7,
+	0x29, 0xe3, 0x1b, 0xf8, 0x5a, 0x1f, 0x8c, 0x25, 0xd2, 0xc0, 0x77, 0xaf, 0x60, 0x78, 0x13, 0xc1,
+	0xbe, 0x00, 0x85, 0x8c, 0x5f, 0xef, 0x22, 0xef, 0x16, 0x31, 0x2f, 0x9c, 0xeb, 0xd1, 0x9b, 0xb3,
+	0xbd, 0x4a, 0xd9, 0x5e, 0xc4, 0x17, 0xfa, 0x61, 0xdb, 0x90, 0xdf, 0xf8, 0x67, 0x04, 0xa3, 0xcd,
+	0x62, 0x13, 0xbf, 0xd6, 0x45, 0x8e, 0x7e, 0xb5, 0x2e, 0xcc, 0xf6, 0xe2, 0xca, 0xb9, 0x5d, 0xa6,
+	0xdc, 0x16, 0x71, 0xb6, 0x1f, 0x6e, 0xae, 0xa2, 0xfd, 0x0b, 0xc1, 0x58, 0x8b, 0x80, 0xc3, 0x1d,
+	0xa4, 0x17, 0x26, 0x5c, 0x85, 0xb9, 0x9e, 0x7c, 0x39, 0xb7, 0x3c, 0xe5, 0xf6, 0x1e, 0xbe, 0x1d,
+	0xc9, 0xad, 0x7e, 0x2c, 0x9b, 0xd2, 0xbd, 0x96, 0x53, 0xfd, 0xbe, 0xc4, 0x27, 0x33, 0x70, 0xcf,
+	0x3e, 0x47, 0xf0, 0x52, 0xb0, 0x48, 0xc3, 0xe7, 0xbb, 0x49, 0x3c, 0x40, 0x56, 0x0a, 0x6f, 0xf6,
+	0x0e, 0xd0, 0x55, 0x6b, 0x3b, 0xa3, 0x4f, 0x37, 0x66, 0x80, 0x52, 0xea, 0x64, 0x63, 0x86, 0x8b,
+	0x3a, 0xe1, 0x5c, 0x8f, 0xde, 0x5d, 0x6d, 0xcc, 0x36, 0x0c, 0x1b, 0xb3, 0x8d, 0xff, 0x45, 0x10,
+	0x0f, 0xd3, 0x51, 0x78, 0xbe, 0x8b, 0x5c, 0x83, 0xc5, 0x9f, 0x90, 0xe9, 0x07, 0x82, 0x73, 0xbe,
+	0x45, 0x39, 0x5f, 0xc5, 0x57, 0xfa, 0xe1, 0xdc, 0x2c, 0x04, 0x9d, 0xaf, 0xcf, 0x81, 0x40, 0x91,
+	0x81, 0xdf, 0xe8, 0x22, 0xe7, 0x00, 0xad, 0x24, 0x9c, 0xef, 0xd9, 0x9f, 0x13, 0xd6, 0x9e, 0x84,
+	0x68, 0x18, 0x5a, 0x88, 0xcb, 0xf8, 0x52, 0x3f, 0x85, 0x90, 0x6d, 0x8b, 0xe4, 0x8b, 0x2e, 0xd7,
+	0x1f, 0x11, 0xec, 0xf5, 0x49, 0x53, 0x7c, 0xa6, 0x7d, 0xf6, 0x41, 0x02, 0x58, 0x78, 0xb5, 0x6b,
+	0x3f, 0xce, 0xf6, 0xd2, 0x93, 0x56, 0x5d, 0x4a, 0x89, 0x4e, 0xe3, 0x93, 0x91, 0x44, 0x8b, 0x2e,
+	0x5c, 0xde, 0xd1, 0xb7, 0x0f, 0x63, 0x28, 0x33, 0xf7, 0x78, 0x33, 0x81, 0x9e, 0x6e, 0x26, 0xd0,
+	0xb3, 0xcd, 0x04, 0xfa, 0x6c, 0x2b, 0x31, 0xf4, 0x74, 0x2b, 0x31, 0xf4, 0xcb, 0x56, 0x62, 0xe8,
+	0xfd, 0xa3, 0x0c, 0xc8, 0x54, 0xee, 0xa6, 0x34, 0x22, 0xf9, 0x6b, 0xcb, 0x34, 0x73, 0x61, 0x07,
+	0xfd, 0x7f, 0xf4, 0xe9, 0xff, 0x06, 0x00, 0x66, 0x3a, 0x9a, 0x7f, 0xb5, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1104,6 +1198,8 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether the staking rewards of a delegator are auto-compounded.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1199,6 +1295,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1230,6 +1335,8 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether the staking rewards of a delegator are auto-compounded.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1267,6 +1374,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1437,6 +1547,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1495,6 +1623,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2212,6 +2344,69 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2515,6 +2710,31 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4352,6 +4572,158 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -652,6 +652,173 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of all the tokenize share records owned by the owner to the owner address.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetAutoCompound opts a delegator in or out of the auto-compounding of its
+// staking rewards, which restakes them to the same validator on each
+// auto-compounding epoch.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -667,6 +834,10 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
 }
 
 func init() {
@@ -674,71 +845,82 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x22, 0x22, 0xd3, 0x4a, 0x69, 0xb6, 0x29, 0x71, 0x37, 0xed, 0x3a, 0xdd, 0x42,
-	0x88, 0x22, 0xb2, 0x9b, 0x84, 0xa6, 0x15, 0x46, 0xa8, 0x22, 0x29, 0x91, 0x38, 0x6c, 0xa9, 0x1c,
-	0x01, 0x82, 0x4b, 0xb4, 0xf6, 0x2e, 0x9b, 0x51, 0xb3, 0x3b, 0xab, 0x9d, 0x71, 0x5c, 0xdf, 0x10,
-	0x42, 0xa2, 0x42, 0x1c, 0x90, 0x38, 0xd1, 0x0b, 0x3d, 0x56, 0x9c, 0x2c, 0x61, 0x89, 0xfe, 0x01,
-	0x1c, 0xaa, 0x9e, 0x2a, 0x9f, 0x10, 0x07, 0xa8, 0x9c, 0x83, 0x91, 0xf8, 0x07, 0x38, 0x21, 0xb4,
-	0xbb, 0xb3, 0x6b, 0xef, 0x0f, 0xef, 0xda, 0x21, 0x02, 0x2e, 0xb6, 0x35, 0xf3, 0xbe, 0x37, 0xdf,
-	0x7c, 0xf3, 0xcd, 0x7b, 0x63, 0xf8, 0x72, 0x1d, 0x13, 0x13, 0x13, 0x59, 0x43, 0x84, 0x3a, 0xa8,
-	0xd6, 0xa0, 0x08, 0x5b, 0xf2, 0xd1, 0x46, 0x4d, 0xa7, 0xea, 0x86, 0x4c, 0xef, 0x49, 0xb6, 0x83,
-	0x29, 0xe6, 0x16, 0xfd, 0x28, 0x69, 0x38, 0x4a, 0x62, 0x51, 0xfc, 0xbc, 0x81, 0x0d, 0xec, 0xc5,
-	0xc9, 0xee, 0x2f, 0x1f, 0xc2, 0x0b, 0x2c, 0x71, 0x4d, 0x25, 0x7a, 0x98, 0xb0, 0x8e, 0x91, 0xc5,
-	0xe6, 0x2f, 0xfa, 0xf3, 0xfb, 0x3e, 0x90, 0xe5, 0xf7, 0xa7, 0x16, 0x18, 0xd4, 0x24, 0x86, 0x7c,
-	0xb4, 0xe1, 0x7e, 0xb1, 0x89, 0x39, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x4f, 0x36, 0x24, 0x65, 0xf1,
-	0x8f, 0xd0, 0xf5, 0xe2, 0xc5, 0x3f, 0x00, 0xbc, 0xa0, 0x10, 0x63, 0x4f, 0xa7, 0x1f, 0x22, 0x7a,
-	0xa0, 0x39, 0x6a, 0xf3, 0x6d, 0x4d, 0x73, 0x74, 0x42, 0xb8, 0x77, 0xe0, 0x9c, 0xa6, 0x1f, 0xea,
-	0x86, 0x4a, 0xb1, 0xb3, 0xaf, 0xfa, 0x83, 0x25, 0xb0, 0x04, 0x56, 0x66, 0xb6, 0x4b, 0xdd, 0xce,
-	0xda, 0x3c, 0xa3, 0xc8, 0xc2, 0xf7, 0xa8, 0x83, 0x2c, 0xa3, 0x7a, 0x2e, 0x84, 0x04, 0x69, 0x76,
-	0xe0, 0xb9, 0x26, 0xcb, 0x1c, 0x66, 0x29, 0xe6, 0x64, 0x99, 0x6d, 0x46, 0xb9, 0x54, 0x76, 0xef,
-	0x3f, 0x2c, 0x17, 0x7e, 0x7f, 0x58, 0x2e, 0x7c, 0xd6, 0x6f, 0xaf, 0x26, 0x69, 0x7d, 0xd9, 0x6f,
-	0xaf, 0x5e, 0xf5, 0x33, 0xad, 0x11, 0xed, 0xae, 0xac, 0x10, 0x43, 0xc1, 0x1a, 0xfa, 0xa4, 0x15,
-	0xdb, 0x93, 0x58, 0x86, 0x97, 0x53, 0x37, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0x7f, 0x01,
-	0xc8, 0x2b, 0xc4, 0x08, 0xa6, 0x6f, 0x05, 0x2b, 0x55, 0xf5, 0xa6, 0xea, 0x68, 0xa7, 0xa5, 0xc9,
-	0x6d, 0x38, 0x77, 0xa4, 0x1e, 0x22, 0x2d, 0x92, 0xc6, 0x17, 0xe5, 0x4a, 0xb7, 0xb3, 0x76, 0x99,
-	0xa5, 0xf9, 0x20, 0x88, 0x89, 0xe5, 0x3b, 0x8a, 0x8d, 0x57, 0xde, 0xcd, 0x97, 0x67, 0x39, 0x2a,
-	0x4f, 0x6c, 0x83, 0x08, 0x5b, 0xfe, 0x0e, 0xc5, 0x1f, 0x00, 0x14, 0x47, 0x0b, 0x10, 0xe8, 0xc4,
-	0x7d, 0x05, 0xe0, 0xb4, 0x6a, 0xe2, 0x86, 0x45, 0x4b, 0x60, 0x69, 0x6a, 0xe5, 0xcc, 0xe6, 0x45,
-	0x66, 0x3c, 0xc9, 0xf5, 0x77, 0x70, 0x15, 0xa4, 0x1d, 0x8c, 0xac, 0xed, 0x8f, 0x9e, 0xfc, 0x5a,
-	0x2e, 0x7c, 0xff, 0x5b, 0x79, 0xc5, 0x40, 0xf4, 0xa0, 0x51, 0x93, 0xea, 0xd8, 0x64, 0xfe, 0x96,
-	0x87, 0x48, 0xd1, 0x96, 0xad, 0x13, 0x0f, 0x40, 0x7e, 0xe9, 0xac, 0xcd, 0x0e, 0x66, 0x96, 0xd6,
-	0xa5, 0x6b, 0x37, 0x1e, 0xf4, 0xdb, 0xab, 0x67, 0x5d, 0x2a, 0xf5, 0xd6, 0xbe, 0x7b, 0x69, 0xc8,
-	0xa3, 0x7e, 0x7b, 0x15, 0x54, 0x19, 0x07, 0xf1, 0x31, 0x80, 0xc2, 0x10, 0xeb, 0x50, 0xb8, 0x1d,
-	0x6c, 0x9a, 0x88, 0x10, 0x84, 0xad, 0x74, 0xcd, 0xc1, 0xc9, 0x35, 0x8f, 0x5a, 0x32, 0x91, 0x3a,
-	0xc5, 0x92, 0x43, 0xec, 0x06, 0xbc, 0xc4, 0x1f, 0x01, 0x5c, 0xce, 0xa6, 0xfe, 0x7f, 0x15, 0xfd,
-	0x41, 0x11, 0xce, 0x2b, 0xc4, 0xd8, 0x6d, 0x58, 0x9a, 0x4b, 0xb6, 0x61, 0x21, 0xda, 0xba, 0x83,
-	0xf1, 0x21, 0xd7, 0x1a, 0x9f, 0xe6, 0xee, 0xa4, 0x34, 0xb3, 0x39, 0x71, 0xd7, 0xe1, 0x8c, 0xa6,
-	0xdb, 0x98, 0x20, 0x8a, 0x9d, 0xdc, 0x32, 0x33, 0x08, 0xad, 0xbc, 0x17, 0x9c, 0x66, 0x37, 0x2e,
-	0xc3, 0xd6, 0xba, 0x7b, 0xc0, 0x83, 0x50, 0xf7, 0x60, 0xcb, 0xd1, 0x83, 0x4d, 0x28, 0x50, 0x02,
-	0xe2, 0x16, 0xbc, 0x94, 0x36, 0x13, 0x9c, 0x65, 0xe5, 0x42, 0xca, 0x42, 0x25, 0x20, 0x3e, 0x07,
-	0x70, 0x56, 0x21, 0xc6, 0xfb, 0xb6, 0xa6, 0x52, 0xfd, 0x8e, 0xea, 0xa8, 0x26, 0x71, 0xf7, 0xa4,
-	0x36, 0xe8, 0x01, 0x76, 0x10, 0x6d, 0xe5, 0x16, 0x9b, 0x41, 0x28, 0xb7, 0x0b, 0xa7, 0x6d, 0x2f,
-	0x83, 0x27, 0xc4, 0x99, 0xcd, 0xab, 0x52, 0x46, 0xd7, 0x92, 0xfc, 0xc5, 0xb6, 0x67, 0xdc, 0x03,
-	0x61, 0x9a, 0xfa, 0xe8, 0x8a, 0xd2, 0x4d, 0x5a, 0xc3, 0xd3, 0x24, 0x5c, 0xca, 0xd5, 0xe4, 0xd5,
-	0x21, 0x4d, 0x22, 0xcd, 0x27, 0xb6, 0x1d, 0x51, 0x82, 0x0b, 0xb1, 0xa1, 0x50, 0x94, 0xf3, 0x29,
-	0x2b, 0x89, 0x8f, 0x8b, 0x5e, 0x87, 0x8a, 0xc8, 0xb8, 0x67, 0xeb, 0x96, 0x76, 0x62, 0x61, 0x2e,
-	0xc1, 0x19, 0x47, 0xaf, 0x23, 0x1b, 0xe9, 0x16, 0xf5, 0x4d, 0x52, 0x1d, 0x0c, 0x0c, 0xb9, 0x77,
-	0xea, 0x5f, 0x76, 0x6f, 0xe5, 0xf6, 0x28, 0xf7, 0x45, 0x94, 0x5e, 0x8e, 0x2b, 0x2d, 0xa7, 0xca,
-	0x53, 0x02, 0xe2, 0x75, 0xaf, 0xdd, 0x25, 0xa7, 0xf2, 0x5c, 0xf8, 0x67, 0xd1, 0x2b, 0xa7, 0xb7,
-	0x7c, 0xcf, 0x87, 0x25, 0xc9, 0xef, 0x01, 0xc4, 0xbb, 0xe3, 0x91, 0x8b, 0x06, 0xc6, 0xbe, 0x68,
-	0xa7, 0xdd, 0xfa, 0xfe, 0xcb, 0xd3, 0xda, 0x9b, 0xa8, 0x66, 0xbc, 0x92, 0x76, 0x6a, 0x03, 0x85,
-	0x99, 0xb6, 0xe2, 0x5b, 0x5e, 0x37, 0xc8, 0x50, 0x3e, 0xe3, 0xb2, 0x6c, 0xad, 0x6f, 0xfe, 0xf4,
-	0x22, 0x9c, 0x52, 0x88, 0xc1, 0x7d, 0x0e, 0x20, 0x97, 0xf2, 0xa6, 0xdb, 0xcc, 0x2c, 0x01, 0xa9,
-	0x4f, 0x23, 0xbe, 0x32, 0x39, 0x26, 0xec, 0x58, 0xdf, 0x00, 0xb8, 0x30, 0xea, 0x2d, 0x75, 0x23,
-	0x2f, 0xef, 0x08, 0x20, 0x7f, 0xf3, 0x84, 0xc0, 0x90, 0xd5, 0x77, 0x00, 0x2e, 0x66, 0x3d, 0x15,
-	0xde, 0x1c, 0x77, 0x81, 0x14, 0x30, 0xbf, 0xf3, 0x0f, 0xc0, 0x21, 0xc3, 0x6f, 0x01, 0x9c, 0x4b,
-	0xf6, 0xd5, 0x8d, 0xbc, 0xd4, 0x09, 0x08, 0xff, 0xc6, 0xc4, 0x90, 0xf0, 0x29, 0xfc, 0xd2, 0xd3,
-	0xa4, 0xbf, 0xee, 0x17, 0x01, 0xf7, 0x05, 0x80, 0x67, 0x23, 0xfd, 0xe9, 0xb5, 0xbc, 0x35, 0x86,
-	0xa3, 0xf9, 0x6b, 0x93, 0x44, 0x87, 0x64, 0xce, 0x3f, 0x4d, 0x76, 0x06, 0x57, 0x25, 0x2e, 0xa5,
-	0x2d, 0xe4, 0x9a, 0x3c, 0x89, 0xc9, 0x37, 0xf9, 0xe8, 0x22, 0x9a, 0xc2, 0x6d, 0x6b, 0x9d, 0xeb,
-	0x00, 0xb8, 0x98, 0x55, 0x3f, 0x73, 0x3d, 0x96, 0x01, 0xce, 0xf7, 0xd8, 0x18, 0xf5, 0x23, 0x95,
-	0x36, 0xff, 0xc2, 0xa7, 0x6e, 0x89, 0xdb, 0xbe, 0xf9, 0xa8, 0x27, 0x80, 0x27, 0x3d, 0x01, 0x3c,
-	0xeb, 0x09, 0xe0, 0x79, 0x4f, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0x67, 0xc7, 0x42, 0xe1, 0xe7, 0x63,
-	0xa1, 0xf0, 0xf1, 0x15, 0x1f, 0x45, 0xb4, 0xbb, 0x12, 0xc2, 0xf2, 0xbd, 0x68, 0xbb, 0xf7, 0xea,
-	0x67, 0x6d, 0xda, 0xfb, 0x77, 0xf9, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xd3, 0xe1,
-	0xa5, 0x4f, 0x0f, 0x00, 0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x24, 0x22, 0x90, 0x49, 0x50, 0x9a, 0x6d, 0x4a, 0xdc, 0x4d, 0x63, 0x27, 0xdb, 0x10,
+	0xa2, 0xa8, 0xd9, 0xb5, 0x43, 0x93, 0x88, 0x45, 0x55, 0x95, 0xb8, 0x44, 0xea, 0xc1, 0xa5, 0xb2,
+	0xf9, 0xe6, 0x10, 0xad, 0xbd, 0xc3, 0x66, 0x94, 0x78, 0xc7, 0xda, 0x59, 0xc7, 0x35, 0x27, 0x84,
+	0x90, 0xa8, 0x10, 0x07, 0x24, 0xc4, 0x81, 0x5e, 0xe8, 0xb1, 0xea, 0x29, 0x12, 0x91, 0xe8, 0x19,
+	0x2e, 0x55, 0x38, 0x50, 0xe5, 0x84, 0x38, 0x40, 0x95, 0x1c, 0x82, 0xc4, 0x1f, 0xe0, 0x84, 0xd0,
+	0x7e, 0x7a, 0xbf, 0xbc, 0x6b, 0x27, 0x11, 0xe5, 0x92, 0x8f, 0x99, 0xf7, 0x79, 0xe6, 0x99, 0x67,
+	0xde, 0x99, 0xf7, 0xb5, 0xe1, 0x4c, 0x95, 0xd0, 0x1a, 0xa1, 0x82, 0x8c, 0xa9, 0xae, 0xe1, 0x4a,
+	0x43, 0xc7, 0x44, 0x15, 0x76, 0xf2, 0x15, 0xa4, 0x4b, 0x79, 0x41, 0xbf, 0xc3, 0xd7, 0x35, 0xa2,
+	0x13, 0x66, 0xc2, 0x8a, 0xe2, 0xbd, 0x51, 0xbc, 0x1d, 0xc5, 0x8e, 0x29, 0x44, 0x21, 0x66, 0x9c,
+	0x60, 0xfc, 0x65, 0x41, 0xd8, 0x8c, 0x4d, 0x5c, 0x91, 0x28, 0x72, 0x09, 0xab, 0x04, 0xab, 0xf6,
+	0xfc, 0x45, 0x6b, 0x7e, 0xc3, 0x02, 0xda, 0xfc, 0xd6, 0xd4, 0xb8, 0x0d, 0xad, 0x51, 0x45, 0xd8,
+	0xc9, 0x1b, 0xbf, 0xec, 0x89, 0x51, 0xa9, 0x86, 0x55, 0x22, 0x98, 0x3f, 0xed, 0x21, 0x3e, 0x4e,
+	0xbf, 0x4f, 0xae, 0x19, 0xcf, 0xfd, 0x05, 0xe0, 0x85, 0x22, 0x55, 0xca, 0x48, 0x7f, 0x17, 0xeb,
+	0x9b, 0xb2, 0x26, 0x35, 0x57, 0x65, 0x59, 0x43, 0x94, 0x32, 0x6f, 0xc0, 0x51, 0x19, 0x6d, 0x23,
+	0x45, 0xd2, 0x89, 0xb6, 0x21, 0x59, 0x83, 0x69, 0x30, 0x05, 0xe6, 0x06, 0xd7, 0xd2, 0x07, 0x7b,
+	0x0b, 0x63, 0xb6, 0x44, 0x3b, 0xbc, 0xac, 0x6b, 0x58, 0x55, 0x4a, 0xe7, 0x5c, 0x88, 0x43, 0x53,
+	0x80, 0xe7, 0x9a, 0x36, 0xb3, 0xcb, 0xd2, 0x97, 0xc0, 0x32, 0xd2, 0xf4, 0x6b, 0x11, 0xd7, 0xef,
+	0xde, 0xcf, 0xa6, 0xfe, 0xbc, 0x9f, 0x4d, 0x7d, 0x7a, 0xbc, 0x3b, 0x1f, 0x96, 0xf5, 0xc5, 0xf1,
+	0xee, 0xfc, 0x65, 0x8b, 0x69, 0x81, 0xca, 0x5b, 0x42, 0x91, 0x2a, 0x45, 0x22, 0xe3, 0x8f, 0x5a,
+	0x81, 0x3d, 0x71, 0x59, 0x38, 0x19, 0xb9, 0xd9, 0x12, 0xa2, 0x75, 0xa2, 0x52, 0xc4, 0xfd, 0x03,
+	0x20, 0x5b, 0xa4, 0x8a, 0x33, 0x7d, 0xc3, 0x59, 0xa9, 0x84, 0x9a, 0x92, 0x26, 0x9f, 0x95, 0x27,
+	0xb7, 0xe0, 0xe8, 0x8e, 0xb4, 0x8d, 0x65, 0x1f, 0x8d, 0x65, 0xca, 0xf4, 0xc1, 0xde, 0xc2, 0xa4,
+	0x4d, 0xf3, 0x8e, 0x13, 0x13, 0xe0, 0xdb, 0x09, 0x8c, 0x8b, 0x37, 0x93, 0xed, 0x99, 0xf5, 0xdb,
+	0x13, 0xd8, 0x20, 0x26, 0xaa, 0xb5, 0x43, 0xee, 0x7b, 0x00, 0xb9, 0xce, 0x06, 0x38, 0x3e, 0x31,
+	0x5f, 0x02, 0x38, 0x20, 0xd5, 0x48, 0x43, 0xd5, 0xd3, 0x60, 0xaa, 0x7f, 0x6e, 0x68, 0xf1, 0xa2,
+	0x9d, 0x78, 0xbc, 0x91, 0xdf, 0xce, 0x55, 0xe0, 0x0b, 0x04, 0xab, 0x6b, 0xef, 0x3f, 0xfe, 0x3d,
+	0x9b, 0x7a, 0xf8, 0x47, 0x76, 0x4e, 0xc1, 0xfa, 0x66, 0xa3, 0xc2, 0x57, 0x49, 0xcd, 0xce, 0x6f,
+	0xc1, 0x23, 0x4a, 0x6f, 0xd5, 0x11, 0x35, 0x01, 0xf4, 0xb7, 0xbd, 0x85, 0x91, 0xf6, 0xcc, 0x54,
+	0x8e, 0xbf, 0xba, 0x72, 0xef, 0x78, 0x77, 0x7e, 0xd8, 0x90, 0x52, 0x6d, 0x6d, 0x18, 0x97, 0x86,
+	0x3e, 0x38, 0xde, 0x9d, 0x07, 0x25, 0x5b, 0x03, 0xf7, 0x08, 0xc0, 0x8c, 0x47, 0xb5, 0x6b, 0x5c,
+	0x81, 0xd4, 0x6a, 0x98, 0x52, 0x4c, 0xd4, 0x68, 0xcf, 0xc1, 0xc9, 0x3d, 0xf7, 0xa7, 0x64, 0x88,
+	0x3a, 0x22, 0x25, 0x3d, 0xea, 0xda, 0xba, 0xb8, 0x1f, 0x00, 0x9c, 0x8d, 0x97, 0xfe, 0x7f, 0x35,
+	0xfd, 0x5e, 0x1f, 0x1c, 0x2b, 0x52, 0x65, 0xbd, 0xa1, 0xca, 0x86, 0xd8, 0x86, 0x8a, 0xf5, 0xd6,
+	0x6d, 0x42, 0xb6, 0x99, 0x56, 0xf7, 0x32, 0xd7, 0x7b, 0x95, 0x19, 0xaf, 0x89, 0x59, 0x86, 0x83,
+	0x32, 0xaa, 0x13, 0x8a, 0x75, 0xa2, 0x25, 0x3e, 0x33, 0xed, 0x50, 0xf1, 0x4d, 0xe7, 0x34, 0x0f,
+	0x82, 0x36, 0x2c, 0xe5, 0x8c, 0x03, 0x6e, 0x87, 0x1a, 0x07, 0x9b, 0xf5, 0x1f, 0x6c, 0xc8, 0x81,
+	0x34, 0xe0, 0x96, 0xe0, 0xa5, 0xa8, 0x19, 0xe7, 0x2c, 0xc5, 0x0b, 0x11, 0x0b, 0xa5, 0x01, 0xf7,
+	0x14, 0xc0, 0x91, 0x22, 0x55, 0xde, 0xae, 0xcb, 0x92, 0x8e, 0x6e, 0x4b, 0x9a, 0x54, 0xa3, 0xc6,
+	0x9e, 0xa4, 0x86, 0xbe, 0x49, 0x34, 0xac, 0xb7, 0x12, 0x1f, 0x9b, 0x76, 0x28, 0xb3, 0x0e, 0x07,
+	0xea, 0x26, 0x83, 0x69, 0xc4, 0xd0, 0xe2, 0x65, 0x3e, 0xa6, 0x6a, 0xf1, 0xd6, 0x62, 0x6b, 0x83,
+	0xc6, 0x81, 0xd8, 0x9e, 0x5a, 0x68, 0xb1, 0x78, 0x10, 0x4e, 0x0d, 0xd3, 0x13, 0x77, 0x29, 0xc3,
+	0x93, 0x57, 0x3c, 0x9e, 0xf8, 0x8a, 0x4f, 0x60, 0x3b, 0x1c, 0x0f, 0xc7, 0x03, 0x43, 0xae, 0x29,
+	0xe7, 0x23, 0x56, 0xe2, 0x1e, 0xf5, 0x99, 0x15, 0xca, 0x67, 0x63, 0xb9, 0x8e, 0x54, 0xf9, 0xc4,
+	0xc6, 0x5c, 0x82, 0x83, 0x1a, 0xaa, 0xe2, 0x3a, 0x46, 0xaa, 0x6e, 0x25, 0x49, 0xa9, 0x3d, 0xe0,
+	0xc9, 0xde, 0xfe, 0xff, 0x38, 0x7b, 0xc5, 0x5b, 0x9d, 0xb2, 0xcf, 0xe7, 0xf4, 0x6c, 0xd0, 0x69,
+	0x21, 0xd2, 0x9e, 0x34, 0xe0, 0x96, 0xe1, 0x64, 0xe4, 0x54, 0x52, 0x16, 0xfe, 0xdd, 0x67, 0x3e,
+	0xa7, 0x37, 0xac, 0x9c, 0x77, 0x9f, 0x24, 0xab, 0x06, 0x50, 0xf3, 0x8e, 0xfb, 0x2e, 0x1a, 0xe8,
+	0xfa, 0xa2, 0x9d, 0x75, 0xe9, 0x7b, 0x96, 0xa7, 0x55, 0xee, 0xe9, 0xcd, 0x78, 0x39, 0xea, 0xd4,
+	0xda, 0x0e, 0xdb, 0xde, 0x72, 0xd7, 0xe0, 0xac, 0x6f, 0x3c, 0xe4, 0x7c, 0xcc, 0x65, 0x59, 0xca,
+	0x71, 0x3f, 0x02, 0x38, 0xe3, 0xa9, 0x26, 0x6f, 0x91, 0x2d, 0xa4, 0xe2, 0x8f, 0x51, 0x79, 0x53,
+	0xd2, 0x50, 0x09, 0x55, 0x89, 0x26, 0x5b, 0x54, 0xcc, 0x35, 0xf8, 0x22, 0x69, 0xaa, 0xa8, 0xfb,
+	0x2e, 0x66, 0xd8, 0x0c, 0x77, 0xaa, 0xdf, 0x87, 0x9e, 0xbd, 0x8f, 0xdd, 0xf1, 0x5d, 0xf8, 0xa9,
+	0x3c, 0x9f, 0xe3, 0x4d, 0x03, 0xfc, 0x2b, 0x18, 0x26, 0xcc, 0x44, 0x57, 0x44, 0x47, 0xa6, 0xdd,
+	0x83, 0xfc, 0x04, 0xe0, 0x95, 0x6e, 0x36, 0xe1, 0x16, 0xc6, 0x67, 0x57, 0x70, 0xc4, 0x74, 0x27,
+	0x03, 0xb8, 0x5f, 0x00, 0x64, 0xac, 0x66, 0x73, 0xb5, 0xa1, 0x93, 0x02, 0xa9, 0xd5, 0x49, 0x43,
+	0x3d, 0xb3, 0x16, 0x32, 0x0d, 0x9f, 0x47, 0xaa, 0x54, 0xd9, 0x46, 0xb2, 0x79, 0x7b, 0x5e, 0x28,
+	0x39, 0xff, 0x8a, 0xef, 0x75, 0x73, 0x34, 0xd1, 0x4d, 0xe2, 0xa4, 0xff, 0x78, 0x02, 0xd2, 0xb9,
+	0x65, 0xc8, 0x86, 0x47, 0xdd, 0x7c, 0xec, 0xe8, 0xc4, 0xe2, 0xc3, 0x21, 0xd8, 0x5f, 0xa4, 0x0a,
+	0xf3, 0x19, 0x80, 0x4c, 0xc4, 0x07, 0x8d, 0xc5, 0xd8, 0xba, 0x14, 0xd9, 0xaf, 0xb3, 0x62, 0xef,
+	0x18, 0x37, 0x5b, 0xbe, 0x06, 0x70, 0xbc, 0x53, 0x83, 0xbf, 0x92, 0xc4, 0xdb, 0x01, 0xc8, 0x5e,
+	0x3f, 0x21, 0xd0, 0x55, 0xf5, 0x1d, 0x80, 0x13, 0x71, 0xfd, 0xeb, 0xeb, 0xdd, 0x2e, 0x10, 0x01,
+	0x66, 0x0b, 0xa7, 0x00, 0xbb, 0x0a, 0xbf, 0x05, 0x70, 0x34, 0xdc, 0xec, 0xe5, 0x93, 0xa8, 0x43,
+	0x10, 0xf6, 0xb5, 0x9e, 0x21, 0xee, 0xe7, 0xb3, 0x97, 0xf6, 0xc3, 0x8f, 0xde, 0xdd, 0x3e, 0xc0,
+	0x7c, 0x0e, 0xe0, 0xb0, 0xaf, 0x69, 0xba, 0x92, 0xb4, 0x86, 0x37, 0x9a, 0xbd, 0xda, 0x4b, 0xb4,
+	0x2b, 0xe6, 0xfc, 0x7e, 0xb8, 0x5d, 0x31, 0x5c, 0x62, 0x22, 0x7a, 0x95, 0xc4, 0x24, 0x0f, 0x63,
+	0x58, 0xb1, 0x77, 0x4c, 0x8c, 0xb6, 0xa5, 0x1c, 0xb3, 0x07, 0xe0, 0x44, 0x5c, 0x51, 0x4f, 0xcc,
+	0xb1, 0x18, 0x30, 0x5b, 0x38, 0x05, 0x38, 0x5e, 0xf6, 0xcf, 0x00, 0x4e, 0x27, 0x57, 0xb4, 0xd5,
+	0x6e, 0x73, 0xbc, 0x23, 0x05, 0x7b, 0xf3, 0xd4, 0x14, 0xee, 0x46, 0xd2, 0xfb, 0x1d, 0x5e, 0x43,
+	0xe6, 0x1b, 0x00, 0x47, 0x82, 0x45, 0x41, 0xe8, 0xe2, 0x39, 0xf3, 0x02, 0xd8, 0x95, 0x1e, 0x01,
+	0xc9, 0xba, 0xd8, 0xe7, 0x3e, 0x31, 0x0a, 0xdb, 0xda, 0xf5, 0x07, 0x87, 0x19, 0xf0, 0xf8, 0x30,
+	0x03, 0x9e, 0x1c, 0x66, 0xc0, 0xd3, 0xc3, 0x0c, 0xf8, 0xea, 0x28, 0x93, 0x7a, 0x72, 0x94, 0x49,
+	0xfd, 0x7a, 0x94, 0x49, 0x7d, 0x30, 0x6d, 0x2d, 0x4b, 0xe5, 0x2d, 0x1e, 0x13, 0xc1, 0xcf, 0x63,
+	0x55, 0xcd, 0xca, 0x80, 0xf9, 0xc5, 0xd2, 0xab, 0xff, 0x0e, 0x00, 0x37, 0x67, 0x4a, 0x6f, 0x4a,
+	0x13, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -966,6 +1148,56 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1006,6 +1238,12 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of all the tokenize share records owned by an address.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to opt in or out of the auto-compounding
+	// of the staking rewards of a delegator.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -1080,6 +1318,24 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1109,6 +1365,12 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of all the tokenize share records owned by an address.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to opt in or out of the auto-compounding
+	// of the staking rewards of a delegator.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1136,6 +1398,12 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1267,6 +1535,42 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1299,6 +1603,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1783,69 +2095,199 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
@@ -1996,6 +2438,59 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3257,6 +3752,324 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
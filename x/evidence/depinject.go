@@ -28,6 +28,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
@@ -47,6 +48,11 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	router := types.NewRouter()
+	if in.Config.EnableVoteExtensionEquivocation {
+		router = router.AddRoute(types.RouteVoteExtensionEquivocation, k.VoteExtensionEquivocationHandler())
+	}
+	k.SetRouter(router)
 	m := NewAppModule(in.Cdc, *k, in.CometService, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/errors"
 	consensusv1 "cosmossdk.io/x/consensus/types"
+	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -113,6 +115,137 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		"infraction_time", infractionTime,
 	)
 
+	if err := k.slashEquivocation(ctx, validator, consAddr, evidence.GetValidatorPower(), infractionHeight); err != nil {
+		return err
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// VoteExtensionEquivocationHandler returns the evidence handler of vote extension
+// equivocations, it must be registered in the evidence router under the
+// RouteVoteExtensionEquivocation route.
+func (k Keeper) VoteExtensionEquivocationHandler() types.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.VoteExtensionEquivocation)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+		if err := evidence.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return k.handleVoteExtensionEquivocationEvidence(ctx, evidence)
+	}
+}
+
+// handleVoteExtensionEquivocationEvidence implements a vote extension equivocation
+// evidence handler. Assuming the precommit and both conflicting vote extensions are
+// signed by the consensus key which the validator used at the evidence height,
+// according to its consensus key rotation history, the validator is slashed, jailed
+// and tombstoned like for a double sign.
+//
+// As CometBFT re-signs the vote extension of a precommit when a validator restarts
+// at the same height and round, the handler must only be registered by applications
+// whose vote extensions are deterministic.
+//
+// As this evidence is submitted by users and not by CometBFT, an error is returned
+// if the evidence is invalid, too old or cannot be handled.
+func (k Keeper) handleVoteExtensionEquivocationEvidence(ctx context.Context, evidence *types.VoteExtensionEquivocation) error {
+	consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(evidence.ConsensusAddress)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded", evidence.ConsensusAddress)
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	infractionHeight := evidence.GetHeight()
+	if infractionHeight >= headerInfo.Height {
+		return fmt.Errorf("infraction height %d must be lower than the current height %d", infractionHeight, headerInfo.Height)
+	}
+
+	// Reject evidence if the vote extensions are too old. As the time of the vote
+	// extensions is unknown, only the number of blocks is taken into account.
+	var res consensusv1.QueryParamsResponse
+	if err := k.QueryRouterService.InvokeTyped(ctx, &consensusv1.QueryParamsRequest{}, &res); err != nil {
+		return fmt.Errorf("failed to query consensus params: %w", err)
+	}
+	if res.Params.Evidence != nil && headerInfo.Height-infractionHeight > res.Params.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf("evidence too old; infraction height %d, max age num blocks %d", infractionHeight, res.Params.Evidence.MaxAgeNumBlocks)
+	}
+
+	// The vote extensions must be signed by the consensus key the validator used at
+	// the infraction height, which could have been rotated since.
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+	pubKey, err := k.stakingKeeper.ValidatorConsPubKeyAtHeight(ctx, valAddr, infractionHeight)
+	if err != nil {
+		return err
+	}
+	if !sdk.ConsAddress(pubKey.Address()).Equals(sdk.ConsAddress(consAddr)) {
+		return errors.Wrapf(types.ErrInvalidVoteExtensionSig, "validator consensus key at height %d does not match %s", infractionHeight, evidence.ConsensusAddress)
+	}
+
+	// The precommit proves that the vote extensions were signed for a block at the
+	// given height and round, as precommits for no block do not carry any.
+	signBytes, err := types.PrecommitSignBytes(headerInfo.ChainID, infractionHeight, evidence.Round, evidence.BlockID, evidence.Timestamp)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, evidence.Signature) {
+		return errors.Wrapf(types.ErrInvalidVoteExtensionSig, "failed to verify precommit signature of %s", evidence.ConsensusAddress)
+	}
+
+	for _, ext := range []struct{ extension, signature []byte }{
+		{evidence.VoteExtensionA, evidence.ExtensionSignatureA},
+		{evidence.VoteExtensionB, evidence.ExtensionSignatureB},
+	} {
+		signBytes, err := types.VoteExtensionSignBytes(headerInfo.ChainID, infractionHeight, evidence.Round, ext.extension)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, ext.signature) {
+			return errors.Wrapf(types.ErrInvalidVoteExtensionSig, "failed to verify vote extension signature of %s", evidence.ConsensusAddress)
+		}
+	}
+
+	// Get the consAddr from the validator read from the store and not from the evidence,
+	// because if the validator has rotated its key, the key in evidence could be outdated.
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, valConsAddr); !ok {
+		return fmt.Errorf("expected signing info for validator %s but not found", sdk.ConsAddress(valConsAddr))
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		return fmt.Errorf("validator %s already tombstoned", sdk.ConsAddress(valConsAddr))
+	}
+
+	k.Logger.Info(
+		"confirmed vote extension equivocation",
+		"validator", sdk.ConsAddress(valConsAddr),
+		"infraction_height", infractionHeight,
+		"infraction_round", evidence.Round,
+	)
+
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	return k.slashEquivocation(ctx, validator, valConsAddr, power, infractionHeight)
+}
+
+// slashEquivocation slashes the validator committing an equivocation at the given
+// height with the double sign slash fraction, jails it and tombstones it.
+func (k Keeper) slashEquivocation(ctx context.Context, validator sdk.ValidatorI, consAddr sdk.ConsAddress, power, infractionHeight int64) error {
 	// We need to retrieve the stake distribution which signed the block, so we
 	// subtract ValidatorUpdateDelay from the evidence height.
 	// Note, that this *can* result in a negative "distributionHeight", up to
@@ -134,7 +267,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		ctx,
 		consAddr,
 		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 	if err != nil {
@@ -155,9 +288,5 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return err
 	}
 
-	err = k.slashingKeeper.Tombstone(ctx, consAddr)
-	if err != nil {
-		return err
-	}
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	return k.slashingKeeper.Tombstone(ctx, consAddr)
 }
@@ -16,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence")
 	cdc.RegisterConcrete(&Equivocation{}, "cosmos-sdk/Equivocation")
+	cdc.RegisterConcrete(&VoteExtensionEquivocation{}, "cosmos-sdk/VoteExtensionEquivocation")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -25,6 +26,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.evidence.v1beta1.Evidence",
 		(*exported.Evidence)(nil),
 		&Equivocation{},
+		&VoteExtensionEquivocation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -7,4 +7,5 @@ var (
 	ErrNoEvidenceHandlerExists = errors.Register(ModuleName, 2, "unregistered handler for evidence type")
 	ErrInvalidEvidence         = errors.Register(ModuleName, 3, "invalid evidence")
 	ErrEvidenceExists          = errors.Register(ModuleName, 5, "evidence already exists")
+	ErrInvalidVoteExtensionSig = errors.Register(ModuleName, 6, "invalid vote extension signature")
 )
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	protoio "github.com/cosmos/gogoproto/io"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/x/evidence/exported"
@@ -13,9 +17,15 @@ import (
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation              = "equivocation"
+	RouteVoteExtensionEquivocation = "voteextensionequivocation"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &VoteExtensionEquivocation{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -91,3 +101,109 @@ func FromABCIEvidence(e comet.Evidence, conAc address.Codec) *Equivocation {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a VoteExtensionEquivocation type.
+func (e *VoteExtensionEquivocation) Route() string { return RouteVoteExtensionEquivocation }
+
+// Hash returns the hash of a VoteExtensionEquivocation object.
+func (e *VoteExtensionEquivocation) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return hash[:]
+}
+
+// ValidateBasic performs basic stateless validation checks on a VoteExtensionEquivocation
+// object.
+func (e *VoteExtensionEquivocation) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid vote extension equivocation height: %d", e.Height)
+	}
+	if e.Round < 0 {
+		return fmt.Errorf("invalid vote extension equivocation round: %d", e.Round)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid vote extension equivocation validator consensus address: %s", e.ConsensusAddress)
+	}
+	if len(e.Signature) == 0 {
+		return fmt.Errorf("precommit signature cannot be empty")
+	}
+	if len(e.ExtensionSignatureA) == 0 || len(e.ExtensionSignatureB) == 0 {
+		return fmt.Errorf("vote extension signatures cannot be empty")
+	}
+	if isNilBlockID(e.BlockID) {
+		return fmt.Errorf("precommits carrying vote extensions cannot be for a nil block")
+	}
+	if bytes.Equal(e.VoteExtensionA, e.VoteExtensionB) {
+		return fmt.Errorf("vote extensions must conflict")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address which signed the
+// conflicting vote extensions.
+func (e VoteExtensionEquivocation) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height of the conflicting vote extensions.
+func (e VoteExtensionEquivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// PrecommitSignBytes returns the bytes signed by a validator for a precommit, i.e. the
+// length-delimited CometBFT canonical vote.
+func PrecommitSignBytes(chainID string, height, round int64, blockID cmtproto.BlockID, timestamp time.Time) ([]byte, error) {
+	cv := cmtproto.CanonicalVote{
+		Type:      cmtproto.PrecommitType,
+		Height:    height,
+		Round:     round,
+		Timestamp: timestamp,
+		ChainID:   chainID,
+	}
+	if !isNilBlockID(blockID) {
+		cv.BlockID = &cmtproto.CanonicalBlockID{
+			Hash: blockID.Hash,
+			PartSetHeader: cmtproto.CanonicalPartSetHeader{
+				Total: blockID.PartSetHeader.Total,
+				Hash:  blockID.PartSetHeader.Hash,
+			},
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cv); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isNilBlockID reports whether a block ID is the one of a vote for no block.
+func isNilBlockID(blockID cmtproto.BlockID) bool {
+	return len(blockID.Hash) == 0 && blockID.PartSetHeader.Total == 0 && len(blockID.PartSetHeader.Hash) == 0
+}
+
+// VoteExtensionSignBytes returns the bytes signed by a validator for a vote extension,
+// i.e. the length-delimited CometBFT canonical vote extension.
+func VoteExtensionSignBytes(chainID string, height, round int64, extension []byte) ([]byte, error) {
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		Round:     round,
+		ChainId:   chainID,
+	}
+
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cve); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -75,8 +76,74 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// VoteExtensionEquivocation implements the Evidence interface and defines evidence of a
+// validator signing two conflicting vote extensions at the same height and round, along
+// with the precommit carrying them.
+//
+// CometBFT re-signs the vote extension of a precommit when a validator restarts at the
+// same height and round, so conflicting vote extensions can only be attributed to a
+// misbehavior if the vote extensions of the application are deterministic. The handler of
+// this evidence is therefore only registered by the applications enabling it.
+type VoteExtensionEquivocation struct {
+	// height is the height of the conflicting vote extensions.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// round is the round of the conflicting vote extensions.
+	Round int64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// consensus_address is the consensus address of the validator which signed the
+	// conflicting vote extensions.
+	ConsensusAddress string `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// block_id is the block ID of the precommit carrying the vote extensions.
+	BlockID v1.BlockID `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	// timestamp is the timestamp of the precommit.
+	Timestamp time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// signature is the signature of the precommit.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// vote_extension_a is the first vote extension.
+	VoteExtensionA []byte `protobuf:"bytes,7,opt,name=vote_extension_a,json=voteExtensionA,proto3" json:"vote_extension_a,omitempty"`
+	// extension_signature_a is the signature of the first vote extension.
+	ExtensionSignatureA []byte `protobuf:"bytes,8,opt,name=extension_signature_a,json=extensionSignatureA,proto3" json:"extension_signature_a,omitempty"`
+	// vote_extension_b is the second vote extension, it must differ from the first one.
+	VoteExtensionB []byte `protobuf:"bytes,9,opt,name=vote_extension_b,json=voteExtensionB,proto3" json:"vote_extension_b,omitempty"`
+	// extension_signature_b is the signature of the second vote extension.
+	ExtensionSignatureB []byte `protobuf:"bytes,10,opt,name=extension_signature_b,json=extensionSignatureB,proto3" json:"extension_signature_b,omitempty"`
+}
+
+func (m *VoteExtensionEquivocation) Reset()         { *m = VoteExtensionEquivocation{} }
+func (m *VoteExtensionEquivocation) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionEquivocation) ProtoMessage()    {}
+func (*VoteExtensionEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *VoteExtensionEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionEquivocation.Merge(m, src)
+}
+func (m *VoteExtensionEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionEquivocation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*VoteExtensionEquivocation)(nil), "cosmos.evidence.v1beta1.VoteExtensionEquivocation")
 }
 
 func init() {
@@ -84,29 +151,43 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xf6, 0x92, 0xb4, 0x69, 0x96, 0x82, 0x52, 0x13, 0x60, 0x1b, 0x51, 0x3b, 0x54, 0x15, 0x8a,
+	0x2a, 0xc5, 0x6e, 0xca, 0xad, 0x88, 0x43, 0x0c, 0x11, 0xaa, 0x90, 0x38, 0xa4, 0x88, 0x03, 0x17,
+	0xcb, 0x3f, 0x5b, 0xd7, 0x4a, 0xe3, 0x0d, 0xde, 0x8d, 0x29, 0x6f, 0x80, 0x38, 0xf5, 0x11, 0x7a,
+	0xec, 0xb1, 0x87, 0x3c, 0x44, 0x8f, 0x51, 0x4e, 0x9c, 0x0a, 0x4a, 0x0e, 0xed, 0x63, 0xa0, 0xec,
+	0xae, 0x9d, 0x08, 0x25, 0x12, 0x5c, 0xac, 0x9d, 0x99, 0x6f, 0xbe, 0xf9, 0xe6, 0xc7, 0xf0, 0x85,
+	0x47, 0x68, 0x97, 0x50, 0x13, 0x27, 0xa1, 0x8f, 0x23, 0x0f, 0x9b, 0x49, 0xc3, 0xc5, 0xcc, 0x69,
+	0x64, 0x0e, 0xa3, 0x17, 0x13, 0x46, 0xd4, 0xa7, 0x02, 0x67, 0x64, 0x6e, 0x89, 0xab, 0x6c, 0x38,
+	0xdd, 0x30, 0x22, 0x26, 0xff, 0x0a, 0x6c, 0xa5, 0x1c, 0x90, 0x80, 0xf0, 0xa7, 0x39, 0x7d, 0x49,
+	0xaf, 0x1e, 0x10, 0x12, 0x9c, 0x62, 0x93, 0x5b, 0x6e, 0xff, 0xd8, 0x64, 0x61, 0x17, 0x53, 0xe6,
+	0x74, 0x7b, 0x12, 0xb0, 0x29, 0x4a, 0xd8, 0x22, 0x53, 0xd6, 0x13, 0xa1, 0x2d, 0x8f, 0x74, 0x31,
+	0x73, 0x8f, 0x99, 0xc9, 0xbe, 0xf5, 0x30, 0x35, 0x93, 0x86, 0x78, 0x88, 0xf0, 0xf6, 0x1d, 0x80,
+	0xeb, 0xad, 0x2f, 0xfd, 0x30, 0x21, 0x9e, 0xc3, 0x42, 0x12, 0xa9, 0x4f, 0xe0, 0xea, 0x09, 0x0e,
+	0x83, 0x13, 0x86, 0x40, 0x15, 0xd4, 0x72, 0x6d, 0x69, 0xa9, 0xaf, 0x61, 0x7e, 0x5a, 0x15, 0xdd,
+	0xab, 0x82, 0xda, 0xfd, 0xfd, 0x8a, 0x21, 0x24, 0x19, 0xa9, 0x24, 0xe3, 0x63, 0x2a, 0xc9, 0x7a,
+	0x70, 0x7d, 0xa3, 0x2b, 0xe7, 0xbf, 0x74, 0x70, 0x79, 0x7b, 0xb5, 0x0b, 0xda, 0x3c, 0x4d, 0x2d,
+	0xc3, 0x95, 0x1e, 0xf9, 0x8a, 0x63, 0x94, 0xe3, 0xac, 0xc2, 0x50, 0x5b, 0x70, 0xc3, 0x23, 0x11,
+	0xc5, 0x11, 0xed, 0x53, 0xdb, 0xf1, 0xfd, 0x18, 0x53, 0x8a, 0xf2, 0x55, 0x50, 0x2b, 0x5a, 0x68,
+	0x34, 0xa8, 0x97, 0x65, 0x27, 0x4d, 0x11, 0x39, 0x62, 0x71, 0x18, 0x05, 0xed, 0x52, 0x96, 0x22,
+	0xfd, 0x07, 0x3b, 0xdf, 0x2f, 0x74, 0xe5, 0xee, 0x42, 0x57, 0x7e, 0xdc, 0x5e, 0xed, 0xca, 0x71,
+	0xd7, 0xa9, 0xdf, 0x31, 0xe7, 0x3b, 0xdb, 0x1e, 0xe6, 0xe1, 0xe6, 0x27, 0xc2, 0x70, 0xeb, 0x8c,
+	0xe1, 0x88, 0x86, 0x24, 0xfa, 0xa7, 0xbe, 0xcb, 0x70, 0x25, 0x26, 0xfd, 0xc8, 0xe7, 0x8d, 0xe7,
+	0xda, 0xc2, 0x50, 0x3f, 0x2c, 0x12, 0x9e, 0xe3, 0xc2, 0x9f, 0x8f, 0x06, 0xf5, 0x2d, 0x29, 0xfc,
+	0xcd, 0x5f, 0x4a, 0x97, 0x75, 0xa0, 0xbe, 0x87, 0x6b, 0xee, 0x29, 0xf1, 0x3a, 0x76, 0xe8, 0xa3,
+	0xbc, 0x9c, 0x70, 0xba, 0x38, 0x43, 0xec, 0x2b, 0x69, 0x18, 0xd6, 0x14, 0x72, 0xf8, 0xd6, 0x2a,
+	0x4f, 0x27, 0x3c, 0xbe, 0xd1, 0x0b, 0xd2, 0x21, 0x06, 0x5d, 0xe0, 0x0c, 0x87, 0xbe, 0xfa, 0x0e,
+	0x16, 0xb3, 0x03, 0x41, 0x2b, 0xff, 0xbb, 0xaf, 0x59, 0xae, 0xfa, 0x0c, 0x16, 0x69, 0x18, 0x44,
+	0x0e, 0xeb, 0xc7, 0x18, 0xad, 0x56, 0x41, 0x6d, 0xbd, 0x3d, 0x73, 0xa8, 0x35, 0x58, 0x4a, 0x08,
+	0xc3, 0x36, 0x4e, 0xe7, 0x69, 0x3b, 0xa8, 0xc0, 0x41, 0x0f, 0x93, 0xf9, 0x31, 0x37, 0xd5, 0x7d,
+	0xf8, 0x78, 0x06, 0xca, 0x08, 0x6c, 0x07, 0xad, 0x71, 0xf8, 0xa3, 0x2c, 0x78, 0x94, 0xc6, 0x9a,
+	0x0b, 0xd8, 0x5d, 0x54, 0x5c, 0xc0, 0x6e, 0x2d, 0x63, 0x77, 0x11, 0x5c, 0xc6, 0x6e, 0x1d, 0xb4,
+	0xd2, 0x8b, 0x19, 0x0d, 0xea, 0xa5, 0xb3, 0xec, 0x8f, 0xad, 0x36, 0x8c, 0x3d, 0x63, 0x6f, 0x7a,
+	0x45, 0x3b, 0x73, 0x57, 0xb4, 0xf4, 0x68, 0xac, 0x57, 0x97, 0x63, 0x0d, 0x5c, 0x8f, 0x35, 0x30,
+	0x1c, 0x6b, 0xe0, 0xf7, 0x58, 0x03, 0xe7, 0x13, 0x4d, 0x19, 0x4e, 0x34, 0xe5, 0xe7, 0x44, 0x53,
+	0x3e, 0xcb, 0x2b, 0xa0, 0x7e, 0xc7, 0x08, 0x89, 0x39, 0xab, 0x22, 0x7e, 0x40, 0x77, 0x95, 0xef,
+	0xe2, 0xe5, 0x9f, 0x01, 0x00, 0x25, 0xd2, 0x97, 0x2d, 0x48, 0x04, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -157,6 +238,99 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteExtensionEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtensionSignatureB) > 0 {
+		i -= len(m.ExtensionSignatureB)
+		copy(dAtA[i:], m.ExtensionSignatureB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionSignatureB)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.VoteExtensionB) > 0 {
+		i -= len(m.VoteExtensionB)
+		copy(dAtA[i:], m.VoteExtensionB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionB)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ExtensionSignatureA) > 0 {
+		i -= len(m.ExtensionSignatureA)
+		copy(dAtA[i:], m.ExtensionSignatureA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionSignatureA)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VoteExtensionA) > 0 {
+		i -= len(m.VoteExtensionA)
+		copy(dAtA[i:], m.VoteExtensionA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.VoteExtensionA)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +363,49 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *VoteExtensionEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = m.BlockID.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExtensionSignatureA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.VoteExtensionB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExtensionSignatureB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +565,362 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteExtensionEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtensionEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionA = append(m.VoteExtensionA[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionA == nil {
+				m.VoteExtensionA = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignatureA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignatureA = append(m.ExtensionSignatureA[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignatureA == nil {
+				m.ExtensionSignatureA = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteExtensionB = append(m.VoteExtensionB[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteExtensionB == nil {
+				m.VoteExtensionB = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignatureB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignatureB = append(m.ExtensionSignatureB[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignatureB == nil {
+				m.ExtensionSignatureB = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
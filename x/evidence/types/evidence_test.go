@@ -6,6 +6,9 @@ import (
 	"testing"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/comet"
@@ -77,6 +80,88 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestVoteExtensionEquivocationValidateBasic(t *testing.T) {
+	addr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(sdk.ConsAddress("foo_________________"))
+	require.NoError(t, err)
+
+	blockID := func(hash string) cmtproto.BlockID {
+		return cmtproto.BlockID{Hash: []byte(hash), PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: []byte(hash)}}
+	}
+	valid := func() types.VoteExtensionEquivocation {
+		return types.VoteExtensionEquivocation{
+			Height:              100,
+			ConsensusAddress:    addr,
+			BlockID:             blockID("block"),
+			Signature:           []byte("sig"),
+			VoteExtensionA:      []byte("a"),
+			ExtensionSignatureA: []byte("ext_sig_a"),
+			VoteExtensionB:      []byte("b"),
+			ExtensionSignatureB: []byte("ext_sig_b"),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(e *types.VoteExtensionEquivocation)
+		expectErr bool
+	}{
+		{"valid", func(e *types.VoteExtensionEquivocation) {}, false},
+		{"valid empty vote extension", func(e *types.VoteExtensionEquivocation) { e.VoteExtensionA = nil }, false},
+		{"invalid height", func(e *types.VoteExtensionEquivocation) { e.Height = 0 }, true},
+		{"invalid round", func(e *types.VoteExtensionEquivocation) { e.Round = -1 }, true},
+		{"invalid address", func(e *types.VoteExtensionEquivocation) { e.ConsensusAddress = "" }, true},
+		{"missing precommit signature", func(e *types.VoteExtensionEquivocation) { e.Signature = nil }, true},
+		{"missing vote extension signature", func(e *types.VoteExtensionEquivocation) { e.ExtensionSignatureB = nil }, true},
+		{"nil block", func(e *types.VoteExtensionEquivocation) { e.BlockID = cmtproto.BlockID{} }, true},
+		{"same vote extensions", func(e *types.VoteExtensionEquivocation) { e.VoteExtensionB = e.VoteExtensionA }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := valid()
+			tc.malleate(&e)
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+			require.Equal(t, types.RouteVoteExtensionEquivocation, e.Route())
+		})
+	}
+}
+
+func TestPrecommitSignBytes(t *testing.T) {
+	vote := &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    100,
+		Round:     1,
+		BlockID:   cmtproto.BlockID{Hash: tmhash.Sum([]byte("block")), PartSetHeader: cmtproto.PartSetHeader{Total: 2, Hash: tmhash.Sum([]byte("parts"))}},
+		Timestamp: time.Unix(1700000000, 0).UTC(),
+		Extension: []byte("extension"),
+	}
+
+	bz, err := types.PrecommitSignBytes("test-chain", vote.Height, int64(vote.Round), vote.BlockID, vote.Timestamp)
+	require.NoError(t, err)
+	require.Equal(t, cmttypes.VoteSignBytes("test-chain", vote), bz)
+
+	bz, err = types.VoteExtensionSignBytes("test-chain", vote.Height, int64(vote.Round), vote.Extension)
+	require.NoError(t, err)
+	require.Equal(t, cmttypes.VoteExtensionSignBytes("test-chain", vote), bz)
+}
+
+func TestVoteExtensionSignBytes(t *testing.T) {
+	bz, err := types.VoteExtensionSignBytes("test-chain", 100, 1, []byte("extension"))
+	require.NoError(t, err)
+
+	// the canonical vote extension is length-prefixed
+	require.Equal(t, len(bz)-1, int(bz[0]))
+
+	other, err := types.VoteExtensionSignBytes("test-chain", 100, 1, []byte("other extension"))
+	require.NoError(t, err)
+	require.NotEqual(t, bz, other)
+
+	other, err = types.VoteExtensionSignBytes("other-chain", 100, 1, []byte("extension"))
+	require.NoError(t, err)
+	require.NotEqual(t, bz, other)
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := NewCometMisbehavior(1, 100, time.Now(), comet.DuplicateVote,
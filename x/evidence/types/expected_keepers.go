@@ -16,7 +16,10 @@ import (
 // evidence module.
 type StakingKeeper interface {
 	ConsensusAddressCodec() address.Codec
+	ValidatorAddressCodec() address.Codec
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+	ValidatorConsPubKeyAtHeight(context.Context, sdk.ValAddress, int64) (cryptotypes.PubKey, error)
+	PowerReduction(context.Context) math.Int
 }
 
 // SlashingKeeper defines the slashing module interface contract needed by the
@@ -94,6 +94,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor [address]",
+					Short:     "Query details of a governor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors",
+				},
+				{
+					RpcMethod: "GovernanceDelegation",
+					Use:       "governance-delegation [delegator-addr]",
+					Short:     "Query the governor an account delegates its voting power to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "GovernorDelegatedPower",
+					Use:       "governor-delegated-power [governor-addr]",
+					Short:     "Query the voting power delegated to a governor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "SimulateProposal",
+					Skip:      true, // skipped because a custom command is defined
+				},
+				{
+					RpcMethod: "ConvictionLocks",
+					Use:       "conviction-locks [delegator-addr]",
+					Short:     "Query the conviction locks of a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +195,54 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "RegisterGovernor",
+					Use:       "register-governor",
+					Short:     "Register the sender as a governor, to which other accounts can delegate their voting power",
+					Example:   fmt.Sprintf("$ %s tx gov register-governor --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "DeregisterGovernor",
+					Use:       "deregister-governor",
+					Short:     "Deregister the sender as a governor, removing all the voting power delegated to it",
+					Example:   fmt.Sprintf("$ %s tx gov deregister-governor --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "DelegateVote",
+					Use:       "delegate-vote [governor-addr]",
+					Short:     "Delegate the voting power of the sender to a governor",
+					Long:      "Delegate the voting power of the sender to a governor. The governor votes with the stake of the sender on the proposals the sender does not vote on.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-vote cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateVote",
+					Use:       "undelegate-vote",
+					Short:     "Remove the delegation of the voting power of the sender to a governor",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-vote --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "VetoProposal",
+					Use:       "veto-proposal [proposal-id]",
+					Short:     "Veto a timelocked proposal as its veto guardian",
+					Example:   fmt.Sprintf("$ %s tx gov veto-proposal 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "LockConviction",
+					Use:       "lock-conviction [validator-addr] [duration]",
+					Short:     "Lock the delegation of the sender to a validator for a conviction period",
+					Long:      "Lock the current shares of the delegation of the sender to a validator for one of the conviction periods of the gov params. The locked shares cannot be undelegated nor redelegated until the end of the lock, and their voting power is weighted by the multiplier of the period.",
+					Example:   fmt.Sprintf("$ %s tx gov lock-conviction cosmosvaloper1... 2160h --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "duration"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewQueryCmd returns the query commands for this module, which are not generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	govQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the governance module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	govQueryCmd.AddCommand(
+		NewCmdSimulateProposal(),
+	)
+
+	return govQueryCmd
+}
+
+// NewCmdSimulateProposal implements simulating the execution of the messages of a proposal.
+func NewCmdSimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id | path/to/proposal.json]",
+		Short: "Simulate the execution of the messages of a proposal against the current state",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the execution of the messages of a proposal against the current state,
+as they would be executed by the governance module once the proposal passed. The state is left
+untouched. The results, events and gas of each message are returned, along with the state changes
+of the execution.
+
+The proposal is either an existing proposal ID, or a proposal JSON file as accepted by submit-proposal.
+
+Example:
+$ %[1]s query gov simulate-proposal 1
+$ %[1]s query gov simulate-proposal path/to/proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.QuerySimulateProposalRequest{}
+			if proposalID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				req.ProposalId = proposalID
+			} else {
+				_, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+				if err != nil {
+					return err
+				}
+
+				req, err = v1.NewQuerySimulateProposalRequest(msgs)
+				if err != nil {
+					return fmt.Errorf("invalid message: %w", err)
+				}
+			}
+
+			res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -55,6 +55,29 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			return err
+		}
+		err = k.Governors.Set(ctx, addr, *governor)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		if err := k.SetGovernanceDelegation(ctx, *delegation); err != nil {
+			return err
+		}
+	}
+
+	for _, lock := range data.ConvictionLocks {
+		if err := k.SetConvictionLock(ctx, *lock); err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -67,6 +90,11 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+		case v1.StatusTimelocked:
+			err := k.TimelockProposalsQueue.Set(ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id), proposal.Id)
+			if err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
@@ -131,12 +159,43 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export governors and governance delegations
+	var governors []*v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governanceDelegations []*v1.GovernanceDelegation
+	err = k.GovernanceDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegation) (stop bool, err error) {
+		governanceDelegations = append(governanceDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var convictionLocks []*v1.ConvictionLock
+	err = k.ConvictionLocks.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], value v1.ConvictionLock) (stop bool, err error) {
+		convictionLocks = append(convictionLocks, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		Governors:             governors,
+		GovernanceDelegations: governanceDelegations,
+		ConvictionLocks:       convictionLocks,
 	}, nil
 }
@@ -175,48 +175,20 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
+			// a passed proposal with a timelock is executed once its timelock ends, unless vetoed
+			timelocked, err := k.timelockProposal(ctx, &proposal)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
-						return err
-					}
-				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			})
-			if err != nil {
-				// `idx` and `err` are populated with the msg index and error.
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+			if timelocked {
+				tagValue = types.AttributeValueProposalTimelocked
+				logMsg = fmt.Sprintf("passed, timelocked until %s", proposal.TimelockEndTime)
 
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+				break
 			}
+
+			tagValue, logMsg = k.executeProposal(ctx, &proposal, params)
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -277,7 +249,58 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
-	return nil
+
+	// execute the timelocked proposals whose timelock ended
+	if err := k.processTimelockedProposals(ctx, k.HeaderService.HeaderInfo(ctx).Time, params); err != nil {
+		return err
+	}
+
+	// remove the conviction locks which ended
+	return k.pruneConvictionLocks(ctx, k.HeaderService.HeaderInfo(ctx).Time)
+}
+
+// executeProposal executes the messages of a passed proposal, and updates its status according
+// to the execution result.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal, params v1.Params) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// `idx` and `err` are populated with the msg index and error.
+		// We do not anything with the error. Returning an error halts the chain, and proposal struct is updated.
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	proposal.Status = v1.StatusPassed
+
+	return types.AttributeValueProposalPassed, "passed"
 }
 
 // executes route(msg) and recovers from panic.
@@ -101,6 +101,7 @@ func mockDefaultExpectations(ctx sdk.Context, m mocks) error {
 	}).AnyTimes()
 
 	m.stakingKeeper.EXPECT().BondDenom(ctx).Return("stake", nil).AnyTimes()
+	m.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
@@ -30,8 +30,12 @@ type Config struct {
 	// This only applies to WeightedVoteOption messages and not to the VoteOption messages
 	// 0 means this param is disabled, hence all supported options are allowed
 	MaxVoteOptionsLen uint64
+	// MaxGovernorDelegators defines the maximum number of accounts which can delegate their voting power to a governor.
+	// It bounds the delegations iterated over when tallying the vote of a governor
+	MaxGovernorDelegators uint64
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
+	// Setting it to ConvictionCalculateVoteResultsAndVotingPower enables conviction voting
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
 }
 
@@ -42,6 +46,7 @@ func DefaultConfig() Config {
 		MaxMetadataLen:                       255,
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
+		MaxGovernorDelegators:                1000,
 		CalculateVoteResultsAndVotingPowerFn: nil,
 	}
 }
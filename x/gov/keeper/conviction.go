@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockConviction locks the current shares of a delegation for one of the conviction periods,
+// granting them the voting power multiplier of the period until the end of the lock.
+// Locking a delegation already locked replaces its lock, which cannot end earlier than the current one.
+func (k Keeper) LockConviction(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, duration time.Duration) (v1.ConvictionLock, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return v1.ConvictionLock{}, err
+	}
+
+	var period *v1.ConvictionPeriod
+	for i := range params.ConvictionPeriods {
+		if params.ConvictionPeriods[i].Duration == duration {
+			period = &params.ConvictionPeriods[i]
+			break
+		}
+	}
+	if period == nil {
+		return v1.ConvictionLock{}, errors.Wrapf(types.ErrInvalidConvictionLock, "%s is not a conviction period", duration)
+	}
+
+	delegation, err := k.sk.Delegation(ctx, delegatorAddr, validatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return v1.ConvictionLock{}, errors.Wrap(types.ErrInvalidConvictionLock, "delegation not found")
+		}
+
+		return v1.ConvictionLock{}, err
+	}
+
+	if !delegation.GetShares().IsPositive() {
+		return v1.ConvictionLock{}, errors.Wrap(types.ErrInvalidConvictionLock, "delegation has no shares")
+	}
+
+	endTime := k.HeaderService.HeaderInfo(ctx).Time.Add(duration)
+
+	current, err := k.ConvictionLocks.Get(ctx, collections.Join(delegatorAddr, validatorAddr))
+	if err == nil {
+		if endTime.Before(current.EndTime) {
+			return v1.ConvictionLock{}, errors.Wrapf(types.ErrInvalidConvictionLock, "lock cannot end before the current lock end time %s", current.EndTime)
+		}
+
+		if err := k.ConvictionLocksQueue.Remove(ctx, collections.Join3(current.EndTime, delegatorAddr, validatorAddr)); err != nil {
+			return v1.ConvictionLock{}, err
+		}
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return v1.ConvictionLock{}, err
+	}
+
+	lock := v1.ConvictionLock{
+		DelegatorAddress: delegation.GetDelegatorAddr(),
+		ValidatorAddress: delegation.GetValidatorAddr(),
+		Shares:           delegation.GetShares().String(),
+		Multiplier:       period.Multiplier,
+		EndTime:          endTime,
+	}
+
+	if err := k.SetConvictionLock(ctx, lock); err != nil {
+		return v1.ConvictionLock{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeLockConviction,
+		event.NewAttribute(types.AttributeKeyDelegator, lock.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, lock.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyMultiplier, lock.Multiplier),
+		event.NewAttribute(types.AttributeKeyEndTime, lock.EndTime.String()),
+	); err != nil {
+		return v1.ConvictionLock{}, err
+	}
+
+	return lock, nil
+}
+
+// SetConvictionLock stores a conviction lock and queues it for pruning at its end time.
+func (k Keeper) SetConvictionLock(ctx context.Context, lock v1.ConvictionLock) error {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(lock.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	validatorAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(lock.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConvictionLocks.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddr), sdk.ValAddress(validatorAddr)), lock); err != nil {
+		return err
+	}
+
+	return k.ConvictionLocksQueue.Set(ctx, collections.Join3(lock.EndTime, sdk.AccAddress(delegatorAddr), sdk.ValAddress(validatorAddr)))
+}
+
+// getActiveConvictionLock returns the conviction lock of a delegation, if it did not end yet.
+func (k Keeper) getActiveConvictionLock(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (v1.ConvictionLock, bool, error) {
+	lock, err := k.ConvictionLocks.Get(ctx, collections.Join(delegatorAddr, validatorAddr))
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return v1.ConvictionLock{}, false, nil
+		}
+
+		return v1.ConvictionLock{}, false, err
+	}
+
+	if !lock.EndTime.After(k.HeaderService.HeaderInfo(ctx).Time) {
+		return v1.ConvictionLock{}, false, nil
+	}
+
+	return lock, true, nil
+}
+
+// removeConvictionLock removes the conviction lock of a delegation and its pruning queue entry.
+func (k Keeper) removeConvictionLock(ctx context.Context, lock v1.ConvictionLock, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
+	if err := k.ConvictionLocks.Remove(ctx, collections.Join(delegatorAddr, validatorAddr)); err != nil {
+		return err
+	}
+
+	return k.ConvictionLocksQueue.Remove(ctx, collections.Join3(lock.EndTime, delegatorAddr, validatorAddr))
+}
+
+// pruneConvictionLocks removes the conviction locks which ended before the given time.
+func (k Keeper) pruneConvictionLocks(ctx context.Context, blockTime time.Time) error {
+	rng := collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, sdk.ValAddress](blockTime)
+	iter, err := k.ConvictionLocksQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ConvictionLocks.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+
+		if err := k.ConvictionLocksQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConvictionCalculateVoteResultsAndVotingPower tallies the votes like the default implementation, except
+// that the shares of the delegations of the voters locked by conviction are weighted by the multiplier of
+// their lock. The shares delegated since the lock, and the shares of the delegators not voting themselves,
+// are not weighted.
+// It can be set as the CalculateVoteResultsAndVotingPowerFn of the keeper config to enable conviction voting.
+func ConvictionCalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return calculateVoteResultsAndVotingPower(ctx, k, proposalID, validators, convictionDelegationVotingPower)
+}
+
+// convictionDelegationVotingPower returns the voting power of a delegation, weighting its locked shares
+// by the multiplier of its conviction lock.
+func convictionDelegationVotingPower(
+	ctx context.Context,
+	k Keeper,
+	delegatorAddr sdk.AccAddress,
+	delegation sdk.DelegationI,
+	validator v1.ValidatorGovInfo,
+) (math.LegacyDec, error) {
+	shares := delegation.GetShares()
+
+	lock, found, err := k.getActiveConvictionLock(ctx, delegatorAddr, validator.Address)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if found {
+		lockedShares, err := math.LegacyNewDecFromStr(lock.Shares)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		multiplier, err := math.LegacyNewDecFromStr(lock.Multiplier)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		// locked shares * multiplier + unlocked shares
+		shares = shares.Add(math.LegacyMinDec(shares, lockedShares).Mul(multiplier.Sub(math.LegacyOneDec())))
+	}
+
+	// weighted shares * bonded / total shares
+	return shares.MulInt(validator.BondedTokens).Quo(validator.DelegatorShares), nil
+}
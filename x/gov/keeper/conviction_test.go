@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupConvictionLocks enables conviction periods of one and two hours, and mocks a delegation
+// of addrs[0] to the validator of addrs[1] with the shares pointed to.
+func (suite *KeeperTestSuite) setupConvictionLocks(shares *sdkmath.LegacyDec) sdk.ValAddress {
+	params := v1.DefaultParams()
+	params.ConvictionPeriods = []v1.ConvictionPeriod{
+		{Duration: time.Hour, Multiplier: "2"},
+		{Duration: 2 * time.Hour, Multiplier: "3"},
+	}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	suite.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	valAddr := sdk.ValAddress(suite.addrs[1])
+	delAddrStr, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	valAddrStr, err := valCodec.BytesToString(valAddr)
+	suite.Require().NoError(err)
+
+	suite.stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, del sdk.AccAddress, val sdk.ValAddress) (sdk.DelegationI, error) {
+			if !del.Equals(suite.addrs[0]) || !val.Equals(valAddr) {
+				return nil, collections.ErrNotFound
+			}
+
+			return stakingtypes.Delegation{DelegatorAddress: delAddrStr, ValidatorAddress: valAddrStr, Shares: *shares}, nil
+		}).AnyTimes()
+
+	return valAddr
+}
+
+func (suite *KeeperTestSuite) TestLockConviction() {
+	suite.reset()
+	shares := sdkmath.LegacyNewDec(100)
+	valAddr := suite.setupConvictionLocks(&shares)
+	delAddr := suite.addrs[0]
+
+	_, err := suite.govKeeper.LockConviction(suite.ctx, delAddr, valAddr, 30*time.Minute)
+	suite.Require().ErrorIs(err, types.ErrInvalidConvictionLock)
+
+	_, err = suite.govKeeper.LockConviction(suite.ctx, suite.addrs[2], valAddr, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidConvictionLock)
+
+	lock, err := suite.govKeeper.LockConviction(suite.ctx, delAddr, valAddr, 2*time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal("3", lock.Multiplier)
+	suite.Require().Equal(shares.String(), lock.Shares)
+	suite.Require().Equal(suite.ctx.HeaderInfo().Time.Add(2*time.Hour), lock.EndTime)
+
+	// a lock cannot be replaced by a lock ending earlier
+	_, err = suite.govKeeper.LockConviction(suite.ctx, delAddr, valAddr, time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidConvictionLock)
+
+	// a lock can be replaced by a lock ending later, with the current shares
+	shares = sdkmath.LegacyNewDec(150)
+	header := suite.ctx.HeaderInfo()
+	header.Time = header.Time.Add(90 * time.Minute)
+	ctx := suite.ctx.WithHeaderInfo(header)
+	newLock, err := suite.govKeeper.LockConviction(ctx, delAddr, valAddr, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal("2", newLock.Multiplier)
+	suite.Require().Equal(shares.String(), newLock.Shares)
+
+	has, err := suite.govKeeper.ConvictionLocksQueue.Has(ctx, collections.Join3(lock.EndTime, delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	delAddrStr, err := suite.acctKeeper.AddressCodec().BytesToString(delAddr)
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.ConvictionLocks(ctx, &v1.QueryConvictionLocksRequest{DelegatorAddress: delAddrStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ConvictionLocks, 1)
+	suite.Require().Equal(newLock.Shares, res.ConvictionLocks[0].Shares)
+	suite.Require().True(newLock.EndTime.Equal(res.ConvictionLocks[0].EndTime))
+
+	// the lock is pruned once ended
+	header.Time = newLock.EndTime
+	ctx = suite.ctx.WithHeaderInfo(header)
+	suite.Require().NoError(suite.govKeeper.EndBlocker(ctx))
+
+	has, err = suite.govKeeper.ConvictionLocks.Has(ctx, collections.Join(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestConvictionHooks() {
+	suite.reset()
+	shares := sdkmath.LegacyNewDec(100)
+	valAddr := suite.setupConvictionLocks(&shares)
+	delAddr := suite.addrs[0]
+	hooks := suite.govKeeper.ConvictionHooks()
+
+	// delegations without lock are not restricted
+	suite.Require().NoError(hooks.BeforeDelegationRemoved(suite.ctx, delAddr, valAddr))
+
+	lock, err := suite.govKeeper.LockConviction(suite.ctx, delAddr, valAddr, time.Hour)
+	suite.Require().NoError(err)
+
+	// the shares delegated since the lock can be undelegated, not the locked ones
+	shares = sdkmath.LegacyNewDec(150)
+	suite.Require().NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+	shares = sdkmath.LegacyNewDec(100)
+	suite.Require().NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+	shares = sdkmath.LegacyNewDec(90)
+	suite.Require().ErrorIs(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr), types.ErrConvictionLocked)
+	suite.Require().ErrorIs(hooks.BeforeDelegationRemoved(suite.ctx, delAddr, valAddr), types.ErrConvictionLocked)
+
+	// slashes are not restricted, the locked shares are reduced to the shares left instead
+	shares = sdkmath.LegacyNewDec(100)
+	suite.Require().NoError(hooks.BeforeDelegationSlashed(suite.ctx, delAddr, valAddr, sdkmath.LegacyNewDec(10)))
+	lock, err = suite.govKeeper.ConvictionLocks.Get(suite.ctx, collections.Join(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDec(90).String(), lock.Shares)
+	shares = sdkmath.LegacyNewDec(90)
+	suite.Require().NoError(hooks.AfterDelegationModified(suite.ctx, delAddr, valAddr))
+
+	// a slash unbonding all the shares removes the lock
+	suite.Require().NoError(hooks.BeforeDelegationSlashed(suite.ctx, delAddr, valAddr, shares))
+	has, err := suite.govKeeper.ConvictionLocks.Has(suite.ctx, collections.Join(delAddr, valAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	suite.Require().NoError(hooks.BeforeDelegationRemoved(suite.ctx, delAddr, valAddr))
+
+	// the delegation is not restricted once the lock ended
+	header := suite.ctx.HeaderInfo()
+	header.Time = lock.EndTime
+	ctx := suite.ctx.WithHeaderInfo(header)
+	suite.Require().NoError(hooks.AfterDelegationModified(ctx, delAddr, valAddr))
+	suite.Require().NoError(hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr))
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"context"
+
+	"cosmossdk.io/math"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,3 +19,9 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 
 	return k.validateInitialDeposit(params, initialDeposit, proposalType)
 }
+
+// DefaultCalculateVoteResultsAndVotingPower is a helper function used only in tally tests which returns
+// the same functionality of defaultCalculateVoteResultsAndVotingPower private function.
+func DefaultCalculateVoteResultsAndVotingPower(ctx context.Context, k Keeper, proposalID uint64, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return defaultCalculateVoteResultsAndVotingPower(ctx, k, proposalID, validators)
+}
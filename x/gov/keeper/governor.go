@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGovernor registers an account as a governor, to which other accounts can delegate
+// their governance voting power.
+func (k Keeper) RegisterGovernor(ctx context.Context, governorAddr sdk.AccAddress) error {
+	has, err := k.Governors.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrGovernorExists
+	}
+
+	// a governor votes with its own stake only, it cannot delegate its voting power
+	if _, err := k.GovernanceDelegations.Get(ctx, governorAddr); err == nil {
+		return errors.Wrap(types.ErrInvalidGovDelegation, "an account delegating its voting power cannot be a governor")
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Governors.Set(ctx, governorAddr, v1.NewGovernor(governorStrAddr)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeRegisterGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, governorStrAddr),
+	)
+}
+
+// DeregisterGovernor deregisters a governor and removes all the governance delegations to it.
+func (k Keeper) DeregisterGovernor(ctx context.Context, governorAddr sdk.AccAddress) error {
+	has, err := k.Governors.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrGovernorNotFound
+	}
+
+	var delegators []sdk.AccAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governorAddr)
+	if err := k.DelegationsByGovernor.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		delegators = append(delegators, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, delegator := range delegators {
+		if err := k.removeGovernanceDelegation(ctx, delegator, governorAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Governors.Remove(ctx, governorAddr); err != nil {
+		return err
+	}
+
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDeregisterGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, governorStrAddr),
+	)
+}
+
+// DelegateVote delegates the governance voting power of an account to a governor,
+// replacing its previous governance delegation, if any.
+func (k Keeper) DelegateVote(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if delegatorAddr.Equals(governorAddr) {
+		return errors.Wrap(types.ErrInvalidGovDelegation, "cannot delegate voting power to self")
+	}
+
+	has, err := k.Governors.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrGovernorNotFound
+	}
+
+	isGovernor, err := k.Governors.Has(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	if isGovernor {
+		return errors.Wrap(types.ErrInvalidGovDelegation, "a governor cannot delegate its voting power")
+	}
+
+	previous, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err == nil {
+		previousGovernor, err := k.authKeeper.AddressCodec().StringToBytes(previous.GovernorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeGovernanceDelegation(ctx, delegatorAddr, previousGovernor); err != nil {
+			return err
+		}
+	} else if !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetGovernanceDelegation(ctx, v1.NewGovernanceDelegation(delegatorStrAddr, governorStrAddr)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyGovernor, governorStrAddr),
+	)
+}
+
+// UndelegateVote removes the governance delegation of an account.
+func (k Keeper) UndelegateVote(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return types.ErrGovDelegationNotFound
+		}
+
+		return err
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeGovernanceDelegation(ctx, delegatorAddr, governorAddr); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+	)
+}
+
+// GetGovernorDelegatedPower returns the voting power delegated to a governor, i.e. the stake
+// bonded to the current validators by the accounts delegating their voting power to the
+// governor, along with the number of these accounts.
+func (k Keeper) GetGovernorDelegatedPower(ctx context.Context, governorAddr sdk.AccAddress) (math.LegacyDec, uint64, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, 0, err
+	}
+
+	power := math.LegacyZeroDec()
+	count := uint64(0)
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governorAddr)
+	err = k.DelegationsByGovernor.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		count++
+		return false, k.sk.IterateDelegations(ctx, key.K2(), func(_ int64, delegation sdk.DelegationI) (stop bool) {
+			if val, ok := validators[delegation.GetValidatorAddr()]; ok {
+				power = power.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+
+			return false
+		})
+	})
+	if err != nil {
+		return math.LegacyDec{}, 0, err
+	}
+
+	return power, count, nil
+}
+
+// SetGovernanceDelegation stores a governance delegation along with its index by governor.
+// It fails if the governor already has the maximum number of delegators, so that tallying
+// the vote of a governor only iterates over a bounded number of delegations.
+func (k Keeper) SetGovernanceDelegation(ctx context.Context, delegation v1.GovernanceDelegation) error {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(sdk.AccAddress(governorAddr), sdk.AccAddress(delegatorAddr))
+	has, err := k.DelegationsByGovernor.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		count, err := k.getGovernorDelegators(ctx, governorAddr)
+		if err != nil {
+			return err
+		}
+
+		if count >= k.config.MaxGovernorDelegators {
+			return errors.Wrapf(types.ErrMaxGovernorDelegators, "%s has %d delegators", delegation.GovernorAddress, count)
+		}
+
+		if err := k.GovernorDelegators.Set(ctx, governorAddr, count+1); err != nil {
+			return err
+		}
+
+		if err := k.DelegationsByGovernor.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return k.GovernanceDelegations.Set(ctx, delegatorAddr, delegation)
+}
+
+// removeGovernanceDelegation removes a governance delegation along with its index by governor.
+func (k Keeper) removeGovernanceDelegation(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if err := k.GovernanceDelegations.Remove(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	if err := k.DelegationsByGovernor.Remove(ctx, collections.Join(governorAddr, delegatorAddr)); err != nil {
+		return err
+	}
+
+	count, err := k.getGovernorDelegators(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+
+	if count <= 1 {
+		return k.GovernorDelegators.Remove(ctx, governorAddr)
+	}
+
+	return k.GovernorDelegators.Set(ctx, governorAddr, count-1)
+}
+
+// getGovernorDelegators returns the number of accounts delegating their voting power to a governor.
+func (k Keeper) getGovernorDelegators(ctx context.Context, governorAddr sdk.AccAddress) (uint64, error) {
+	count, err := k.GovernorDelegators.Get(ctx, governorAddr)
+	if err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	return count, nil
+}
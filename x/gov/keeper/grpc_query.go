@@ -319,7 +319,7 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	case proposal.Status == v1.StatusDepositPeriod:
 		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed || proposal.Status == v1.StatusTimelocked:
 		tallyResult = *proposal.FinalTallyResult
 
 	default:
@@ -334,6 +334,178 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// Governor queries a governor based on its address.
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	governor, err := q.k.Governors.Get(ctx, addr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.Address)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors queries all the governors.
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(_ sdk.AccAddress, governor v1.Governor) (*v1.Governor, error) {
+		return &governor, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernanceDelegation queries the governance delegation of an account.
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, err := q.k.GovernanceDelegations.Get(ctx, addr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governance delegation of %s doesn't exist", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{Delegation: &delegation}, nil
+}
+
+// GovernorDelegatedPower queries the voting power delegated to a governor.
+func (q queryServer) GovernorDelegatedPower(ctx context.Context, req *v1.QueryGovernorDelegatedPowerRequest) (*v1.QueryGovernorDelegatedPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	has, err := q.k.Governors.Has(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+	}
+
+	power, delegators, err := q.k.GetGovernorDelegatedPower(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegatedPowerResponse{DelegatedPower: power.String(), Delegators: delegators}, nil
+}
+
+// SimulateProposal executes the messages of a proposal against the current state.
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	messages, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// simulate the messages of an existing proposal if no messages are given
+	if len(messages) == 0 {
+		if req.ProposalId == 0 {
+			return nil, status.Error(codes.InvalidArgument, "either messages or a proposal id must be given")
+		}
+
+		proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+			}
+
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		messages, err = proposal.GetMsgs()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if len(messages) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "proposal %d has no messages", req.ProposalId)
+		}
+	}
+
+	res, err := q.k.SimulateProposal(ctx, messages)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+// ConvictionLocks queries the conviction locks of a delegator.
+func (q queryServer) ConvictionLocks(ctx context.Context, req *v1.QueryConvictionLocksRequest) (*v1.QueryConvictionLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(ctx, q.k.ConvictionLocks, req.Pagination, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], lock v1.ConvictionLock) (*v1.ConvictionLock, error) {
+		return &lock, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.ValAddress](addr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConvictionLocksResponse{ConvictionLocks: locks, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
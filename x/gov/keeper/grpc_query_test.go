@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/math"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -2041,3 +2045,104 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	governor, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	delegator, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{Address: governor})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	suite.Require().NoError(suite.govKeeper.RegisterGovernor(ctx, addrs[0]))
+	suite.Require().NoError(suite.govKeeper.DelegateVote(ctx, addrs[1], addrs[0]))
+
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{})
+	suite.Require().ErrorContains(err, "empty governor address")
+
+	governorRes, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{Address: governor})
+	suite.Require().NoError(err)
+	suite.Require().Equal(governor, governorRes.Governor.Address)
+
+	governorsRes, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(governorsRes.Governors, 1)
+	suite.Require().Equal(governor, governorsRes.Governors[0].Address)
+
+	delegationRes, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: delegator})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.NewGovernanceDelegation(delegator, governor), *delegationRes.Delegation)
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: governor})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	powerRes, err := queryClient.GovernorDelegatedPower(gocontext.Background(), &v1.QueryGovernorDelegatedPowerRequest{GovernorAddress: governor})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyZeroDec().String(), powerRes.DelegatedPower)
+	suite.Require().Equal(uint64(1), powerRes.Delegators)
+
+	_, err = queryClient.GovernorDelegatedPower(gocontext.Background(), &v1.QueryGovernorDelegatedPowerRequest{GovernorAddress: delegator})
+	suite.Require().ErrorContains(err, "doesn't exist")
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	govAcctStr, err := suite.acctKeeper.AddressCodec().BytesToString(govAcct)
+	suite.Require().NoError(err)
+
+	params := v1.DefaultParams()
+	params.MaxDepositPeriod = func() *time.Duration { d := time.Hour; return &d }()
+	updateParams := &v1.MsgUpdateParams{Authority: govAcctStr, Params: params}
+
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{})
+	suite.Require().ErrorContains(err, "either messages or a proposal id must be given")
+
+	_, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{ProposalId: 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	// the gov module account must be the signer of the messages
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	req, err := v1.NewQuerySimulateProposalRequest([]sdk.Msg{&v1.MsgUpdateParams{Authority: addr0Str, Params: params}})
+	suite.Require().NoError(err)
+	_, err = queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().ErrorContains(err, "expected authority account as only signer for proposal message")
+
+	req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{updateParams})
+	suite.Require().NoError(err)
+	res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+	suite.Require().Empty(res.Results[0].Error)
+	suite.Require().Equal(sdk.MsgTypeURL(updateParams), res.Results[0].MsgTypeUrl)
+	suite.Require().NotNil(res.Results[0].MsgResponse)
+	suite.Require().NotZero(res.Results[0].GasUsed)
+	suite.Require().Equal(res.Results[0].GasUsed, res.GasUsed)
+	suite.Require().Len(res.StateChanges, 1)
+	suite.Require().Equal(types.StoreKey, res.StateChanges[0].StoreName)
+	suite.Require().Equal(types.ParamsKey.Bytes(), res.StateChanges[0].Key)
+	suite.Require().False(res.StateChanges[0].Delete)
+
+	// the state is left untouched
+	storedParams, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.DefaultParams().MaxDepositPeriod, storedParams.MaxDepositPeriod)
+
+	// a failing message does not change the state
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	res, err = queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+	suite.Require().NotEmpty(res.Results[0].Error)
+	suite.Require().Empty(res.StateChanges)
+
+	// the simulation is unimplemented without an sdk.Context, instead of panicking
+	_, err = suite.govKeeper.SimulateProposal(gocontext.Background(), []sdk.Msg{updateParams})
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+}
@@ -65,6 +65,22 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// TimelockProposalsQueue key: timelockEndTime+proposalID | value: proposalID
+	TimelockProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	// Governors key: governorAddr | value: Governor
+	Governors collections.Map[sdk.AccAddress, v1.Governor]
+	// GovernanceDelegations key: delegatorAddr | value: GovernanceDelegation
+	GovernanceDelegations collections.Map[sdk.AccAddress, v1.GovernanceDelegation]
+	// DelegationsByGovernor key: governorAddr+delegatorAddr
+	// This indexes the governance delegations by governor.
+	DelegationsByGovernor collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// GovernorDelegators key: governorAddr | value: number of accounts delegating to the governor
+	GovernorDelegators collections.Map[sdk.AccAddress, uint64]
+	// ConvictionLocks key: delegatorAddr+validatorAddr | value: ConvictionLock
+	ConvictionLocks collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], v1.ConvictionLock]
+	// ConvictionLocksQueue key: endTime+delegatorAddr+validatorAddr
+	// This indexes the conviction locks by end time, to prune them once ended.
+	ConvictionLocksQueue collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, sdk.ValAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -110,6 +126,10 @@ func NewKeeper(
 	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = defaultConfig.MaxVoteOptionsLen
 	}
+	// If MaxGovernorDelegators not set by app developer, set to default value.
+	if config.MaxGovernorDelegators == 0 {
+		config.MaxGovernorDelegators = defaultConfig.MaxGovernorDelegators
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -131,6 +151,13 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		Governors:              collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, codec.CollValue[v1.Governor](cdc)),
+		GovernanceDelegations:  collections.NewMap(sb, types.GovernanceDelegationsPrefix, "governance_delegations", sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegation](cdc)),
+		DelegationsByGovernor:  collections.NewKeySet(sb, types.DelegationsByGovernorPrefix, "delegations_by_governor", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		GovernorDelegators:     collections.NewMap(sb, types.GovernorDelegatorsPrefix, "governor_delegators", sdk.AccAddressKey, collections.Uint64Value),
+		TimelockProposalsQueue: collections.NewMap(sb, types.TimelockProposalQueuePrefix, "timelock_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		ConvictionLocks:        collections.NewMap(sb, types.ConvictionLocksPrefix, "conviction_locks", collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), codec.CollValue[v1.ConvictionLock](cdc)),
+		ConvictionLocksQueue:   collections.NewKeySet(sb, types.ConvictionLocksQueuePrefix, "conviction_locks_queue", collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, sdk.ValAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
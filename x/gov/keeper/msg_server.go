@@ -346,6 +346,12 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 		return nil, err
 	}
 
+	if msg.Params.VetoGuardian != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Params.VetoGuardian); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid veto guardian address: %s", err)
+		}
+	}
+
 	// note: we don't need to validate the message URL here, as it is gov gated
 	// a chain may want to configure proposal messages before having an upgrade
 	// adding new messages.
@@ -408,6 +414,101 @@ func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSu
 	}, nil
 }
 
+// RegisterGovernor implements the MsgServer.RegisterGovernor method.
+func (k msgServer) RegisterGovernor(ctx context.Context, msg *v1.MsgRegisterGovernor) (*v1.MsgRegisterGovernorResponse, error) {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.RegisterGovernor(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRegisterGovernorResponse{}, nil
+}
+
+// DeregisterGovernor implements the MsgServer.DeregisterGovernor method.
+func (k msgServer) DeregisterGovernor(ctx context.Context, msg *v1.MsgDeregisterGovernor) (*v1.MsgDeregisterGovernorResponse, error) {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DeregisterGovernor(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDeregisterGovernorResponse{}, nil
+}
+
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateVote(ctx, delegator, governor); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements the MsgServer.UndelegateVote method.
+func (k msgServer) UndelegateVote(ctx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateVote(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
+// VetoProposal implements the v1.MsgServer method
+func (k msgServer) VetoProposal(ctx context.Context, msg *v1.MsgVetoProposal) (*v1.MsgVetoProposalResponse, error) {
+	guardian, err := k.authKeeper.AddressCodec().StringToBytes(msg.Guardian)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+	}
+
+	if err := k.Keeper.VetoTimelockedProposal(ctx, msg.ProposalId, guardian); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoProposalResponse{}, nil
+}
+
+// LockConviction implements the MsgServer.LockConviction method.
+func (k msgServer) LockConviction(ctx context.Context, msg *v1.MsgLockConviction) (*v1.MsgLockConvictionResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	validator, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	lock, err := k.Keeper.LockConviction(ctx, delegator, validator, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgLockConvictionResponse{EndTime: lock.EndTime}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -4,8 +4,10 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
+	govtypes "cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -2137,6 +2139,54 @@ func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
 			},
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid timelock",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0.5",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					Timelock:      func() *time.Duration { d := -time.Hour; return &d }(),
+				},
+			},
+			expErrMsg: "timelock must not be negative",
+		},
+		{
+			name: "invalid timelock veto threshold",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:          func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:                "0.334",
+					YesQuorum:             "0.5",
+					Threshold:             "0.5",
+					VetoThreshold:         "0.334",
+					TimelockVetoThreshold: "1.5",
+				},
+			},
+			expErrMsg: "timelock veto threshold too large",
+		},
+		{
+			name: "invalid veto guardian",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0.5",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					VetoGuardian:  "invalid",
+				},
+			},
+			expErrMsg: "invalid veto guardian address",
+		},
 		{
 			name: "valid",
 			input: &v1.MsgUpdateMessageParams{
@@ -2151,6 +2201,23 @@ func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
 				},
 			},
 		},
+		{
+			name: "valid with timelock",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:          func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:                "0.334",
+					YesQuorum:             "0",
+					Threshold:             "0.5",
+					VetoThreshold:         "0.334",
+					Timelock:              func() *time.Duration { d := time.Hour; return &d }(),
+					VetoGuardian:          address1,
+					TimelockVetoThreshold: "0.334",
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2320,3 +2387,270 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgRegisterGovernor() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *v1.MsgRegisterGovernor
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			input:     v1.NewMsgRegisterGovernor("invalid"),
+			expErrMsg: "invalid governor address",
+		},
+		{
+			name:  "valid",
+			input: v1.NewMsgRegisterGovernor(addr0Str),
+		},
+		{
+			name:      "already registered",
+			input:     v1.NewMsgRegisterGovernor(addr0Str),
+			expErrMsg: "governor already registered",
+		},
+		{
+			name: "account delegating its voting power",
+			preRun: func() {
+				_, err := suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(addr1Str, addr0Str))
+				suite.Require().NoError(err)
+			},
+			input:     v1.NewMsgRegisterGovernor(addr1Str),
+			expErrMsg: "an account delegating its voting power cannot be a governor",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := suite.msgSrvr.RegisterGovernor(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgDeregisterGovernor() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RegisterGovernor(suite.ctx, v1.NewMsgRegisterGovernor(addr0Str))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(addr1Str, addr0Str))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.DeregisterGovernor(suite.ctx, v1.NewMsgDeregisterGovernor(addr1Str))
+	suite.Require().ErrorIs(err, govtypes.ErrGovernorNotFound)
+
+	_, err = suite.msgSrvr.DeregisterGovernor(suite.ctx, v1.NewMsgDeregisterGovernor(addr0Str))
+	suite.Require().NoError(err)
+
+	has, err := suite.govKeeper.Governors.Has(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// the delegations to the governor are removed
+	has, err = suite.govKeeper.GovernanceDelegations.Has(suite.ctx, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.govKeeper.DelegationsByGovernor.Has(suite.ctx, collections.Join(suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RegisterGovernor(suite.ctx, v1.NewMsgRegisterGovernor(addr0Str))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RegisterGovernor(suite.ctx, v1.NewMsgRegisterGovernor(addr1Str))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		input        *v1.MsgDelegateVote
+		expErrMsg    string
+		expGovernor  sdk.AccAddress
+		prevGovernor sdk.AccAddress
+	}{
+		{
+			name:      "invalid delegator address",
+			input:     v1.NewMsgDelegateVote("invalid", addr0Str),
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid governor address",
+			input:     v1.NewMsgDelegateVote(addr2Str, "invalid"),
+			expErrMsg: "invalid governor address",
+		},
+		{
+			name:      "self delegation",
+			input:     v1.NewMsgDelegateVote(addr0Str, addr0Str),
+			expErrMsg: "cannot delegate voting power to self",
+		},
+		{
+			name:      "governor not registered",
+			input:     v1.NewMsgDelegateVote(addr0Str, addr2Str),
+			expErrMsg: "governor not found",
+		},
+		{
+			name:      "governor delegating",
+			input:     v1.NewMsgDelegateVote(addr1Str, addr0Str),
+			expErrMsg: "a governor cannot delegate its voting power",
+		},
+		{
+			name:        "valid",
+			input:       v1.NewMsgDelegateVote(addr2Str, addr0Str),
+			expGovernor: suite.addrs[0],
+		},
+		{
+			name:         "valid, replacing the previous delegation",
+			input:        v1.NewMsgDelegateVote(addr2Str, addr1Str),
+			expGovernor:  suite.addrs[1],
+			prevGovernor: suite.addrs[0],
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			delegation, err := suite.govKeeper.GovernanceDelegations.Get(suite.ctx, suite.addrs[2])
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.GovernorAddress, delegation.GovernorAddress)
+
+			has, err := suite.govKeeper.DelegationsByGovernor.Has(suite.ctx, collections.Join(tc.expGovernor, suite.addrs[2]))
+			suite.Require().NoError(err)
+			suite.Require().True(has)
+			count, err := suite.govKeeper.GovernorDelegators.Get(suite.ctx, tc.expGovernor)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), count)
+
+			if tc.prevGovernor != nil {
+				has, err := suite.govKeeper.DelegationsByGovernor.Has(suite.ctx, collections.Join(tc.prevGovernor, suite.addrs[2]))
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+				has, err = suite.govKeeper.GovernorDelegators.Has(suite.ctx, tc.prevGovernor)
+				suite.Require().NoError(err)
+				suite.Require().False(has)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUndelegateVote() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(addr1Str))
+	suite.Require().ErrorIs(err, govtypes.ErrGovDelegationNotFound)
+
+	_, err = suite.msgSrvr.RegisterGovernor(suite.ctx, v1.NewMsgRegisterGovernor(addr0Str))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(addr1Str, addr0Str))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(addr1Str))
+	suite.Require().NoError(err)
+
+	has, err := suite.govKeeper.GovernanceDelegations.Has(suite.ctx, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.govKeeper.DelegationsByGovernor.Has(suite.ctx, collections.Join(suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestMsgVetoProposal() {
+	suite.reset()
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(1, "invalid"))
+	suite.Require().ErrorContains(err, "invalid guardian address")
+
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(100, addr1Str))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidProposal)
+
+	proposal := suite.submitTimelockedProposal("", addr1Str)
+
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(proposal.Id, addr2Str))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidGuardian)
+
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(proposal.Id, addr1Str))
+	suite.Require().NoError(err)
+
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusRejected, proposal.Status)
+
+	has, err := suite.govKeeper.TimelockProposalsQueue.Has(suite.ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// a vetoed proposal cannot be vetoed again
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(proposal.Id, addr1Str))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidProposal)
+
+	// a proposal without veto guardian cannot be vetoed
+	proposal = suite.submitTimelockedProposal("", "")
+	_, err = suite.msgSrvr.VetoProposal(suite.ctx, v1.NewMsgVetoProposal(proposal.Id, addr1Str))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidGuardian)
+}
+
+func (suite *KeeperTestSuite) TestMsgLockConviction() {
+	suite.reset()
+	shares := sdkmath.LegacyNewDec(100)
+	valAddr := suite.setupConvictionLocks(&shares)
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	valAddrStr, err := suite.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.LockConviction(suite.ctx, v1.NewMsgLockConviction("invalid", valAddrStr, time.Hour))
+	suite.Require().ErrorContains(err, "invalid delegator address")
+
+	_, err = suite.msgSrvr.LockConviction(suite.ctx, v1.NewMsgLockConviction(addr0Str, "invalid", time.Hour))
+	suite.Require().ErrorContains(err, "invalid validator address")
+
+	_, err = suite.msgSrvr.LockConviction(suite.ctx, v1.NewMsgLockConviction(addr0Str, valAddrStr, 3*time.Hour))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidConvictionLock)
+
+	res, err := suite.msgSrvr.LockConviction(suite.ctx, v1.NewMsgLockConviction(addr0Str, valAddrStr, time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.HeaderInfo().Time.Add(time.Hour), res.EndTime)
+}
@@ -64,33 +64,9 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 			}
 		}
 
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgSigners(msg)
-		if err != nil {
+		if err := k.assertProposalMsg(ctx, msg); err != nil {
 			return v1.Proposal{}, err
 		}
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
-			if err != nil {
-				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, err.Error())
-			}
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, addr)
-		}
-
-		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
-		}
 
 		// Only if it's a MsgExecLegacyContent we try to execute the
 		// proposal in a cached context.
@@ -168,6 +144,40 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	return proposal, nil
 }
 
+// assertProposalMsg performs a basic validation of a proposal message, and checks that it has a
+// handler and the gov module account as its only signer.
+func (k Keeper) assertProposalMsg(ctx context.Context, msg sdk.Msg) error {
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, err.Error())
+		}
+		return errorsmod.Wrapf(types.ErrInvalidSigner, addr)
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+	}
+
+	return nil
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	proposal, err := k.Proposals.Get(ctx, proposalID)
@@ -283,7 +293,7 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 
 		if len(proposal.Messages) > 0 {
 			// check if any of the message has message based params
-			customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+			customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
 			if err == nil {
 				votingPeriod = customMessageParams.VotingPeriod
 			} else if !errors.Is(err, collections.ErrNotFound) {
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// storeTraceOperation is a store operation, as traced by the stores of a traced multistore.
+type storeTraceOperation struct {
+	Operation string         `json:"operation"`
+	Key       string         `json:"key"`
+	Value     string         `json:"value"`
+	Metadata  map[string]any `json:"metadata"`
+}
+
+// SimulateProposal executes the messages of a proposal against the current state, as they would
+// be executed once the proposal passed, and returns the results of the execution. The execution
+// happens in a branch of the state which is discarded afterwards.
+// The state changes are traced by the multistore of the sdk.Context, hence the simulation is
+// unimplemented for the contexts which do not carry one.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg) (*v1.QuerySimulateProposalResponse, error) {
+	sdkCtx, ok := unwrapSDKContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "proposal simulation is only supported with an sdk.Context")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range messages {
+		if err := k.assertProposalMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// the writes of the execution branch to the traced branch are traced, and the traced
+	// branch is never written to the state.
+	var trace bytes.Buffer
+	traced := sdkCtx.MultiStore().CacheMultiStore().SetTracer(&trace)
+	branch := traced.CacheMultiStore()
+	gasMeter := storetypes.NewGasMeter(params.ProposalExecutionGas)
+	branchCtx := sdkCtx.WithMultiStore(branch).WithGasMeter(gasMeter)
+
+	res := &v1.QuerySimulateProposalResponse{}
+	failed := false
+	for _, msg := range messages {
+		eventManager := sdk.NewEventManager()
+		gasBefore := gasMeter.GasConsumed()
+		msgRes, err := safeExecuteHandler(branchCtx.WithEventManager(eventManager), msg, k.MsgRouterService)
+
+		result := &v1.SimulatedMsgResult{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Events:     simulatedEvents(eventManager.Events()),
+			GasUsed:    gasMeter.GasConsumed() - gasBefore,
+		}
+		res.Results = append(res.Results, result)
+
+		// the execution of a proposal stops at its first failing message
+		if err != nil {
+			result.Error = err.Error()
+			failed = true
+			break
+		}
+
+		if msgRes != nil {
+			result.MsgResponse, err = codectypes.NewAnyWithValue(msgRes)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	res.GasUsed = gasMeter.GasConsumed()
+
+	// a failing proposal does not change the state
+	if failed {
+		return res, nil
+	}
+
+	trace.Reset()
+	branch.Write()
+
+	res.StateChanges, err = parseStateChanges(&trace)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// unwrapSDKContext returns the sdk.Context of a context, if any, without panicking.
+func unwrapSDKContext(ctx context.Context) (sdk.Context, bool) {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx, true
+	}
+
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	return sdkCtx, ok
+}
+
+// simulatedEvents converts the events emitted by a message to simulated events.
+func simulatedEvents(events sdk.Events) []v1.SimulatedEvent {
+	simulated := make([]v1.SimulatedEvent, 0, len(events))
+	for _, event := range events {
+		attributes := make([]v1.SimulatedEventAttribute, 0, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes = append(attributes, v1.SimulatedEventAttribute{Key: attribute.Key, Value: attribute.Value})
+		}
+
+		simulated = append(simulated, v1.SimulatedEvent{Type: event.Type, Attributes: attributes})
+	}
+
+	return simulated
+}
+
+// parseStateChanges parses the write and delete operations of a store trace into state changes,
+// sorted by store name and key.
+func parseStateChanges(trace *bytes.Buffer) ([]*v1.SimulatedStateChange, error) {
+	var changes []*v1.SimulatedStateChange
+	decoder := json.NewDecoder(trace)
+	for decoder.More() {
+		var op storeTraceOperation
+		if err := decoder.Decode(&op); err != nil {
+			return nil, err
+		}
+
+		if op.Operation != "write" && op.Operation != "delete" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		storeName, _ := op.Metadata["store_name"].(string)
+		changes = append(changes, &v1.SimulatedStateChange{
+			StoreName: storeName,
+			Key:       key,
+			Value:     value,
+			Delete:    op.Operation == "delete",
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].StoreName != changes[j].StoreName {
+			return changes[i].StoreName < changes[j].StoreName
+		}
+
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+
+	return changes, nil
+}
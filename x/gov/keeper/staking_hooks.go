@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ stakingtypes.StakingHooks         = ConvictionHooks{}
+	_ stakingtypes.DelegationSlashHooks = ConvictionHooks{}
+)
+
+// ConvictionHooks are the staking hooks preventing delegators from undelegating or redelegating
+// the shares of their delegations locked by conviction before the end of their lock.
+type ConvictionHooks struct {
+	k Keeper
+}
+
+// ConvictionHooks returns the conviction staking hooks of the gov keeper.
+func (k Keeper) ConvictionHooks() ConvictionHooks {
+	return ConvictionHooks{k}
+}
+
+// AfterDelegationModified fails if the shares of a delegation drop below its locked shares.
+func (h ConvictionHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	lock, found, err := h.k.getActiveConvictionLock(ctx, delAddr, valAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	lockedShares, err := math.LegacyNewDecFromStr(lock.Shares)
+	if err != nil {
+		return err
+	}
+
+	if delegation.GetShares().GTE(lockedShares) {
+		return nil
+	}
+
+	return errors.Wrapf(types.ErrConvictionLocked, "%s shares are locked until %s", lock.Shares, lock.EndTime)
+}
+
+// BeforeDelegationRemoved fails if the delegation is locked.
+func (h ConvictionHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	lock, found, err := h.k.getActiveConvictionLock(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	return errors.Wrapf(types.ErrConvictionLocked, "%s shares are locked until %s", lock.Shares, lock.EndTime)
+}
+
+// BeforeDelegationSlashed reduces the locked shares of a delegation to the shares left after a slash,
+// removing the lock if no shares are left, so that the lock does not prevent the slash.
+func (h ConvictionHooks) BeforeDelegationSlashed(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	lock, found, err := h.k.getActiveConvictionLock(ctx, delAddr, valAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remainingShares := delegation.GetShares().Sub(shares)
+	if !remainingShares.IsPositive() {
+		return h.k.removeConvictionLock(ctx, lock, delAddr, valAddr)
+	}
+
+	lockedShares, err := math.LegacyNewDecFromStr(lock.Shares)
+	if err != nil {
+		return err
+	}
+
+	if remainingShares.GTE(lockedShares) {
+		return nil
+	}
+
+	lock.Shares = remainingShares.String()
+	return h.k.SetConvictionLock(ctx, lock)
+}
+
+func (h ConvictionHooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h ConvictionHooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (h ConvictionHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (h ConvictionHooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
@@ -79,7 +79,7 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 
 	if len(proposal.Messages) > 0 {
 		// check if any of the message has message based params
-		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
 		if err != nil && !errors.Is(err, collections.ErrNotFound) {
 			return false, false, tallyResults, err
 		} else if err == nil {
@@ -234,13 +234,49 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 	return currValidators, nil
 }
 
-// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// delegationVotingPowerFn returns the voting power of the delegation of an account to a bonded validator.
+type delegationVotingPowerFn func(
+	ctx context.Context,
+	k Keeper,
+	delegatorAddr sdk.AccAddress,
+	delegation sdk.DelegationI,
+	validator v1.ValidatorGovInfo,
+) (math.LegacyDec, error)
+
+// defaultCalculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
+// and returns the votes results from voters.
+// The voting power of the accounts delegating it to a governor is tallied with the vote of the governor,
+// unless they voted themselves, or with the votes of their validators if the governor did not vote.
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return calculateVoteResultsAndVotingPower(ctx, k, proposalID, validators, defaultDelegationVotingPower)
+}
+
+// defaultDelegationVotingPower returns the voting power of a delegation, proportional to its shares.
+func defaultDelegationVotingPower(
+	_ context.Context,
+	_ Keeper,
+	_ sdk.AccAddress,
+	delegation sdk.DelegationI,
+	validator v1.ValidatorGovInfo,
+) (math.LegacyDec, error) {
+	// delegation shares * bonded / total shares
+	return delegation.GetShares().MulInt(validator.BondedTokens).Quo(validator.DelegatorShares), nil
+}
+
+// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
+// and returns the votes results from voters, the voting power of the delegations of the voters being
+// computed by the given function.
+func calculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+	delegationVotingPower delegationVotingPowerFn,
 ) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
@@ -248,6 +284,7 @@ func defaultCalculateVoteResultsAndVotingPower(
 	// iterate over all votes, tally up the voting power of each validator
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
 	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	governorVotes := []v1.Vote{}
 	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
@@ -265,33 +302,20 @@ func defaultCalculateVoteResultsAndVotingPower(
 			validators[valAddrStr] = val
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-
-				totalVP = totalVP.Add(votingPower)
-			}
+		// if governor, record its vote to tally the voting power delegated to it
+		isGovernor, err := k.Governors.Has(ctx, voter)
+		if err != nil {
+			return false, err
+		}
+		if isGovernor {
+			governorVotes = append(governorVotes, vote)
+		}
 
-			return false
-		})
+		votingPower, err := tallyAccountVotingPower(ctx, k, voter, vote.Options, validators, results, delegationVotingPower)
 		if err != nil {
 			return false, err
 		}
+		totalVP = totalVP.Add(votingPower)
 
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
@@ -299,6 +323,34 @@ func defaultCalculateVoteResultsAndVotingPower(
 		return math.LegacyDec{}, nil, err
 	}
 
+	// iterate over the votes of the governors, tally up the voting power delegated to them
+	// the number of delegators of each governor is bounded by the MaxGovernorDelegators config
+	for _, vote := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor)
+		if err := k.DelegationsByGovernor.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+			// the delegators voting themselves override the vote of their governor
+			voted, err := k.Votes.Has(ctx, collections.Join(proposalID, key.K2()))
+			if err != nil || voted {
+				return false, err
+			}
+
+			votingPower, err := tallyAccountVotingPower(ctx, k, key.K2(), vote.Options, validators, results, delegationVotingPower)
+			if err != nil {
+				return false, err
+			}
+			totalVP = totalVP.Add(votingPower)
+
+			return false, nil
+		}); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
@@ -326,6 +378,56 @@ func defaultCalculateVoteResultsAndVotingPower(
 	return totalVP, results, nil
 }
 
+// tallyAccountVotingPower iterates over all delegations of an account, deducts them from the delegated-to
+// validators and tallies up their voting power with the given vote options. It returns the voting power of
+// the account.
+func tallyAccountVotingPower(
+	ctx context.Context,
+	k Keeper,
+	account sdk.AccAddress,
+	options v1.WeightedVoteOptions,
+	validators map[string]v1.ValidatorGovInfo,
+	results map[v1.VoteOption]math.LegacyDec,
+	delegationVotingPower delegationVotingPowerFn,
+) (math.LegacyDec, error) {
+	totalVotingPower := math.LegacyZeroDec()
+	var powerErr error
+	err := k.sk.IterateDelegations(ctx, account, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			votingPower, err := delegationVotingPower(ctx, k, account, delegation, val)
+			if err != nil {
+				powerErr = err
+				return true
+			}
+
+			for _, option := range options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				subPower := votingPower.Mul(weight)
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+
+			totalVotingPower = totalVotingPower.Add(votingPower)
+		}
+
+		return false
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if powerErr != nil {
+		return math.LegacyDec{}, powerErr
+	}
+
+	return totalVotingPower, nil
+}
+
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,7 @@ import (
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
@@ -52,6 +54,39 @@ var (
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	delegationsOnValidator = func(s tallyFixture, delegator sdk.AccAddress, validator sdk.ValAddress, shares int64) []stakingtypes.Delegation {
+		delAddr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delegator)
+		require.NoError(s.t, err)
+		valAddr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(validator)
+		require.NoError(s.t, err)
+		return []stakingtypes.Delegation{{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}}
+	}
+	governorDelegation = func(s tallyFixture, delegator, governor sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		// governorDelegation delegates the voting power of delegator to governor, and mocks the
+		// delegations of delegator if they are tallied with the vote of governor
+		has, err := s.keeper.Governors.Has(s.ctx, governor)
+		require.NoError(s.t, err)
+		if !has {
+			require.NoError(s.t, s.keeper.RegisterGovernor(s.ctx, governor))
+		}
+		require.NoError(s.t, s.keeper.DelegateVote(s.ctx, delegator, governor))
+		if delegations == nil {
+			return
+		}
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -455,6 +490,72 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "governor votes with the voting power delegated to it: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				governorDelegation(s, s.delAddrs[0], s.delAddrs[1], delegationsOnValidator(s, s.delAddrs[0], s.valAddrs[0], 21))
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "21",
+				AbstainCount:     "0",
+				NoCount:          "999979",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "21",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999979",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "delegator vote overrides the vote of its governor: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				governorDelegation(s, s.delAddrs[0], s.delAddrs[1], nil)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[0], delegationsOnValidator(s, s.delAddrs[0], s.valAddrs[0], 21), v1.VoteOption_VOTE_OPTION_TWO)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "21",
+				NoCount:          "999979",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "21",
+				OptionThreeCount: "999979",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "governor does not vote, delegator inherits the vote of its validator: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				governorDelegation(s, s.delAddrs[0], s.delAddrs[1], nil)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1546,3 +1647,135 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_Conviction(t *testing.T) {
+	tests := []struct {
+		name        string
+		lockShares  string
+		multiplier  string
+		lockEnd     time.Duration
+		expectedYes string
+	}{
+		{
+			name:        "no lock: shares count once",
+			expectedYes: "100",
+		},
+		{
+			name:        "all shares locked: shares weighted by the multiplier",
+			lockShares:  "100",
+			multiplier:  "3",
+			lockEnd:     time.Hour,
+			expectedYes: "300",
+		},
+		{
+			name:        "shares delegated since the lock: only locked shares weighted",
+			lockShares:  "40",
+			multiplier:  "2",
+			lockEnd:     time.Hour,
+			expectedYes: "140",
+		},
+		{
+			name:        "ended lock: shares count once",
+			lockShares:  "100",
+			multiplier:  "3",
+			lockEnd:     -time.Hour,
+			expectedYes: "100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			addrs := simtestutil.CreateRandomAccounts(2)
+			valAddr, delAddr := sdk.ValAddress(addrs[0]), addrs[1]
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			s := tallyFixture{t: t, ctx: ctx, keeper: govKeeper, mocks: mocks}
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddr, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+			s.proposal = proposal
+
+			delegations := delegationsOnValidator(s, delAddr, valAddr, 100)
+			if tt.lockShares != "" {
+				require.NoError(t, govKeeper.SetConvictionLock(ctx, v1.ConvictionLock{
+					DelegatorAddress: delegations[0].DelegatorAddress,
+					ValidatorAddress: delegations[0].ValidatorAddress,
+					Shares:           tt.lockShares,
+					Multiplier:       tt.multiplier,
+					EndTime:          ctx.HeaderInfo().Time.Add(tt.lockEnd),
+				}))
+			}
+			delegatorVote(s, delAddr, delegations, v1.OptionYes)
+
+			validators := map[string]v1.ValidatorGovInfo{
+				delegations[0].ValidatorAddress: v1.NewValidatorGovInfo(valAddr, sdkmath.NewInt(1000000), sdkmath.LegacyNewDec(1000000), sdkmath.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+			}
+			totalVP, results, err := keeper.ConvictionCalculateVoteResultsAndVotingPower(ctx, *govKeeper, proposal.Id, validators)
+			require.NoError(t, err)
+
+			expectedYes, err := sdkmath.LegacyNewDecFromStr(tt.expectedYes)
+			require.NoError(t, err)
+			require.True(t, expectedYes.Equal(results[v1.OptionYes]), results[v1.OptionYes].String())
+			require.True(t, expectedYes.Equal(totalVP), totalVP.String())
+			// the deductions from the validator are the actual shares of the delegation
+			require.True(t, sdkmath.LegacyNewDec(100).Equal(validators[delegations[0].ValidatorAddress].DelegatorDeductions))
+		})
+	}
+}
+
+func TestTally_GovernorManyDelegators(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	maxDelegators := int(keeper.DefaultConfig().MaxGovernorDelegators)
+	addrs := simtestutil.CreateRandomAccounts(maxDelegators + 3)
+	valAddr, governor, other, delAddrs := sdk.ValAddress(addrs[0]), addrs[1], addrs[2], addrs[3:]
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	valAddrStr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, govKeeper.RegisterGovernor(ctx, governor))
+	for _, delAddr := range delAddrs {
+		require.NoError(t, govKeeper.DelegateVote(ctx, delAddr, governor))
+	}
+
+	// the governor cannot have more than the maximum number of delegators
+	err = govKeeper.DelegateVote(ctx, other, governor)
+	require.ErrorIs(t, err, types.ErrMaxGovernorDelegators)
+	count, err := govKeeper.GovernorDelegators.Get(ctx, governor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxDelegators), count)
+
+	// a delegator can still delegate again to the same governor
+	require.NoError(t, govKeeper.DelegateVote(ctx, delAddrs[0], governor))
+
+	// each delegator has one share on the validator
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				if delegator.Equals(governor) {
+					return nil
+				}
+				delAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(delegator)
+				require.NoError(t, err)
+				fn(0, stakingtypes.Delegation{DelegatorAddress: delAddr, ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyOneDec()})
+				return nil
+			}).
+		Times(maxDelegators + 1)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", governor, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, governor, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	validators := map[string]v1.ValidatorGovInfo{
+		valAddrStr: v1.NewValidatorGovInfo(valAddr, sdkmath.NewInt(1000000), sdkmath.LegacyNewDec(1000000), sdkmath.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+	}
+	totalVP, results, err := keeper.DefaultCalculateVoteResultsAndVotingPower(ctx, *govKeeper, proposal.Id, validators)
+	require.NoError(t, err)
+
+	// the voting power of all the delegators is tallied with the vote of the governor
+	expected := sdkmath.LegacyNewDec(int64(maxDelegators))
+	require.True(t, expected.Equal(results[v1.OptionYes]), results[v1.OptionYes].String())
+	require.True(t, expected.Equal(totalVP), totalVP.String())
+	require.True(t, expected.Equal(validators[valAddrStr].DelegatorDeductions))
+}
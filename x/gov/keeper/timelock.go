@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// proposalMessageBasedParams returns the message based params of a proposal, if any.
+// Proposals with message based params only have one message.
+func (k Keeper) proposalMessageBasedParams(ctx context.Context, proposal v1.Proposal) (v1.MessageBasedParams, bool, error) {
+	if len(proposal.Messages) == 0 {
+		return v1.MessageBasedParams{}, false, nil
+	}
+
+	params, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return v1.MessageBasedParams{}, false, nil
+		}
+
+		return v1.MessageBasedParams{}, false, err
+	}
+
+	return params, true, nil
+}
+
+// timelockProposal puts a passed proposal in the timelock queue if its message based params
+// define a timelock, and reports whether it did.
+func (k Keeper) timelockProposal(ctx context.Context, proposal *v1.Proposal) (bool, error) {
+	params, found, err := k.proposalMessageBasedParams(ctx, *proposal)
+	if err != nil || !found {
+		return false, err
+	}
+
+	if params.Timelock == nil || *params.Timelock <= 0 {
+		return false, nil
+	}
+
+	endTime := k.HeaderService.HeaderInfo(ctx).Time.Add(*params.Timelock)
+	proposal.TimelockEndTime = &endTime
+	proposal.Status = v1.StatusTimelocked
+
+	return true, k.TimelockProposalsQueue.Set(ctx, collections.Join(endTime, proposal.Id), proposal.Id)
+}
+
+// assertTimelockVetoVote checks that a vote on a timelocked proposal is a veto vote,
+// and that the message based params of the proposal enable the veto vote.
+func (k Keeper) assertTimelockVetoVote(ctx context.Context, proposal v1.Proposal, options v1.WeightedVoteOptions) error {
+	params, _, err := k.proposalMessageBasedParams(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	if params.TimelockVetoThreshold == "" {
+		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposal.Id)
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(params.TimelockVetoThreshold)
+	if err != nil {
+		return err
+	}
+	if threshold.IsZero() {
+		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposal.Id)
+	}
+
+	for _, option := range options {
+		if option.Option != v1.OptionNoWithVeto {
+			return errors.Wrap(types.ErrInvalidVote, "timelocked proposals can only be vetoed")
+		}
+	}
+
+	return nil
+}
+
+// tallyTimelockVeto tallies the veto votes cast during the timelock of a proposal, and reports
+// whether they reached the timelock veto threshold of the proposal.
+func (k Keeper) tallyTimelockVeto(ctx context.Context, proposal v1.Proposal) (bool, error) {
+	params, _, err := k.proposalMessageBasedParams(ctx, proposal)
+	if err != nil {
+		return false, err
+	}
+
+	threshold := math.LegacyZeroDec()
+	if params.TimelockVetoThreshold != "" {
+		threshold, err = math.LegacyNewDecFromStr(params.TimelockVetoThreshold)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if threshold.IsZero() {
+		return false, k.deleteVotes(ctx, proposal.Id)
+	}
+
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	}
+
+	_, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	if err != nil {
+		return false, err
+	}
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, nil
+	}
+
+	return results[v1.OptionNoWithVeto].Quo(math.LegacyNewDecFromInt(totalBonded)).GTE(threshold), nil
+}
+
+// VetoTimelockedProposal vetoes a proposal during its timelock on behalf of its veto guardian.
+func (k Keeper) VetoTimelockedProposal(ctx context.Context, proposalID uint64, guardianAddr sdk.AccAddress) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrInvalidProposal, "proposal %d doesn't exist", proposalID)
+		}
+
+		return err
+	}
+
+	if proposal.Status != v1.StatusTimelocked {
+		return errors.Wrapf(types.ErrInvalidProposal, "proposal %d is not timelocked", proposalID)
+	}
+
+	params, _, err := k.proposalMessageBasedParams(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	if params.VetoGuardian == "" {
+		return errors.Wrapf(types.ErrInvalidGuardian, "proposal %d has no veto guardian", proposalID)
+	}
+
+	guardian, err := k.authKeeper.AddressCodec().StringToBytes(params.VetoGuardian)
+	if err != nil {
+		return err
+	}
+
+	if !guardianAddr.Equals(sdk.AccAddress(guardian)) {
+		return errors.Wrapf(types.ErrInvalidGuardian, "expected %s", params.VetoGuardian)
+	}
+
+	if err := k.TimelockProposalsQueue.Remove(ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id)); err != nil {
+		return err
+	}
+
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusRejected
+	proposal.FailedReason = "proposal vetoed by its guardian during its timelock"
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+
+	k.Logger.Info(
+		"proposal vetoed",
+		"proposal", proposal.Id,
+		"guardian", params.VetoGuardian,
+	)
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeVetoProposal,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		event.NewAttribute(types.AttributeKeyGuardian, params.VetoGuardian),
+	)
+}
+
+// processTimelockedProposals executes the timelocked proposals whose timelock ended before
+// the given time, unless vetoed by vote during their timelock.
+func (k Keeper) processTimelockedProposals(ctx context.Context, blockTime time.Time, params v1.Params) error {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](blockTime)
+	iter, err := k.TimelockProposalsQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	timelockedProps, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, prop := range timelockedProps {
+		proposal, err := k.Proposals.Get(ctx, prop.Key.K2())
+		if err != nil {
+			// if the proposal has an encoding error, this means it cannot be processed by x/gov
+			// instead of returning an error (i.e, halting the chain), we fail the proposal
+			if stderrors.Is(err, collections.ErrEncoding) {
+				proposal.Id = prop.Key.K2()
+				if err := failUnsupportedProposal(ctx, k, proposal, err.Error(), true); err != nil {
+					return err
+				}
+
+				if err = k.TimelockProposalsQueue.Remove(ctx, prop.Key); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			return err
+		}
+
+		if err = k.TimelockProposalsQueue.Remove(ctx, prop.Key); err != nil {
+			return err
+		}
+
+		vetoed, err := k.tallyTimelockVeto(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		var tagValue, logMsg string
+		if vetoed {
+			proposal.Status = v1.StatusRejected
+			proposal.FailedReason = "proposal vetoed by vote during its timelock"
+			tagValue = types.AttributeValueProposalVetoed
+			logMsg = "vetoed"
+		} else {
+			tagValue, logMsg = k.executeProposal(ctx, &proposal, params)
+		}
+
+		if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+
+		k.Logger.Info(
+			"proposal timelock ended",
+			"proposal", proposal.Id,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeTimelockedProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+	}
+
+	return nil
+}
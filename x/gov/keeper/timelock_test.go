@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// submitTimelockedProposal submits a MsgUpdateParams proposal, with message based params defining
+// a one hour timelock, and timelocks it as if it had passed.
+func (suite *KeeperTestSuite) submitTimelockedProposal(vetoThreshold, vetoGuardian string) v1.Proposal {
+	votingPeriod, timelock := time.Hour, time.Hour
+	params := v1.MessageBasedParams{
+		VotingPeriod:          &votingPeriod,
+		Quorum:                "0.334",
+		YesQuorum:             "0",
+		Threshold:             "0.5",
+		VetoThreshold:         "0.334",
+		Timelock:              &timelock,
+		VetoGuardian:          vetoGuardian,
+		TimelockVetoThreshold: vetoThreshold,
+	}
+
+	govStrAcct, err := suite.acctKeeper.AddressCodec().BytesToString(govAcct)
+	suite.Require().NoError(err)
+
+	msg := &v1.MsgUpdateParams{Authority: govStrAcct, Params: v1.DefaultParams()}
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(suite.ctx, sdk.MsgTypeURL(msg), params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msg}, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	endTime := suite.ctx.HeaderInfo().Time.Add(*params.Timelock)
+	proposal.Status = v1.StatusTimelocked
+	proposal.TimelockEndTime = &endTime
+	tally := v1.EmptyTallyResult()
+	proposal.FinalTallyResult = &tally
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal))
+	suite.Require().NoError(suite.govKeeper.TimelockProposalsQueue.Set(suite.ctx, collections.Join(endTime, proposal.Id), proposal.Id))
+
+	return proposal
+}
+
+func (suite *KeeperTestSuite) TestTimelockedProposal() {
+	testCases := []struct {
+		name       string
+		threshold  string
+		votes      []sdk.AccAddress
+		expVoteErr error
+		expStatus  v1.ProposalStatus
+	}{
+		{
+			name:       "veto vote disabled",
+			threshold:  "",
+			votes:      []sdk.AccAddress{suite.addrs[1]},
+			expVoteErr: types.ErrInactiveProposal,
+			expStatus:  v1.StatusPassed,
+		},
+		{
+			name:      "veto vote below threshold",
+			threshold: "0.5",
+			votes:     []sdk.AccAddress{suite.addrs[1]},
+			expStatus: v1.StatusPassed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.reset()
+			proposal := suite.submitTimelockedProposal(tc.threshold, "")
+
+			for _, voter := range tc.votes {
+				err := suite.govKeeper.AddVote(suite.ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+				if tc.expVoteErr != nil {
+					suite.Require().ErrorIs(err, tc.expVoteErr)
+				} else {
+					suite.Require().NoError(err)
+				}
+			}
+
+			// the proposal is not executed before the end of its timelock
+			suite.Require().NoError(suite.govKeeper.EndBlocker(suite.ctx))
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.StatusTimelocked, proposal.Status)
+
+			newHeader := suite.ctx.HeaderInfo()
+			newHeader.Time = proposal.TimelockEndTime.Add(time.Second)
+			ctx := suite.ctx.WithHeaderInfo(newHeader)
+			suite.Require().NoError(suite.govKeeper.EndBlocker(ctx))
+
+			proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStatus, proposal.Status)
+
+			has, err := suite.govKeeper.TimelockProposalsQueue.Has(ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTimelockedProposalVoteOptions() {
+	suite.reset()
+	proposal := suite.submitTimelockedProposal("0.5", "")
+
+	err := suite.govKeeper.AddVote(suite.ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+
+	err = suite.govKeeper.AddVote(suite.ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+	suite.Require().NoError(err)
+}
@@ -26,8 +26,14 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
-	// check if proposal is in voting period.
-	if proposal.Status != v1.StatusVotingPeriod {
+	// check if proposal is in voting period, or timelocked and open to veto votes.
+	switch proposal.Status {
+	case v1.StatusVotingPeriod:
+	case v1.StatusTimelocked:
+		if err := k.assertTimelockVetoVote(ctx, proposal, options); err != nil {
+			return err
+		}
+	default:
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
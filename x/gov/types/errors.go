@@ -28,4 +28,12 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrGovernorNotFound        = errors.Register(ModuleName, 27, "governor not found")
+	ErrGovernorExists          = errors.Register(ModuleName, 28, "governor already registered")
+	ErrInvalidGovDelegation    = errors.Register(ModuleName, 29, "invalid governance delegation")
+	ErrGovDelegationNotFound   = errors.Register(ModuleName, 30, "governance delegation not found")
+	ErrInvalidGuardian         = errors.Register(ModuleName, 31, "invalid veto guardian")
+	ErrInvalidConvictionLock   = errors.Register(ModuleName, 32, "invalid conviction lock")
+	ErrConvictionLocked        = errors.Register(ModuleName, 33, "delegation locked by conviction")
+	ErrMaxGovernorDelegators   = errors.Register(ModuleName, 34, "governor has reached the maximum number of delegators")
 )
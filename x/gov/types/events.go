@@ -9,6 +9,14 @@ const (
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
 
+	EventTypeRegisterGovernor   = "register_governor"
+	EventTypeDeregisterGovernor = "deregister_governor"
+	EventTypeDelegateVote       = "delegate_vote"
+	EventTypeUndelegateVote     = "undelegate_vote"
+	EventTypeTimelockedProposal = "timelocked_proposal"
+	EventTypeVetoProposal       = "veto_proposal"
+	EventTypeLockConviction     = "lock_conviction"
+
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
 	AttributeKeyOption               = "option"
@@ -31,4 +39,14 @@ const (
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
 	AttributeSignalDescription = "signal_description"
+
+	AttributeKeyGovernor   = "governor"
+	AttributeKeyDelegator  = "delegator"
+	AttributeKeyGuardian   = "guardian"
+	AttributeKeyValidator  = "validator"
+	AttributeKeyMultiplier = "multiplier"
+	AttributeKeyEndTime    = "end_time"
+
+	AttributeValueProposalTimelocked = "proposal_timelocked" // passed and waits for its timelock to end
+	AttributeValueProposalVetoed     = "proposal_vetoed"     // vetoed during its timelock
 )
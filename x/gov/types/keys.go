@@ -26,6 +26,13 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	GovernorsKeyPrefix           = collections.NewPrefix(52) // GovernorsKeyPrefix stores the governors.
+	GovernanceDelegationsPrefix  = collections.NewPrefix(53) // GovernanceDelegationsPrefix stores the governance delegations by delegator.
+	DelegationsByGovernorPrefix  = collections.NewPrefix(54) // DelegationsByGovernorPrefix indexes the governance delegations by governor.
+	TimelockProposalQueuePrefix  = collections.NewPrefix(55) // TimelockProposalQueuePrefix stores the timelocked proposals.
+	ConvictionLocksPrefix        = collections.NewPrefix(56) // ConvictionLocksPrefix stores the conviction locks by delegator and validator.
+	ConvictionLocksQueuePrefix   = collections.NewPrefix(57) // ConvictionLocksQueuePrefix indexes the conviction locks by end time.
+	GovernorDelegatorsPrefix     = collections.NewPrefix(58) // GovernorDelegatorsPrefix stores the number of accounts delegating to each governor.
 )
 
 // Reserved kvstore keys
@@ -21,6 +21,12 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterGovernor{}, "cosmos-sdk/v1/MsgRegisterGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterGovernor{}, "cosmos-sdk/v1/MsgDeregisterGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "cosmos-sdk/v1/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "cosmos-sdk/v1/MsgUndelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "cosmos-sdk/v1/MsgVetoProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgLockConviction{}, "cosmos-sdk/v1/MsgLockConviction")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -35,6 +41,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgRegisterGovernor{},
+		&MsgDeregisterGovernor{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
+		&MsgVetoProposal{},
+		&MsgLockConviction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
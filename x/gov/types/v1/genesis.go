@@ -7,6 +7,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -50,6 +51,10 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 			return fmt.Errorf("duplicate proposal id: %d", p.Id)
 		}
 
+		if p.Status == StatusTimelocked && p.TimelockEndTime == nil {
+			return fmt.Errorf("timelocked proposal %d has no timelock end time", p.Id)
+		}
+
 		proposalIds[p.Id] = struct{}{}
 	}
 
@@ -99,6 +104,74 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate governors and invalid governance delegations
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, err := ac.StringToBytes(g.Address); err != nil {
+				return fmt.Errorf("invalid governor address %s: %w", g.Address, err)
+			}
+
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.Address)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := ac.StringToBytes(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegator address %s: %w", d.DelegatorAddress, err)
+			}
+
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+
+			if _, ok := governors[d.DelegatorAddress]; ok {
+				return fmt.Errorf("governance delegation %v is from a governor", d)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate and invalid conviction locks
+	errGroup.Go(func() error {
+		locks := make(map[string]struct{})
+		for _, l := range data.ConvictionLocks {
+			if _, err := ac.StringToBytes(l.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid conviction lock delegator address %s: %w", l.DelegatorAddress, err)
+			}
+
+			shares, err := sdkmath.LegacyNewDecFromStr(l.Shares)
+			if err != nil || !shares.IsPositive() {
+				return fmt.Errorf("conviction lock %v has invalid shares: %s", l, l.Shares)
+			}
+
+			multiplier, err := sdkmath.LegacyNewDecFromStr(l.Multiplier)
+			if err != nil || multiplier.LT(sdkmath.LegacyOneDec()) {
+				return fmt.Errorf("conviction lock %v has invalid multiplier: %s", l, l.Multiplier)
+			}
+
+			lk := l.DelegatorAddress + "/" + l.ValidatorAddress
+			if _, ok := locks[lk]; ok {
+				return fmt.Errorf("duplicate conviction lock: %v", l)
+			}
+
+			locks[lk] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -23,6 +24,19 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	governor, err := codec.BytesToString(sdk.AccAddress("governor"))
+	require.NoError(t, err)
+	delegator, err := codec.BytesToString(sdk.AccAddress("delegator"))
+	require.NoError(t, err)
+	convictionLock := func(multiplier string) *v1.ConvictionLock {
+		return &v1.ConvictionLock{
+			DelegatorAddress: delegator,
+			ValidatorAddress: "cosmosvaloper1...",
+			Shares:           "100",
+			Multiplier:       multiplier,
+			EndTime:          time.Now(),
+		}
+	}
 
 	testCases := []struct {
 		name         string
@@ -106,6 +120,16 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "duplicate proposal id: 1",
 		},
+		{
+			name: "timelocked proposal without timelock end time",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusTimelocked})
+
+				return state
+			},
+			expErrMsg: "timelocked proposal 1 has no timelock end time",
+		},
 		{
 			name: "duplicate votes",
 			genesisState: func() *v1.GenesisState {
@@ -172,6 +196,104 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid governance delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: governor})
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor})
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors,
+					&v1.Governor{Address: governor},
+					&v1.Governor{Address: governor})
+
+				return state
+			},
+			expErrMsg: "duplicate governor",
+		},
+		{
+			name: "non-existent governor in governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor})
+
+				return state
+			},
+			expErrMsg: "has non-existent governor",
+		},
+		{
+			name: "governance delegation from a governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: governor})
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: governor, GovernorAddress: governor})
+
+				return state
+			},
+			expErrMsg: "is from a governor",
+		},
+		{
+			name: "duplicate governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = append(state.Governors, &v1.Governor{Address: governor})
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor},
+					&v1.GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor})
+
+				return state
+			},
+			expErrMsg: "duplicate governance delegation",
+		},
+		{
+			name: "valid conviction lock",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ConvictionLocks = append(state.ConvictionLocks, convictionLock("2"))
+
+				return state
+			},
+		},
+		{
+			name: "conviction lock with invalid multiplier",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ConvictionLocks = append(state.ConvictionLocks, convictionLock("0.5"))
+
+				return state
+			},
+			expErrMsg: "has invalid multiplier",
+		},
+		{
+			name: "duplicate conviction locks",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ConvictionLocks = append(state.ConvictionLocks, convictionLock("2"), convictionLock("3"))
+
+				return state
+			},
+			expErrMsg: "duplicate conviction lock",
+		},
+		{
+			name: "invalid conviction period",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ConvictionPeriods = []v1.ConvictionPeriod{{Duration: time.Hour, Multiplier: "0.5"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+			},
+			expErrMsg: "conviction period multiplier must be greater than or equal to one",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -154,6 +154,9 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_TIMELOCKED defines a proposal status of a proposal that has
+	// passed and waits for its timelock to end before being executed.
+	ProposalStatus_PROPOSAL_STATUS_TIMELOCKED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +166,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_TIMELOCKED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +176,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_TIMELOCKED":     6,
 }
 
 func (x ProposalStatus) String() string {
@@ -342,6 +347,9 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// timelock_end_time is the end time of the timelock of a passed proposal, after which it is executed
+	// unless vetoed.
+	TimelockEndTime *time.Time `protobuf:"bytes,17,opt,name=timelock_end_time,json=timelockEndTime,proto3,stdtime" json:"timelock_end_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +498,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetTimelockEndTime() *time.Time {
+	if m != nil {
+		return m.TimelockEndTime
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -1005,6 +1020,10 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// conviction_periods defines the lock periods delegators can lock their delegations for,
+	// and the voting power multiplier each of them grants to the locked shares.
+	// If empty then conviction voting is disabled.
+	ConvictionPeriods []ConvictionPeriod `protobuf:"bytes,23,rep,name=conviction_periods,json=convictionPeriods,proto3" json:"conviction_periods"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1194,6 +1213,69 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetConvictionPeriods() []ConvictionPeriod {
+	if m != nil {
+		return m.ConvictionPeriods
+	}
+	return nil
+}
+
+// ConvictionPeriod defines a lock period and the voting power multiplier it grants.
+type ConvictionPeriod struct {
+	// duration is the duration of the lock.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// multiplier is the voting power multiplier of the shares locked for the duration.
+	// It must be greater than or equal to one.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (m *ConvictionPeriod) Reset()         { *m = ConvictionPeriod{} }
+func (m *ConvictionPeriod) String() string { return proto.CompactTextString(m) }
+func (*ConvictionPeriod) ProtoMessage()    {}
+func (*ConvictionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ConvictionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionPeriod.Merge(m, src)
+}
+func (m *ConvictionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionPeriod proto.InternalMessageInfo
+
+func (m *ConvictionPeriod) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ConvictionPeriod) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1209,13 +1291,22 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Duration a passed proposal waits before being executed, during which it can be vetoed.
+	// If zero then the proposal is executed as soon as it passes.
+	Timelock *time.Duration `protobuf:"bytes,5,opt,name=timelock,proto3,stdduration" json:"timelock,omitempty"`
+	// veto_guardian is the address allowed to veto a proposal during its timelock.
+	// If empty then no guardian can veto the proposal.
+	VetoGuardian string `protobuf:"bytes,6,opt,name=veto_guardian,json=vetoGuardian,proto3" json:"veto_guardian,omitempty"`
+	// Minimum proportion of the total stake voting NoWithVeto during the timelock for the proposal to be vetoed.
+	// If zero then the proposal cannot be vetoed by vote during its timelock.
+	TimelockVetoThreshold string `protobuf:"bytes,7,opt,name=timelock_veto_threshold,json=timelockVetoThreshold,proto3" json:"timelock_veto_threshold,omitempty"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
 func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
 func (*MessageBasedParams) ProtoMessage()    {}
 func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{10}
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
 }
 func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,6 +1370,213 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetTimelock() *time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetVetoGuardian() string {
+	if m != nil {
+		return m.VetoGuardian
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetTimelockVetoThreshold() string {
+	if m != nil {
+		return m.TimelockVetoThreshold
+	}
+	return ""
+}
+
+// Governor defines an account registered as a governor, to which other accounts
+// can delegate their governance voting power.
+type Governor struct {
+	// address is the address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// GovernanceDelegation defines the delegation of the governance voting power of an
+// account to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the address of the delegating account.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// ConvictionLock defines the lock of the shares of a delegation, granting them a voting
+// power multiplier until the end of the lock.
+type ConvictionLock struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator the locked shares are delegated to.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the locked delegation shares.
+	Shares string `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	// multiplier is the voting power multiplier of the locked shares.
+	Multiplier string `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// end_time is the time at which the lock ends.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *ConvictionLock) Reset()         { *m = ConvictionLock{} }
+func (m *ConvictionLock) String() string { return proto.CompactTextString(m) }
+func (*ConvictionLock) ProtoMessage()    {}
+func (*ConvictionLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *ConvictionLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionLock.Merge(m, src)
+}
+func (m *ConvictionLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionLock proto.InternalMessageInfo
+
+func (m *ConvictionLock) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetShares() string {
+	if m != nil {
+		return m.Shares
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1293,139 +1591,164 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ConvictionPeriod)(nil), "cosmos.gov.v1.ConvictionPeriod")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*ConvictionLock)(nil), "cosmos.gov.v1.ConvictionLock")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x94, 0x44, 0x3e, 0x51, 0xd4, 0x6a, 0x24, 0x59, 0x6b, 0x29, 0xfa, 0x63, 0xa1,
+	0x48, 0x55, 0x27, 0xa2, 0x24, 0xa7, 0x6a, 0x53, 0x37, 0x39, 0x90, 0xe2, 0xda, 0xa6, 0x2b, 0x89,
+	0xec, 0x92, 0x92, 0xe3, 0x06, 0xc5, 0x62, 0xc5, 0x1d, 0x53, 0x1b, 0x93, 0xbb, 0xec, 0xee, 0x92,
+	0x92, 0xfa, 0x29, 0x7c, 0x74, 0x2e, 0x41, 0x6f, 0xed, 0xa1, 0x87, 0x02, 0x35, 0xfa, 0x19, 0x82,
+	0x9e, 0x02, 0x1f, 0x8a, 0xc2, 0x40, 0xdd, 0xc2, 0x3e, 0x14, 0xc8, 0xa1, 0x1f, 0xa0, 0xe8, 0xa1,
+	0x98, 0x3f, 0xfb, 0x97, 0xa4, 0x49, 0x07, 0xb9, 0x24, 0xe2, 0xcc, 0xef, 0xf7, 0x9b, 0x37, 0xf3,
+	0xde, 0xbc, 0xf7, 0x76, 0x0c, 0x4b, 0x0d, 0xcb, 0x69, 0x5b, 0xce, 0x4e, 0xd3, 0xea, 0xed, 0xf4,
+	0xf6, 0xc8, 0xff, 0xf2, 0x1d, 0xdb, 0x72, 0x2d, 0x34, 0xc3, 0x26, 0xf2, 0x64, 0xa4, 0xb7, 0xb7,
+	0xbc, 0xc6, 0x71, 0x67, 0x9a, 0x83, 0x77, 0x7a, 0x7b, 0x67, 0xd8, 0xd5, 0xf6, 0x76, 0x1a, 0x96,
+	0x61, 0x32, 0xf8, 0xf2, 0x42, 0xd3, 0x6a, 0x5a, 0xf4, 0xcf, 0x1d, 0xf2, 0x17, 0x1f, 0x5d, 0x6f,
+	0x5a, 0x56, 0xb3, 0x85, 0x77, 0xe8, 0xaf, 0xb3, 0xee, 0xe3, 0x1d, 0xd7, 0x68, 0x63, 0xc7, 0xd5,
+	0xda, 0x1d, 0x0e, 0xb8, 0x11, 0x07, 0x68, 0xe6, 0x15, 0x9f, 0x5a, 0x8b, 0x4f, 0xe9, 0x5d, 0x5b,
+	0x73, 0x0d, 0xcb, 0x5b, 0xf1, 0x06, 0xb3, 0x48, 0x65, 0x8b, 0x72, 0x6b, 0xd9, 0xd4, 0x9c, 0xd6,
+	0x36, 0x4c, 0x6b, 0x87, 0xfe, 0x97, 0x0d, 0x6d, 0x5a, 0x80, 0x1e, 0x62, 0xa3, 0x79, 0xee, 0x62,
+	0xfd, 0xd4, 0x72, 0x71, 0xa5, 0x43, 0x94, 0xd0, 0x1e, 0x4c, 0x5a, 0xf4, 0x2f, 0x49, 0xd8, 0x10,
+	0xb6, 0x72, 0xb7, 0x6f, 0xe4, 0x23, 0xbb, 0xce, 0x07, 0x50, 0x85, 0x03, 0xd1, 0xfb, 0x30, 0x79,
+	0x41, 0x85, 0xa4, 0xc4, 0x86, 0xb0, 0x95, 0x29, 0xe6, 0x5e, 0x3c, 0xdf, 0x06, 0xce, 0x2a, 0xe1,
+	0x86, 0xc2, 0x67, 0x37, 0x7f, 0x27, 0xc0, 0x54, 0x09, 0x77, 0x2c, 0xc7, 0x70, 0xd1, 0x3a, 0x4c,
+	0x77, 0x6c, 0xab, 0x63, 0x39, 0x5a, 0x4b, 0x35, 0x74, 0xba, 0x56, 0x4a, 0x01, 0x6f, 0xa8, 0xac,
+	0xa3, 0x9f, 0x40, 0x46, 0x67, 0x58, 0xcb, 0xe6, 0xba, 0xd2, 0x8b, 0xe7, 0xdb, 0x0b, 0x5c, 0xb7,
+	0xa0, 0xeb, 0x36, 0x76, 0x9c, 0x9a, 0x6b, 0x1b, 0x66, 0x53, 0x09, 0xa0, 0xe8, 0x13, 0x98, 0xd4,
+	0xda, 0x56, 0xd7, 0x74, 0xa5, 0xe4, 0x46, 0x72, 0x6b, 0x3a, 0xb0, 0x9f, 0xb8, 0x29, 0xcf, 0xdd,
+	0x94, 0x3f, 0xb0, 0x0c, 0xb3, 0x98, 0xf9, 0xfa, 0xd5, 0xfa, 0xb5, 0x3f, 0xfc, 0xfb, 0x4f, 0xb7,
+	0x04, 0x85, 0x73, 0x36, 0xbf, 0x4a, 0x43, 0xba, 0xca, 0x8d, 0x40, 0x39, 0x48, 0xf8, 0xa6, 0x25,
+	0x0c, 0x1d, 0xed, 0x42, 0xba, 0x8d, 0x1d, 0x47, 0x6b, 0x62, 0x47, 0x4a, 0x50, 0xf1, 0x85, 0x3c,
+	0xf3, 0x48, 0xde, 0xf3, 0x48, 0xbe, 0x60, 0x5e, 0x29, 0x3e, 0x0a, 0xed, 0xc3, 0xa4, 0xe3, 0x6a,
+	0x6e, 0xd7, 0x91, 0x92, 0xf4, 0x30, 0x57, 0x63, 0x87, 0xe9, 0x2d, 0x55, 0xa3, 0x20, 0x85, 0x83,
+	0xd1, 0x7d, 0x40, 0x8f, 0x0d, 0x53, 0x6b, 0xa9, 0xae, 0xd6, 0x6a, 0x5d, 0xa9, 0x36, 0x76, 0xba,
+	0x2d, 0x57, 0x4a, 0x6d, 0x08, 0x5b, 0xd3, 0xb7, 0x97, 0x63, 0x12, 0x75, 0x02, 0x51, 0x28, 0x42,
+	0x11, 0x29, 0x2b, 0x34, 0x82, 0x0a, 0x30, 0xed, 0x74, 0xcf, 0xda, 0x86, 0xab, 0x92, 0x30, 0x93,
+	0x26, 0xb8, 0x44, 0xdc, 0xea, 0xba, 0x17, 0x83, 0xc5, 0xd4, 0xd3, 0x7f, 0xae, 0x0b, 0x0a, 0x30,
+	0x12, 0x19, 0x46, 0x0f, 0x40, 0xe4, 0xa7, 0xab, 0x62, 0x53, 0x67, 0x3a, 0x93, 0x63, 0xea, 0xe4,
+	0x38, 0x53, 0x36, 0x75, 0xaa, 0x55, 0x86, 0x19, 0xd7, 0x72, 0xb5, 0x96, 0xca, 0xc7, 0xa5, 0xa9,
+	0x77, 0xf0, 0x51, 0x96, 0x52, 0xbd, 0x00, 0x3a, 0x84, 0xb9, 0x9e, 0xe5, 0x1a, 0x66, 0x53, 0x75,
+	0x5c, 0xcd, 0xe6, 0xfb, 0x4b, 0x8f, 0x69, 0xd7, 0x2c, 0xa3, 0xd6, 0x08, 0x93, 0x1a, 0x76, 0x1f,
+	0xf8, 0x50, 0xb0, 0xc7, 0xcc, 0x98, 0x5a, 0x33, 0x8c, 0xe8, 0x6d, 0x71, 0x99, 0x04, 0x89, 0xab,
+	0xe9, 0x9a, 0xab, 0x49, 0x40, 0xc2, 0x56, 0xf1, 0x7f, 0xa3, 0x1f, 0xc1, 0x84, 0x6b, 0xb8, 0x2d,
+	0x2c, 0x4d, 0xd3, 0x78, 0x9e, 0x7f, 0xf9, 0x7c, 0x7b, 0x96, 0xed, 0x7c, 0xdb, 0xd1, 0x9f, 0x6c,
+	0xec, 0xe6, 0x7f, 0xfc, 0x53, 0x85, 0x21, 0xd0, 0x36, 0x4c, 0x39, 0xdd, 0x76, 0x5b, 0xb3, 0xaf,
+	0xa4, 0xec, 0x70, 0xb0, 0x87, 0x41, 0xf7, 0x20, 0xcd, 0xee, 0x0e, 0xb6, 0xa5, 0x19, 0x8a, 0xff,
+	0x60, 0xd8, 0x65, 0x19, 0xa4, 0xe3, 0x93, 0xd1, 0x47, 0x90, 0xc1, 0x97, 0x1d, 0xac, 0x1b, 0x2e,
+	0xd6, 0xa5, 0xdc, 0x86, 0xb0, 0x95, 0x2e, 0x2e, 0xf6, 0x31, 0xf6, 0x77, 0x25, 0x41, 0x09, 0x70,
+	0xe8, 0x63, 0x98, 0x79, 0xac, 0x19, 0x2d, 0xac, 0xab, 0x36, 0xd6, 0x1c, 0xcb, 0x94, 0x66, 0x87,
+	0x98, 0xbc, 0xbf, 0xab, 0x64, 0x19, 0x52, 0xa1, 0x40, 0xa4, 0xc0, 0x8c, 0x9f, 0x06, 0xdc, 0xab,
+	0x0e, 0x96, 0x44, 0x7a, 0x4f, 0x56, 0x86, 0xdc, 0x93, 0xfa, 0x55, 0x07, 0x17, 0xc5, 0x97, 0xcf,
+	0xb7, 0xb3, 0x97, 0x24, 0x2f, 0x6f, 0xf4, 0x76, 0xf3, 0xb7, 0xf3, 0xbb, 0x4a, 0xb6, 0x13, 0x9a,
+	0x47, 0x9f, 0xc3, 0x1c, 0x71, 0x60, 0xcb, 0x6a, 0x3c, 0x09, 0xbc, 0x39, 0x37, 0xd2, 0x9b, 0xf3,
+	0xc4, 0x9b, 0x2f, 0x9f, 0x6f, 0x4f, 0x33, 0xe9, 0xbd, 0xfc, 0x6e, 0x7e, 0x57, 0x99, 0xf5, 0x94,
+	0xb8, 0x7b, 0x37, 0xff, 0x2a, 0xc0, 0xbc, 0x67, 0x4d, 0x90, 0x0a, 0x1d, 0xb4, 0x0a, 0xc0, 0xb2,
+	0xa1, 0x6a, 0x99, 0x98, 0xe6, 0x8c, 0x8c, 0x92, 0x61, 0x23, 0x15, 0x13, 0x87, 0xa6, 0xdd, 0x0b,
+	0x4b, 0x4a, 0x84, 0xa7, 0xeb, 0x17, 0x16, 0xba, 0x09, 0x59, 0x6f, 0xfa, 0xdc, 0xc6, 0x98, 0x66,
+	0x8b, 0x8c, 0x32, 0xcd, 0x01, 0x64, 0x88, 0x24, 0x4c, 0x0e, 0x79, 0x6c, 0x75, 0x6d, 0x9a, 0x0c,
+	0x32, 0x0a, 0x17, 0xbd, 0x6b, 0x75, 0xed, 0x10, 0xc0, 0xe9, 0x68, 0x6d, 0x69, 0x22, 0x0c, 0xa8,
+	0x75, 0xb4, 0xf6, 0x1d, 0xf1, 0x45, 0xec, 0xdc, 0x36, 0xff, 0x97, 0x84, 0xe9, 0x70, 0xb6, 0xd8,
+	0x86, 0xcc, 0x15, 0x76, 0xd4, 0x06, 0x4d, 0x9f, 0x74, 0x0f, 0x45, 0x31, 0x94, 0xcb, 0xcb, 0x64,
+	0x54, 0x49, 0x5f, 0x61, 0xe7, 0x80, 0x20, 0xd0, 0x3e, 0xcc, 0x68, 0x67, 0x8e, 0xab, 0x19, 0x26,
+	0xa7, 0x24, 0x86, 0x50, 0xb2, 0x1c, 0xc6, 0x68, 0x1f, 0x40, 0xda, 0xb4, 0x38, 0x23, 0x39, 0x84,
+	0x31, 0x65, 0x5a, 0x0c, 0xfc, 0x29, 0x20, 0xd3, 0x52, 0x2f, 0x0c, 0xf7, 0x5c, 0xed, 0x61, 0xd7,
+	0xa3, 0xa5, 0x86, 0xd0, 0x66, 0x4d, 0xeb, 0xa1, 0xe1, 0x9e, 0x9f, 0x62, 0x97, 0xd3, 0x3f, 0x06,
+	0x31, 0x70, 0x0b, 0x27, 0x4f, 0xf4, 0x15, 0xa9, 0xb2, 0xe9, 0x2a, 0x39, 0xdf, 0x59, 0x71, 0xa6,
+	0x7b, 0xe1, 0x2d, 0x3b, 0xf9, 0x36, 0x66, 0xfd, 0x82, 0xaf, 0xf9, 0x09, 0xa0, 0xb0, 0x33, 0x39,
+	0x77, 0x6a, 0x20, 0x57, 0x0c, 0xb9, 0x98, 0xb1, 0xef, 0xc0, 0x5c, 0xc8, 0xcf, 0x9c, 0x9c, 0x1e,
+	0x48, 0x9e, 0x0d, 0xbc, 0xcf, 0xb8, 0xdb, 0x00, 0xc4, 0xf7, 0x9c, 0x94, 0x19, 0x48, 0xca, 0x10,
+	0x04, 0x85, 0x6f, 0xfe, 0x45, 0x80, 0x14, 0x89, 0xe1, 0xd1, 0xc5, 0x38, 0x0f, 0x13, 0x3d, 0xcb,
+	0xc5, 0xa3, 0x0b, 0x31, 0x83, 0xa1, 0x9f, 0xc3, 0x14, 0xb3, 0xcd, 0x91, 0x52, 0x34, 0xc3, 0xdf,
+	0x8c, 0x5d, 0xe8, 0xfe, 0xc6, 0x43, 0xf1, 0x18, 0x91, 0x0c, 0x3a, 0x11, 0xcd, 0xa0, 0x0f, 0x52,
+	0xe9, 0xa4, 0x98, 0xda, 0xfc, 0x87, 0x00, 0x33, 0xbc, 0x0e, 0x54, 0x35, 0x5b, 0x6b, 0x3b, 0xe8,
+	0x11, 0x4c, 0xb7, 0x0d, 0xd3, 0x2f, 0x2b, 0xc2, 0xa8, 0xb2, 0xb2, 0x4a, 0xca, 0xca, 0xb7, 0xaf,
+	0xd6, 0x17, 0x43, 0xac, 0x0f, 0xad, 0xb6, 0xe1, 0xe2, 0x76, 0xc7, 0xbd, 0x52, 0xa0, 0x6d, 0x98,
+	0x5e, 0xa1, 0x69, 0x03, 0x6a, 0x6b, 0x97, 0x1e, 0x48, 0xed, 0x60, 0xdb, 0xb0, 0x74, 0x7a, 0x10,
+	0x64, 0x85, 0x78, 0x3e, 0x29, 0xf1, 0x8e, 0xac, 0xf8, 0x83, 0x6f, 0x5f, 0xad, 0xbf, 0xd7, 0x4f,
+	0x0c, 0x16, 0x79, 0x46, 0x8a, 0x87, 0xd8, 0xd6, 0x2e, 0xbd, 0x9d, 0xd0, 0xf9, 0x3b, 0x09, 0x49,
+	0xd8, 0xfc, 0x0c, 0xb2, 0xa7, 0xb4, 0xa8, 0xf0, 0xdd, 0x95, 0x80, 0x17, 0x19, 0x6f, 0x75, 0x61,
+	0xd4, 0xea, 0x29, 0xaa, 0x9e, 0x65, 0xac, 0x90, 0xf2, 0x57, 0x02, 0xbf, 0xf1, 0x5c, 0xf9, 0x7d,
+	0x98, 0xfc, 0x4d, 0xd7, 0xb2, 0xbb, 0x6d, 0x49, 0xe8, 0x8b, 0x16, 0xda, 0xba, 0xb1, 0x59, 0xf4,
+	0x21, 0x64, 0x48, 0x30, 0x3b, 0xe7, 0x56, 0x4b, 0x1f, 0xd2, 0xe5, 0x05, 0x00, 0xb4, 0x0f, 0x39,
+	0x7a, 0x59, 0x03, 0x4a, 0x72, 0x20, 0x65, 0x86, 0xa0, 0xea, 0x1e, 0x88, 0x1a, 0xf8, 0xc7, 0x1c,
+	0x4c, 0x72, 0xdb, 0xe4, 0x77, 0xf4, 0x69, 0xa8, 0x55, 0x08, 0xfb, 0xef, 0xe8, 0xbb, 0xf9, 0x2f,
+	0x35, 0xd8, 0x3f, 0xfd, 0xbe, 0x48, 0x7e, 0x07, 0x5f, 0x84, 0xce, 0x3d, 0x35, 0xfe, 0xb9, 0x4f,
+	0xbc, 0xfb, 0xb9, 0x4f, 0x8e, 0x71, 0xee, 0xa8, 0x0c, 0x37, 0xc8, 0x41, 0x1b, 0xa6, 0xe1, 0x1a,
+	0x41, 0x6f, 0xa6, 0x52, 0xf3, 0xa5, 0xa9, 0x81, 0x0a, 0xd7, 0xdb, 0x86, 0x59, 0x66, 0x78, 0x7e,
+	0x3c, 0x0a, 0x41, 0xa3, 0x13, 0x58, 0xf4, 0x33, 0x49, 0x43, 0x33, 0x1b, 0xb8, 0xc5, 0x65, 0x58,
+	0x06, 0xbb, 0x19, 0x95, 0x19, 0xd4, 0x1f, 0xcc, 0x7b, 0xfc, 0x03, 0x4a, 0x67, 0xb2, 0xbf, 0x86,
+	0x85, 0xb8, 0xac, 0x8e, 0x1d, 0x2f, 0xc5, 0x8d, 0xdf, 0xea, 0xec, 0xef, 0x2a, 0x28, 0xaa, 0x5f,
+	0xc2, 0x8e, 0x8b, 0xbe, 0x80, 0x25, 0xbf, 0x99, 0x51, 0xa3, 0xde, 0x85, 0x51, 0xde, 0x5d, 0x7a,
+	0xc6, 0xda, 0x86, 0xbe, 0x85, 0x16, 0x7d, 0xc9, 0xd3, 0xb0, 0xe7, 0x15, 0x98, 0x0f, 0xd6, 0x0a,
+	0x1c, 0x35, 0x3d, 0xee, 0xf9, 0x20, 0x9f, 0x1d, 0x38, 0xf0, 0x33, 0x08, 0x16, 0x53, 0xc3, 0x77,
+	0x26, 0xfb, 0x0e, 0x77, 0x26, 0x30, 0xeb, 0x28, 0xb8, 0x3c, 0x9f, 0x82, 0x78, 0xd6, 0xb5, 0x4d,
+	0x72, 0x28, 0x58, 0xe5, 0x11, 0x3b, 0x43, 0xbb, 0xc2, 0x81, 0xfd, 0x68, 0x8e, 0x80, 0x49, 0x4e,
+	0xff, 0x25, 0x0b, 0xdf, 0x53, 0x58, 0xa5, 0x74, 0xdf, 0x79, 0xfe, 0x2d, 0xb4, 0x31, 0x91, 0x94,
+	0x72, 0xc3, 0xb5, 0x96, 0x09, 0xd3, 0x6b, 0xb5, 0xbc, 0x3b, 0xc8, 0x68, 0xe8, 0x67, 0x90, 0x0b,
+	0xcc, 0x22, 0xc1, 0x2c, 0xcd, 0x0e, 0x17, 0xca, 0x7a, 0x46, 0x91, 0xb6, 0x00, 0x1d, 0xc1, 0x5c,
+	0xe8, 0x84, 0x78, 0x74, 0x8a, 0xe3, 0x9e, 0xfe, 0x6c, 0x90, 0x58, 0x58, 0x64, 0x7e, 0x0e, 0xcb,
+	0xf1, 0xc8, 0x24, 0xd9, 0x86, 0x47, 0xcf, 0x1c, 0xd5, 0x5d, 0xeb, 0xd3, 0x8d, 0xb6, 0xaf, 0x4b,
+	0xd1, 0x90, 0x3c, 0xd2, 0x2e, 0x79, 0xac, 0x74, 0x60, 0x9d, 0x14, 0xc5, 0xb6, 0xe1, 0xb8, 0x46,
+	0x43, 0xd5, 0xba, 0xee, 0xb9, 0x65, 0x1b, 0xbf, 0xc5, 0xba, 0xaa, 0xb1, 0x28, 0xc7, 0x8e, 0x84,
+	0x36, 0x92, 0x5b, 0x99, 0xe2, 0xd6, 0x5b, 0x6e, 0x40, 0x74, 0xad, 0xd5, 0x40, 0xb0, 0xe0, 0xeb,
+	0x15, 0x3c, 0x39, 0x74, 0x06, 0x21, 0x80, 0x6a, 0xe3, 0x2f, 0x70, 0x23, 0x1a, 0xa7, 0xf3, 0x63,
+	0xed, 0x68, 0x25, 0x10, 0x51, 0xb8, 0x46, 0x10, 0xad, 0x9f, 0x02, 0x90, 0x2e, 0x93, 0x47, 0xd3,
+	0xc2, 0x58, 0x82, 0xa4, 0x2f, 0xe5, 0x31, 0x55, 0x06, 0x31, 0x08, 0x76, 0x2e, 0xb2, 0x38, 0x42,
+	0x84, 0x37, 0xf3, 0x3e, 0x8f, 0x4b, 0xdd, 0x85, 0xeb, 0xbe, 0xf3, 0xf0, 0x25, 0x6e, 0x74, 0x69,
+	0xdf, 0xd5, 0xd4, 0x1c, 0xe9, 0x3a, 0x69, 0x81, 0x06, 0x7c, 0x69, 0xf8, 0x69, 0x48, 0xf6, 0xe0,
+	0xf7, 0x34, 0x07, 0x35, 0x00, 0x35, 0x2c, 0xb3, 0x67, 0x34, 0x28, 0x9f, 0xf9, 0xde, 0x91, 0x96,
+	0xe8, 0xe5, 0x5b, 0x8f, 0x75, 0x3e, 0x07, 0x3e, 0x90, 0x39, 0xb9, 0x38, 0x4f, 0xae, 0x60, 0xfc,
+	0xbb, 0x63, 0xae, 0x11, 0x83, 0x39, 0x77, 0xe6, 0x5f, 0xf4, 0xc7, 0xf6, 0xe6, 0x97, 0x02, 0x88,
+	0x71, 0x45, 0x54, 0x82, 0xb4, 0xf7, 0x30, 0x34, 0xba, 0x53, 0x98, 0x21, 0xcb, 0x93, 0x1c, 0xc6,
+	0xb2, 0x80, 0xcf, 0x44, 0x79, 0x80, 0x76, 0xb7, 0xe5, 0x1a, 0x9d, 0x96, 0xe1, 0x37, 0x7e, 0xf1,
+	0x32, 0x10, 0x42, 0xdc, 0x99, 0x7d, 0x11, 0xdd, 0xc3, 0xe6, 0x97, 0x29, 0x40, 0x47, 0xec, 0x25,
+	0xa4, 0xa8, 0x39, 0x58, 0xff, 0x3e, 0x9b, 0x99, 0x50, 0x01, 0x4d, 0xbc, 0xb5, 0x80, 0x6e, 0x0f,
+	0x08, 0xb6, 0xbe, 0x0a, 0x1a, 0x04, 0x57, 0xa4, 0xde, 0x26, 0xdf, 0xbd, 0xde, 0xa6, 0xc6, 0xa9,
+	0xb7, 0x0f, 0x20, 0xed, 0x7d, 0x56, 0x4a, 0x13, 0xa3, 0x36, 0x3f, 0xff, 0x6c, 0xc0, 0x67, 0xa9,
+	0xcf, 0x47, 0x87, 0x40, 0xc5, 0xd5, 0x66, 0x57, 0xb3, 0x75, 0x43, 0x33, 0x79, 0xc5, 0xff, 0xe1,
+	0x5b, 0x12, 0x42, 0x44, 0x29, 0x4b, 0xd8, 0xf7, 0x38, 0x19, 0x9d, 0xc0, 0x92, 0xff, 0xe9, 0x1c,
+	0xdb, 0x19, 0xeb, 0x03, 0x56, 0xfb, 0xae, 0x58, 0x44, 0x6d, 0xd1, 0x63, 0x9f, 0x46, 0x1a, 0xbb,
+	0xfe, 0x2f, 0xcf, 0x0a, 0xa4, 0xef, 0x59, 0x3d, 0x6c, 0x9b, 0x96, 0x8d, 0x6e, 0xc3, 0x14, 0xcf,
+	0x67, 0x92, 0x30, 0xe2, 0xf3, 0xc2, 0x03, 0xf6, 0x07, 0xdb, 0x9f, 0x05, 0x58, 0x60, 0x8a, 0x24,
+	0x87, 0x96, 0x70, 0x0b, 0x37, 0x59, 0x18, 0xcb, 0x30, 0xa7, 0xb3, 0x5f, 0x96, 0xad, 0x8e, 0xbb,
+	0x8e, 0xe8, 0x53, 0xf8, 0x38, 0x3a, 0x00, 0xb1, 0xc9, 0x0d, 0xf6, 0x55, 0x46, 0x7d, 0x0c, 0xcd,
+	0x7a, 0x8c, 0xc2, 0x30, 0xab, 0xff, 0x96, 0x80, 0x5c, 0x70, 0x7d, 0x0f, 0x89, 0x43, 0xbf, 0x27,
+	0x7b, 0x8f, 0x61, 0xae, 0xa7, 0xb5, 0x0c, 0x5d, 0x73, 0xfb, 0x0c, 0x26, 0x65, 0x6e, 0x95, 0xcb,
+	0x9c, 0x7a, 0x98, 0x98, 0x5e, 0x2f, 0x36, 0x4e, 0xee, 0x9b, 0x73, 0xae, 0xd9, 0xd8, 0x19, 0x72,
+	0x2b, 0xf8, 0x6c, 0x2c, 0x6b, 0xa4, 0x46, 0x65, 0x0d, 0x92, 0xab, 0xfc, 0x37, 0x9a, 0xd1, 0xaf,
+	0x93, 0x34, 0x59, 0x3d, 0xf5, 0x93, 0xd5, 0x14, 0x66, 0xaf, 0x32, 0x7d, 0x07, 0x7b, 0xeb, 0xf7,
+	0x02, 0x64, 0xc3, 0x8f, 0x46, 0x68, 0x15, 0x6e, 0x54, 0x95, 0x4a, 0xb5, 0x52, 0x2b, 0x1c, 0xaa,
+	0xf5, 0x47, 0x55, 0x59, 0x3d, 0x39, 0xae, 0x55, 0xe5, 0x83, 0xf2, 0xdd, 0xb2, 0x5c, 0x12, 0xaf,
+	0xa1, 0x65, 0xb8, 0x1e, 0x9d, 0xae, 0xd5, 0x0b, 0xc7, 0xa5, 0x82, 0x52, 0x12, 0x05, 0x74, 0x13,
+	0x56, 0xa3, 0x73, 0x47, 0x27, 0x87, 0xf5, 0x72, 0xf5, 0x50, 0x56, 0x0f, 0xee, 0x57, 0xca, 0x07,
+	0xb2, 0x98, 0x40, 0xef, 0x81, 0x14, 0x85, 0x54, 0xaa, 0xf5, 0xf2, 0x51, 0xb9, 0x56, 0x2f, 0x1f,
+	0x88, 0x49, 0xb4, 0x02, 0x4b, 0xd1, 0x59, 0xf9, 0xb3, 0xaa, 0x5c, 0x2a, 0xd7, 0xe5, 0x92, 0x98,
+	0xba, 0xf5, 0x5f, 0x01, 0x20, 0xf4, 0xfc, 0xbe, 0x02, 0x4b, 0xa7, 0x95, 0x3a, 0x13, 0xa8, 0x1c,
+	0xc7, 0xac, 0x9c, 0x87, 0xd9, 0xf0, 0xe4, 0x23, 0xb9, 0x26, 0x0a, 0xf1, 0xc1, 0xca, 0xb1, 0x2c,
+	0x0a, 0x68, 0x09, 0xe6, 0xc3, 0x83, 0x85, 0x62, 0xad, 0x5e, 0x28, 0x1f, 0x8b, 0x89, 0x38, 0xba,
+	0xfe, 0xb0, 0x22, 0x26, 0x10, 0x82, 0x5c, 0x78, 0xf0, 0xb8, 0x22, 0x26, 0xd1, 0x22, 0xcc, 0x45,
+	0x80, 0xf7, 0x15, 0x59, 0x16, 0x93, 0x64, 0xa7, 0x51, 0xa8, 0xfa, 0xb0, 0x5c, 0xbf, 0xaf, 0x9e,
+	0xca, 0xf5, 0x8a, 0x98, 0x42, 0x0b, 0x20, 0x86, 0x67, 0xef, 0x56, 0x4e, 0x94, 0xfe, 0xd1, 0x5a,
+	0xb5, 0x70, 0x24, 0x4e, 0x2c, 0x27, 0x44, 0xe1, 0xd6, 0x7f, 0x04, 0xc8, 0x45, 0xdf, 0xc0, 0xd1,
+	0x3a, 0xac, 0xf8, 0x87, 0x55, 0xab, 0x17, 0xea, 0x27, 0xb5, 0xd8, 0x21, 0x6c, 0xc2, 0x5a, 0x1c,
+	0x50, 0x92, 0xab, 0x95, 0x5a, 0xb9, 0xae, 0x56, 0x65, 0xa5, 0x5c, 0x89, 0xbb, 0x8c, 0x63, 0x4e,
+	0x2b, 0xf5, 0xf2, 0xf1, 0x3d, 0x0f, 0x92, 0x88, 0x78, 0x9c, 0x43, 0xaa, 0x85, 0x5a, 0x4d, 0x2e,
+	0xb1, 0x4d, 0xc6, 0xe7, 0x14, 0xf9, 0x81, 0x7c, 0x40, 0x3d, 0x36, 0x88, 0x79, 0xb7, 0x50, 0x3e,
+	0x94, 0x4b, 0xe2, 0x04, 0x5a, 0x83, 0xe5, 0xf8, 0x5c, 0xbd, 0x7c, 0x24, 0x1f, 0x56, 0x0e, 0x7e,
+	0x21, 0x97, 0xc4, 0xc9, 0xe2, 0xfe, 0xd7, 0xaf, 0xd7, 0x84, 0x6f, 0x5e, 0xaf, 0x09, 0xff, 0x7a,
+	0xbd, 0x26, 0x3c, 0x7d, 0xb3, 0x76, 0xed, 0x9b, 0x37, 0x6b, 0xd7, 0xfe, 0xfe, 0x66, 0xed, 0xda,
+	0xaf, 0x56, 0xd8, 0x05, 0x71, 0xf4, 0x27, 0x79, 0xc3, 0xda, 0xa1, 0xb1, 0xbc, 0x43, 0x5e, 0x44,
+	0x1d, 0xf2, 0x4f, 0x4b, 0x93, 0xf4, 0x2e, 0x7c, 0xf4, 0xff, 0x01, 0x00, 0xce, 0xbb, 0x9a, 0xdd,
+	0x9b, 0x1a, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1855,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimelockEndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1585,22 +1920,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1619,22 +1954,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1898,12 +2233,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2280,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2021,6 +2356,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConvictionPeriods) > 0 {
+		for iNdEx := len(m.ConvictionPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvictionPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2136,12 +2487,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2539,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2224,7 +2575,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+func (m *ConvictionPeriod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2234,27 +2585,89 @@ func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConvictionPeriod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConvictionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.YesQuorum) > 0 {
-		i -= len(m.YesQuorum)
-		copy(dAtA[i:], m.YesQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Multiplier)))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x12
 	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGov(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YesQuorum) > 0 {
+		i -= len(m.YesQuorum)
+		copy(dAtA[i:], m.YesQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.TimelockVetoThreshold) > 0 {
+		i -= len(m.TimelockVetoThreshold)
+		copy(dAtA[i:], m.TimelockVetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TimelockVetoThreshold)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoGuardian) > 0 {
+		i -= len(m.VetoGuardian)
+		copy(dAtA[i:], m.VetoGuardian)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoGuardian)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timelock != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
 		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
 		i--
@@ -2275,12 +2688,138 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvictionLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvictionLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGov(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2406,6 +2945,10 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if m.TimelockEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2658,6 +3201,27 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if len(m.ConvictionPeriods) > 0 {
+		for _, e := range m.ConvictionPeriods {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvictionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2683,6 +3247,18 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Timelock != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoGuardian)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TimelockVetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	l = len(m.YesQuorum)
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
@@ -2690,6 +3266,63 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvictionLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3446,6 +4079,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEndTime == nil {
+				m.TimelockEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5234,16 +5903,165 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionPeriods", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvictionPeriods = append(m.ConvictionPeriods, ConvictionPeriod{})
+			if err := m.ConvictionPeriods[len(m.ConvictionPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvictionPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -5416,6 +6234,106 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timelock == nil {
+				m.Timelock = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockVetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockVetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
@@ -5469,6 +6387,413 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvictionLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
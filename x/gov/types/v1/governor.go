@@ -0,0 +1,11 @@
+package v1
+
+// NewGovernor creates a new Governor instance
+func NewGovernor(address string) Governor {
+	return Governor{Address: address}
+}
+
+// NewGovernanceDelegation creates a new GovernanceDelegation instance
+func NewGovernanceDelegation(delegator, governor string) GovernanceDelegation {
+	return GovernanceDelegation{DelegatorAddress: delegator, GovernorAddress: governor}
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -133,6 +134,43 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgRegisterGovernor creates a new MsgRegisterGovernor instance.
+func NewMsgRegisterGovernor(address string) *MsgRegisterGovernor {
+	return &MsgRegisterGovernor{Address: address}
+}
+
+// NewMsgDeregisterGovernor creates a new MsgDeregisterGovernor instance.
+func NewMsgDeregisterGovernor(address string) *MsgDeregisterGovernor {
+	return &MsgDeregisterGovernor{Address: address}
+}
+
+// NewMsgDelegateVote creates a new MsgDelegateVote instance.
+func NewMsgDelegateVote(delegator, governor string) *MsgDelegateVote {
+	return &MsgDelegateVote{
+		DelegatorAddress: delegator,
+		GovernorAddress:  governor,
+	}
+}
+
+// NewMsgUndelegateVote creates a new MsgUndelegateVote instance.
+func NewMsgUndelegateVote(delegator string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{DelegatorAddress: delegator}
+}
+
+// NewMsgVetoProposal creates a new MsgVetoProposal instance.
+func NewMsgVetoProposal(proposalID uint64, guardian string) *MsgVetoProposal {
+	return &MsgVetoProposal{ProposalId: proposalID, Guardian: guardian}
+}
+
+// NewMsgLockConviction creates a new MsgLockConviction instance.
+func NewMsgLockConviction(delegator, validator string, duration time.Duration) *MsgLockConviction {
+	return &MsgLockConviction{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Duration:         duration,
+	}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
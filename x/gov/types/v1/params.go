@@ -274,6 +274,25 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	durations := make(map[time.Duration]bool, len(p.ConvictionPeriods))
+	for _, period := range p.ConvictionPeriods {
+		if period.Duration <= 0 {
+			return fmt.Errorf("conviction period duration must be positive: %s", period.Duration)
+		}
+		if durations[period.Duration] {
+			return fmt.Errorf("duplicate conviction period duration: %s", period.Duration)
+		}
+		durations[period.Duration] = true
+
+		multiplier, err := sdkmath.LegacyNewDecFromStr(period.Multiplier)
+		if err != nil {
+			return fmt.Errorf("invalid conviction period multiplier: %w", err)
+		}
+		if multiplier.LT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("conviction period multiplier must be greater than or equal to one: %s", multiplier)
+		}
+	}
+
 	return nil
 }
 
@@ -330,5 +349,22 @@ func (p MessageBasedParams) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	if p.Timelock != nil && p.Timelock.Seconds() < 0 {
+		return fmt.Errorf("timelock must not be negative: %s", p.Timelock)
+	}
+
+	if p.TimelockVetoThreshold != "" {
+		timelockVetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.TimelockVetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid timelock_veto_threshold string: %w", err)
+		}
+		if timelockVetoThreshold.IsNegative() {
+			return fmt.Errorf("timelock veto threshold cannot be negative: %s", timelockVetoThreshold)
+		}
+		if timelockVetoThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("timelock veto threshold too large: %s", p.TimelockVetoThreshold)
+		}
+	}
+
 	return nil
 }
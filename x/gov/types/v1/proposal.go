@@ -20,6 +20,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusTimelocked    = ProposalStatus_PROPOSAL_STATUS_TIMELOCKED
 )
 
 // NewProposal creates a new Proposal instance
@@ -136,7 +137,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusTimelocked {
 		return true
 	}
 	return false
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QuerySimulateProposalRequest{}
+
+// NewQuerySimulateProposalRequest creates a new QuerySimulateProposalRequest simulating
+// the given proposal messages.
+func NewQuerySimulateProposalRequest(messages []sdk.Msg) (*QuerySimulateProposalRequest, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySimulateProposalRequest{Messages: anys}, nil
+}
+
+// GetMsgs unpacks q.Messages Any's into sdk.Msg's
+func (q *QuerySimulateProposalRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(q.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QuerySimulateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, q.Messages)
+}
@@ -0,0 +1,203 @@
+package abci_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	oracleabci "cosmossdk.io/x/oracle/abci"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/provider"
+	"cosmossdk.io/x/oracle/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	atomUSD = types.NewCurrencyPair("ATOM", "USD")
+	ethUSD  = types.NewCurrencyPair("ETH", "USD")
+	btcUSD  = types.NewCurrencyPair("BTC", "USD")
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	k := keeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger()), encCfg.Codec, "authority")
+
+	require.NoError(t, k.AddCurrencyPair(ctx, atomUSD))
+	require.NoError(t, k.AddCurrencyPair(ctx, ethUSD))
+
+	return ctx, k
+}
+
+func voteExtension(t *testing.T, prices map[types.CurrencyPair]int64) []byte {
+	t.Helper()
+	reported := make(map[string]math.LegacyDec)
+	for cp, price := range prices {
+		reported[cp.String()] = math.LegacyNewDec(price)
+	}
+	bz, err := types.NewOracleVoteExtension(reported).Encode()
+	require.NoError(t, err)
+	return bz
+}
+
+func vote(power int64, flag cmtproto.BlockIDFlag, ext []byte) abci.ExtendedVoteInfo {
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Power: power},
+		VoteExtension: ext,
+		BlockIdFlag:   flag,
+	}
+}
+
+func TestAggregatePrices(t *testing.T) {
+	pairs := map[string]struct{}{atomUSD.String(): {}, ethUSD.String(): {}, btcUSD.String(): {}}
+
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		vote(40, cmtproto.BlockIDFlagCommit, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 100, ethUSD: 3100, btcUSD: 60000})),
+		vote(30, cmtproto.BlockIDFlagCommit, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 11, ethUSD: 3000})),
+		vote(20, cmtproto.BlockIDFlagCommit, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 10, ethUSD: 2900})),
+		vote(10, cmtproto.BlockIDFlagCommit, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 9})),
+		// invalid extensions and votes which are not commits are ignored
+		vote(5, cmtproto.BlockIDFlagCommit, []byte("invalid")),
+		vote(5, cmtproto.BlockIDFlagCommit, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 1, types.NewCurrencyPair("OSMO", "USD"): 1})),
+		vote(5, cmtproto.BlockIDFlagAbsent, voteExtension(t, map[types.CurrencyPair]int64{atomUSD: 1})),
+	}}
+
+	prices := oracleabci.AggregatePrices(extCommit, pairs)
+	require.Len(t, prices, 2)
+	// 9 (10), 10 (20), 11 (30), 100 (40): half of the power is reached at 11
+	require.Equal(t, "11.000000000000000000", prices[atomUSD.String()].String())
+	// 2900 (20), 3000 (30), 3100 (40): 90 of 115 is more than 2/3
+	require.Equal(t, "3000.000000000000000000", prices[ethUSD.String()].String())
+	// BTC/USD is reported by less than 2/3 of the voting power
+	require.NotContains(t, prices, btcUSD.String())
+}
+
+func TestVoteExtensionHandler(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	p := provider.NewMedianProvider(
+		provider.NewMockProvider(map[types.CurrencyPair]math.LegacyDec{atomUSD: math.LegacyNewDec(10), btcUSD: math.LegacyNewDec(60000)}),
+		provider.NewMockProvider(map[types.CurrencyPair]math.LegacyDec{atomUSD: math.LegacyNewDec(12)}),
+	)
+	h := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), k, p, time.Second)
+
+	res, err := h.ExtendVoteHandler()(ctx, &abci.ExtendVoteRequest{Height: 2})
+	require.NoError(t, err)
+	ve, err := types.DecodeOracleVoteExtension(res.VoteExtension)
+	require.NoError(t, err)
+	require.Len(t, ve.Prices, 1)
+	require.Equal(t, atomUSD.String(), ve.Prices[0].CurrencyPair)
+	require.Equal(t, "11.000000000000000000", ve.Prices[0].Price.String())
+
+	unsorted, err := types.OracleVoteExtension{Prices: []types.ReportedPrice{
+		{CurrencyPair: ethUSD.String(), Price: math.LegacyNewDec(3000)},
+		{CurrencyPair: atomUSD.String(), Price: math.LegacyNewDec(11)},
+	}}.Encode()
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		ext       []byte
+		expStatus abci.VerifyVoteExtensionStatus
+	}{
+		"own extension": {
+			ext:       res.VoteExtension,
+			expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		},
+		"unknown currency pair": {
+			ext:       voteExtension(t, map[types.CurrencyPair]int64{btcUSD: 60000}),
+			expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"non positive price": {
+			ext:       voteExtension(t, map[types.CurrencyPair]int64{ethUSD: 0}),
+			expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"unsorted prices": {
+			ext:       unsorted,
+			expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+		"invalid encoding": {
+			ext:       []byte("invalid"),
+			expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := h.VerifyVoteExtensionHandler()(ctx, &abci.VerifyVoteExtensionRequest{Height: 2, VoteExtension: tc.ext})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+		})
+	}
+}
+
+func TestProposalHandler(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: blockTime})
+
+	prepare := func(_ sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
+	}
+	process := func(_ sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		if len(req.Txs) != 1 {
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	}
+	h := oracleabci.NewProposalHandler(log.NewNopLogger(), k, nil, prepare, process)
+
+	// vote extensions disabled: the wrapped handlers are called as is
+	prepareRes, err := h.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{Txs: [][]byte{[]byte("tx")}, MaxTxBytes: 1000})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx")}, prepareRes.Txs)
+	processRes, err := h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: [][]byte{[]byte("tx")}})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processRes.Status)
+
+	// vote extensions enabled: the injected prices are written to state
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2}},
+	})
+	injected, err := types.NewInjectedPrices(map[string]math.LegacyDec{
+		atomUSD.String(): math.LegacyNewDec(11),
+		btcUSD.String():  math.LegacyNewDec(60000),
+	}, abci.ExtendedCommitInfo{})
+	require.NoError(t, err)
+	bz, err := injected.Encode()
+	require.NoError(t, err)
+
+	// a proposal without injected prices is rejected
+	processRes, err = h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, processRes.Status)
+
+	err = h.PreBlocker(nil)(ctx, &abci.FinalizeBlockRequest{Height: 3, Txs: [][]byte{bz, []byte("tx")}})
+	require.NoError(t, err)
+
+	price, err := k.GetPrice(ctx, atomUSD)
+	require.NoError(t, err)
+	require.Equal(t, "11.000000000000000000", price.Price.String())
+	require.Equal(t, int64(3), price.BlockHeight)
+	require.Equal(t, blockTime, price.BlockTimestamp)
+
+	// prices of unknown currency pairs are ignored
+	_, err = k.GetPrice(ctx, btcUSD)
+	require.Error(t, err)
+	_, err = k.GetPrice(ctx, ethUSD)
+	require.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package abci
+
+import (
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type observation struct {
+	price math.LegacyDec
+	power int64
+}
+
+// AggregatePrices returns the stake-weighted median of the prices reported in
+// the vote extensions of the given extended commit, for each currency pair
+// reported by validators holding more than 2/3 of its voting power. Votes that
+// are not commits, or whose extension is not a valid OracleVoteExtension of the
+// given currency pairs, are ignored.
+func AggregatePrices(extCommit abci.ExtendedCommitInfo, pairs map[string]struct{}) map[string]math.LegacyDec {
+	var totalPower int64
+	observations := make(map[string][]observation)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		ve, err := types.DecodeOracleVoteExtension(vote.VoteExtension)
+		if err != nil || ve.Validate(pairs) != nil {
+			continue
+		}
+
+		for _, p := range ve.Prices {
+			observations[p.CurrencyPair] = append(observations[p.CurrencyPair], observation{price: p.Price, power: vote.Validator.Power})
+		}
+	}
+
+	prices := make(map[string]math.LegacyDec, len(observations))
+	for pair, obs := range observations {
+		var power int64
+		for _, o := range obs {
+			power += o.power
+		}
+		if power*3 <= totalPower*2 {
+			continue
+		}
+
+		prices[pair] = stakeWeightedMedian(obs, power)
+	}
+
+	return prices
+}
+
+// stakeWeightedMedian returns the lowest price such that the observations of at
+// most this price hold at least half of the power.
+func stakeWeightedMedian(obs []observation, power int64) math.LegacyDec {
+	sort.SliceStable(obs, func(i, j int) bool { return obs[i].price.LT(obs[j].price) })
+
+	var cumulative int64
+	for _, o := range obs {
+		cumulative += o.power
+		if cumulative*2 >= power {
+			return o.price
+		}
+	}
+
+	return obs[len(obs)-1].price
+}
+
+// voteExtensionsEnabled reports whether the proposal of the current height holds
+// the vote extensions of the previous height, as checked by
+// baseapp.ValidateVoteExtensions.
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams() // nolint:staticcheck // ignore linting error
+	height := ctx.HeaderInfo().Height
+
+	enabled := cp.Feature != nil && cp.Feature.VoteExtensionsEnableHeight != nil && height > cp.Feature.VoteExtensionsEnableHeight.Value && cp.Feature.VoteExtensionsEnableHeight.Value != 0
+	if !enabled {
+		enabled = cp.Abci != nil && height > cp.Abci.VoteExtensionsEnableHeight && cp.Abci.VoteExtensionsEnableHeight != 0 // nolint:staticcheck // ignore linting error
+	}
+
+	return enabled
+}
+
+// pricesEqual reports whether both prices are equal.
+func pricesEqual(a, b map[string]math.LegacyDec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for pair, price := range a {
+		other, ok := b[pair]
+		if !ok || other.IsNil() || !price.Equal(other) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,170 @@
+package abci
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalHandler wraps the PrepareProposal and ProcessProposal handlers of an
+// application so that, once vote extensions are enabled, the first transaction
+// of every proposal holds the prices aggregated from the vote extensions of the
+// previous height, see types.InjectedPrices. The prices are written to state by
+// its PreBlocker.
+//
+// The injected transaction is not a valid sdk.Tx, so it is skipped by the
+// wrapped handlers and fails to decode when the block is finalized, without
+// affecting the other transactions.
+type ProposalHandler struct {
+	logger   log.Logger
+	keeper   *keeper.Keeper
+	valStore baseapp.ValidatorStore
+
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a new ProposalHandler wrapping the given handlers.
+func NewProposalHandler(
+	logger log.Logger,
+	k *keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger.With("module", "x/"+types.ModuleName),
+		keeper:          k,
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler injecting the
+// aggregated prices before the transactions of the wrapped handler.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		pairs, err := currencyPairSet(ctx, h.keeper)
+		if err != nil {
+			return nil, err
+		}
+
+		injected, err := types.NewInjectedPrices(AggregatePrices(req.LocalLastCommit, pairs), req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := injected.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		size := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+		if size > req.MaxTxBytes {
+			return nil, fmt.Errorf("injected oracle tx of %d bytes exceeds max tx bytes %d", size, req.MaxTxBytes)
+		}
+
+		innerReq := *req
+		innerReq.MaxTxBytes -= size
+		res, err := h.prepareProposal(ctx, &innerReq)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Txs = append([][]byte{bz}, res.Txs...)
+		return res, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler verifying the
+// injected prices, by aggregating them again from the vote extensions they were
+// injected with, before calling the wrapped handler with the other
+// transactions.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.processProposal(ctx, req)
+		}
+
+		if err := h.verifyInjectedPrices(ctx, req.Txs); err != nil {
+			h.logger.Error("rejecting proposal", "height", req.Height, "err", err)
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+
+		innerReq := *req
+		innerReq.Txs = req.Txs[1:]
+		return h.processProposal(ctx, &innerReq)
+	}
+}
+
+func (h *ProposalHandler) verifyInjectedPrices(ctx sdk.Context, txs [][]byte) error {
+	if len(txs) == 0 {
+		return fmt.Errorf("missing injected oracle tx: %w", types.ErrInvalidInjectedTx)
+	}
+
+	injected, err := types.DecodeInjectedPrices(txs[0])
+	if err != nil {
+		return err
+	}
+
+	extCommit, err := injected.ExtendedCommit()
+	if err != nil {
+		return err
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, extCommit); err != nil {
+		return err
+	}
+
+	pairs, err := currencyPairSet(ctx, h.keeper)
+	if err != nil {
+		return err
+	}
+
+	if !pricesEqual(AggregatePrices(extCommit, pairs), types.ReportedPriceMap(injected.Prices)) {
+		return fmt.Errorf("injected prices do not match the vote extensions: %w", types.ErrInvalidInjectedTx)
+	}
+
+	return nil
+}
+
+// PreBlocker returns a PreBlocker writing the injected prices to state before
+// calling next, if not nil.
+func (h *ProposalHandler) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+		if voteExtensionsEnabled(ctx) && len(req.Txs) > 0 {
+			// the proposal was accepted, so its first tx holds verified prices
+			injected, err := types.DecodeInjectedPrices(req.Txs[0])
+			if err != nil {
+				return err
+			}
+
+			if err := h.keeper.SetPrices(ctx, types.ReportedPriceMap(injected.Prices)); err != nil {
+				return err
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		return next(ctx, req)
+	}
+}
@@ -0,0 +1,120 @@
+package abci
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/provider"
+	"cosmossdk.io/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultFetchTimeout is the default time a validator waits for its provider
+// when extending its vote.
+const DefaultFetchTimeout = 500 * time.Millisecond
+
+// VoteExtensionHandler extends the votes of a validator with the prices of the
+// currency pairs fetched from its provider, and verifies the vote extensions of
+// the other validators.
+type VoteExtensionHandler struct {
+	logger       log.Logger
+	keeper       *keeper.Keeper
+	provider     provider.Provider
+	fetchTimeout time.Duration
+}
+
+// NewVoteExtensionHandler returns a new VoteExtensionHandler. A zero
+// fetchTimeout defaults to DefaultFetchTimeout.
+func NewVoteExtensionHandler(logger log.Logger, k *keeper.Keeper, p provider.Provider, fetchTimeout time.Duration) *VoteExtensionHandler {
+	if fetchTimeout == 0 {
+		fetchTimeout = DefaultFetchTimeout
+	}
+
+	return &VoteExtensionHandler{
+		logger:       logger.With("module", "x/"+types.ModuleName),
+		keeper:       k,
+		provider:     p,
+		fetchTimeout: fetchTimeout,
+	}
+}
+
+// ExtendVoteHandler returns the ExtendVote handler of the oracle. A provider
+// failure does not fail the vote, the validator then reports no prices.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		pairs, err := h.keeper.GetCurrencyPairs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reported := make(map[string]math.LegacyDec, len(pairs))
+		if len(pairs) > 0 {
+			fetchCtx, cancel := context.WithTimeout(ctx, h.fetchTimeout)
+			defer cancel()
+
+			prices, err := h.provider.FetchPrices(fetchCtx, pairs)
+			if err != nil {
+				h.logger.Error("failed to fetch prices", "height", req.Height, "provider", h.provider.Name(), "err", err)
+			}
+			for cp, price := range prices {
+				if price.IsNil() || !price.IsPositive() {
+					continue
+				}
+				reported[cp.String()] = price
+			}
+		}
+
+		bz, err := types.NewOracleVoteExtension(reported).Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the VerifyVoteExtension handler of the
+// oracle, rejecting the extensions which are not valid OracleVoteExtensions of
+// the currency pairs.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		ve, err := types.DecodeOracleVoteExtension(req.VoteExtension)
+		if err != nil {
+			h.logger.Info("rejecting vote extension", "height", req.Height, "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+
+		pairs, err := currencyPairSet(ctx, h.keeper)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ve.Validate(pairs); err != nil {
+			h.logger.Info("rejecting vote extension", "height", req.Height, "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+
+		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+	}
+}
+
+// currencyPairSet returns the set of the currency pairs, by string.
+func currencyPairSet(ctx context.Context, k *keeper.Keeper) (map[string]struct{}, error) {
+	pairs, err := k.GetCurrencyPairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{}, len(pairs))
+	for _, cp := range pairs {
+		set[cp.String()] = struct{}{}
+	}
+
+	return set, nil
+}
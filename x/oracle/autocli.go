@@ -0,0 +1,51 @@
+package oracle
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	oraclev1 "cosmossdk.io/api/cosmos/oracle/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CurrencyPairs",
+					Use:       "currency-pairs",
+					Short:     "Query the currency pairs validators report prices for",
+				},
+				{
+					RpcMethod:      "Price",
+					Use:            "price [base] [quote]",
+					Short:          "Query the last price of a currency pair",
+					Example:        fmt.Sprintf(`%s query oracle price ATOM USD`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "base"}, {ProtoField: "quote"}},
+				},
+				{
+					RpcMethod: "Prices",
+					Use:       "prices",
+					Short:     "Query the last prices of the currency pairs",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AddCurrencyPairs",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveCurrencyPairs",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
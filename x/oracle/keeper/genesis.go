@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, cp := range data.CurrencyPairs {
+		if err := k.AddCurrencyPair(ctx, cp); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range data.Prices {
+		if err := k.Prices.Set(ctx, p.CurrencyPair.String(), p.QuotePrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	pairs, err := k.GetCurrencyPairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := []types.CurrencyPairPrice{}
+	err = k.Prices.Walk(ctx, nil, func(pair string, price types.QuotePrice) (bool, error) {
+		cp, err := types.ParseCurrencyPair(pair)
+		if err != nil {
+			return true, err
+		}
+		prices = append(prices, types.CurrencyPairPrice{CurrencyPair: cp, QuotePrice: price})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(pairs, prices), nil
+}
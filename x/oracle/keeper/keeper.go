@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper of the oracle store
+type Keeper struct {
+	appmodule.Environment
+
+	authority string
+
+	Schema collections.Schema
+	// CurrencyPairs are the currency pairs validators report prices for, by
+	// their string representation.
+	CurrencyPairs collections.KeySet[string]
+	// Prices are the last prices of the currency pairs, by their string
+	// representation.
+	Prices collections.Map[string, types.QuotePrice]
+}
+
+// NewKeeper returns a new oracle keeper. The authority is the address allowed
+// to add and remove currency pairs.
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string) *Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:   env,
+		authority:     authority,
+		CurrencyPairs: collections.NewKeySet(sb, types.CurrencyPairsKey, "currency_pairs", collections.StringKey),
+		Prices:        collections.NewMap(sb, types.PricesKey, "prices", collections.StringKey, codec.CollValue[types.QuotePrice](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return &k
+}
+
+// GetAuthority returns the address allowed to add and remove currency pairs.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// AddCurrencyPair adds a currency pair validators report prices for.
+func (k *Keeper) AddCurrencyPair(ctx context.Context, cp types.CurrencyPair) error {
+	if err := cp.Validate(); err != nil {
+		return err
+	}
+
+	return k.CurrencyPairs.Set(ctx, cp.String())
+}
+
+// RemoveCurrencyPair removes a currency pair validators report prices for,
+// along with its last price.
+func (k *Keeper) RemoveCurrencyPair(ctx context.Context, cp types.CurrencyPair) error {
+	if err := k.CurrencyPairs.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+
+	return k.Prices.Remove(ctx, cp.String())
+}
+
+// GetCurrencyPairs returns the currency pairs validators report prices for,
+// sorted by their string representation.
+func (k *Keeper) GetCurrencyPairs(ctx context.Context) ([]types.CurrencyPair, error) {
+	pairs := []types.CurrencyPair{}
+	err := k.CurrencyPairs.Walk(ctx, nil, func(s string) (bool, error) {
+		cp, err := types.ParseCurrencyPair(s)
+		if err != nil {
+			return true, err
+		}
+		pairs = append(pairs, cp)
+		return false, nil
+	})
+
+	return pairs, err
+}
+
+// GetPrice returns the last price of a currency pair.
+func (k *Keeper) GetPrice(ctx context.Context, cp types.CurrencyPair) (types.QuotePrice, error) {
+	price, err := k.Prices.Get(ctx, cp.String())
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.QuotePrice{}, fmt.Errorf("no price for %s: %w", cp, err)
+	}
+
+	return price, err
+}
+
+// SetPrices writes the given prices, by currency pair string, at the current
+// block height and time. Prices of unknown currency pairs are ignored.
+func (k *Keeper) SetPrices(ctx context.Context, prices map[string]math.LegacyDec) error {
+	header := k.HeaderService.HeaderInfo(ctx)
+	for pair, price := range prices {
+		ok, err := k.CurrencyPairs.Has(ctx, pair)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		err = k.Prices.Set(ctx, pair, types.QuotePrice{
+			Price:          price,
+			BlockHeight:    header.Height,
+			BlockTimestamp: header.Time,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
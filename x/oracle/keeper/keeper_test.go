@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/oracle"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	atomUSD = types.NewCurrencyPair("ATOM", "USD")
+	ethUSD  = types.NewCurrencyPair("ETH", "USD")
+	btcUSD  = types.NewCurrencyPair("BTC", "USD")
+)
+
+type fixture struct {
+	ctx       sdk.Context
+	keeper    *keeper.Keeper
+	authority string
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, oracle.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+
+	authority, err := addresscodec.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1700000000, 0).UTC()})
+
+	return &fixture{
+		ctx:       ctx,
+		keeper:    keeper.NewKeeper(env, encCfg.Codec, authority),
+		authority: authority,
+	}
+}
+
+func TestSetPrices(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.AddCurrencyPair(f.ctx, atomUSD))
+	require.Error(t, f.keeper.AddCurrencyPair(f.ctx, types.CurrencyPair{Base: "atom", Quote: "USD"}))
+
+	err := f.keeper.SetPrices(f.ctx, map[string]math.LegacyDec{
+		atomUSD.String(): math.LegacyNewDec(11),
+		btcUSD.String():  math.LegacyNewDec(60000),
+	})
+	require.NoError(t, err)
+
+	price, err := f.keeper.GetPrice(f.ctx, atomUSD)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(11), price.Price)
+	require.Equal(t, int64(10), price.BlockHeight)
+	require.Equal(t, f.ctx.HeaderInfo().Time, price.BlockTimestamp)
+
+	// prices of unknown currency pairs are ignored
+	_, err = f.keeper.GetPrice(f.ctx, btcUSD)
+	require.Error(t, err)
+
+	require.NoError(t, f.keeper.RemoveCurrencyPair(f.ctx, atomUSD))
+	_, err = f.keeper.GetPrice(f.ctx, atomUSD)
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/oracle/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the oracle MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AddCurrencyPairs adds currency pairs validators report prices for.
+func (k msgServer) AddCurrencyPairs(ctx context.Context, msg *types.MsgAddCurrencyPairs) (*types.MsgAddCurrencyPairsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	for _, cp := range msg.CurrencyPairs {
+		if err := k.AddCurrencyPair(ctx, cp); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAddCurrencyPairsResponse{}, nil
+}
+
+// RemoveCurrencyPairs removes currency pairs validators report prices for,
+// along with their last prices.
+func (k msgServer) RemoveCurrencyPairs(ctx context.Context, msg *types.MsgRemoveCurrencyPairs) (*types.MsgRemoveCurrencyPairsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	for _, cp := range msg.CurrencyPairs {
+		ok, err := k.CurrencyPairs.Has(ctx, cp.String())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCurrencyPair, "unknown currency pair %s", cp)
+		}
+
+		if err := k.RemoveCurrencyPair(ctx, cp); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveCurrencyPairsResponse{}, nil
+}
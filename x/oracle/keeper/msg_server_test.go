@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+)
+
+func TestAddCurrencyPairs(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	testCases := map[string]struct {
+		msg    *types.MsgAddCurrencyPairs
+		expErr error
+	}{
+		"invalid authority": {
+			msg:    &types.MsgAddCurrencyPairs{Authority: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9", CurrencyPairs: []types.CurrencyPair{atomUSD}},
+			expErr: types.ErrInvalidAuthority,
+		},
+		"invalid currency pair": {
+			msg:    &types.MsgAddCurrencyPairs{Authority: f.authority, CurrencyPairs: []types.CurrencyPair{{Base: "ATOM", Quote: "ATOM"}}},
+			expErr: types.ErrInvalidCurrencyPair,
+		},
+		"valid": {
+			msg: &types.MsgAddCurrencyPairs{Authority: f.authority, CurrencyPairs: []types.CurrencyPair{atomUSD, ethUSD}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := srv.AddCurrencyPairs(f.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	pairs, err := f.keeper.GetCurrencyPairs(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.CurrencyPair{atomUSD, ethUSD}, pairs)
+}
+
+func TestRemoveCurrencyPairs(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := srv.AddCurrencyPairs(f.ctx, &types.MsgAddCurrencyPairs{Authority: f.authority, CurrencyPairs: []types.CurrencyPair{atomUSD, ethUSD}})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetPrices(f.ctx, map[string]math.LegacyDec{atomUSD.String(): math.LegacyNewDec(11)}))
+
+	_, err = srv.RemoveCurrencyPairs(f.ctx, &types.MsgRemoveCurrencyPairs{Authority: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9", CurrencyPairs: []types.CurrencyPair{atomUSD}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = srv.RemoveCurrencyPairs(f.ctx, &types.MsgRemoveCurrencyPairs{Authority: f.authority, CurrencyPairs: []types.CurrencyPair{btcUSD}})
+	require.ErrorIs(t, err, types.ErrInvalidCurrencyPair)
+
+	_, err = srv.RemoveCurrencyPairs(f.ctx, &types.MsgRemoveCurrencyPairs{Authority: f.authority, CurrencyPairs: []types.CurrencyPair{atomUSD}})
+	require.NoError(t, err)
+
+	pairs, err := f.keeper.GetCurrencyPairs(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.CurrencyPair{ethUSD}, pairs)
+
+	// the price of a removed currency pair is removed with it
+	_, err = f.keeper.GetPrice(f.ctx, atomUSD)
+	require.Error(t, err)
+}
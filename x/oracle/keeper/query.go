@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+type QueryServer struct {
+	keeper *Keeper
+}
+
+// NewQueryServer returns an implementation of the oracle QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper *Keeper) types.QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// CurrencyPairs returns the currency pairs validators report prices for.
+func (qs QueryServer) CurrencyPairs(ctx context.Context, req *types.QueryCurrencyPairsRequest) (*types.QueryCurrencyPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pairs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.CurrencyPairs,
+		req.Pagination,
+		func(pair string, _ collections.NoValue) (types.CurrencyPair, error) {
+			return types.ParseCurrencyPair(pair)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrencyPairsResponse{CurrencyPairs: pairs, Pagination: pageRes}, nil
+}
+
+// Price returns the last price of a currency pair.
+func (qs QueryServer) Price(ctx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	cp := types.CurrencyPair{Base: req.Base, Quote: req.Quote}
+	if err := cp.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	price, err := qs.keeper.GetPrice(ctx, cp)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceResponse{Price: price}, nil
+}
+
+// Prices returns the last prices of the currency pairs.
+func (qs QueryServer) Prices(ctx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	prices, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Prices,
+		req.Pagination,
+		func(pair string, price types.QuotePrice) (types.CurrencyPairPrice, error) {
+			cp, err := types.ParseCurrencyPair(pair)
+			if err != nil {
+				return types.CurrencyPairPrice{}, err
+			}
+			return types.CurrencyPairPrice{CurrencyPair: cp, QuotePrice: price}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
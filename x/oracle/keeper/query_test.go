@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/oracle/keeper"
+	"cosmossdk.io/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestQueryCurrencyPairs(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(f.keeper)
+
+	for _, cp := range []types.CurrencyPair{atomUSD, btcUSD, ethUSD} {
+		require.NoError(t, f.keeper.AddCurrencyPair(f.ctx, cp))
+	}
+
+	res, err := qs.CurrencyPairs(f.ctx, &types.QueryCurrencyPairsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []types.CurrencyPair{atomUSD, btcUSD}, res.CurrencyPairs)
+
+	res, err = qs.CurrencyPairs(f.ctx, &types.QueryCurrencyPairsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []types.CurrencyPair{ethUSD}, res.CurrencyPairs)
+}
+
+func TestQueryPrices(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServer(f.keeper)
+
+	require.NoError(t, f.keeper.AddCurrencyPair(f.ctx, atomUSD))
+	require.NoError(t, f.keeper.AddCurrencyPair(f.ctx, ethUSD))
+	require.NoError(t, f.keeper.SetPrices(f.ctx, map[string]math.LegacyDec{atomUSD.String(): math.LegacyNewDec(11)}))
+
+	res, err := qs.Price(f.ctx, &types.QueryPriceRequest{Base: "ATOM", Quote: "USD"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(11), res.Price.Price)
+	require.Equal(t, int64(10), res.Price.BlockHeight)
+
+	_, err = qs.Price(f.ctx, &types.QueryPriceRequest{Base: "ETH", Quote: "USD"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.Price(f.ctx, &types.QueryPriceRequest{Base: "ETH"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	prices, err := qs.Prices(f.ctx, &types.QueryPricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, prices.Prices, 1)
+	require.Equal(t, atomUSD, prices.Prices[0].CurrencyPair)
+	require.Equal(t, math.LegacyNewDec(11), prices.Prices[0].QuotePrice.Price)
+}
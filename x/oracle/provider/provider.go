@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	"cosmossdk.io/x/oracle/types"
+)
+
+// Provider fetches the prices of currency pairs, e.g. from an exchange API.
+// Providers are only called by the validator extending its vote, so they are
+// not required to be deterministic.
+type Provider interface {
+	// Name identifies the provider.
+	Name() string
+	// FetchPrices returns the prices of the given currency pairs the provider
+	// knows of.
+	FetchPrices(ctx context.Context, pairs []types.CurrencyPair) (map[types.CurrencyPair]math.LegacyDec, error)
+}
+
+var _ Provider = MedianProvider{}
+
+// MedianProvider is a Provider returning, for each currency pair, the median
+// of the prices fetched from its providers. The providers failing are skipped.
+type MedianProvider struct {
+	providers []Provider
+}
+
+// NewMedianProvider returns a MedianProvider of the given providers.
+func NewMedianProvider(providers ...Provider) MedianProvider {
+	return MedianProvider{providers: providers}
+}
+
+// Name implements Provider.
+func (MedianProvider) Name() string {
+	return "median"
+}
+
+// FetchPrices implements Provider. It fails if every provider fails.
+func (mp MedianProvider) FetchPrices(ctx context.Context, pairs []types.CurrencyPair) (map[types.CurrencyPair]math.LegacyDec, error) {
+	observed := make(map[types.CurrencyPair][]math.LegacyDec, len(pairs))
+
+	var errs []error
+	for _, p := range mp.providers {
+		prices, err := p.FetchPrices(ctx, pairs)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("provider %s: %w", p.Name(), err))
+			continue
+		}
+
+		for cp, price := range prices {
+			if price.IsNil() || !price.IsPositive() {
+				continue
+			}
+			observed[cp] = append(observed[cp], price)
+		}
+	}
+	if len(mp.providers) > 0 && len(errs) == len(mp.providers) {
+		return nil, errors.Join(errs...)
+	}
+
+	medians := make(map[types.CurrencyPair]math.LegacyDec, len(observed))
+	for cp, prices := range observed {
+		medians[cp] = median(prices)
+	}
+
+	return medians, nil
+}
+
+// median returns the median of non-empty prices, the mean of the two middle
+// prices if their number is even.
+func median(prices []math.LegacyDec) math.LegacyDec {
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+
+	return prices[mid-1].Add(prices[mid]).QuoInt64(2)
+}
+
+var _ Provider = MockProvider{}
+
+// MockProvider is a deterministic Provider returning fixed prices, meant for
+// tests.
+type MockProvider struct {
+	prices map[types.CurrencyPair]math.LegacyDec
+}
+
+// NewMockProvider returns a MockProvider of the given prices.
+func NewMockProvider(prices map[types.CurrencyPair]math.LegacyDec) MockProvider {
+	return MockProvider{prices: prices}
+}
+
+// Name implements Provider.
+func (MockProvider) Name() string {
+	return "mock"
+}
+
+// FetchPrices implements Provider.
+func (mp MockProvider) FetchPrices(_ context.Context, pairs []types.CurrencyPair) (map[types.CurrencyPair]math.LegacyDec, error) {
+	prices := make(map[types.CurrencyPair]math.LegacyDec, len(pairs))
+	for _, cp := range pairs {
+		if price, ok := mp.prices[cp]; ok {
+			prices[cp] = price
+		}
+	}
+
+	return prices, nil
+}
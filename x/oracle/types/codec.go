@@ -0,0 +1,26 @@
+package types
+
+import (
+	corelegacy "cosmossdk.io/core/legacy"
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
+		&MsgAddCurrencyPairs{},
+		&MsgRemoveCurrencyPairs{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/oracle interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAddCurrencyPairs{}, "cosmos-sdk/MsgAddCurrencyPairs")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveCurrencyPairs{}, "cosmos-sdk/MsgRemoveCurrencyPairs")
+}
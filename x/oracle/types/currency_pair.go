@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewCurrencyPair returns a new CurrencyPair.
+func NewCurrencyPair(base, quote string) CurrencyPair {
+	return CurrencyPair{Base: strings.ToUpper(base), Quote: strings.ToUpper(quote)}
+}
+
+// ParseCurrencyPair parses a currency pair from its "BASE/QUOTE" string
+// representation.
+func ParseCurrencyPair(s string) (CurrencyPair, error) {
+	base, quote, ok := strings.Cut(s, "/")
+	if !ok {
+		return CurrencyPair{}, errorsmod.Wrapf(ErrInvalidCurrencyPair, "%q is not of the form BASE/QUOTE", s)
+	}
+
+	cp := CurrencyPair{Base: base, Quote: quote}
+	if err := cp.Validate(); err != nil {
+		return CurrencyPair{}, err
+	}
+
+	return cp, nil
+}
+
+// String returns the "BASE/QUOTE" representation of the currency pair.
+func (cp CurrencyPair) String() string {
+	return cp.Base + "/" + cp.Quote
+}
+
+// Validate checks that the assets of the currency pair are non-empty, upper
+// case, distinct and do not contain a "/".
+func (cp CurrencyPair) Validate() error {
+	for _, asset := range []string{cp.Base, cp.Quote} {
+		if asset == "" || strings.Contains(asset, "/") || strings.ToUpper(asset) != asset {
+			return errorsmod.Wrapf(ErrInvalidCurrencyPair, "invalid asset %q in %s", asset, cp)
+		}
+	}
+	if cp.Base == cp.Quote {
+		return errorsmod.Wrapf(ErrInvalidCurrencyPair, "%s has identical assets", cp)
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidCurrencyPair = errors.Register(ModuleName, 2, "invalid currency pair")
+	ErrInvalidPrice        = errors.Register(ModuleName, 3, "invalid price")
+	ErrInvalidVoteExt      = errors.Register(ModuleName, 4, "invalid oracle vote extension")
+	ErrInvalidInjectedTx   = errors.Register(ModuleName, 5, "invalid injected oracle tx")
+	ErrInvalidAuthority    = errors.Register(ModuleName, 6, "invalid authority")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(pairs []CurrencyPair, prices []CurrencyPairPrice) *GenesisState {
+	return &GenesisState{
+		CurrencyPairs: pairs,
+		Prices:        prices,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]CurrencyPair{}, []CurrencyPairPrice{})
+}
+
+// ValidateGenesis validates the oracle genesis data
+func ValidateGenesis(data *GenesisState) error {
+	pairs := make(map[CurrencyPair]struct{}, len(data.CurrencyPairs))
+	for _, cp := range data.CurrencyPairs {
+		if err := cp.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pairs[cp]; ok {
+			return fmt.Errorf("duplicate currency pair %s", cp)
+		}
+		pairs[cp] = struct{}{}
+	}
+
+	for _, p := range data.Prices {
+		if _, ok := pairs[p.CurrencyPair]; !ok {
+			return fmt.Errorf("price of unknown currency pair %s", p.CurrencyPair)
+		}
+		if p.QuotePrice.Price.IsNil() || !p.QuotePrice.Price.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidPrice, "price of %s must be positive", p.CurrencyPair)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewInjectedPrices returns the InjectedPrices of the given prices, by currency
+// pair string, and extended commit.
+func NewInjectedPrices(prices map[string]math.LegacyDec, extCommit abci.ExtendedCommitInfo) (InjectedPrices, error) {
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return InjectedPrices{}, err
+	}
+
+	return InjectedPrices{Prices: NewReportedPrices(prices), ExtendedCommitInfo: bz}, nil
+}
+
+// Encode returns the encoding of the injected prices.
+func (ip InjectedPrices) Encode() ([]byte, error) {
+	return ip.Marshal()
+}
+
+// DecodeInjectedPrices decodes an injected transaction, whose prices must be
+// positive and sorted by currency pair.
+func DecodeInjectedPrices(bz []byte) (InjectedPrices, error) {
+	var ip InjectedPrices
+	if err := ip.Unmarshal(bz); err != nil {
+		return InjectedPrices{}, errorsmod.Wrap(ErrInvalidInjectedTx, err.Error())
+	}
+	if err := validateReportedPrices(ip.Prices); err != nil {
+		return InjectedPrices{}, errorsmod.Wrap(ErrInvalidInjectedTx, err.Error())
+	}
+
+	return ip, nil
+}
+
+// ExtendedCommit decodes the extended commit the prices were aggregated from.
+func (ip InjectedPrices) ExtendedCommit() (abci.ExtendedCommitInfo, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(ip.ExtendedCommitInfo); err != nil {
+		return abci.ExtendedCommitInfo{}, errorsmod.Wrap(ErrInvalidInjectedTx, err.Error())
+	}
+
+	return extCommit, nil
+}
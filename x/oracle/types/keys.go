@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the oracle module
+	ModuleName = "oracle"
+
+	// StoreKey is the store key string for the oracle module
+	StoreKey = ModuleName
+)
+
+var (
+	// CurrencyPairsKey is the prefix of the currency pairs prices are reported for
+	CurrencyPairsKey = collections.NewPrefix(0)
+	// PricesKey is the prefix of the prices of the currency pairs
+	PricesKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewReportedPrices returns the given prices, by currency pair string, sorted
+// by currency pair so that their encoding is deterministic.
+func NewReportedPrices(prices map[string]math.LegacyDec) []ReportedPrice {
+	reported := make([]ReportedPrice, 0, len(prices))
+	for pair, price := range prices {
+		reported = append(reported, ReportedPrice{CurrencyPair: pair, Price: price})
+	}
+	sort.Slice(reported, func(i, j int) bool { return reported[i].CurrencyPair < reported[j].CurrencyPair })
+
+	return reported
+}
+
+// ReportedPriceMap returns the given prices by currency pair string.
+func ReportedPriceMap(prices []ReportedPrice) map[string]math.LegacyDec {
+	m := make(map[string]math.LegacyDec, len(prices))
+	for _, p := range prices {
+		m[p.CurrencyPair] = p.Price
+	}
+
+	return m
+}
+
+// validateReportedPrices checks that the given prices are sorted by currency
+// pair, without duplicates, and positive.
+func validateReportedPrices(prices []ReportedPrice) error {
+	for i, p := range prices {
+		if i > 0 && prices[i-1].CurrencyPair >= p.CurrencyPair {
+			return errorsmod.Wrapf(ErrInvalidPrice, "prices are not sorted by currency pair at %s", p.CurrencyPair)
+		}
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidPrice, "price of %s must be positive", p.CurrencyPair)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1001 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/oracle/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddCurrencyPairs is the Msg/AddCurrencyPairs request type.
+type MsgAddCurrencyPairs struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pairs are the currency pairs to add.
+	CurrencyPairs []CurrencyPair `protobuf:"bytes,2,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
+}
+
+func (m *MsgAddCurrencyPairs) Reset()         { *m = MsgAddCurrencyPairs{} }
+func (m *MsgAddCurrencyPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCurrencyPairs) ProtoMessage()    {}
+func (*MsgAddCurrencyPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836933fb4b988e66, []int{0}
+}
+func (m *MsgAddCurrencyPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCurrencyPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCurrencyPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCurrencyPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCurrencyPairs.Merge(m, src)
+}
+func (m *MsgAddCurrencyPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCurrencyPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCurrencyPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCurrencyPairs proto.InternalMessageInfo
+
+func (m *MsgAddCurrencyPairs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddCurrencyPairs) GetCurrencyPairs() []CurrencyPair {
+	if m != nil {
+		return m.CurrencyPairs
+	}
+	return nil
+}
+
+// MsgAddCurrencyPairsResponse is the Msg/AddCurrencyPairs response type.
+type MsgAddCurrencyPairsResponse struct {
+}
+
+func (m *MsgAddCurrencyPairsResponse) Reset()         { *m = MsgAddCurrencyPairsResponse{} }
+func (m *MsgAddCurrencyPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCurrencyPairsResponse) ProtoMessage()    {}
+func (*MsgAddCurrencyPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836933fb4b988e66, []int{1}
+}
+func (m *MsgAddCurrencyPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCurrencyPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCurrencyPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCurrencyPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCurrencyPairsResponse.Merge(m, src)
+}
+func (m *MsgAddCurrencyPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCurrencyPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCurrencyPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCurrencyPairsResponse proto.InternalMessageInfo
+
+// MsgRemoveCurrencyPairs is the Msg/RemoveCurrencyPairs request type.
+type MsgRemoveCurrencyPairs struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// currency_pairs are the currency pairs to remove.
+	CurrencyPairs []CurrencyPair `protobuf:"bytes,2,rep,name=currency_pairs,json=currencyPairs,proto3" json:"currency_pairs"`
+}
+
+func (m *MsgRemoveCurrencyPairs) Reset()         { *m = MsgRemoveCurrencyPairs{} }
+func (m *MsgRemoveCurrencyPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCurrencyPairs) ProtoMessage()    {}
+func (*MsgRemoveCurrencyPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836933fb4b988e66, []int{2}
+}
+func (m *MsgRemoveCurrencyPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCurrencyPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCurrencyPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveCurrencyPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCurrencyPairs.Merge(m, src)
+}
+func (m *MsgRemoveCurrencyPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCurrencyPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCurrencyPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveCurrencyPairs proto.InternalMessageInfo
+
+func (m *MsgRemoveCurrencyPairs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveCurrencyPairs) GetCurrencyPairs() []CurrencyPair {
+	if m != nil {
+		return m.CurrencyPairs
+	}
+	return nil
+}
+
+// MsgRemoveCurrencyPairsResponse is the Msg/RemoveCurrencyPairs response type.
+type MsgRemoveCurrencyPairsResponse struct {
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Reset()         { *m = MsgRemoveCurrencyPairsResponse{} }
+func (m *MsgRemoveCurrencyPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCurrencyPairsResponse) ProtoMessage()    {}
+func (*MsgRemoveCurrencyPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_836933fb4b988e66, []int{3}
+}
+func (m *MsgRemoveCurrencyPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCurrencyPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCurrencyPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveCurrencyPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCurrencyPairsResponse.Merge(m, src)
+}
+func (m *MsgRemoveCurrencyPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCurrencyPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "cosmos.oracle.v1.MsgAddCurrencyPairs")
+	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "cosmos.oracle.v1.MsgAddCurrencyPairsResponse")
+	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "cosmos.oracle.v1.MsgRemoveCurrencyPairs")
+	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "cosmos.oracle.v1.MsgRemoveCurrencyPairsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/oracle/v1/tx.proto", fileDescriptor_836933fb4b988e66) }
+
+var fileDescriptor_836933fb4b988e66 = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x4d, 0x8b, 0xda, 0x40,
+	0x18, 0xce, 0x54, 0x5a, 0x70, 0x4a, 0x8b, 0x8d, 0xd2, 0xda, 0x88, 0x53, 0x1b, 0x28, 0x88, 0x60,
+	0x52, 0xed, 0xc7, 0xa1, 0x37, 0xed, 0x59, 0x90, 0xf4, 0xd6, 0x8b, 0xa4, 0xc9, 0x30, 0x06, 0x9b,
+	0x4c, 0x3a, 0x13, 0x45, 0x6f, 0xa5, 0xc7, 0x9e, 0xf6, 0x67, 0xec, 0xd1, 0xc3, 0xfe, 0x08, 0x8f,
+	0xe2, 0xc9, 0xd3, 0xb2, 0xe8, 0x41, 0xf6, 0x5f, 0x2c, 0x49, 0x26, 0xe8, 0x6e, 0xe6, 0xe0, 0x71,
+	0x2f, 0x21, 0xbc, 0xcf, 0x93, 0xe7, 0xe3, 0x0d, 0x2f, 0x7c, 0xeb, 0x50, 0xee, 0x53, 0x6e, 0x52,
+	0x66, 0x3b, 0xbf, 0xb1, 0x39, 0xeb, 0x98, 0xd1, 0xdc, 0x08, 0x19, 0x8d, 0xa8, 0x5a, 0x4a, 0x21,
+	0x23, 0x85, 0x8c, 0x59, 0x47, 0xab, 0x10, 0x4a, 0x68, 0x02, 0x9a, 0xf1, 0x5b, 0xca, 0xd3, 0x84,
+	0xc4, 0x28, 0x05, 0xc4, 0x47, 0x29, 0xf4, 0x46, 0xa8, 0xfb, 0x9c, 0xc4, 0xd2, 0x3e, 0x27, 0x02,
+	0x78, 0x65, 0xfb, 0x5e, 0x40, 0xcd, 0xe4, 0x29, 0x46, 0xf5, 0x5c, 0x12, 0x61, 0x9c, 0xc0, 0xfa,
+	0x06, 0xc0, 0xf2, 0x80, 0x93, 0x9e, 0xeb, 0x7e, 0x9f, 0x32, 0x86, 0x03, 0x67, 0x31, 0xb4, 0x3d,
+	0xc6, 0xd5, 0xaf, 0xb0, 0x68, 0x4f, 0xa3, 0x31, 0x65, 0x5e, 0xb4, 0xa8, 0x82, 0x06, 0x68, 0x16,
+	0xfb, 0xd5, 0xcd, 0x55, 0xbb, 0x22, 0x72, 0xf4, 0x5c, 0x97, 0x61, 0xce, 0x7f, 0x44, 0xcc, 0x0b,
+	0x88, 0x75, 0xa4, 0xaa, 0x43, 0xf8, 0xd2, 0x11, 0x42, 0xa3, 0x30, 0x56, 0xaa, 0x3e, 0x69, 0x14,
+	0x9a, 0xcf, 0xbb, 0xc8, 0x78, 0x58, 0xdb, 0x38, 0x35, 0xec, 0x17, 0x57, 0xd7, 0xef, 0x94, 0xcb,
+	0xc3, 0xb2, 0x05, 0xac, 0x17, 0xce, 0x69, 0x92, 0x6f, 0x9d, 0x7f, 0x87, 0x65, 0xeb, 0xe8, 0xf0,
+	0xff, 0xb0, 0x6c, 0xa1, 0x54, 0xab, 0xcd, 0xdd, 0x89, 0x29, 0x09, 0xaf, 0xd7, 0x61, 0x4d, 0x32,
+	0xb6, 0x30, 0x0f, 0x69, 0xc0, 0xb1, 0xbe, 0x05, 0xf0, 0xf5, 0x80, 0x13, 0x0b, 0xfb, 0x74, 0x86,
+	0x1f, 0x6b, 0xed, 0xcf, 0xf9, 0xda, 0xef, 0xef, 0xd7, 0x96, 0xe4, 0xd7, 0x1b, 0x10, 0xc9, 0x91,
+	0xac, 0x7c, 0xf7, 0x16, 0xc0, 0xc2, 0x80, 0x13, 0x75, 0x0c, 0x4b, 0xb9, 0x9f, 0xfe, 0x21, 0x9f,
+	0x56, 0xb2, 0x47, 0xad, 0x7d, 0x16, 0x2d, 0x73, 0x54, 0xff, 0xc0, 0xb2, 0x6c, 0xd5, 0x4d, 0xa9,
+	0x8a, 0x84, 0xa9, 0x7d, 0x3c, 0x97, 0x99, 0x59, 0x6a, 0x4f, 0xff, 0xc6, 0x2b, 0xed, 0x7f, 0x59,
+	0xed, 0x10, 0x58, 0xef, 0x10, 0xb8, 0xd9, 0x21, 0x70, 0xb1, 0x47, 0xca, 0x7a, 0x8f, 0x94, 0xed,
+	0x1e, 0x29, 0x3f, 0x6b, 0xa9, 0x22, 0x77, 0x27, 0x86, 0x47, 0xcd, 0x79, 0x76, 0x1d, 0xd1, 0x22,
+	0xc4, 0xfc, 0xd7, 0xb3, 0xe4, 0x34, 0x3e, 0xdd, 0x0d, 0x00, 0xc7, 0x83, 0xff, 0xd0, 0xc5, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddCurrencyPairs adds currency pairs validators report prices for.
+	AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error)
+	// RemoveCurrencyPairs removes currency pairs validators report prices for,
+	// along with their last prices.
+	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddCurrencyPairs(ctx context.Context, in *MsgAddCurrencyPairs, opts ...grpc.CallOption) (*MsgAddCurrencyPairsResponse, error) {
+	out := new(MsgAddCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.oracle.v1.Msg/AddCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error) {
+	out := new(MsgRemoveCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.oracle.v1.Msg/RemoveCurrencyPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddCurrencyPairs adds currency pairs validators report prices for.
+	AddCurrencyPairs(context.Context, *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error)
+	// RemoveCurrencyPairs removes currency pairs validators report prices for,
+	// along with their last prices.
+	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAddCurrencyPairs) (*MsgAddCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCurrencyPairs not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCurrencyPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.oracle.v1.Msg/AddCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCurrencyPairs(ctx, req.(*MsgAddCurrencyPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCurrencyPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCurrencyPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.oracle.v1.Msg/RemoveCurrencyPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCurrencyPairs(ctx, req.(*MsgRemoveCurrencyPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.oracle.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCurrencyPairs",
+			Handler:    _Msg_AddCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "RemoveCurrencyPairs",
+			Handler:    _Msg_RemoveCurrencyPairs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/oracle/v1/tx.proto",
+}
+
+func (m *MsgAddCurrencyPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCurrencyPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
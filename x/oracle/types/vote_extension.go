@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxVoteExtensionSize is the maximum size, in bytes, of an oracle vote
+// extension.
+const MaxVoteExtensionSize = 64 * 1024
+
+// NewOracleVoteExtension returns the vote extension of the given prices, by
+// currency pair string.
+func NewOracleVoteExtension(prices map[string]math.LegacyDec) OracleVoteExtension {
+	return OracleVoteExtension{Prices: NewReportedPrices(prices)}
+}
+
+// Encode returns the encoding of the vote extension. As the prices are sorted,
+// the encoding is deterministic.
+func (ve OracleVoteExtension) Encode() ([]byte, error) {
+	return ve.Marshal()
+}
+
+// DecodeOracleVoteExtension decodes a vote extension.
+func DecodeOracleVoteExtension(bz []byte) (OracleVoteExtension, error) {
+	if len(bz) > MaxVoteExtensionSize {
+		return OracleVoteExtension{}, errorsmod.Wrapf(ErrInvalidVoteExt, "size %d exceeds %d bytes", len(bz), MaxVoteExtensionSize)
+	}
+
+	var ve OracleVoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return OracleVoteExtension{}, errorsmod.Wrap(ErrInvalidVoteExt, err.Error())
+	}
+
+	return ve, nil
+}
+
+// Validate checks that the vote extension only holds positive prices of the
+// given currency pairs, sorted by currency pair.
+func (ve OracleVoteExtension) Validate(pairs map[string]struct{}) error {
+	for _, p := range ve.Prices {
+		if _, ok := pairs[p.CurrencyPair]; !ok {
+			return errorsmod.Wrapf(ErrInvalidVoteExt, "unknown currency pair %s", p.CurrencyPair)
+		}
+	}
+
+	return validateReportedPrices(ve.Prices)
+}
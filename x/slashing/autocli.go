@@ -34,6 +34,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "OffenseHistory",
+					Use:       "offense-history [validator-conspub/address]",
+					Short:     "Query a validator's downtime offense history and the penalties of its next downtime offense",
+					Example:   fmt.Sprintf(`%s query slashing offense-history cosmosvalcons1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -80,3 +80,42 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// OffenseHistory returns the downtime offense history of a specific validator and the
+// penalties of its next downtime offense.
+func (k Keeper) OffenseHistory(ctx context.Context, req *types.QueryOffenseHistoryRequest) (*types.QueryOffenseHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recentJails := params.RecentDowntimeJails(signingInfo.RecentDowntimeJails, k.HeaderService.HeaderInfo(ctx).Time)
+	slashFraction, jailDuration := params.DowntimePenalty(len(recentJails))
+
+	return &types.QueryOffenseHistoryResponse{
+		DowntimeJailCount:         signingInfo.DowntimeJailCount,
+		RecentDowntimeJails:       recentJails,
+		JailedUntil:               signingInfo.JailedUntil,
+		Tombstoned:                signingInfo.Tombstoned,
+		NextDowntimeSlashFraction: slashFraction,
+		NextDowntimeJailDuration:  jailDuration,
+	}, nil
+}
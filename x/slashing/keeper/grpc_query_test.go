@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"time"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 
@@ -94,3 +95,37 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCOffenseHistory() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.OffenseHistory(gocontext.Background(), &slashingtypes.QueryOffenseHistoryRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	_, err = queryClient.OffenseHistory(gocontext.Background(), &slashingtypes.QueryOffenseHistoryRequest{ConsAddress: consStr})
+	require.ErrorContains(err, "SigningInfo not found")
+
+	params := testutil.TestParams()
+	params.DowntimeEscalationMultiplier = math.LegacyNewDec(2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// only the last downtime jail is within the offense window
+	now := ctx.HeaderInfo().Time
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, 0, now.Add(time.Hour), false, int64(0))
+	signingInfo.RecentDowntimeJails = []time.Time{now.Add(-2 * params.DowntimeOffenseWindow), now.Add(-time.Hour)}
+	signingInfo.DowntimeJailCount = 2
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	res, err := queryClient.OffenseHistory(gocontext.Background(), &slashingtypes.QueryOffenseHistoryRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(uint64(2), res.DowntimeJailCount)
+	require.Equal([]time.Time{now.Add(-time.Hour)}, res.RecentDowntimeJails)
+	require.Equal(signingInfo.JailedUntil, res.JailedUntil)
+	require.False(res.Tombstoned)
+	require.True(params.SlashFractionDowntime.MulInt64(2).Equal(res.NextDowntimeSlashFraction))
+	require.Equal(2*params.DowntimeJailDuration, res.NextDowntimeJailDuration)
+}
@@ -7,6 +7,7 @@ import (
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -149,14 +150,18 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-			if err != nil {
-				return err
-			}
-
-			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
-			if err != nil {
-				return err
+			// the penalties escalate with the downtime jails of the validator within the
+			// downtime offense window
+			blockTime := k.HeaderService.HeaderInfo(ctx).Time
+			recentJails := params.RecentDowntimeJails(signInfo.RecentDowntimeJails, blockTime)
+			slashFractionDowntime, downtimeJailDur := params.DowntimePenalty(len(recentJails))
+
+			coinsBurned := math.ZeroInt()
+			if slashFractionDowntime.IsPositive() {
+				coinsBurned, err = k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
+				if err != nil {
+					return err
+				}
 			}
 
 			if err := k.EventService.EventManager(ctx).EmitKV(
@@ -174,11 +179,14 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
-				return err
+			signInfo.JailedUntil = blockTime.Add(downtimeJailDur)
+
+			// record the downtime jail in the offense history of the validator
+			if params.DowntimeOffenseWindow > 0 {
+				recentJails = append(recentJails, blockTime)
 			}
-			signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
+			signInfo.RecentDowntimeJails = recentJails
+			signInfo.DowntimeJailCount++
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -197,6 +205,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
 				"slashed", slashFractionDowntime.String(),
+				"downtime_jail_count", signInfo.DowntimeJailCount,
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
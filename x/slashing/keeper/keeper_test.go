@@ -152,6 +152,8 @@ func validatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 
 func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 	s.SetupTest()
+	// the hashes only cover the entries written by the test, not the params
+	s.ctx.KVStore(s.key).Delete(slashingtypes.ParamsKey)
 
 	consAddr := sdk.ConsAddress(sdk.AccAddress([]byte("addr1_______________")))
 	index := int64(0)
@@ -162,7 +164,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"929f3e366a005f9ca78c98e4f3b9d364006f55477f9b2539236314d5a482679c",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +176,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"929f3e366a005f9ca78c98e4f3b9d364006f55477f9b2539236314d5a482679c",
 	)
 	s.Require().NoError(err)
 }
@@ -118,16 +118,54 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime escalation multiplier",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(10),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1),
+					MaxDowntimeJailDuration:      time.Duration(10),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime escalation multiplier cannot be less than one",
+		},
+		{
+			name: "set invalid max downtime jail duration",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(10),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDec(2),
+					MaxDowntimeJailDuration:      time.Duration(5),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "must not be less than downtime jail duration",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeOffenseWindow:        30 * 24 * time.Hour,
+					DowntimeEscalationMultiplier: sdkmath.LegacyNewDec(2),
+					MaxDowntimeJailDuration:      time.Duration(34800000000000) * 4,
+					DowntimeFirstOffenseGrace:    true,
 				},
 			},
 			expectErr: false,
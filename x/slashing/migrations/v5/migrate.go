@@ -0,0 +1,38 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/slashing/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var ParamsKey = collections.NewPrefix(0)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+// It sets the downtime escalation params to their default value.
+func MigrateStore(ctx context.Context, env appmodule.Environment, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	params := collections.NewItem(sb, ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.DowntimeOffenseWindow = types.DefaultDowntimeOffenseWindow
+	p.DowntimeEscalationMultiplier = types.DefaultDowntimeEscalationMultiplier
+	p.MaxDowntimeJailDuration = types.DefaultMaxDowntimeJailDuration
+	if p.MaxDowntimeJailDuration < p.DowntimeJailDuration {
+		p.MaxDowntimeJailDuration = p.DowntimeJailDuration
+	}
+	p.DowntimeFirstOffenseGrace = types.DefaultDowntimeFirstOffenseGrace
+
+	return params.Set(ctx, p)
+}
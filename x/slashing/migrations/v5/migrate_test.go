@@ -0,0 +1,58 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/slashing"
+	v5 "cosmossdk.io/x/slashing/migrations/v5"
+	"cosmossdk.io/x/slashing/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, slashing.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
+
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, v5.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	oldParams := types.Params{
+		SignedBlocksWindow:      200,
+		MinSignedPerWindow:      math.LegacyNewDecWithPrec(5, 1),
+		DowntimeJailDuration:    time.Hour,
+		SlashFractionDoubleSign: math.LegacyNewDecWithPrec(5, 2),
+		SlashFractionDowntime:   math.LegacyNewDecWithPrec(1, 2),
+	}
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, env, cdc))
+
+	newParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, oldParams.SignedBlocksWindow, newParams.SignedBlocksWindow)
+	require.Equal(t, oldParams.DowntimeJailDuration, newParams.DowntimeJailDuration)
+	require.Equal(t, types.DefaultDowntimeOffenseWindow, newParams.DowntimeOffenseWindow)
+	require.Equal(t, types.DefaultDowntimeEscalationMultiplier, newParams.DowntimeEscalationMultiplier)
+	require.Equal(t, types.DefaultMaxDowntimeJailDuration, newParams.MaxDowntimeJailDuration)
+	require.Equal(t, types.DefaultDowntimeFirstOffenseGrace, newParams.DowntimeFirstOffenseGrace)
+	require.NoError(t, newParams.Validate())
+}
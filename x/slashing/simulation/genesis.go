@@ -20,6 +20,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeEscalationMultiplier = "downtime_escalation_multiplier"
+	DowntimeFirstOffenseGrace    = "downtime_first_offense_grace"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -47,6 +50,16 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeEscalationMultiplier randomized DowntimeEscalationMultiplier
+func GenDowntimeEscalationMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyOneDec().Add(math.LegacyNewDecWithPrec(int64(r.Intn(11)), 1))
+}
+
+// GenDowntimeFirstOffenseGrace randomized DowntimeFirstOffenseGrace
+func GenDowntimeFirstOffenseGrace(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -64,9 +77,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var downtimeEscalationMultiplier math.LegacyDec
+	simState.AppParams.GetOrGenerate(DowntimeEscalationMultiplier, &downtimeEscalationMultiplier, simState.Rand, func(r *rand.Rand) { downtimeEscalationMultiplier = GenDowntimeEscalationMultiplier(r) })
+
+	var downtimeFirstOffenseGrace bool
+	simState.AppParams.GetOrGenerate(DowntimeFirstOffenseGrace, &downtimeFirstOffenseGrace, simState.Rand, func(r *rand.Rand) { downtimeFirstOffenseGrace = GenDowntimeFirstOffenseGrace(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, types.DefaultDowntimeOffenseWindow,
+		downtimeEscalationMultiplier, types.DefaultMaxDowntimeJailDuration, downtimeFirstOffenseGrace,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
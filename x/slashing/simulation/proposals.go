@@ -48,6 +48,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ []simtypes.Account, ac coreaddress.
 	params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDowntime = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+	params.MaxDowntimeJailDuration = params.DowntimeJailDuration
 
 	return &types.MsgUpdateParams{
 		Authority: authorityAddr,
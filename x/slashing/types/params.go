@@ -9,27 +9,37 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow        = int64(100)
+	DefaultDowntimeJailDuration      = 60 * 10 * time.Second
+	DefaultDowntimeOffenseWindow     = 30 * 24 * time.Hour
+	DefaultMaxDowntimeJailDuration   = 7 * 24 * time.Hour
+	DefaultDowntimeFirstOffenseGrace = false
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	// DefaultDowntimeEscalationMultiplier does not escalate the downtime penalties
+	DefaultDowntimeEscalationMultiplier = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, downtimeOffenseWindow time.Duration,
+	downtimeEscalationMultiplier math.LegacyDec, maxDowntimeJailDuration time.Duration, downtimeFirstOffenseGrace bool,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		DowntimeOffenseWindow:        downtimeOffenseWindow,
+		DowntimeEscalationMultiplier: downtimeEscalationMultiplier,
+		MaxDowntimeJailDuration:      maxDowntimeJailDuration,
+		DowntimeFirstOffenseGrace:    downtimeFirstOffenseGrace,
 	}
 }
 
@@ -41,6 +51,10 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeOffenseWindow,
+		DefaultDowntimeEscalationMultiplier,
+		DefaultMaxDowntimeJailDuration,
+		DefaultDowntimeFirstOffenseGrace,
 	)
 }
 
@@ -61,6 +75,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeOffenseWindow(p.DowntimeOffenseWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeEscalationMultiplier(p.DowntimeEscalationMultiplier); err != nil {
+		return err
+	}
+	if p.MaxDowntimeJailDuration < p.DowntimeJailDuration {
+		return fmt.Errorf("max downtime jail duration %s must not be less than downtime jail duration %s", p.MaxDowntimeJailDuration, p.DowntimeJailDuration)
+	}
 	return nil
 }
 
@@ -147,6 +170,35 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeOffenseWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeEscalationMultiplier(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime escalation multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime escalation multiplier cannot be less than one: %s", v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -156,3 +208,42 @@ func (p *Params) MinSignedPerWindowInt() int64 {
 	//       less than 1.
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
+
+// RecentDowntimeJails returns the downtime jails which are within the downtime offense
+// window at the given time.
+func (p Params) RecentDowntimeJails(jails []time.Time, now time.Time) []time.Time {
+	if p.DowntimeOffenseWindow == 0 {
+		return nil
+	}
+
+	var recent []time.Time
+	for _, jailTime := range jails {
+		if now.Before(jailTime.Add(p.DowntimeOffenseWindow)) {
+			recent = append(recent, jailTime)
+		}
+	}
+
+	return recent
+}
+
+// DowntimePenalty returns the slash fraction and the jail duration of a downtime
+// offense, escalated by the number of recent downtime jails of the validator.
+func (p Params) DowntimePenalty(recentJails int) (math.LegacyDec, time.Duration) {
+	slashFraction := p.SlashFractionDowntime
+	if recentJails == 0 && p.DowntimeFirstOffenseGrace {
+		slashFraction = math.LegacyZeroDec()
+	}
+
+	one := math.LegacyOneDec()
+	jailDuration := math.LegacyNewDec(int64(p.DowntimeJailDuration))
+	maxJailDuration := math.LegacyNewDec(int64(p.MaxDowntimeJailDuration))
+
+	// the penalties are escalated one step at a time so that they stop growing
+	// once capped, which keeps the decimals bounded
+	for i := 0; i < recentJails && (slashFraction.LT(one) || jailDuration.LT(maxJailDuration)); i++ {
+		slashFraction = math.LegacyMinDec(slashFraction.Mul(p.DowntimeEscalationMultiplier), one)
+		jailDuration = math.LegacyMinDec(jailDuration.Mul(p.DowntimeEscalationMultiplier), maxJailDuration)
+	}
+
+	return slashFraction, time.Duration(jailDuration.TruncateInt64())
+}
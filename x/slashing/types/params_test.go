@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestRecentDowntimeJails(t *testing.T) {
+	now := time.Unix(100*24*60*60, 0)
+	jails := []time.Time{now.Add(-40 * 24 * time.Hour), now.Add(-30 * 24 * time.Hour), now.Add(-time.Hour)}
+
+	params := DefaultParams()
+	require.Equal(t, []time.Time{now.Add(-time.Hour)}, params.RecentDowntimeJails(jails, now))
+
+	// a zero offense window disables escalation
+	params.DowntimeOffenseWindow = 0
+	require.Empty(t, params.RecentDowntimeJails(jails, now))
+}
+
+func TestDowntimePenalty(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionDowntime = math.LegacyNewDecWithPrec(3, 1)
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeEscalationMultiplier = math.LegacyNewDec(2)
+	params.MaxDowntimeJailDuration = 5 * time.Hour
+
+	testCases := []struct {
+		name          string
+		grace         bool
+		recentJails   int
+		expFraction   math.LegacyDec
+		expJailPeriod time.Duration
+	}{
+		{"first offense", false, 0, math.LegacyNewDecWithPrec(3, 1), time.Hour},
+		{"first offense with grace", true, 0, math.LegacyZeroDec(), time.Hour},
+		{"second offense with grace", true, 1, math.LegacyNewDecWithPrec(6, 1), 2 * time.Hour},
+		{"third offense", false, 2, math.LegacyOneDec(), 4 * time.Hour},
+		{"capped offense", false, 100, math.LegacyOneDec(), 5 * time.Hour},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params.DowntimeFirstOffenseGrace = tc.grace
+			fraction, jailPeriod := params.DowntimePenalty(tc.recentJails)
+			require.True(t, tc.expFraction.Equal(fraction), "expected %s, got %s", tc.expFraction, fraction)
+			require.Equal(t, tc.expJailPeriod, jailPeriod)
+		})
+	}
+}
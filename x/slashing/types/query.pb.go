@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -12,19 +13,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -309,6 +315,139 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOffenseHistoryRequest is the request type for the Query/OffenseHistory RPC
+// method
+type QueryOffenseHistoryRequest struct {
+	// cons_address is the address to query the offense history of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryOffenseHistoryRequest) Reset()         { *m = QueryOffenseHistoryRequest{} }
+func (m *QueryOffenseHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffenseHistoryRequest) ProtoMessage()    {}
+func (*QueryOffenseHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryOffenseHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffenseHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffenseHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOffenseHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffenseHistoryRequest.Merge(m, src)
+}
+func (m *QueryOffenseHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffenseHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffenseHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffenseHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryOffenseHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryOffenseHistoryResponse is the response type for the Query/OffenseHistory RPC
+// method
+type QueryOffenseHistoryResponse struct {
+	// downtime_jail_count is the total number of times the validator was jailed for downtime
+	DowntimeJailCount uint64 `protobuf:"varint,1,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
+	// recent_downtime_jails are the times at which the validator was jailed for downtime
+	// within the downtime offense window
+	RecentDowntimeJails []time.Time `protobuf:"bytes,2,rep,name=recent_downtime_jails,json=recentDowntimeJails,proto3,stdtime" json:"recent_downtime_jails"`
+	// jailed_until is the time until which the validator is jailed due to liveness downtime
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// tombstoned is whether the validator has been tombstoned
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// next_downtime_slash_fraction is the slash fraction of the next downtime offense
+	NextDowntimeSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=next_downtime_slash_fraction,json=nextDowntimeSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_downtime_slash_fraction"`
+	// next_downtime_jail_duration is the jail duration of the next downtime offense
+	NextDowntimeJailDuration time.Duration `protobuf:"bytes,6,opt,name=next_downtime_jail_duration,json=nextDowntimeJailDuration,proto3,stdduration" json:"next_downtime_jail_duration"`
+}
+
+func (m *QueryOffenseHistoryResponse) Reset()         { *m = QueryOffenseHistoryResponse{} }
+func (m *QueryOffenseHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffenseHistoryResponse) ProtoMessage()    {}
+func (*QueryOffenseHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryOffenseHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffenseHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffenseHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOffenseHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffenseHistoryResponse.Merge(m, src)
+}
+func (m *QueryOffenseHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffenseHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffenseHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffenseHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryOffenseHistoryResponse) GetDowntimeJailCount() uint64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
+func (m *QueryOffenseHistoryResponse) GetRecentDowntimeJails() []time.Time {
+	if m != nil {
+		return m.RecentDowntimeJails
+	}
+	return nil
+}
+
+func (m *QueryOffenseHistoryResponse) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *QueryOffenseHistoryResponse) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *QueryOffenseHistoryResponse) GetNextDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.NextDowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +455,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryOffenseHistoryRequest)(nil), "cosmos.slashing.v1beta1.QueryOffenseHistoryRequest")
+	proto.RegisterType((*QueryOffenseHistoryResponse)(nil), "cosmos.slashing.v1beta1.QueryOffenseHistoryResponse")
 }
 
 func init() {
@@ -323,43 +464,63 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xbb, 0x69, 0xc4, 0x4e, 0xca, 0x6a, 0x77, 0x5a, 0xb4, 0x49, 0xba, 0xeb, 0x64, 0x8d,
+	0xd4, 0xad, 0x16, 0x62, 0x37, 0x5d, 0xa0, 0x02, 0x89, 0x03, 0xd9, 0xa8, 0xfc, 0x50, 0x25, 0x20,
+	0x01, 0x24, 0x90, 0x90, 0x35, 0xb1, 0x27, 0xee, 0x80, 0x33, 0x93, 0x7a, 0x26, 0x6d, 0x23, 0x04,
+	0x07, 0xce, 0x1c, 0x2a, 0xb8, 0xf0, 0x0f, 0x20, 0x71, 0x04, 0xd4, 0x7f, 0x01, 0xa9, 0xe2, 0x54,
+	0x95, 0x0b, 0xe2, 0x50, 0x50, 0x8b, 0xc4, 0xbf, 0x81, 0x3c, 0x33, 0x4e, 0x9d, 0x26, 0x29, 0x8d,
+	0xe0, 0x12, 0x39, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0x7b, 0x33, 0xef, 0x81, 0x67, 0x3d, 0xc6, 0xbb,
+	0x8c, 0x3b, 0x3c, 0x44, 0x7c, 0x9b, 0xd0, 0xc0, 0xd9, 0xad, 0xb5, 0xb1, 0x40, 0x35, 0x67, 0xa7,
+	0x8f, 0xa3, 0x81, 0xdd, 0x8b, 0x98, 0x60, 0xf0, 0xae, 0x4a, 0xb2, 0x93, 0x24, 0x5b, 0x27, 0x95,
+	0x1e, 0x69, 0x74, 0x1b, 0x71, 0xac, 0x10, 0x43, 0x7c, 0x0f, 0x05, 0x84, 0x22, 0x41, 0x18, 0x55,
+	0x45, 0x4a, 0x4b, 0x01, 0x0b, 0x98, 0xfc, 0x74, 0xe2, 0x2f, 0x7d, 0x7a, 0x2f, 0x60, 0x2c, 0x08,
+	0xb1, 0x83, 0x7a, 0xc4, 0x41, 0x94, 0x32, 0x21, 0x21, 0x5c, 0x47, 0x4d, 0x1d, 0x95, 0xff, 0xda,
+	0xfd, 0x8e, 0xe3, 0xf7, 0xa3, 0x74, 0xcd, 0xf2, 0xe5, 0xb8, 0x20, 0x5d, 0xcc, 0x05, 0xea, 0xf6,
+	0x74, 0xc2, 0xca, 0x34, 0x7b, 0x43, 0x2b, 0x2a, 0xaf, 0xa8, 0xf2, 0x5c, 0xa5, 0x4f, 0xdb, 0x55,
+	0xa1, 0x3b, 0xa8, 0x4b, 0x28, 0x73, 0xe4, 0xaf, 0x3a, 0xb2, 0x96, 0x00, 0x7c, 0x37, 0x36, 0xfb,
+	0x0e, 0x8a, 0x50, 0x97, 0x37, 0xf1, 0x4e, 0x1f, 0x73, 0x61, 0x7d, 0x08, 0x16, 0x47, 0x4e, 0x79,
+	0x8f, 0x51, 0x8e, 0x61, 0x1d, 0xe4, 0x7a, 0xf2, 0xa4, 0x60, 0x54, 0x8c, 0xd5, 0xfc, 0x7a, 0xd9,
+	0x9e, 0xd2, 0x4d, 0x5b, 0x01, 0xeb, 0x37, 0x8f, 0x4e, 0xcb, 0x99, 0xef, 0xff, 0xfe, 0xe1, 0x91,
+	0xd1, 0xd4, 0x48, 0xcb, 0x05, 0x77, 0x65, 0xe9, 0x16, 0x09, 0x28, 0xa1, 0xc1, 0x9b, 0xb4, 0xc3,
+	0x34, 0x2b, 0x6c, 0x80, 0x05, 0x8f, 0x51, 0xee, 0x22, 0xdf, 0x8f, 0x30, 0x57, 0x24, 0x37, 0xeb,
+	0x0f, 0x4e, 0x0e, 0xab, 0xf7, 0x35, 0xcf, 0x93, 0x58, 0x06, 0xe5, 0x7d, 0xfe, 0x9a, 0x4a, 0x69,
+	0x89, 0x88, 0xd0, 0xa0, 0x99, 0x8f, 0x61, 0xfa, 0xc8, 0xfa, 0x02, 0x14, 0xc6, 0x09, 0xb4, 0x81,
+	0x36, 0xb8, 0xbd, 0x8b, 0x42, 0x97, 0xab, 0x90, 0x4b, 0x68, 0x87, 0x69, 0x2b, 0xd5, 0xa9, 0x56,
+	0x3e, 0x40, 0x21, 0xf1, 0x91, 0x60, 0x51, 0xaa, 0x60, 0xda, 0xd8, 0xad, 0x5d, 0x14, 0xa6, 0x42,
+	0x56, 0x7b, 0x9c, 0x3f, 0xe9, 0x2b, 0xdc, 0x04, 0xe0, 0xe2, 0x31, 0x69, 0xe6, 0x95, 0x84, 0x39,
+	0x7e, 0x79, 0xb6, 0x7a, 0xab, 0x17, 0x6d, 0x0c, 0xb0, 0xc6, 0x36, 0x53, 0x48, 0xeb, 0x27, 0x03,
+	0x14, 0x27, 0x90, 0x68, 0x97, 0x5b, 0x20, 0xab, 0x9d, 0xdd, 0xf8, 0x4f, 0xce, 0x64, 0x15, 0xf8,
+	0xfa, 0x88, 0xe6, 0x39, 0xa9, 0xf9, 0xe1, 0xbf, 0x6a, 0x56, 0x52, 0x46, 0x44, 0xef, 0x83, 0x92,
+	0xd4, 0xfc, 0x76, 0xa7, 0x83, 0x29, 0xc7, 0x6f, 0x10, 0x2e, 0x58, 0x34, 0xf8, 0x5f, 0x2f, 0xff,
+	0x95, 0xa5, 0x93, 0xc3, 0xea, 0xed, 0xfd, 0xe1, 0x48, 0x54, 0x6a, 0xf6, 0x9a, 0xbd, 0x66, 0x7d,
+	0x9d, 0x05, 0xcb, 0x13, 0xa9, 0x75, 0xc3, 0x6c, 0xb0, 0xe8, 0xb3, 0x3d, 0x1a, 0x4f, 0x9c, 0xfb,
+	0x09, 0x22, 0xa1, 0xeb, 0xb1, 0x3e, 0x15, 0x52, 0x42, 0xb6, 0x79, 0x27, 0x09, 0xbd, 0x85, 0x48,
+	0xf8, 0x24, 0x0e, 0xc0, 0x8f, 0xc1, 0x33, 0x11, 0xf6, 0x30, 0x15, 0xee, 0x08, 0x8c, 0x17, 0xe6,
+	0x64, 0xc7, 0x4b, 0xb6, 0x9a, 0x65, 0x3b, 0x99, 0x65, 0xfb, 0xbd, 0x64, 0x96, 0xeb, 0x4f, 0xc7,
+	0xed, 0x3d, 0xf8, 0xa3, 0x6c, 0xa8, 0x16, 0x2f, 0xaa, 0x3a, 0x8d, 0x14, 0x05, 0x87, 0x5b, 0x60,
+	0x21, 0x2e, 0x87, 0x7d, 0xb7, 0x4f, 0x05, 0x09, 0x0b, 0x37, 0x2a, 0xc6, 0x6c, 0x55, 0xf3, 0x0a,
+	0xfe, 0x7e, 0x8c, 0x86, 0x26, 0x00, 0x82, 0x75, 0xdb, 0x5c, 0x30, 0x8a, 0xfd, 0x42, 0xb6, 0x62,
+	0xac, 0x3e, 0xd5, 0x4c, 0x9d, 0xc0, 0x3d, 0x70, 0x8f, 0xe2, 0xfd, 0x94, 0x15, 0xd9, 0x3c, 0xb7,
+	0x13, 0x21, 0x4f, 0xde, 0xf8, 0xbc, 0xbc, 0x88, 0x97, 0x62, 0x86, 0xdf, 0x4f, 0xcb, 0xcb, 0xea,
+	0x32, 0xb8, 0xff, 0xa9, 0x4d, 0x98, 0xd3, 0x45, 0x62, 0xdb, 0xde, 0xc2, 0x01, 0xf2, 0x06, 0x0d,
+	0xec, 0x9d, 0x1c, 0x56, 0x81, 0xbe, 0xab, 0x06, 0xf6, 0x94, 0x94, 0x62, 0x5c, 0x3b, 0xb1, 0xd7,
+	0x8a, 0x2b, 0x6f, 0xea, 0xc2, 0x30, 0x00, 0xcb, 0xa3, 0xc4, 0xb2, 0xf5, 0xc9, 0x5a, 0x2c, 0xe4,
+	0xa4, 0xeb, 0xe2, 0x98, 0xeb, 0x86, 0x4e, 0x50, 0xa6, 0xbf, 0x1d, 0x9a, 0x2e, 0xa4, 0x99, 0xe2,
+	0x46, 0x26, 0x89, 0x93, 0x1f, 0xc5, 0xfa, 0xcf, 0xf3, 0x60, 0x5e, 0x3e, 0x0a, 0xf8, 0x95, 0x01,
+	0x72, 0x6a, 0x61, 0xc1, 0xe7, 0xa6, 0x0e, 0xcb, 0xf8, 0x96, 0x2c, 0x3d, 0x7f, 0xbd, 0x64, 0xf5,
+	0xc8, 0xac, 0x87, 0x5f, 0xfe, 0xfa, 0xd7, 0x37, 0x73, 0x0f, 0x60, 0xd9, 0x99, 0xb6, 0xc8, 0xd5,
+	0x86, 0x84, 0x3f, 0x1a, 0x20, 0x9f, 0x9a, 0x48, 0xb8, 0x76, 0x35, 0xcd, 0xf8, 0x22, 0x2d, 0xd5,
+	0x66, 0x40, 0x68, 0x75, 0xaf, 0x4a, 0x75, 0x1b, 0xf0, 0xc5, 0xa9, 0xea, 0xd2, 0x4b, 0x93, 0x3b,
+	0x9f, 0xa5, 0x87, 0xf5, 0x73, 0xf8, 0x9d, 0x01, 0x16, 0x52, 0x65, 0x39, 0xbc, 0xbe, 0x84, 0x61,
+	0x3b, 0xd7, 0x67, 0x81, 0x68, 0xd9, 0xb6, 0x94, 0xbd, 0x0a, 0x57, 0xae, 0x27, 0x1b, 0x1e, 0x1b,
+	0xe0, 0xd6, 0xe8, 0x12, 0x80, 0x8f, 0xaf, 0xa6, 0x9d, 0xb8, 0xad, 0x4a, 0x2f, 0xcc, 0x06, 0xd2,
+	0x6a, 0x5b, 0xbf, 0x4c, 0x78, 0x88, 0xd2, 0xc1, 0xcb, 0x70, 0x63, 0xaa, 0x03, 0xa6, 0x8a, 0xb9,
+	0xdb, 0xaa, 0xda, 0xa5, 0xd6, 0xd7, 0x37, 0x8e, 0xce, 0x4c, 0xe3, 0xf8, 0xcc, 0x34, 0xfe, 0x3c,
+	0x33, 0x8d, 0x83, 0x73, 0x33, 0x73, 0x7c, 0x6e, 0x66, 0x7e, 0x3b, 0x37, 0x33, 0x1f, 0xdd, 0x1f,
+	0x99, 0xd5, 0x0b, 0x5e, 0x47, 0x0c, 0x7a, 0x98, 0xb7, 0x73, 0x72, 0xa4, 0x1e, 0xff, 0x33, 0x00,
+	0xde, 0x2c, 0xd8, 0x86, 0x38, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +541,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// OffenseHistory queries the downtime offense history of given cons address
+	// and the penalties of its next downtime offense
+	OffenseHistory(ctx context.Context, in *QueryOffenseHistoryRequest, opts ...grpc.CallOption) (*QueryOffenseHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +581,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) OffenseHistory(ctx context.Context, in *QueryOffenseHistoryRequest, opts ...grpc.CallOption) (*QueryOffenseHistoryResponse, error) {
+	out := new(QueryOffenseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/OffenseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +598,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// OffenseHistory queries the downtime offense history of given cons address
+	// and the penalties of its next downtime offense
+	OffenseHistory(context.Context, *QueryOffenseHistoryRequest) (*QueryOffenseHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +616,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) OffenseHistory(ctx context.Context, req *QueryOffenseHistoryRequest) (*QueryOffenseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffenseHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +678,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OffenseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffenseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffenseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/OffenseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffenseHistory(ctx, req.(*QueryOffenseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +712,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "OffenseHistory",
+			Handler:    _Query_OffenseHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +924,112 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOffenseHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffenseHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffenseHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffenseHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffenseHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffenseHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextDowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextDowntimeJailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NextDowntimeSlashFraction.Size()
+		i -= size
+		if _, err := m.NextDowntimeSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RecentDowntimeJails) > 0 {
+		for iNdEx := len(m.RecentDowntimeJails) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecentDowntimeJails[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecentDowntimeJails[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1117,46 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOffenseHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffenseHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovQuery(uint64(m.DowntimeJailCount))
+	}
+	if len(m.RecentDowntimeJails) > 0 {
+		for _, e := range m.RecentDowntimeJails {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	l = m.NextDowntimeSlashFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextDowntimeJailDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1667,311 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOffenseHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffenseHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffenseHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffenseHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffenseHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffenseHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDowntimeJails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentDowntimeJails = append(m.RecentDowntimeJails, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.RecentDowntimeJails[len(m.RecentDowntimeJails)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDowntimeSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextDowntimeSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.NextDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
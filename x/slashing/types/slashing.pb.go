@@ -50,6 +50,11 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Times at which the validator was jailed for downtime within the downtime
+	// offense window, they determine the escalation of the downtime penalties.
+	RecentDowntimeJails []time.Time `protobuf:"bytes,7,rep,name=recent_downtime_jails,json=recentDowntimeJails,proto3,stdtime" json:"recent_downtime_jails"`
+	// Total number of times the validator was jailed for downtime.
+	DowntimeJailCount uint64 `protobuf:"varint,8,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +133,20 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetRecentDowntimeJails() []time.Time {
+	if m != nil {
+		return m.RecentDowntimeJails
+	}
+	return nil
+}
+
+func (m *ValidatorSigningInfo) GetDowntimeJailCount() uint64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +154,17 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_offense_window is the duration during which a downtime jail counts
+	// towards the escalation of the downtime penalties, zero disables escalation.
+	DowntimeOffenseWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_offense_window,json=downtimeOffenseWindow,proto3,stdduration" json:"downtime_offense_window"`
+	// downtime_escalation_multiplier multiplies the downtime slash fraction and jail
+	// duration once for each downtime jail within the downtime offense window.
+	DowntimeEscalationMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=downtime_escalation_multiplier,json=downtimeEscalationMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_escalation_multiplier"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
+	// downtime_first_offense_grace only jails, without slashing, a validator which
+	// has no downtime jail within the downtime offense window.
+	DowntimeFirstOffenseGrace bool `protobuf:"varint,9,opt,name=downtime_first_offense_grace,json=downtimeFirstOffenseGrace,proto3" json:"downtime_first_offense_grace,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,6 +214,27 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeOffenseWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeFirstOffenseGrace() bool {
+	if m != nil {
+		return m.DowntimeFirstOffenseGrace
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -194,47 +245,56 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0xc3, 0x46,
+	0x10, 0x8d, 0x49, 0x48, 0x60, 0x93, 0x1e, 0x58, 0x92, 0xc6, 0xa4, 0xe0, 0x04, 0xa4, 0x56, 0x11,
+	0x12, 0x76, 0xa1, 0x52, 0x0f, 0x70, 0xa8, 0x1a, 0x52, 0xfa, 0x21, 0x2a, 0x50, 0xe8, 0x87, 0x54,
+	0xa9, 0x75, 0x37, 0xf6, 0xda, 0xd9, 0x62, 0xef, 0x46, 0xde, 0x4d, 0x09, 0x52, 0x7f, 0x41, 0x4f,
+	0x5c, 0x2a, 0xf5, 0xd8, 0x23, 0x47, 0x0e, 0xfc, 0x08, 0x8e, 0x08, 0xf5, 0x50, 0xf5, 0x40, 0xab,
+	0x70, 0xa0, 0x3f, 0xa3, 0xf2, 0xae, 0x6d, 0x02, 0xb4, 0x07, 0xc4, 0x25, 0x4a, 0x66, 0xde, 0xbc,
+	0x99, 0xf7, 0x66, 0x1c, 0x83, 0x77, 0x1c, 0xc6, 0x43, 0xc6, 0x2d, 0x1e, 0x20, 0x3e, 0x20, 0xd4,
+	0xb7, 0x7e, 0xdc, 0xec, 0x63, 0x81, 0x36, 0xb3, 0x80, 0x39, 0x8c, 0x98, 0x60, 0xb0, 0xae, 0x70,
+	0x66, 0x16, 0x4e, 0x70, 0x8d, 0xaa, 0xcf, 0x7c, 0x26, 0x31, 0x56, 0xfc, 0x4d, 0xc1, 0x1b, 0x86,
+	0xcf, 0x98, 0x1f, 0x60, 0x4b, 0xfe, 0xea, 0x8f, 0x3c, 0xcb, 0x1d, 0x45, 0x48, 0x10, 0x46, 0x93,
+	0x7c, 0xf3, 0x69, 0x5e, 0x90, 0x10, 0x73, 0x81, 0xc2, 0x61, 0x02, 0x58, 0x52, 0xfd, 0x6c, 0xc5,
+	0x9c, 0x34, 0x57, 0xa9, 0x05, 0x14, 0x12, 0xca, 0x2c, 0xf9, 0xa9, 0x42, 0x6b, 0xbf, 0xe7, 0x41,
+	0xf5, 0x2b, 0x14, 0x10, 0x17, 0x09, 0x16, 0x1d, 0x11, 0x9f, 0x12, 0xea, 0x7f, 0x4a, 0x3d, 0x06,
+	0x77, 0x40, 0x09, 0xb9, 0x6e, 0x84, 0x39, 0xd7, 0xb5, 0x96, 0xd6, 0x9e, 0xef, 0xac, 0xde, 0x5c,
+	0x6e, 0xac, 0x24, 0x74, 0xbb, 0x8c, 0x72, 0x4c, 0xf9, 0x88, 0x7f, 0xa8, 0x20, 0x47, 0x22, 0x22,
+	0xd4, 0xef, 0xa5, 0x15, 0x70, 0x15, 0x54, 0xb8, 0x40, 0x91, 0xb0, 0x07, 0x98, 0xf8, 0x03, 0xa1,
+	0xcf, 0xb4, 0xb4, 0x76, 0xbe, 0x57, 0x96, 0xb1, 0x4f, 0x64, 0x08, 0xbe, 0x0d, 0x2a, 0x84, 0xba,
+	0x78, 0x6c, 0x33, 0xcf, 0xe3, 0x58, 0xe8, 0xf9, 0x18, 0xd2, 0x99, 0xd1, 0xb5, 0x5e, 0x59, 0xc6,
+	0x0f, 0x64, 0x18, 0xee, 0x83, 0xca, 0x0f, 0x88, 0x04, 0xd8, 0xb5, 0x47, 0x54, 0x90, 0x40, 0x2f,
+	0xb4, 0xb4, 0x76, 0x79, 0xab, 0x61, 0x2a, 0x17, 0xcc, 0xd4, 0x05, 0xf3, 0x8b, 0xd4, 0x85, 0xce,
+	0x1b, 0x57, 0xb7, 0xcd, 0xdc, 0xd9, 0x5f, 0x4d, 0xed, 0xfc, 0xfe, 0x62, 0x5d, 0xeb, 0x95, 0x55,
+	0xf9, 0x97, 0x71, 0x35, 0x34, 0x00, 0x10, 0x2c, 0xec, 0x73, 0xc1, 0x28, 0x76, 0xf5, 0xd9, 0x96,
+	0xd6, 0x9e, 0xeb, 0x4d, 0x45, 0xe0, 0x16, 0xa8, 0x85, 0x84, 0x73, 0xec, 0xda, 0xfd, 0x80, 0x39,
+	0xc7, 0xdc, 0x76, 0xd8, 0x88, 0x0a, 0x1c, 0xe9, 0x45, 0x29, 0x60, 0x51, 0x25, 0x3b, 0x32, 0xb7,
+	0xab, 0x52, 0xf0, 0x5b, 0x50, 0x8b, 0xb0, 0x83, 0xa9, 0xb0, 0x5d, 0x76, 0x42, 0xe3, 0x6d, 0xd8,
+	0x71, 0x4b, 0xae, 0x97, 0x5a, 0xf9, 0x97, 0x8d, 0xba, 0xa8, 0x78, 0xba, 0x09, 0xcd, 0x67, 0x31,
+	0x0b, 0x34, 0xc1, 0xe2, 0x23, 0x5e, 0x35, 0x92, 0x3e, 0xd7, 0xd2, 0xda, 0x85, 0xde, 0x82, 0x3b,
+	0x85, 0x95, 0x03, 0x6d, 0x17, 0xfe, 0xf9, 0xad, 0xa9, 0xad, 0xfd, 0x52, 0x02, 0xc5, 0x43, 0x14,
+	0xa1, 0x90, 0xc3, 0x77, 0x41, 0x95, 0x13, 0x9f, 0x3e, 0x68, 0x3a, 0x21, 0xd4, 0x65, 0x27, 0x72,
+	0xab, 0xf9, 0x1e, 0x54, 0x39, 0x25, 0xe9, 0x6b, 0x99, 0x81, 0x24, 0x76, 0x81, 0xda, 0x49, 0xd5,
+	0x10, 0x47, 0x69, 0x49, 0xbc, 0xc6, 0x4a, 0xe7, 0xfd, 0x78, 0xea, 0x3f, 0x6f, 0x9b, 0x6f, 0xa9,
+	0x63, 0xe0, 0xee, 0xb1, 0x49, 0x98, 0x15, 0x22, 0x31, 0x30, 0xf7, 0xb1, 0x8f, 0x9c, 0xd3, 0x2e,
+	0x76, 0x6e, 0x2e, 0x37, 0x40, 0x72, 0x2b, 0x5d, 0xec, 0x28, 0x79, 0x30, 0x24, 0xf4, 0x48, 0x72,
+	0x1e, 0xe2, 0x28, 0x69, 0xf5, 0x1d, 0x78, 0xf3, 0xb1, 0xba, 0xf4, 0xda, 0xe5, 0x3d, 0x94, 0xb7,
+	0x96, 0x9e, 0xb9, 0xd7, 0x4d, 0x00, 0xca, 0xbc, 0x5f, 0x33, 0xf3, 0xaa, 0xd3, 0x56, 0xa4, 0x20,
+	0xc8, 0x41, 0x43, 0x3e, 0x77, 0xb6, 0x17, 0x21, 0x27, 0x8e, 0xd8, 0x2e, 0x1b, 0xf5, 0x03, 0x2c,
+	0xc5, 0xe9, 0x85, 0x57, 0xe9, 0xa9, 0x4b, 0xe6, 0xbd, 0x84, 0xb8, 0x2b, 0x79, 0x63, 0x7d, 0x90,
+	0x82, 0xfa, 0xb3, 0xa6, 0x6a, 0x36, 0x7d, 0xf6, 0x55, 0x1d, 0x6b, 0x4f, 0x3a, 0x2a, 0x52, 0xf8,
+	0x3d, 0xa8, 0x67, 0x26, 0x32, 0xcf, 0xc3, 0x94, 0xe3, 0x74, 0x63, 0xc5, 0x17, 0xba, 0x58, 0x4b,
+	0x89, 0x0e, 0x14, 0x4f, 0xb2, 0xa6, 0x9f, 0x80, 0x91, 0x75, 0xc0, 0xdc, 0x41, 0x81, 0x2c, 0xb6,
+	0xc3, 0x51, 0x20, 0xc8, 0x30, 0x20, 0x38, 0xd2, 0x4b, 0xaf, 0x12, 0xb6, 0x9c, 0xb2, 0x7f, 0x94,
+	0x91, 0x7f, 0x9e, 0x71, 0x43, 0x0c, 0x1a, 0x21, 0x1a, 0xdb, 0xff, 0x73, 0x28, 0x73, 0x2f, 0x94,
+	0x58, 0x0f, 0xd1, 0xb8, 0xfb, 0x5f, 0xb7, 0xf2, 0x01, 0xc8, 0xc6, 0xb0, 0x3d, 0x12, 0x71, 0x91,
+	0x99, 0xe9, 0x47, 0xc8, 0xc1, 0xfa, 0xbc, 0xfc, 0xbb, 0x58, 0x4a, 0x31, 0x7b, 0x31, 0x24, 0xb1,
+	0xe9, 0xe3, 0x18, 0xb0, 0xbd, 0xfa, 0xf3, 0xfd, 0xc5, 0xfa, 0xb2, 0xd2, 0xb6, 0xc1, 0xdd, 0x63,
+	0x6b, 0xfc, 0xf0, 0x72, 0x50, 0x0f, 0x63, 0x67, 0xe7, 0x7c, 0x62, 0x68, 0x57, 0x13, 0x43, 0xbb,
+	0x9e, 0x18, 0xda, 0xdf, 0x13, 0x43, 0x3b, 0xbb, 0x33, 0x72, 0xd7, 0x77, 0x46, 0xee, 0x8f, 0x3b,
+	0x23, 0xf7, 0xcd, 0xca, 0x23, 0xdb, 0xa6, 0xaa, 0xc5, 0xe9, 0x10, 0xf3, 0x7e, 0x51, 0x6a, 0x7b,
+	0xef, 0xdf, 0x01, 0x00, 0x3a, 0x85, 0xfb, 0x4f, 0x7a, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +334,17 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if len(this.RecentDowntimeJails) != len(that1.RecentDowntimeJails) {
+		return false
+	}
+	for i := range this.RecentDowntimeJails {
+		if !this.RecentDowntimeJails[i].Equal(that1.RecentDowntimeJails[i]) {
+			return false
+		}
+	}
+	if this.DowntimeJailCount != that1.DowntimeJailCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +381,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeOffenseWindow != that1.DowntimeOffenseWindow {
+		return false
+	}
+	if !this.DowntimeEscalationMultiplier.Equal(that1.DowntimeEscalationMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if this.DowntimeFirstOffenseGrace != that1.DowntimeFirstOffenseGrace {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +415,23 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RecentDowntimeJails) > 0 {
+		for iNdEx := len(m.RecentDowntimeJails) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecentDowntimeJails[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecentDowntimeJails[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintSlashing(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -395,6 +495,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeFirstOffenseGrace {
+		i--
+		if m.DowntimeFirstOffenseGrace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeEscalationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeEscalationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeOffenseWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +551,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -476,6 +612,15 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if len(m.RecentDowntimeJails) > 0 {
+		for _, e := range m.RecentDowntimeJails {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeJailCount))
+	}
 	return n
 }
 
@@ -496,6 +641,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeEscalationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeFirstOffenseGrace {
+		n += 2
+	}
 	return n
 }
 
@@ -676,6 +830,59 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentDowntimeJails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentDowntimeJails = append(m.RecentDowntimeJails, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.RecentDowntimeJails[len(m.RecentDowntimeJails)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -877,6 +1084,125 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeOffenseWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeEscalationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeFirstOffenseGrace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeFirstOffenseGrace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -137,6 +137,45 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecord",
+					Use:       "tokenize-share-record [id]",
+					Short:     "Query a tokenize share record by its id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsByOwner",
+					Use:       "tokenize-share-records [owner-addr]",
+					Short:     "Query the tokenize share records owned by an address",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records [owner-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "LiquidStaked",
+					Use:       "liquid-staked",
+					Short:     "Query the amount of tokens delegated through tokenize share records, in total or for a validator",
+					Example:   fmt.Sprintf("$ %s query staking liquid-staked --validator-addr [val-addr]", version.AppName),
+				},
+				{
+					RpcMethod: "PendingCommissionChange",
+					Use:       "pending-commission-change [validator-addr]",
+					Short:     "Query the pending commission change of a validator",
+					Example:   fmt.Sprintf("$ %s query staking pending-commission-change [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
+				{
+					RpcMethod: "PendingCommissionChanges",
+					Use:       "pending-commission-changes",
+					Short:     "Query the pending commission changes of all validators",
+					Example:   fmt.Sprintf("$ %s query staking pending-commission-changes", version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -166,6 +205,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "InstantUndelegate",
+					Use:            "instant-unbond [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Unbond shares from a validator without unbonding period, for a fee",
+					Long:           "Unbond an amount of bonded shares from a validator and release the tokens immediately. The instant unbonding fee is taken from the unbonded tokens.",
+					Example:        fmt.Sprintf(`%s tx staking instant-unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "CancelUnbondingDelegation",
 					Use:            "cancel-unbond [validator-addr] [amount] [creation-height]",
@@ -180,6 +227,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [rewards-owner] --from [delegator_address]",
+					Short:          "Tokenize delegation shares into liquid staking share tokens",
+					Long:           "Tokenize an amount of the delegation to a validator into liquid staking share tokens. The rewards of the tokenized delegation are withdrawn by the rewards owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem liquid staking share tokens for the delegation shares they represent",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record [record-id] [new-owner] --from [sender]",
+					Short:          "Transfer the ownership of a tokenize share record, and thereby of its rewards",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "ScheduleCommissionChange",
+					Use:            "schedule-commission-change [validator-address] [new-rate]",
+					Short:          "Schedule a commission rate change of a validator, effective after the commission change notice period",
+					Example:        fmt.Sprintf(`%s tx staking schedule-commission-change cosmosvaloper... 0.05 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_rate"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
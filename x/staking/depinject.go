@@ -114,7 +114,8 @@ func InvokeSetStakingHooks(
 			return fmt.Errorf("can't find staking hooks for module %s", modName)
 		}
 
-		multiHooks = append(multiHooks, hook)
+		// the wrapped hooks are set, so that their optional hooks interfaces are called
+		multiHooks = append(multiHooks, hook.StakingHooks)
 	}
 
 	keeper.SetHooks(multiHooks)
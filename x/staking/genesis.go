@@ -6,6 +6,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -55,6 +56,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStatePendingCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -95,3 +104,43 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d must be positive and not greater than the last record id %d", record.Id, lastID)
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.ModuleAccount != types.TokenizeShareModuleAccountName(record.Id) {
+			return fmt.Errorf("invalid module account %s for tokenize share record %d", record.ModuleAccount, record.Id)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStatePendingCommissionChanges(changes []types.PendingCommissionChange) error {
+	validators := make(map[string]bool, len(changes))
+
+	for _, change := range changes {
+		if validators[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+
+		if change.NewRate.IsNil() || change.NewRate.IsNegative() || change.NewRate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid pending commission change rate %s for validator %s", change.NewRate, change.ValidatorAddress)
+		}
+
+		validators[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
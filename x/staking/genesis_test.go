@@ -41,6 +41,34 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, "owner", "validator")}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(2, "owner", "validator")}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, "owner", "validator")
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"invalid tokenize share record module account", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, "owner", "validator")
+			record.ModuleAccount = types.TokenizeShareModuleAccountName(2)
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 2
+		}, true},
+		// validate genesis pending commission changes
+		{"duplicate pending commission change", func(data *types.GenesisState) {
+			change := types.PendingCommissionChange{ValidatorAddress: "validator", NewRate: math.LegacyNewDecWithPrec(1, 1)}
+			data.PendingCommissionChanges = []types.PendingCommissionChange{change, change}
+		}, true},
+		{"invalid pending commission change rate", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{{ValidatorAddress: "validator", NewRate: math.LegacyNewDec(2)}}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -20,5 +20,15 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 func (k *Keeper) EndBlocker(ctx context.Context) ([]appmodule.ValidatorUpdate, error) {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	// the instant unbonding block limit applies to each block
+	if err := k.InstantUnbondedTokens.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.ApplyMaturePendingCommissionChanges(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleCommissionChange schedules a commission rate change of a validator, effective after the
+// commission change notice period. It replaces the pending commission change of the validator, if any.
+func (k Keeper) ScheduleCommissionChange(
+	ctx context.Context, valAddr sdk.ValAddress, newRate math.LegacyDec,
+) (types.PendingCommissionChange, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	noticePeriod, err := k.CommissionChangeNoticePeriod(ctx)
+	if err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	effectiveTime := k.HeaderService.HeaderInfo(ctx).Time.Add(noticePeriod)
+	if err := k.validateScheduledCommissionRate(ctx, validator.Commission, newRate, effectiveTime); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if err := k.RemovePendingCommissionChange(ctx, valAddr); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	change := types.PendingCommissionChange{
+		ValidatorAddress: validator.OperatorAddress,
+		NewRate:          newRate,
+		EffectiveTime:    effectiveTime,
+	}
+
+	if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if err := k.PendingCommissionChangeQueue.Set(ctx, collections.Join(change.EffectiveTime, valAddr)); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if hooks, ok := k.Hooks().(types.CommissionChangeHooks); ok {
+		if err := hooks.AfterCommissionChangeScheduled(ctx, valAddr, newRate, change.EffectiveTime); err != nil {
+			return types.PendingCommissionChange{}, err
+		}
+	}
+
+	return change, nil
+}
+
+// RemovePendingCommissionChange removes the pending commission change of a validator, if any.
+func (k Keeper) RemovePendingCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.PendingCommissionChangeQueue.Remove(ctx, collections.Join(change.EffectiveTime, valAddr)); err != nil {
+		return err
+	}
+
+	return k.PendingCommissionChanges.Remove(ctx, valAddr)
+}
+
+// ApplyMaturePendingCommissionChanges applies the pending commission changes whose effective time
+// has been reached. A change which is no longer valid, e.g. because the commission rate bounds were
+// updated by governance in the meantime, is cancelled.
+func (k Keeper) ApplyMaturePendingCommissionChanges(ctx context.Context) error {
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+
+	var matureKeys []collections.Pair[time.Time, sdk.ValAddress]
+	err := k.PendingCommissionChangeQueue.Walk(
+		ctx,
+		collections.NewPrefixUntilPairRange[time.Time, sdk.ValAddress](blockTime),
+		func(key collections.Pair[time.Time, sdk.ValAddress]) (bool, error) {
+			matureKeys = append(matureKeys, key)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range matureKeys {
+		valAddr := key.K2()
+		change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RemovePendingCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.validateScheduledCommissionRate(ctx, validator.Commission, change.NewRate, blockTime); err != nil {
+			if err := k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeCancelCommissionChange,
+				event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+				event.NewAttribute(types.AttributeKeyCommissionRate, change.NewRate.String()),
+				event.NewAttribute(types.AttributeKeyReason, err.Error()),
+			); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = change.NewRate
+		validator.Commission.UpdateTime = blockTime
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeApplyCommissionChange,
+			event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, change.NewRate.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateScheduledCommissionRate checks a scheduled commission rate, applied at the given time,
+// against the commission of the validator and the chain-wide commission rate bounds.
+func (k Keeper) validateScheduledCommissionRate(
+	ctx context.Context, commission types.Commission, newRate math.LegacyDec, applyTime time.Time,
+) error {
+	if err := commission.ValidateNewRate(newRate, applyTime); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if newRate.LT(params.MinCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", params.MinCommissionRate)
+	}
+
+	if newRate.GT(params.MaxCommissionRate) {
+		return errorsmod.Wrapf(types.ErrCommissionGTGlobalMaxRate, "cannot set validator commission to more than maximum rate of %s", params.MaxCommissionRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleAndApplyCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(1)
+	valAddr := addrVals[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), time.Unix(0, 0),
+	)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.CommissionChangeNoticePeriod = 7 * 24 * time.Hour
+	params.MaxCommissionRate = math.LegacyNewDecWithPrec(15, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the new rate cannot exceed the global max commission rate
+	_, err = keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(2, 1))
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTGlobalMaxRate)
+
+	change, err := keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(15, 2))
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time.Add(params.CommissionChangeNoticePeriod), change.EffectiveTime)
+
+	// the change is not applied before its effective time
+	require.NoError(keeper.ApplyMaturePendingCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: change.EffectiveTime})
+	require.NoError(keeper.ApplyMaturePendingCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(15, 2), validator.Commission.Rate)
+	require.Equal(change.EffectiveTime, validator.Commission.UpdateTime)
+
+	_, err = keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	require.ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestCancelInvalidCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(1)
+	valAddr := addrVals[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), time.Unix(0, 0),
+	)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	change, err := keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(2, 1))
+	require.NoError(err)
+
+	// scheduling a new change replaces the pending one
+	change, err = keeper.ScheduleCommissionChange(ctx, valAddr, math.LegacyNewDecWithPrec(15, 2))
+	require.NoError(err)
+	pending, err := keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(change, pending)
+
+	// governance lowers the max commission rate before the change is applied
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxCommissionRate = math.LegacyNewDecWithPrec(12, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	require.NoError(keeper.ApplyMaturePendingCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	_, err = keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	require.ErrorIs(err, collections.ErrNotFound)
+}
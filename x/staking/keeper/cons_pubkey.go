@@ -131,6 +131,37 @@ func (k Keeper) ValidatorIdentifier(ctx context.Context, newPk sdk.ConsAddress)
 	return pk, nil
 }
 
+// ValidatorConsPubKeyAtHeight returns the consensus key the validator signed with at the given
+// height, based on its consensus key rotation history.
+func (k Keeper) ValidatorConsPubKeyAtHeight(ctx context.Context, valAddr sdk.ValAddress, height int64) (cryptotypes.PubKey, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// A key rotated at height h is only used by CometBFT from height h + 1 + ValidatorUpdateDelay on,
+	// so the old key of the first rotation which was not in effect yet is the key used at that height.
+	pkAny := validator.ConsensusPubkey
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](valAddr.Bytes())
+	err = k.RotationHistory.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		if int64(history.Height)+sdk.ValidatorUpdateDelay >= height {
+			pkAny = history.OldConsPubkey
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pkAny.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
 // ExceedsMaxRotations returns true if the key rotations exceed the limit, currently we are limiting one rotation for unbonding period.
 func (k Keeper) ExceedsMaxRotations(ctx context.Context, valAddr sdk.ValAddress) error {
 	count := 0
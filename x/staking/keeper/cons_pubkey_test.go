@@ -152,6 +152,48 @@ func (s *KeeperTestSuite) TestValidatorIdentifier() {
 	s.Require().Equal(oldPk2.Bytes(), initialConsAddr)
 }
 
+func (s *KeeperTestSuite) TestValidatorConsPubKeyAtHeight() {
+	stakingKeeper, accountKeeper, bankKeeper := s.stakingKeeper, s.accountKeeper, s.bankKeeper
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+
+	msgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	s.setValidators(2)
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	s.Require().NoError(err)
+
+	valAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(validators[1].GetOperator())
+	s.Require().NoError(err)
+	initialPk, err := validators[1].ConsPubKey()
+	s.Require().NoError(err)
+
+	pk, err := stakingKeeper.ValidatorConsPubKeyAtHeight(ctx, valAddr, 5)
+	s.Require().NoError(err)
+	s.Require().Equal(initialPk, pk)
+
+	bondedPool := authtypes.NewEmptyModuleAccount(types.BondedPoolName)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.BondedPoolName).Return(bondedPool).AnyTimes()
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedPool.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	req, err := types.NewMsgRotateConsPubKey(validators[1].GetOperator(), PKs[495])
+	s.Require().NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, req)
+	s.Require().NoError(err)
+	_, err = stakingKeeper.BlockValidatorUpdates(ctx)
+	s.Require().NoError(err)
+
+	// the old key is still used until the rotation is applied by CometBFT
+	for _, height := range []int64{5, 10, 10 + sdk.ValidatorUpdateDelay} {
+		pk, err = stakingKeeper.ValidatorConsPubKeyAtHeight(ctx, valAddr, height)
+		s.Require().NoError(err)
+		s.Require().Equal(initialPk, pk)
+	}
+
+	pk, err = stakingKeeper.ValidatorConsPubKeyAtHeight(ctx, valAddr, 11+sdk.ValidatorUpdateDelay)
+	s.Require().NoError(err)
+	s.Require().Equal(PKs[495], pk)
+}
+
 func (s *KeeperTestSuite) setValidators(n int) {
 	stakingKeeper, ctx := s.stakingKeeper, s.ctx
 
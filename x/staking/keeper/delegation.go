@@ -78,6 +78,21 @@ func (k Keeper) GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAd
 	return delegations[:i], nil // trim if the array length < maxRetrieve
 }
 
+// IterateDelegatorDelegationsFrom iterates through the delegations of a delegator in validator
+// address order, starting from the given validator, or from the first delegation if it is empty.
+func (k Keeper) IterateDelegatorDelegationsFrom(ctx context.Context, delegator sdk.AccAddress, fromVal sdk.ValAddress,
+	fn func(delegation types.Delegation) (stop bool),
+) error {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
+	if len(fromVal) > 0 {
+		rng = rng.StartInclusive(fromVal)
+	}
+
+	return k.Delegations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+		return fn(del), nil
+	})
+}
+
 // SetDelegation sets a delegation.
 func (k Keeper) SetDelegation(ctx context.Context, delegation types.Delegation) error {
 	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
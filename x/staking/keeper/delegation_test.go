@@ -24,7 +24,7 @@ func createValAddrs(count int) ([]sdk.AccAddress, []sdk.ValAddress) {
 	return addrs, valAddrs
 }
 
-// tests GetDelegation, GetDelegatorDelegations, SetDelegation, RemoveDelegation, GetDelegatorDelegations
+// tests GetDelegation, GetDelegatorDelegations, SetDelegation, RemoveDelegation, GetDelegatorDelegations, IterateDelegatorDelegationsFrom
 func (s *KeeperTestSuite) TestDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -94,6 +94,12 @@ func (s *KeeperTestSuite) TestDelegation() {
 	require.Equal(bond2to1, resBonds[0])
 	require.Equal(bond2to2, resBonds[1])
 	require.Equal(bond2to3, resBonds[2])
+	var fromBonds []stakingtypes.Delegation
+	require.NoError(keeper.IterateDelegatorDelegationsFrom(ctx, addrDels[1], valAddrs[1], func(del stakingtypes.Delegation) bool {
+		fromBonds = append(fromBonds, del)
+		return false
+	}))
+	require.Equal([]stakingtypes.Delegation{bond2to2, bond2to3}, fromBonds)
 	allBonds, err := keeper.GetAllDelegations(ctx)
 	require.NoError(err)
 	require.Equal(6, len(allBonds))
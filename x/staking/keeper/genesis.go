@@ -137,6 +137,46 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			return nil, err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the liquid shares of the validators are those of the delegations of the records
+		delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return nil, fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+		}
+
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, change := range data.PendingCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+
+		if err := k.PendingCommissionChangeQueue.Set(ctx, collections.Join(change.EffectiveTime, sdk.ValAddress(valAddr))); err != nil {
+			return nil, err
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -275,6 +315,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var tokenizeShareRecords []types.TokenizeShareRecord
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCommissionChanges []types.PendingCommissionChange
+	err = k.PendingCommissionChanges.Walk(ctx, nil, func(_ sdk.ValAddress, change types.PendingCommissionChange) (stop bool, err error) {
+		pendingCommissionChanges = append(pendingCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -284,5 +347,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+
+		PendingCommissionChanges: pendingCommissionChanges,
 	}, nil
 }
@@ -498,6 +498,129 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.TokenizeShareRecords.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsByOwner queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsByOwner(ctx context.Context, req *types.QueryTokenizeShareRecordsByOwnerRequest) (*types.QueryTokenizeShareRecordsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.TokenizeShareRecordsByOwner, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.TokenizeShareRecord, error) {
+			return k.TokenizeShareRecords.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// PendingCommissionChange queries the pending commission change of a validator
+func (k Querier) PendingCommissionChange(ctx context.Context, req *types.QueryPendingCommissionChangeRequest) (*types.QueryPendingCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	change, err := k.Keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no pending commission change for validator %s", req.ValidatorAddr)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCommissionChangeResponse{PendingCommissionChange: change}, nil
+}
+
+// PendingCommissionChanges queries the pending commission changes of all validators
+func (k Querier) PendingCommissionChanges(ctx context.Context, req *types.QueryPendingCommissionChangesRequest) (*types.QueryPendingCommissionChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.PendingCommissionChanges, req.Pagination,
+		func(_ sdk.ValAddress, change types.PendingCommissionChange) (types.PendingCommissionChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCommissionChangesResponse{PendingCommissionChanges: changes, Pagination: pageRes}, nil
+}
+
+// LiquidStaked queries the amount of tokens delegated through tokenize share records
+func (k Querier) LiquidStaked(ctx context.Context, req *types.QueryLiquidStakedRequest) (*types.QueryLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		tokens, err := k.TotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryLiquidStakedResponse{Tokens: tokens}, nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	shares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidStakedResponse{Tokens: validator.TokensFromShares(shares).TruncateInt()}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InstantUndelegate unbonds shares of the delegation of a delegator to a validator and releases
+// the tokens to the delegator immediately, without unbonding period. The instant unbonding fee is
+// taken from the unbonded tokens, and burned or sent to the community pool. It returns the amount
+// of tokens released to the delegator and the fee.
+//
+// Since instantly unbonded tokens can no longer be slashed for infractions committed while they
+// were bonded, the tokens instantly unbonded in a block are capped by the instant unbonding block
+// limit, and tokens which may be slashed soon (delegated to a jailed validator, or received by a
+// redelegation in progress) cannot be instantly unbonded.
+func (k Keeper) InstantUndelegate(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (math.Int, math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if !params.InstantUnbondingBlockLimit.IsPositive() {
+		return math.Int{}, math.Int{}, types.ErrInstantUnbondingDisabled
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if validator.IsJailed() {
+		return math.Int{}, math.Int{}, types.ErrValidatorJailed
+	}
+
+	hasReceivingRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if hasReceivingRedelegation {
+		return math.Int{}, math.Int{}, types.ErrRedelegationInProgress
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	unbondedTokens, err := k.InstantUnbondedTokens.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		unbondedTokens = math.ZeroInt()
+	case err != nil:
+		return math.Int{}, math.Int{}, err
+	}
+
+	unbondedTokens = unbondedTokens.Add(returnAmount)
+	if unbondedTokens.GT(params.InstantUnbondingBlockLimit) {
+		return math.Int{}, math.Int{}, types.ErrInstantUnbondingBlockLimitExceeded
+	}
+
+	if err := k.InstantUnbondedTokens.Set(ctx, unbondedTokens); err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	// transfer the validator tokens to the not bonded pool
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	// the fee is rounded up, so that splitting an instant unbonding does not lower it
+	fee := math.LegacyNewDecFromInt(returnAmount).Mul(params.InstantUnbondingFeeRate).Ceil().TruncateInt()
+	if params.InstantUnbondingFeeToCommunityPool && fee.IsPositive() {
+		feeCoins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, fee))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.PoolModuleName, feeCoins); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	} else if err := k.burnNotBondedTokens(ctx, fee); err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	releasedAmount := returnAmount.Sub(fee)
+	if releasedAmount.IsPositive() {
+		releasedCoins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, releasedAmount))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, releasedCoins); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	return releasedAmount, fee, nil
+}
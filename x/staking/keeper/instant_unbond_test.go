@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupInstantUnbonding creates an unbonded validator with a delegation of 100 tokens and sets
+// the instant unbonding block limit.
+func (s *KeeperTestSuite) setupInstantUnbonding(blockLimit math.Int, feeToCommunityPool bool) (sdk.AccAddress, sdk.ValAddress) {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(2)
+	delAddr, valAddr := addrDels[1], addrVals[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(math.NewInt(100))
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddr), issuedShares)))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.InstantUnbondingBlockLimit = blockLimit
+	params.InstantUnbondingFeeToCommunityPool = feeToCommunityPool
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	return delAddr, valAddr
+}
+
+func (s *KeeperTestSuite) TestInstantUndelegateDisabled() {
+	delAddr, valAddr := s.setupInstantUnbonding(math.ZeroInt(), false)
+
+	_, _, err := s.stakingKeeper.InstantUndelegate(s.ctx, delAddr, valAddr, math.LegacyNewDec(10))
+	s.Require().ErrorIs(err, stakingtypes.ErrInstantUnbondingDisabled)
+}
+
+func (s *KeeperTestSuite) TestInstantUndelegate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, valAddr := s.setupInstantUnbonding(math.NewInt(50), false)
+
+	// the fee of 10% of 45 tokens is rounded up to 5 tokens
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	releasedCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(notBondedAcc.GetAddress()), feeCoins).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, releasedCoins).Return(nil)
+
+	released, fee, err := keeper.InstantUndelegate(ctx, delAddr, valAddr, math.LegacyNewDec(45))
+	require.NoError(err)
+	require.Equal(math.NewInt(40), released)
+	require.Equal(math.NewInt(5), fee)
+
+	unbondedTokens, err := keeper.InstantUnbondedTokens.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(45), unbondedTokens)
+
+	// the remaining block limit is lower than the tokens to unbond
+	_, _, err = keeper.InstantUndelegate(ctx, delAddr, valAddr, math.LegacyNewDec(10))
+	require.ErrorIs(err, stakingtypes.ErrInstantUnbondingBlockLimitExceeded)
+}
+
+func (s *KeeperTestSuite) TestInstantUndelegateFeeToCommunityPool() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, valAddr := s.setupInstantUnbonding(math.NewInt(50), true)
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))
+	releasedCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 18))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.PoolModuleName, feeCoins).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, releasedCoins).Return(nil)
+
+	released, fee, err := keeper.InstantUndelegate(ctx, delAddr, valAddr, math.LegacyNewDec(20))
+	require.NoError(err)
+	require.Equal(math.NewInt(18), released)
+	require.Equal(math.NewInt(2), fee)
+}
@@ -129,6 +129,20 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// TokenizeShareRecordsByOwner key: owner+recordID | value: none used (index key for tokenize share records by owner)
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record
+	LastTokenizeShareRecordID collections.Sequence
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator held by tokenize share records
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
+	// InstantUnbondedTokens value: tokens instantly unbonded in the current block, cleared at end block
+	InstantUnbondedTokens collections.Item[math.Int]
+	// PendingCommissionChanges key: valAddr | value: PendingCommissionChange
+	PendingCommissionChanges collections.Map[sdk.ValAddress, types.PendingCommissionChange]
+	// PendingCommissionChangeQueue key: effectiveTime+valAddr | value: none used (queue of the pending commission changes)
+	PendingCommissionChangeQueue collections.KeySet[collections.Pair[time.Time, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -305,6 +319,50 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 121 | recordID
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+
+		// key format is: 122 | lengthPrefixedBytes(owner) | recordID
+		TokenizeShareRecordsByOwner: collections.NewKeySet(
+			sb, types.TokenizeShareRecordByOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+
+		// key is: 123 (it's a direct prefix)
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+
+		// key format is: 124 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+
+		// key is: 125 (it's a direct prefix)
+		InstantUnbondedTokens: collections.NewItem(sb, types.InstantUnbondedTokensKey, "instant_unbonded_tokens", sdk.IntValue),
+
+		// key format is: 126 | valAddr
+		PendingCommissionChanges: collections.NewMap(
+			sb, types.PendingCommissionChangeKey,
+			"pending_commission_changes",
+			sdk.ValAddressKey,
+			codec.CollValue[types.PendingCommissionChange](cdc),
+		),
+
+		// key format is: 127 | lengthPrefixedBytes(effectiveTime) | valAddr
+		PendingCommissionChangeQueue: collections.NewKeySet(
+			sb, types.PendingCommissionChangeQueueKey,
+			"pending_commission_change_queue",
+			collections.PairKeyCodec(sdk.TimeKey, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
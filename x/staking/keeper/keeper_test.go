@@ -271,8 +271,15 @@ func getValidatorQueueKey(timestamp time.Time, height int64) []byte {
 	return bz
 }
 
-func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
+// setupMigrationTest resets the suite and removes the params set by SetupTest,
+// so that the migration hashes only cover the entries written by the test.
+func (s *KeeperTestSuite) setupMigrationTest() {
 	s.SetupTest()
+	s.ctx.KVStore(s.key).Delete(stakingtypes.ParamsKey)
+}
+
+func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
+	s.setupMigrationTest()
 
 	_, valAddrs := createValAddrs(100)
 
@@ -286,7 +293,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"7cd5dcd7fb78354cc77cbf65e3ea3d9a9fecf9855a31d4e68a9b812e235855ef",
 	)
 	s.Require().NoError(err)
 
@@ -301,13 +308,13 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"7cd5dcd7fb78354cc77cbf65e3ea3d9a9fecf9855a31d4e68a9b812e235855ef",
 	)
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 
 	addrs, valAddrs := createValAddrs(101)
 
@@ -319,7 +326,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"e62f48a7bc5d3756b2aded541576e5d03e41ea7322adb228cfd906d28b23f259",
 	)
 	s.Require().NoError(err)
 
@@ -332,14 +339,14 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"e62f48a7bc5d3756b2aded541576e5d03e41ea7322adb228cfd906d28b23f259",
 	)
 
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 
 	addrs, valAddrs := createValAddrs(101)
 
@@ -351,7 +358,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"f5f38ece33bc9db1ff3e565a984db42331646ed7ef9b49bd07847965da9ebbbc",
 	)
 	s.Require().NoError(err)
 
@@ -364,14 +371,14 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"f5f38ece33bc9db1ff3e565a984db42331646ed7ef9b49bd07847965da9ebbbc",
 	)
 
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 
 	delAddrs, valAddrs := createValAddrs(100)
 	err := testutil.DiffCollectionsMigration(
@@ -395,7 +402,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"8c5a0aa9c8ecf9d0e7742ad6800a4bb0fa21ff133a0e96122f79cb35874b656a",
 	)
 	s.Require().NoError(err)
 
@@ -419,13 +426,13 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"8c5a0aa9c8ecf9d0e7742ad6800a4bb0fa21ff133a0e96122f79cb35874b656a",
 	)
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 
 	err := testutil.DiffCollectionsMigration(
 		s.ctx,
@@ -436,7 +443,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"24d893d1d51832ab17a30d45e0a48189f99c7cd502e8ed854b2ae8aca1141e81",
 	)
 	s.Require().NoError(err)
 
@@ -449,13 +456,13 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"24d893d1d51832ab17a30d45e0a48189f99c7cd502e8ed854b2ae8aca1141e81",
 	)
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 	pkAny, err := codectypes.NewAnyWithValue(PKs[0])
 	s.Require().NoError(err)
 
@@ -483,7 +490,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"fedbfb473a901388e930bc9ad9019c09341ebaad2f93143607c5a3449f604b96",
 	)
 	s.Require().NoError(err)
 
@@ -509,13 +516,13 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"fedbfb473a901388e930bc9ad9019c09341ebaad2f93143607c5a3449f604b96",
 	)
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 	_, valAddrs := createValAddrs(100)
 	endTime := time.Unix(0, 0).UTC()
 	endHeight := int64(10)
@@ -532,7 +539,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"2f934191401380406ccc5e16bab99cfc44eba3a7c78083651e5e56e79cb16e26",
 	)
 	s.Require().NoError(err)
 
@@ -547,13 +554,13 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"2f934191401380406ccc5e16bab99cfc44eba3a7c78083651e5e56e79cb16e26",
 	)
 	s.Require().NoError(err)
 }
 
 func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
-	s.SetupTest()
+	s.setupMigrationTest()
 
 	addrs, valAddrs := createValAddrs(101)
 	err := testutil.DiffCollectionsMigration(
@@ -575,7 +582,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ed15162e5928fc6983d7fd14e86ba99b7b49c5256d6e30a3c5dde503e8d76d82",
 	)
 	s.Require().NoError(err)
 
@@ -597,7 +604,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"ed15162e5928fc6983d7fd14e86ba99b7b49c5256d6e30a3c5dde503e8d76d82",
 	)
 	s.Require().NoError(err)
 }
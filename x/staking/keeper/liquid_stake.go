@@ -0,0 +1,437 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenizeShares tokenizes an amount of tokens of the delegation of a delegator to a validator.
+// The corresponding shares are moved to the delegation of the module account of a new tokenize
+// share record owned by the given owner, and liquid staking share tokens representing them are
+// minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, types.TokenizeShareRecord, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	// the shares received by a redelegation in progress must remain in the delegation
+	// of the delegator, which is slashed if the source validator is
+	hasReceivingRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if hasReceivingRedelegation {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if shares.TruncateInt().IsZero() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyLiquidStakingAmount
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	id, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	id++
+	if err := k.LastTokenizeShareRecordID.Set(ctx, id); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(id, ownerStr, validator.GetOperator())
+	recordAddr := record.GetModuleAddress()
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyLiquidStakingAmount
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, err
+		}
+	}
+
+	// the tokens go through the account of the delegator, so that vesting
+	// accounts cannot tokenize the delegation of their vesting tokens
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	// the validator is updated by the unbonding
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if shareToken.IsZero() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyLiquidStakingAmount
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.MintCoins(ctx, types.NotBondedPoolName, shareTokens); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, shareTokens); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	return shareToken, record, nil
+}
+
+// RedeemTokensForShares burns liquid staking share tokens of a delegator, and moves the shares
+// they represent from the delegation of the module account of their tokenize share record to the
+// delegation of the delegator. The share tokens of a record represent the shares of its delegation
+// pro rata. Once all its share tokens are redeemed, the record is removed and the remaining rewards
+// of its module account are sent to its owner.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, types.TokenizeShareRecord, error) {
+	id, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotExists
+		}
+
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if record.GetShareTokenDenom() != shareToken.Denom {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrInvalidShareDenom.Wrap(shareToken.Denom)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrNoDelegatorForAddress
+		}
+
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.IsLT(shareToken) {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareToken)
+	}
+
+	shares := delegation.Shares
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom)
+	if shareToken.Amount.LT(supply.Amount) {
+		shares = shares.MulInt(shareToken.Amount).QuoInt(supply.Amount)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, shareTokens); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.NotBondedPoolName), shareTokens); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, types.ErrTinyLiquidStakingAmount
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	// the validator is updated by the unbonding, or removed if it is unbonded and
+	// has no delegator shares left, in which case the tokens remain undelegated
+	validator, err = k.GetValidator(ctx, valAddr)
+	switch {
+	case err == nil:
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, err
+		}
+	case !errors.Is(err, types.ErrNoValidatorFound):
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, shares.Neg()); err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	hasDelegation, err := k.Delegations.Has(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		return sdk.Coin{}, types.TokenizeShareRecord{}, err
+	}
+
+	if !hasDelegation {
+		if err := k.removeTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, types.TokenizeShareRecord{}, err
+		}
+	}
+
+	return returnCoin, record, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record, and thereby of
+// the rewards of its delegation not withdrawn yet.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, recordID uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.TokenizeShareRecords.Get(ctx, recordID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrTokenizeShareRecordNotExists
+		}
+
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !sender.Equals(sdk.AccAddress(owner)) {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	record.Owner, err = k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	return k.SetTokenizeShareRecord(ctx, record)
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and indexes it by owner.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), record.Id))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	var records []types.TokenizeShareRecord
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.TokenizeShareRecordsByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (stop bool, err error) {
+		record, err := k.TokenizeShareRecords.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		records = append(records, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// removeTokenizeShareRecord removes a tokenize share record, and sends the remaining balance
+// of its module account, made of the rewards of its delegation, to its owner.
+func (k Keeper) removeTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if balances.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, recordAddr, owner, balances)
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return shares, err
+}
+
+// addValidatorLiquidShares adds shares, or removes them if negative, to the delegator shares
+// of a validator held by tokenize share records.
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// TotalLiquidStakedTokens returns the amount of tokens delegated by tokenize share records.
+func (k Keeper) TotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.LegacyZeroDec()
+
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(shares))
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of a validator would exceed the
+// global or the validator liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		liquidTokens, err := k.TotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		bondedTokens, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidTokens = liquidTokens.Add(validator.TokensFromShares(shares).TruncateInt())
+		if math.LegacyNewDecFromInt(liquidTokens).GT(math.LegacyNewDecFromInt(bondedTokens).Mul(params.GlobalLiquidStakingCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestTokenizeAndRedeemShares tokenizes a part of a delegation to an unbonded validator,
+// transfers the created record and redeems all its share tokens.
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(3)
+	delAddr, ownerAddr, newOwnerAddr := addrDels[1], addrDels[2], addrDels[0]
+	valAddr := addrVals[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(math.NewInt(100))
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddr), issuedShares)))
+
+	// tokenize 40 of the 100 delegated tokens
+	tokenizeAmount := math.NewInt(40)
+	tokenizedCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokenizeAmount))
+	expRecord := stakingtypes.NewTokenizeShareRecord(1, s.addressToString(ownerAddr), s.valAddressToString(valAddr))
+	recordAddr := expRecord.GetModuleAddress()
+	shareTokens := sdk.NewCoins(sdk.NewCoin(expRecord.GetShareTokenDenom(), tokenizeAmount))
+
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, tokenizedCoins).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delAddr, recordAddr, tokenizedCoins).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), recordAddr, stakingtypes.NotBondedPoolName, tokenizedCoins).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.NotBondedPoolName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, shareTokens).Return(nil)
+
+	shareToken, record, err := keeper.TokenizeShares(ctx, delAddr, valAddr, tokenizeAmount, ownerAddr)
+	require.NoError(err)
+	require.Equal(expRecord, record)
+	require.Equal(shareTokens[0], shareToken)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(60), delegation.Shares)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(40), delegation.Shares)
+
+	records, err := keeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{expRecord}, records)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(40), liquidShares)
+	liquidTokens, err := keeper.TotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokenizeAmount, liquidTokens)
+
+	// only the owner can transfer the record
+	err = keeper.TransferTokenizeShareRecord(ctx, record.Id, delAddr, newOwnerAddr)
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, record.Id, ownerAddr, newOwnerAddr))
+
+	records, err = keeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	require.NoError(err)
+	require.Empty(records)
+	records, err = keeper.GetTokenizeShareRecordsByOwner(ctx, newOwnerAddr)
+	require.NoError(err)
+	require.Len(records, 1)
+
+	// redeem all the share tokens, which removes the record
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, shareToken.Denom).Return(shareToken)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareToken.Denom).Return(shareToken)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, shareTokens).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(notBondedAcc.GetAddress()), shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, tokenizedCoins).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, tokenizedCoins).Return(nil)
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(sdk.NewCoins())
+
+	returnCoin, _, err := keeper.RedeemTokensForShares(ctx, delAddr, shareToken)
+	require.NoError(err)
+	require.Equal(tokenizedCoins[0], returnCoin)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(100), delegation.Shares)
+	_, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, valAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	_, err = keeper.TokenizeShareRecords.Get(ctx, record.Id)
+	require.ErrorIs(err, collections.ErrNotFound)
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesValidatorCap() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(2)
+	delAddr, valAddr := addrDels[1], addrVals[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(math.NewInt(100))
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddr), issuedShares)))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(3, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddr, math.NewInt(40), addrDels[0])
+	require.ErrorIs(err, stakingtypes.ErrValidatorLiquidStakingCapExceeded)
+}
+
+func (s *KeeperTestSuite) TestRedeemTokensForSharesInvalidDenom() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(1)
+
+	_, _, err := keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.ErrorIs(err, stakingtypes.ErrInvalidShareDenom)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, s.addressToString(addrDels[0]), s.valAddressToString(addrVals[0]))
+	_, _, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewInt64Coin(record.GetShareTokenDenom(), 10))
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+}
@@ -5,6 +5,9 @@ import (
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
 	v6 "cosmossdk.io/x/staking/migrations/v6"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
+	v8 "cosmossdk.io/x/staking/migrations/v8"
+	v9 "cosmossdk.io/x/staking/migrations/v9"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +50,21 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
+	return v7.MigrateStore(ctx, store, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates x/staking state from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx context.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
+	return v8.MigrateStore(ctx, store, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates x/staking state from consensus version 8 to 9.
+func (m Migrator) Migrate8to9(ctx context.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
+	return v9.MigrateStore(ctx, store, m.keeper.cdc)
+}
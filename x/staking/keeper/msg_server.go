@@ -58,6 +58,15 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minCommRate)
 	}
 
+	maxCommRate, err := k.MaxCommissionRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Commission.Rate.GT(maxCommRate) {
+		return nil, errorsmod.Wrapf(types.ErrCommissionGTGlobalMaxRate, "cannot set validator commission to more than maximum rate of %s", maxCommRate)
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, err := k.GetValidator(ctx, valAddr); err == nil {
 		return nil, types.ErrValidatorOwnerExists
@@ -195,6 +204,16 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 		if msg.CommissionRate.LT(minCommissionRate) {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "commission rate cannot be less than the min commission rate %s", minCommissionRate.String())
 		}
+
+		// with a notice period, commission changes must go through MsgScheduleCommissionChange
+		noticePeriod, err := k.CommissionChangeNoticePeriod(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if noticePeriod > 0 {
+			return nil, types.ErrCommissionChangeNoticeRequired
+		}
 	}
 
 	// validator must already be registered
@@ -719,3 +738,223 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return res, nil
 }
+
+// TokenizeShares defines a method for tokenizing the shares of a delegation into a liquid staking share denom
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shareToken, record, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount:   shareToken,
+		RecordId: record.Id,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming liquid staking share tokens for the delegation shares they represent
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	returnCoin, record, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, record.Validator),
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership of a tokenize share record
+func (k msgServer) TransferTokenizeShareRecord(ctx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.AddressCodec().StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferTokenizeShareRecord,
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+		event.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		event.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// InstantUndelegate defines a method for performing an undelegation from a
+// delegate and a validator which releases the tokens immediately, for a fee
+func (k msgServer) InstantUndelegate(ctx context.Context, msg *types.MsgInstantUndelegate) (*types.MsgInstantUndelegateResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(
+		ctx, delegatorAddress, valAddr, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	releasedAmt, feeAmt, err := k.Keeper.InstantUndelegate(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	releasedCoin := sdk.NewCoin(msg.Amount.Denom, releasedAmt)
+	feeCoin := sdk.NewCoin(msg.Amount.Denom, feeAmt)
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "instant_undelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeInstantUnbond,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, releasedCoin.String()),
+		event.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantUndelegateResponse{
+		Amount: releasedCoin,
+		Fee:    feeCoin,
+	}, nil
+}
+
+// ScheduleCommissionChange defines a method for scheduling a commission rate change of a validator
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewRate.IsNil() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate cannot be nil")
+	}
+
+	change, err := k.Keeper.ScheduleCommissionChange(ctx, valAddr, msg.NewRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, msg.NewRate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: change.EffectiveTime}, nil
+}
@@ -54,3 +54,15 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// MaxCommissionRate - Maximum validator commission rate
+func (k Keeper) MaxCommissionRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MaxCommissionRate, err
+}
+
+// CommissionChangeNoticePeriod - Minimum notice period of the scheduled commission changes
+func (k Keeper) CommissionChangeNoticePeriod(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	return params.CommissionChangeNoticePeriod, err
+}
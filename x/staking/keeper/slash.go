@@ -381,6 +381,13 @@ func (k Keeper) SlashRedelegation(ctx context.Context, srcValidator types.Valida
 			sharesToUnbond = delegation.Shares
 		}
 
+		// the hooks must not prevent the slash, e.g. by enforcing a lock on the delegation
+		if hooks, ok := k.Hooks().(types.DelegationSlashHooks); ok {
+			if err := hooks.BeforeDelegationSlashed(ctx, delegatorAddress, valDstAddr, sharesToUnbond); err != nil {
+				return math.ZeroInt(), err
+			}
+		}
+
 		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
 		if err != nil {
 			return math.ZeroInt(), err
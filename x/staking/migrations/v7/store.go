@@ -0,0 +1,30 @@
+package v7
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7.
+// It sets the liquid staking caps of the params to their default value.
+func MigrateStore(ctx context.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,39 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestLiquidStakingCapsMigration(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("staking")
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	params := stakingtypes.DefaultParams()
+	params.MaxValidators = 7
+	params.GlobalLiquidStakingCap = sdkmath.LegacyZeroDec()
+	params.ValidatorLiquidStakingCap = sdkmath.LegacyZeroDec()
+	store.Set(v7.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v7.MigrateStore(ctx, store, cdc))
+
+	var migrated stakingtypes.Params
+	cdc.MustUnmarshal(store.Get(v7.ParamsKey), &migrated)
+	require.Equal(t, stakingtypes.DefaultGlobalLiquidStakingCap, migrated.GlobalLiquidStakingCap)
+	require.Equal(t, stakingtypes.DefaultValidatorLiquidStakingCap, migrated.ValidatorLiquidStakingCap)
+	// the other params are left untouched
+	require.Equal(t, uint32(7), migrated.MaxValidators)
+}
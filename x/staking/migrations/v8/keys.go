@@ -0,0 +1,5 @@
+package v8
+
+import "cosmossdk.io/collections"
+
+var ParamsKey = collections.NewPrefix(81)
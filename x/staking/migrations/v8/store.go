@@ -0,0 +1,32 @@
+package v8
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8.
+// It sets the instant unbonding params to their default value, which
+// leaves instant unbonding disabled.
+func MigrateStore(ctx context.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.InstantUnbondingFeeRate = types.DefaultInstantUnbondingFeeRate
+	params.InstantUnbondingBlockLimit = types.DefaultInstantUnbondingBlockLimit
+	params.InstantUnbondingFeeToCommunityPool = false
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,41 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	v8 "cosmossdk.io/x/staking/migrations/v8"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestInstantUnbondingParamsMigration(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("staking")
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	params := stakingtypes.DefaultParams()
+	params.MaxValidators = 7
+	params.InstantUnbondingFeeRate = sdkmath.LegacyZeroDec()
+	params.InstantUnbondingBlockLimit = sdkmath.NewInt(1000)
+	params.InstantUnbondingFeeToCommunityPool = true
+	store.Set(v8.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v8.MigrateStore(ctx, store, cdc))
+
+	var migrated stakingtypes.Params
+	cdc.MustUnmarshal(store.Get(v8.ParamsKey), &migrated)
+	require.Equal(t, stakingtypes.DefaultInstantUnbondingFeeRate, migrated.InstantUnbondingFeeRate)
+	require.Equal(t, stakingtypes.DefaultInstantUnbondingBlockLimit, migrated.InstantUnbondingBlockLimit)
+	require.False(t, migrated.InstantUnbondingFeeToCommunityPool)
+	// the other params are left untouched
+	require.Equal(t, uint32(7), migrated.MaxValidators)
+}
@@ -0,0 +1,31 @@
+package v9
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// MigrateStore performs in-place store migrations from v8 to v9.
+// It sets the commission change params to their default value, which
+// keeps immediate commission changes and does not cap commission rates.
+func MigrateStore(ctx context.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.CommissionChangeNoticePeriod = types.DefaultCommissionChangeNoticePeriod
+	params.MaxCommissionRate = types.DefaultMaxCommissionRate
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,40 @@
+package v9_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	v9 "cosmossdk.io/x/staking/migrations/v9"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestCommissionChangeParamsMigration(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("staking")
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+
+	params := stakingtypes.DefaultParams()
+	params.MaxValidators = 7
+	params.CommissionChangeNoticePeriod = time.Hour
+	params.MaxCommissionRate = sdkmath.LegacyNewDecWithPrec(5, 1)
+	store.Set(v9.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v9.MigrateStore(ctx, store, cdc))
+
+	var migrated stakingtypes.Params
+	cdc.MustUnmarshal(store.Get(v9.ParamsKey), &migrated)
+	require.Equal(t, stakingtypes.DefaultCommissionChangeNoticePeriod, migrated.CommissionChangeNoticePeriod)
+	require.Equal(t, stakingtypes.DefaultMaxCommissionRate, migrated.MaxCommissionRate)
+	// the other params are left untouched
+	require.Equal(t, uint32(7), migrated.MaxValidators)
+}
@@ -24,7 +24,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 6
+	consensusVersion uint64 = 9
 )
 
 var (
@@ -129,6 +129,15 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	if err := mr.Register(types.ModuleName, 5, m.Migrate5to6); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
 	}
+	if err := mr.Register(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 6 to 7: %w", types.ModuleName, err)
+	}
+	if err := mr.Register(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 7 to 8: %w", types.ModuleName, err)
+	}
+	if err := mr.Register(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 8 to 9: %w", types.ModuleName, err)
+	}
 
 	return nil
 }
@@ -64,7 +64,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, rotationFee)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, rotationFee,
+		types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		types.DefaultInstantUnbondingFeeRate, types.DefaultInstantUnbondingBlockLimit, false,
+		types.DefaultCommissionChangeNoticePeriod, types.DefaultMaxCommissionRate)
 
 	// validators & delegations
 	var (
@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	address "cosmossdk.io/core/address"
@@ -230,6 +231,34 @@ func (mr *MockBankKeeperMockRecorder) LockedCoins(ctx, addr interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedCoins", reflect.TypeOf((*MockBankKeeper)(nil).LockedCoins), ctx, addr)
 }
 
+// MintCoins mocks base method.
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintCoins indicates an expected call of MintCoins.
+func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
+}
+
+// SendCoins mocks base method.
+func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types2.AccAddress, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoins", ctx, fromAddr, toAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoins indicates an expected call of SendCoins.
+func (mr *MockBankKeeperMockRecorder) SendCoins(ctx, fromAddr, toAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendCoins), ctx, fromAddr, toAddr, amt)
+}
+
 // SendCoinsFromAccountToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types2.AccAddress, recipientModule string, amt types2.Coins) error {
 	m.ctrl.T.Helper()
@@ -244,6 +273,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAd
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types2.AccAddress, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // SendCoinsFromModuleToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt types2.Coins) error {
 	m.ctrl.T.Helper()
@@ -742,6 +785,80 @@ func (mr *MockStakingHooksMockRecorder) BeforeValidatorSlashed(ctx, valAddr, fra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeValidatorSlashed", reflect.TypeOf((*MockStakingHooks)(nil).BeforeValidatorSlashed), ctx, valAddr, fraction)
 }
 
+// MockCommissionChangeHooks is a mock of CommissionChangeHooks interface.
+type MockCommissionChangeHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionChangeHooksMockRecorder
+}
+
+// MockCommissionChangeHooksMockRecorder is the mock recorder for MockCommissionChangeHooks.
+type MockCommissionChangeHooksMockRecorder struct {
+	mock *MockCommissionChangeHooks
+}
+
+// NewMockCommissionChangeHooks creates a new mock instance.
+func NewMockCommissionChangeHooks(ctrl *gomock.Controller) *MockCommissionChangeHooks {
+	mock := &MockCommissionChangeHooks{ctrl: ctrl}
+	mock.recorder = &MockCommissionChangeHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionChangeHooks) EXPECT() *MockCommissionChangeHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterCommissionChangeScheduled mocks base method.
+func (m *MockCommissionChangeHooks) AfterCommissionChangeScheduled(ctx context.Context, valAddr types2.ValAddress, newRate math.LegacyDec, effectiveTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterCommissionChangeScheduled", ctx, valAddr, newRate, effectiveTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterCommissionChangeScheduled indicates an expected call of AfterCommissionChangeScheduled.
+func (mr *MockCommissionChangeHooksMockRecorder) AfterCommissionChangeScheduled(ctx, valAddr, newRate, effectiveTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterCommissionChangeScheduled", reflect.TypeOf((*MockCommissionChangeHooks)(nil).AfterCommissionChangeScheduled), ctx, valAddr, newRate, effectiveTime)
+}
+
+// MockDelegationSlashHooks is a mock of DelegationSlashHooks interface.
+type MockDelegationSlashHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegationSlashHooksMockRecorder
+}
+
+// MockDelegationSlashHooksMockRecorder is the mock recorder for MockDelegationSlashHooks.
+type MockDelegationSlashHooksMockRecorder struct {
+	mock *MockDelegationSlashHooks
+}
+
+// NewMockDelegationSlashHooks creates a new mock instance.
+func NewMockDelegationSlashHooks(ctrl *gomock.Controller) *MockDelegationSlashHooks {
+	mock := &MockDelegationSlashHooks{ctrl: ctrl}
+	mock.recorder = &MockDelegationSlashHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDelegationSlashHooks) EXPECT() *MockDelegationSlashHooksMockRecorder {
+	return m.recorder
+}
+
+// BeforeDelegationSlashed mocks base method.
+func (m *MockDelegationSlashHooks) BeforeDelegationSlashed(ctx context.Context, delAddr types2.AccAddress, valAddr types2.ValAddress, shares math.LegacyDec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeDelegationSlashed", ctx, delAddr, valAddr, shares)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeforeDelegationSlashed indicates an expected call of BeforeDelegationSlashed.
+func (mr *MockDelegationSlashHooksMockRecorder) BeforeDelegationSlashed(ctx, delAddr, valAddr, shares interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeDelegationSlashed", reflect.TypeOf((*MockDelegationSlashHooks)(nil).BeforeDelegationSlashed), ctx, delAddr, valAddr, shares)
+}
+
 // MockConsensusKeeper is a mock of ConsensusKeeper interface.
 type MockConsensusKeeper struct {
 	ctrl     *gomock.Controller
@@ -52,4 +52,22 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotExists      = errors.Register(ModuleName, 49, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner       = errors.Register(ModuleName, 50, "not tokenize share record owner")
+	ErrInvalidShareDenom                 = errors.Register(ModuleName, 51, "invalid liquid staking share denom")
+	ErrTinyLiquidStakingAmount           = errors.Register(ModuleName, 52, "too few tokens to tokenize or redeem (truncates to zero)")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 53, "delegator has a redelegation to this validator in progress")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 54, "tokenizing shares would exceed the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 55, "tokenizing shares would exceed the validator liquid staking cap")
+
+	// instant unbonding errors
+	ErrInstantUnbondingDisabled           = errors.Register(ModuleName, 56, "instant unbonding is disabled")
+	ErrInstantUnbondingBlockLimitExceeded = errors.Register(ModuleName, 57, "instant unbonding would exceed the block limit")
+
+	// commission change errors
+	ErrCommissionGTGlobalMaxRate      = errors.Register(ModuleName, 58, "commission cannot be more than the global max commission rate")
+	ErrCommissionChangeNoticeRequired = errors.Register(ModuleName, 59, "commission changes must be scheduled with a notice period")
+	ErrNoPendingCommissionChange      = errors.Register(ModuleName, 60, "no pending commission change found")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/address"
@@ -34,11 +35,14 @@ type BankKeeper interface {
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(context.Context, []byte, sdk.Coins) error
 	IsSendEnabledDenom(ctx context.Context, denom string) bool
 }
@@ -110,6 +114,19 @@ type StakingHooks interface {
 	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error
 }
 
+// CommissionChangeHooks are the optional staking hooks notified of the scheduled commission changes.
+// The StakingHooks implementing them are called by MultiStakingHooks.
+type CommissionChangeHooks interface {
+	AfterCommissionChangeScheduled(ctx context.Context, valAddr sdk.ValAddress, newRate math.LegacyDec, effectiveTime time.Time) error // Must be called when a commission change is scheduled
+}
+
+// DelegationSlashHooks are the optional staking hooks notified of the delegation shares unbonded by a slash,
+// before the delegation is modified or removed, which the other hooks must then not fail on.
+// The StakingHooks implementing them are called by MultiStakingHooks.
+type DelegationSlashHooks interface {
+	BeforeDelegationSlashed(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error // Must be called before the shares of a delegation are unbonded by a slash
+}
+
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
 type StakingHooksWrapper struct{ StakingHooks }
 
@@ -46,6 +46,12 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// pending_commission_changes defines the pending commission changes at genesis.
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,11,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +136,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +208,45 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0x5f, 0xff, 0xa5, 0x97, 0xfe, 0x10, 0x1c, 0x69, 0xe5, 0x46, 0xe0, 0x84, 0xa8,
+	0x43, 0x54, 0x54, 0x9b, 0x06, 0x89, 0x81, 0x89, 0xa6, 0x48, 0x28, 0x52, 0x25, 0x2a, 0xa7, 0x65,
+	0xe8, 0x62, 0x5d, 0xe2, 0x93, 0x73, 0x8a, 0x7d, 0x67, 0xf9, 0xae, 0x25, 0xf0, 0x0a, 0x18, 0x79,
+	0x09, 0x1d, 0x19, 0x19, 0xfa, 0x06, 0xd8, 0x3a, 0x56, 0x9d, 0x10, 0x43, 0x85, 0x92, 0x01, 0x5e,
+	0x06, 0xf2, 0x9d, 0x63, 0x1c, 0x25, 0x66, 0xb1, 0x7c, 0x7e, 0xbe, 0xdf, 0xcf, 0xf7, 0x39, 0xf9,
+	0x9e, 0x03, 0x3b, 0x7d, 0xc6, 0x43, 0xc6, 0x6d, 0x2e, 0xd0, 0x90, 0x50, 0xdf, 0xbe, 0xd8, 0xef,
+	0x61, 0x81, 0xf6, 0x6d, 0x1f, 0x53, 0xcc, 0x09, 0xb7, 0xa2, 0x98, 0x09, 0x06, 0xb7, 0x94, 0xca,
+	0x4a, 0x55, 0x56, 0xaa, 0xaa, 0x56, 0x7c, 0xe6, 0x33, 0x29, 0xb1, 0x93, 0x37, 0xa5, 0xae, 0x16,
+	0x31, 0xa7, 0x6e, 0xa5, 0xda, 0x56, 0x2a, 0x57, 0xd9, 0xd3, 0x00, 0x55, 0x7a, 0x80, 0x42, 0x42,
+	0x99, 0x2d, 0x9f, 0xea, 0x53, 0xe3, 0xdb, 0x1a, 0xd8, 0x78, 0xa3, 0x7a, 0xea, 0x0a, 0x24, 0x30,
+	0x3c, 0x00, 0xab, 0x11, 0x8a, 0x51, 0xc8, 0x0d, 0xbd, 0xae, 0x37, 0xcb, 0x2d, 0xd3, 0x5a, 0xdc,
+	0xa3, 0x75, 0x2c, 0x55, 0xed, 0xf5, 0xeb, 0xbb, 0x9a, 0xf6, 0xe5, 0xd7, 0xd7, 0x5d, 0xdd, 0x49,
+	0x8d, 0xf0, 0x0c, 0xdc, 0x0f, 0x10, 0x17, 0xae, 0x60, 0x02, 0x05, 0x6e, 0xc4, 0xde, 0xe3, 0xd8,
+	0xf8, 0xaf, 0xae, 0x37, 0x37, 0xda, 0xcf, 0x12, 0xf1, 0x8f, 0xbb, 0xda, 0xa6, 0x62, 0x72, 0x6f,
+	0x68, 0x11, 0x66, 0x87, 0x48, 0x0c, 0xac, 0x0e, 0x15, 0xb7, 0x57, 0x7b, 0x20, 0x0d, 0xeb, 0x50,
+	0xa1, 0x98, 0xf7, 0x12, 0xd2, 0x49, 0x02, 0x3a, 0x4e, 0x38, 0x90, 0x80, 0x4d, 0xc9, 0xbe, 0x40,
+	0x01, 0xf1, 0x90, 0x60, 0xb1, 0xe2, 0x73, 0x63, 0xa9, 0xbe, 0xd4, 0x2c, 0xb7, 0x76, 0x8b, 0xba,
+	0x3d, 0x42, 0x5c, 0xbc, 0x9b, 0x7a, 0x24, 0x2a, 0xdf, 0xf9, 0xc3, 0x60, 0xae, 0xcc, 0xe1, 0x11,
+	0x00, 0x59, 0x0a, 0x37, 0x96, 0x25, 0xff, 0x49, 0x11, 0x3f, 0x33, 0xe7, 0xb1, 0x39, 0x3f, 0x7c,
+	0x0b, 0xca, 0x1e, 0x0e, 0xb0, 0x8f, 0x04, 0x61, 0x94, 0x1b, 0x2b, 0x12, 0xd7, 0x28, 0xc2, 0xbd,
+	0xce, 0xa4, 0x79, 0x5e, 0x9e, 0x00, 0x87, 0x60, 0xf3, 0x9c, 0xf6, 0x18, 0xf5, 0x08, 0xf5, 0xdd,
+	0x3c, 0x7a, 0x55, 0xa2, 0x9f, 0x16, 0xa1, 0x4f, 0xa7, 0xa6, 0xc5, 0x19, 0x95, 0xf3, 0xf9, 0x3a,
+	0x87, 0xa7, 0xe0, 0xff, 0x18, 0xe7, 0x43, 0xd6, 0x64, 0xc8, 0x4e, 0x51, 0x88, 0x83, 0xbd, 0x85,
+	0xf4, 0x59, 0x0a, 0xac, 0x82, 0x12, 0x1e, 0x45, 0x2c, 0x16, 0xd8, 0x33, 0x4a, 0x75, 0xbd, 0x59,
+	0x72, 0xb2, 0x35, 0x0c, 0xc0, 0x96, 0x60, 0x43, 0x4c, 0xc9, 0x47, 0xec, 0xf2, 0x01, 0x8a, 0xb1,
+	0x1b, 0xe3, 0x3e, 0x8b, 0x3d, 0x6e, 0xac, 0xff, 0x7b, 0x83, 0x27, 0xa9, 0xab, 0x9b, 0x98, 0x1c,
+	0xe9, 0x99, 0xd9, 0xa0, 0x98, 0xaf, 0x73, 0xf8, 0x0a, 0x3c, 0x4e, 0xcf, 0xec, 0x82, 0x48, 0x97,
+	0x78, 0x06, 0xa8, 0xeb, 0xcd, 0x65, 0x67, 0x5b, 0x1d, 0xc7, 0x39, 0x40, 0xc7, 0x83, 0x23, 0x50,
+	0x8d, 0xb0, 0xfa, 0x1b, 0x7d, 0x16, 0x86, 0x84, 0x73, 0xc2, 0xa8, 0xdb, 0x1f, 0x20, 0xea, 0x63,
+	0x6e, 0x94, 0x65, 0xcf, 0x76, 0xe1, 0x30, 0x29, 0xe7, 0x61, 0x66, 0x3c, 0x94, 0xbe, 0x7c, 0xdf,
+	0x46, 0xb4, 0x58, 0xc3, 0x1b, 0x03, 0x00, 0xe7, 0x8f, 0x37, 0x6c, 0x81, 0x35, 0xe4, 0x79, 0x31,
+	0xe6, 0x6a, 0x92, 0xd7, 0xdb, 0xc6, 0xed, 0xd5, 0x5e, 0x25, 0xcd, 0x3f, 0x50, 0x95, 0xae, 0x88,
+	0x09, 0xf5, 0x9d, 0xa9, 0x10, 0x56, 0xc0, 0xca, 0xdf, 0x71, 0x5d, 0x72, 0xd4, 0xe2, 0x65, 0xe9,
+	0xd3, 0x65, 0x4d, 0xfb, 0x7d, 0x59, 0xd3, 0xda, 0x2f, 0xae, 0xc7, 0xa6, 0x7e, 0x33, 0x36, 0xf5,
+	0x9f, 0x63, 0x53, 0xff, 0x3c, 0x31, 0xb5, 0x9b, 0x89, 0xa9, 0x7d, 0x9f, 0x98, 0xda, 0xd9, 0xa3,
+	0x99, 0x89, 0x1e, 0x65, 0x77, 0x94, 0xf8, 0x10, 0x61, 0xde, 0x5b, 0x95, 0x97, 0xcd, 0xf3, 0x3f,
+	0x03, 0x00, 0x83, 0x3f, 0xbc, 0x31, 0x16, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +269,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +494,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +819,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -10,7 +11,11 @@ import (
 )
 
 // combine multiple staking hooks, all hook functions are run in array sequence
-var _ StakingHooks = &MultiStakingHooks{}
+var (
+	_ StakingHooks          = &MultiStakingHooks{}
+	_ CommissionChangeHooks = &MultiStakingHooks{}
+	_ DelegationSlashHooks  = &MultiStakingHooks{}
+)
 
 type MultiStakingHooks []StakingHooks
 
@@ -126,3 +131,33 @@ func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPu
 	}
 	return nil
 }
+
+// AfterCommissionChangeScheduled calls the hooks implementing CommissionChangeHooks.
+func (h MultiStakingHooks) AfterCommissionChangeScheduled(ctx context.Context, valAddr sdk.ValAddress, newRate sdkmath.LegacyDec, effectiveTime time.Time) error {
+	for i := range h {
+		hooks, ok := h[i].(CommissionChangeHooks)
+		if !ok {
+			continue
+		}
+
+		if err := hooks.AfterCommissionChangeScheduled(ctx, valAddr, newRate, effectiveTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeDelegationSlashed calls the hooks implementing DelegationSlashHooks.
+func (h MultiStakingHooks) BeforeDelegationSlashed(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdkmath.LegacyDec) error {
+	for i := range h {
+		hooks, ok := h[i].(DelegationSlashHooks)
+		if !ok {
+			continue
+		}
+
+		if err := hooks.BeforeDelegationSlashed(ctx, delAddr, valAddr, shares); err != nil {
+			return err
+		}
+	}
+	return nil
+}
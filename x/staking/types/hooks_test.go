@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// commissionChangeHooks are staking hooks implementing the optional commission change hooks.
+type commissionChangeHooks struct {
+	*testutil.MockStakingHooks
+	*testutil.MockCommissionChangeHooks
+}
+
+func TestMultiStakingHooksAfterCommissionChangeScheduled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	valAddr := sdk.ValAddress("val")
+	rate := math.LegacyNewDecWithPrec(1, 1)
+	effectiveTime := time.Unix(100, 0)
+
+	// the hooks not implementing CommissionChangeHooks are skipped
+	plain := testutil.NewMockStakingHooks(ctrl)
+	notified := commissionChangeHooks{testutil.NewMockStakingHooks(ctrl), testutil.NewMockCommissionChangeHooks(ctrl)}
+	notified.MockCommissionChangeHooks.EXPECT().AfterCommissionChangeScheduled(ctx, valAddr, rate, effectiveTime).Return(nil)
+
+	hooks := types.NewMultiStakingHooks(plain, notified)
+	require.NoError(t, hooks.AfterCommissionChangeScheduled(ctx, valAddr, rate, effectiveTime))
+
+	// the errors of the hooks are returned
+	failing := commissionChangeHooks{testutil.NewMockStakingHooks(ctrl), testutil.NewMockCommissionChangeHooks(ctrl)}
+	failing.MockCommissionChangeHooks.EXPECT().AfterCommissionChangeScheduled(ctx, valAddr, rate, effectiveTime).Return(errors.New("rejected"))
+
+	hooks = types.NewMultiStakingHooks(plain, failing)
+	require.ErrorContains(t, hooks.AfterCommissionChangeScheduled(ctx, valAddr, rate, effectiveTime), "rejected")
+}
+
+// delegationSlashHooks are staking hooks implementing the optional delegation slash hooks.
+type delegationSlashHooks struct {
+	*testutil.MockStakingHooks
+	*testutil.MockDelegationSlashHooks
+}
+
+func TestMultiStakingHooksBeforeDelegationSlashed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	delAddr, valAddr := sdk.AccAddress("del"), sdk.ValAddress("val")
+	shares := math.LegacyNewDec(10)
+
+	// the hooks not implementing DelegationSlashHooks are skipped
+	plain := testutil.NewMockStakingHooks(ctrl)
+	notified := delegationSlashHooks{testutil.NewMockStakingHooks(ctrl), testutil.NewMockDelegationSlashHooks(ctrl)}
+	notified.MockDelegationSlashHooks.EXPECT().BeforeDelegationSlashed(ctx, delAddr, valAddr, shares).Return(nil)
+
+	hooks := types.NewMultiStakingHooks(plain, notified)
+	require.NoError(t, hooks.BeforeDelegationSlashed(ctx, delAddr, valAddr, shares))
+}
@@ -68,6 +68,16 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey        = collections.NewPrefix(121) // prefix for the tokenize share records
+	TokenizeShareRecordByOwnerKey = collections.NewPrefix(122) // prefix for an index of the tokenize share records by owner
+	LastTokenizeShareRecordIDKey  = collections.NewPrefix(123) // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = collections.NewPrefix(124) // prefix for the tokenized delegator shares of each validator
+
+	InstantUnbondedTokensKey = collections.NewPrefix(125) // key for the tokens instantly unbonded in the current block
+
+	PendingCommissionChangeKey      = collections.NewPrefix(126) // prefix for the pending commission change of each validator
+	PendingCommissionChangeQueueKey = collections.NewPrefix(127) // prefix for the queue of the pending commission changes by effective time
 )
 
 // Reserved kvstore keys
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	authtypes "cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizeShareModuleAccountName returns the name of the module account holding the
+// delegation of the tokenize share record with the given id.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}
+
+// NewTokenizeShareRecord creates a new tokenize share record.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: TokenizeShareModuleAccountName(id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the liquid staking share tokens of the record,
+// made of the validator operator address and the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// ParseShareTokenDenom returns the id of the tokenize share record of a liquid staking
+// share denom.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return 0, ErrInvalidShareDenom.Wrap(denom)
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidShareDenom.Wrapf("%s: %s", denom, err)
+	}
+
+	return id, nil
+}
@@ -21,6 +21,11 @@ var (
 	_ coretransaction.Msg                = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                = &MsgCancelUnbondingDelegation{}
 	_ coretransaction.Msg                = &MsgUpdateParams{}
+	_ coretransaction.Msg                = &MsgTokenizeShares{}
+	_ coretransaction.Msg                = &MsgRedeemTokensForShares{}
+	_ coretransaction.Msg                = &MsgTransferTokenizeShareRecord{}
+	_ coretransaction.Msg                = &MsgInstantUndelegate{}
+	_ coretransaction.Msg                = &MsgScheduleCommissionChange{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -159,6 +164,33 @@ func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRota
 	}, nil
 }
 
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner string) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var pubKey cryptotypes.PubKey
@@ -175,3 +207,20 @@ func (hi ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpa
 	var newPubKey cryptotypes.PubKey
 	return unpacker.UnpackAny(hi.NewConsPubkey, &newPubKey)
 }
+
+// NewMsgInstantUndelegate creates a new MsgInstantUndelegate instance.
+func NewMsgInstantUndelegate(delAddr, valAddr string, amount sdk.Coin) *MsgInstantUndelegate {
+	return &MsgInstantUndelegate{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, newRate math.LegacyDec) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		NewRate:          newRate,
+	}
+}
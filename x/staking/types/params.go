@@ -29,6 +29,10 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeNoticePeriod is 0, allowing validators to change their
+	// commission rate immediately
+	DefaultCommissionChangeNoticePeriod time.Duration = 0
 )
 
 var (
@@ -37,13 +41,30 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, not restricting liquid staking
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, not restricting liquid staking
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultInstantUnbondingFeeRate is set to 10%
+	DefaultInstantUnbondingFeeRate = math.LegacyNewDecWithPrec(1, 1)
+
+	// DefaultInstantUnbondingBlockLimit is set to 0, disabling instant unbonding
+	DefaultInstantUnbondingBlockLimit = math.ZeroInt()
+
+	// DefaultMaxCommissionRate is set to 100%, not restricting commission rates
+	DefaultMaxCommissionRate = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries, historicalEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
-	keyRotationFee sdk.Coin,
+	keyRotationFee sdk.Coin, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	instantUnbondingFeeRate math.LegacyDec, instantUnbondingBlockLimit math.Int, instantUnbondingFeeToCommunityPool bool,
+	commissionChangeNoticePeriod time.Duration, maxCommissionRate math.LegacyDec,
 ) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
@@ -53,6 +74,16 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+
+		InstantUnbondingFeeRate:            instantUnbondingFeeRate,
+		InstantUnbondingBlockLimit:         instantUnbondingBlockLimit,
+		InstantUnbondingFeeToCommunityPool: instantUnbondingFeeToCommunityPool,
+
+		CommissionChangeNoticePeriod: commissionChangeNoticePeriod,
+		MaxCommissionRate:            maxCommissionRate,
 	}
 }
 
@@ -66,6 +97,13 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultInstantUnbondingFeeRate,
+		DefaultInstantUnbondingBlockLimit,
+		false,
+		DefaultCommissionChangeNoticePeriod,
+		DefaultMaxCommissionRate,
 	)
 }
 
@@ -119,6 +157,34 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator %w", err)
+	}
+
+	if err := validateInstantUnbondingFeeRate(p.InstantUnbondingFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateInstantUnbondingBlockLimit(p.InstantUnbondingBlockLimit); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxCommissionRate(p.MaxCommissionRate); err != nil {
+		return err
+	}
+
+	if p.MinCommissionRate.GT(p.MaxCommissionRate) {
+		return fmt.Errorf("minimum commission rate cannot be greater than the maximum commission rate: %s > %s", p.MinCommissionRate, p.MaxCommissionRate)
+	}
+
 	return nil
 }
 
@@ -234,3 +300,89 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUnbondingFeeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unbonding fee rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("instant unbonding fee rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("instant unbonding fee rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUnbondingBlockLimit(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unbonding block limit cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("instant unbonding block limit cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change notice period must not be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxCommissionRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("maximum commission rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("maximum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("maximum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
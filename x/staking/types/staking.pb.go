@@ -999,6 +999,26 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum ratio of the total bonded tokens which can be
+	// tokenized into liquid staking share tokens
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the delegator shares of a validator
+	// which can be tokenized into liquid staking share tokens
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// instant_unbonding_fee_rate is the ratio of the instantly unbonded tokens taken as a fee
+	InstantUnbondingFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=instant_unbonding_fee_rate,json=instantUnbondingFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"instant_unbonding_fee_rate"`
+	// instant_unbonding_block_limit is the maximum amount of tokens which can be instantly
+	// unbonded in a block, zero disables instant unbonding
+	InstantUnbondingBlockLimit cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=instant_unbonding_block_limit,json=instantUnbondingBlockLimit,proto3,customtype=cosmossdk.io/math.Int" json:"instant_unbonding_block_limit"`
+	// instant_unbonding_fee_to_community_pool sends the instant unbonding fees to the
+	// community pool instead of burning them
+	InstantUnbondingFeeToCommunityPool bool `protobuf:"varint,12,opt,name=instant_unbonding_fee_to_community_pool,json=instantUnbondingFeeToCommunityPool,proto3" json:"instant_unbonding_fee_to_community_pool,omitempty"`
+	// commission_change_notice_period is the minimum duration between the scheduling of a
+	// commission change and its effective time. When not zero, validators can only change
+	// their commission rate through scheduled commission changes.
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,13,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
+	// max_commission_rate is the chain-wide maximum commission rate that a validator can charge their delegators
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1076,6 +1096,20 @@ func (m *Params) GetKeyRotationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetInstantUnbondingFeeToCommunityPool() bool {
+	if m != nil {
+		return m.InstantUnbondingFeeToCommunityPool
+	}
+	return false
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1414,6 +1448,139 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents the delegation shares of a validator tokenized into a liquid
+// staking share denom. The tokenized shares are delegated by the module account of the record,
+// and its owner receives the rewards of the delegation.
+type TokenizeShareRecord struct {
+	// id is the identifier of the record, which is part of the share denom.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// PendingCommissionChange defines a commission rate change scheduled by a validator, which becomes
+// effective at the end of the first block after its effective time.
+type PendingCommissionChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// new_rate is the commission rate to apply.
+	NewRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=new_rate,json=newRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_rate"`
+	// effective_time is the time from which the commission change is applied.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
+func (m *PendingCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1441,6 +1608,8 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*PendingCommissionChange)(nil), "cosmos.staking.v1beta1.PendingCommissionChange")
 }
 
 func init() {
@@ -1448,140 +1617,160 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0xf9, 0xd7, 0x92, 0x34, 0x25, 0x7d, 0xa4, 0x44, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x94, 0x19, 0xff,
-	0xff, 0xb1, 0xdd, 0x8a, 0x8a, 0xdc, 0xc0, 0x05, 0x84, 0x20, 0x85, 0x29, 0xd2, 0x31, 0xf3, 0x90,
-	0xd4, 0xa5, 0xa4, 0x3e, 0xd0, 0x66, 0x31, 0xdc, 0x1d, 0x92, 0x5b, 0x91, 0xb3, 0xec, 0xce, 0x50,
-	0x36, 0xef, 0x3d, 0x04, 0x0e, 0x0a, 0xe4, 0x54, 0x04, 0x28, 0x8c, 0x1a, 0xe8, 0x25, 0xbd, 0xe5,
-	0x60, 0xf4, 0xde, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x1c, 0xb4,
-	0x97, 0xa2, 0xa7, 0x1e, 0x8b, 0x99, 0x9d, 0x7d, 0x50, 0x14, 0xad, 0x87, 0x83, 0x22, 0x68, 0x2f,
-	0x02, 0x67, 0xe6, 0xfb, 0x7e, 0xfb, 0xbd, 0x67, 0xbe, 0x4f, 0x70, 0xd9, 0x74, 0x58, 0xd7, 0x61,
-	0xcb, 0x8c, 0xe3, 0x5d, 0x9b, 0xb6, 0x96, 0xf7, 0x56, 0x1a, 0x84, 0xe3, 0x15, 0x7f, 0x5d, 0xea,
-	0xb9, 0x0e, 0x77, 0xd0, 0x39, 0x8f, 0xaa, 0xe4, 0xef, 0x2a, 0xaa, 0xfc, 0x99, 0x96, 0xd3, 0x72,
-	0x24, 0xc9, 0xb2, 0xf8, 0xe5, 0x51, 0xe7, 0xe7, 0x5b, 0x8e, 0xd3, 0xea, 0x90, 0x65, 0xb9, 0x6a,
-	0xf4, 0x9b, 0xcb, 0x98, 0x0e, 0xd4, 0xd1, 0xc2, 0xfe, 0x23, 0xab, 0xef, 0x62, 0x6e, 0x3b, 0x54,
-	0x9d, 0x17, 0xf6, 0x9f, 0x73, 0xbb, 0x4b, 0x18, 0xc7, 0xdd, 0x9e, 0x8f, 0xed, 0x49, 0x62, 0x78,
-	0x1f, 0x55, 0x62, 0x29, 0x6c, 0xa5, 0x4a, 0x03, 0x33, 0x12, 0xe8, 0x61, 0x3a, 0xb6, 0x8f, 0x3d,
-	0x87, 0xbb, 0x36, 0x75, 0x96, 0xe5, 0x5f, 0xb5, 0x75, 0xd1, 0x74, 0xba, 0x84, 0x37, 0x9a, 0x7c,
-	0x99, 0x0f, 0x7a, 0x84, 0x2d, 0xef, 0xad, 0x78, 0x3f, 0xd4, 0xf1, 0x2b, 0xc1, 0x31, 0x6e, 0x98,
-	0xf6, 0xbe, 0xd3, 0xe2, 0x27, 0x1a, 0xcc, 0xde, 0xb6, 0x19, 0x77, 0x5c, 0xdb, 0xc4, 0x9d, 0x1a,
-	0x6d, 0x3a, 0xe8, 0x4d, 0x48, 0xb6, 0x09, 0xb6, 0x88, 0x9b, 0xd3, 0x16, 0xb5, 0x2b, 0xa9, 0xeb,
-	0xf3, 0x25, 0x1f, 0xa1, 0xe4, 0x71, 0xee, 0xad, 0x94, 0x6e, 0x4b, 0x82, 0xf2, 0xf4, 0xe7, 0x4f,
-	0x0a, 0x13, 0x9f, 0x3e, 0xff, 0xec, 0x9a, 0xa6, 0x2b, 0x1e, 0x54, 0x81, 0xe4, 0x1e, 0xee, 0x30,
-	0xc2, 0x73, 0xb1, 0xc5, 0xf8, 0x95, 0xd4, 0xf5, 0x4b, 0xa5, 0x83, 0xcd, 0x5e, 0xda, 0xc1, 0x1d,
-	0xdb, 0xc2, 0xdc, 0x19, 0x46, 0xf1, 0x78, 0x57, 0x63, 0x39, 0xad, 0xf8, 0x91, 0x06, 0xd9, 0x50,
-	0x34, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c, 0x4c, 0xe2, 0x5e, 0xaf, 0x8d, 0x59, 0x5b, 0x4a, 0x97,
-	0xd6, 0xfd, 0x25, 0x7a, 0x03, 0x12, 0xc2, 0xce, 0xb9, 0x98, 0x14, 0x3a, 0x5f, 0xf2, 0x9c, 0x50,
-	0xf2, 0x9d, 0x50, 0xda, 0xf2, 0x9d, 0x50, 0x4e, 0x7c, 0xfc, 0x97, 0x82, 0xa6, 0x4b, 0x6a, 0xf4,
-	0x1a, 0x64, 0xf6, 0x7c, 0x41, 0x98, 0x21, 0x71, 0xe3, 0x12, 0x77, 0x36, 0xdc, 0xbe, 0x8d, 0x59,
-	0xbb, 0xf8, 0x8b, 0x18, 0x64, 0xd6, 0x9c, 0x6e, 0xd7, 0x66, 0xcc, 0x76, 0xa8, 0x8e, 0x39, 0x61,
-	0xe8, 0x1d, 0x48, 0xb8, 0x98, 0x13, 0x29, 0xc9, 0x74, 0xf9, 0x86, 0x50, 0xe3, 0x8b, 0x27, 0x85,
-	0x0b, 0x9e, 0xc2, 0xcc, 0xda, 0x2d, 0xd9, 0xce, 0x72, 0x17, 0xf3, 0x76, 0xe9, 0x3d, 0xd2, 0xc2,
-	0xe6, 0xa0, 0x42, 0xcc, 0xc7, 0x0f, 0x97, 0x40, 0xd9, 0xa3, 0x42, 0x4c, 0x4f, 0x67, 0x89, 0x81,
-	0xbe, 0x0b, 0x53, 0x5d, 0x7c, 0xd7, 0x90, 0x78, 0xb1, 0x97, 0xc2, 0x9b, 0xec, 0xe2, 0xbb, 0x42,
-	0x3e, 0xf4, 0x01, 0x64, 0x04, 0xa4, 0xd9, 0xc6, 0xb4, 0x45, 0x3c, 0xe4, 0xf8, 0x4b, 0x21, 0xcf,
-	0x74, 0xf1, 0xdd, 0x35, 0x89, 0x26, 0xf0, 0x57, 0x13, 0x5f, 0x3e, 0x28, 0x68, 0xc5, 0xdf, 0x69,
-	0x00, 0xa1, 0x61, 0x10, 0x86, 0xac, 0x19, 0xac, 0xe4, 0x47, 0x99, 0x8a, 0xa3, 0xd7, 0xc6, 0x45,
-	0xc2, 0x3e, 0xb3, 0x96, 0x67, 0x84, 0x78, 0x8f, 0x9e, 0x14, 0x34, 0xef, 0xab, 0x19, 0x73, 0xc4,
-	0xec, 0xa9, 0x7e, 0xcf, 0xc2, 0x9c, 0x18, 0x47, 0x74, 0xb8, 0x04, 0x14, 0x4e, 0xf7, 0x00, 0xc1,
-	0xe3, 0x16, 0xe7, 0x4a, 0x87, 0x4f, 0x35, 0x48, 0x55, 0x08, 0x33, 0x5d, 0xbb, 0x27, 0xf2, 0x58,
-	0x44, 0x59, 0xd7, 0xa1, 0xf6, 0xae, 0xca, 0x81, 0x69, 0xdd, 0x5f, 0xa2, 0x3c, 0x4c, 0xd9, 0x16,
-	0xa1, 0xdc, 0xe6, 0x03, 0xcf, 0x4d, 0x7a, 0xb0, 0x16, 0x5c, 0x77, 0x48, 0x83, 0xd9, 0xbe, 0x9d,
-	0x75, 0x7f, 0x89, 0xae, 0x42, 0x96, 0x11, 0xb3, 0xef, 0xda, 0x7c, 0x60, 0x98, 0x0e, 0xe5, 0xd8,
-	0xe4, 0xb9, 0x84, 0x24, 0xc9, 0xf8, 0xfb, 0x6b, 0xde, 0xb6, 0x00, 0xb1, 0x08, 0xc7, 0x76, 0x87,
-	0xe5, 0x4e, 0x79, 0x20, 0x6a, 0xa9, 0x44, 0xbd, 0x3f, 0x09, 0xd3, 0x41, 0xea, 0xa0, 0x35, 0xc8,
-	0x3a, 0x3d, 0xe2, 0x8a, 0xdf, 0x06, 0xb6, 0x2c, 0x97, 0x30, 0xa6, 0xa2, 0x31, 0xf7, 0xf8, 0xe1,
-	0xd2, 0x19, 0x65, 0xf0, 0x9b, 0xde, 0x49, 0x9d, 0xbb, 0x36, 0x6d, 0xe9, 0x19, 0x9f, 0x43, 0x6d,
-	0xa3, 0x1f, 0x08, 0x97, 0x51, 0x46, 0x28, 0xeb, 0x33, 0xa3, 0xd7, 0x6f, 0xec, 0x92, 0x81, 0x32,
-	0xea, 0x99, 0x11, 0xa3, 0xde, 0xa4, 0x83, 0x72, 0xee, 0x0f, 0x21, 0xb4, 0xe9, 0x0e, 0x7a, 0xdc,
-	0x29, 0x6d, 0xf6, 0x1b, 0xef, 0x92, 0x81, 0x70, 0x95, 0xc2, 0xd9, 0x94, 0x30, 0xe8, 0x1c, 0x24,
-	0x7f, 0x82, 0xed, 0x0e, 0xb1, 0xa4, 0x45, 0xa6, 0x74, 0xb5, 0x42, 0xab, 0x90, 0x64, 0x1c, 0xf3,
-	0x3e, 0x93, 0x66, 0x98, 0xbd, 0x5e, 0x1c, 0x17, 0x1b, 0x65, 0x87, 0x5a, 0x75, 0x49, 0xa9, 0x2b,
-	0x0e, 0xb4, 0x06, 0x49, 0xee, 0xec, 0x12, 0xaa, 0x0c, 0x54, 0xfe, 0x86, 0x8a, 0xe6, 0xb3, 0xa3,
-	0xd1, 0x5c, 0xa3, 0x3c, 0x12, 0xc7, 0x35, 0xca, 0x75, 0xc5, 0x8a, 0x7e, 0x04, 0x59, 0x8b, 0x74,
-	0x48, 0x4b, 0x5a, 0x8e, 0xb5, 0xb1, 0x4b, 0x58, 0x2e, 0x29, 0xe1, 0x56, 0x8e, 0x9d, 0x1c, 0x7a,
-	0x26, 0x80, 0xaa, 0x4b, 0x24, 0xb4, 0x09, 0x29, 0x2b, 0x0c, 0xa7, 0xdc, 0xa4, 0x34, 0xe6, 0xab,
-	0xe3, 0x74, 0x8c, 0x44, 0x5e, 0xb4, 0x16, 0x46, 0x21, 0x44, 0x04, 0xf5, 0x69, 0xc3, 0xa1, 0x96,
-	0x4d, 0x5b, 0x46, 0x9b, 0xd8, 0xad, 0x36, 0xcf, 0x4d, 0x2d, 0x6a, 0x57, 0xe2, 0x7a, 0x26, 0xd8,
-	0xbf, 0x2d, 0xb7, 0xd1, 0x26, 0xcc, 0x86, 0xa4, 0x32, 0x43, 0xa6, 0x8f, 0x9b, 0x21, 0x33, 0x01,
-	0x80, 0x20, 0x41, 0xef, 0x03, 0x84, 0x39, 0x98, 0x03, 0x89, 0x56, 0x3c, 0x3c, 0x9b, 0xa3, 0xca,
-	0x44, 0x00, 0x10, 0x85, 0xd3, 0x5d, 0x9b, 0x1a, 0x8c, 0x74, 0x9a, 0x86, 0xb2, 0x9c, 0xc0, 0x4d,
-	0x49, 0xf3, 0xbf, 0x75, 0x0c, 0x6f, 0x7e, 0xf1, 0x70, 0x29, 0xe3, 0xad, 0x96, 0x98, 0xb5, 0xbb,
-	0xf8, 0x7a, 0xe9, 0x8d, 0x6f, 0xeb, 0x73, 0x5d, 0x9b, 0xd6, 0x49, 0xa7, 0x59, 0x09, 0x80, 0xd1,
-	0x9b, 0x70, 0x21, 0x34, 0x88, 0x43, 0x8d, 0xb6, 0xd3, 0xb1, 0x0c, 0x97, 0x34, 0x0d, 0xd3, 0xe9,
-	0x53, 0x9e, 0x4b, 0x4b, 0x33, 0x9e, 0x0f, 0x48, 0x36, 0xe8, 0x6d, 0xa7, 0x63, 0xe9, 0xa4, 0xb9,
-	0x26, 0x8e, 0xd1, 0xab, 0x10, 0x5a, 0xc3, 0xb0, 0x2d, 0x96, 0x9b, 0x59, 0x8c, 0x5f, 0x49, 0xe8,
-	0xe9, 0x60, 0xb3, 0x66, 0xb1, 0xd5, 0xa9, 0x0f, 0x1f, 0x14, 0x26, 0xbe, 0x7c, 0x50, 0x98, 0x28,
-	0xde, 0x82, 0xf4, 0x0e, 0xee, 0xa8, 0xd4, 0x22, 0x0c, 0xdd, 0x80, 0x69, 0xec, 0x2f, 0x72, 0xda,
-	0x62, 0xfc, 0x85, 0xa9, 0x19, 0x92, 0x16, 0x7f, 0xa3, 0x41, 0xb2, 0xb2, 0xb3, 0x89, 0x6d, 0x17,
-	0x55, 0x61, 0x2e, 0x8c, 0xd5, 0xa3, 0x66, 0x79, 0x18, 0xde, 0x7e, 0x9a, 0xaf, 0xc3, 0x5c, 0x70,
-	0xa7, 0x05, 0x30, 0xde, 0x55, 0x73, 0xe9, 0xf1, 0xc3, 0xa5, 0x8b, 0x0a, 0x26, 0x28, 0x2e, 0xfb,
-	0xf0, 0xf6, 0xf6, 0xed, 0x47, 0x74, 0x7e, 0x07, 0x26, 0x3d, 0x51, 0x19, 0xfa, 0x0e, 0x9c, 0xea,
-	0x89, 0x1f, 0x52, 0xd5, 0xd4, 0xf5, 0x85, 0xb1, 0x31, 0x2f, 0xe9, 0xa3, 0x11, 0xe2, 0xf1, 0x15,
-	0x3f, 0x8a, 0x01, 0x54, 0x76, 0x76, 0xb6, 0x5c, 0xbb, 0xd7, 0x21, 0xfc, 0xab, 0xd2, 0x7d, 0x1b,
-	0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7, 0x03, 0xfe, 0xba, 0x6b, 0x1e, 0x08, 0x6b,
-	0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x61, 0x7c, 0xd4, 0xb2, 0xdf, 0x87, 0x54, 0x68, 0x0c,
-	0x86, 0x6a, 0x30, 0xc5, 0xd5, 0x6f, 0x65, 0xe0, 0xe2, 0x78, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07,
-	0xec, 0xc5, 0x7f, 0x69, 0x00, 0x91, 0x1c, 0xf9, 0x7a, 0xc6, 0x18, 0xaa, 0x41, 0x52, 0x15, 0xe7,
-	0xf8, 0x49, 0x8b, 0xb3, 0x02, 0x88, 0x18, 0xf5, 0xe7, 0x31, 0x38, 0xbd, 0xed, 0x67, 0xef, 0xd7,
-	0xdf, 0x06, 0xdb, 0x30, 0x49, 0x28, 0x77, 0x6d, 0x69, 0x04, 0xe1, 0xf3, 0xd7, 0xc7, 0xf9, 0xfc,
-	0x00, 0xa5, 0xaa, 0x94, 0xbb, 0x83, 0x68, 0x04, 0xf8, 0x58, 0x11, 0x7b, 0xfc, 0x32, 0x0e, 0xb9,
-	0x71, 0xac, 0xe2, 0x81, 0x6c, 0xba, 0x44, 0x6e, 0xf8, 0xf7, 0x8e, 0x26, 0x0b, 0xe6, 0xac, 0xbf,
-	0xad, 0xae, 0x1d, 0x1d, 0xc4, 0x43, 0x4d, 0x04, 0x97, 0x20, 0x3d, 0xd9, 0xcb, 0x6c, 0x36, 0x44,
-	0x90, 0x17, 0xcf, 0x16, 0x64, 0x6c, 0x6a, 0x73, 0x1b, 0x77, 0x8c, 0x06, 0xee, 0x60, 0x6a, 0xfa,
-	0x2f, 0xd8, 0x63, 0xdd, 0xf9, 0xb3, 0x0a, 0xa3, 0xec, 0x41, 0xa0, 0x2a, 0x4c, 0xfa, 0x68, 0x89,
-	0xe3, 0xa3, 0xf9, 0xbc, 0xe8, 0x12, 0xa4, 0xa3, 0x17, 0x83, 0x7c, 0x8d, 0x24, 0xf4, 0x54, 0xe4,
-	0x5e, 0x38, 0xec, 0xe6, 0x49, 0xbe, 0xf0, 0xe6, 0x51, 0x0f, 0xbe, 0x5f, 0xc5, 0x61, 0x4e, 0x27,
-	0xd6, 0x7f, 0xbf, 0x5b, 0x36, 0x01, 0xbc, 0x54, 0x15, 0x95, 0x54, 0x79, 0xe6, 0x04, 0xf9, 0x3e,
-	0xed, 0x81, 0x54, 0x18, 0xff, 0x4f, 0x79, 0xe8, 0xcf, 0x31, 0x48, 0x47, 0x3d, 0xf4, 0x3f, 0x79,
-	0x69, 0xa1, 0xf5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba, 0x3a, 0xae, 0x4c, 0x8d, 0x44, 0xf3, 0x21,
-	0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x9b, 0xd8, 0xc5, 0x5d, 0x86, 0x36, 0x46, 0xde, 0xb6, 0xfe, 0x8c,
-	0x62, 0x7f, 0x30, 0x57, 0xd4, 0x4c, 0xc6, 0x8b, 0xe5, 0x4f, 0xc6, 0x3d, 0x6d, 0xff, 0x0f, 0x66,
-	0x45, 0x8f, 0x1c, 0x36, 0xfb, 0xd2, 0xb8, 0x33, 0xb2, 0xd5, 0x0d, 0xb4, 0x67, 0xa8, 0x00, 0x29,
-	0x41, 0x16, 0xd6, 0x61, 0x41, 0x03, 0x5d, 0x7c, 0xb7, 0xea, 0xed, 0xa0, 0x25, 0x40, 0xed, 0x60,
-	0x56, 0x61, 0x84, 0x86, 0x10, 0x74, 0x73, 0xe1, 0x89, 0x4f, 0x7e, 0x11, 0x40, 0x48, 0x61, 0x58,
-	0x84, 0x3a, 0x5d, 0xd5, 0xe8, 0x4d, 0x8b, 0x9d, 0x8a, 0xd8, 0x40, 0x3f, 0xd3, 0xbc, 0x27, 0xf2,
-	0xbe, 0x4e, 0x5a, 0x75, 0x28, 0x5b, 0x47, 0x48, 0x8a, 0x7f, 0x3e, 0x29, 0xe4, 0x07, 0xb8, 0xdb,
-	0x59, 0x2d, 0x1e, 0x80, 0x53, 0x3c, 0xa8, 0xb9, 0x17, 0x0f, 0xe7, 0xe1, 0x4e, 0x1c, 0xd5, 0x20,
-	0xbb, 0x4b, 0x06, 0x86, 0xeb, 0x70, 0xaf, 0xd0, 0x34, 0x09, 0x51, 0xbd, 0xcc, 0xbc, 0xef, 0xdb,
-	0x06, 0x66, 0x24, 0xf2, 0xf4, 0xb7, 0x69, 0x39, 0x21, 0xa4, 0xd3, 0x67, 0x77, 0xc9, 0x40, 0x57,
-	0x7c, 0xb7, 0x08, 0x59, 0xbd, 0x2c, 0x32, 0xe5, 0xde, 0xf3, 0xcf, 0xae, 0x5d, 0x08, 0x1f, 0xec,
-	0xcb, 0x77, 0x83, 0x89, 0x9d, 0xe7, 0x5e, 0xf1, 0xe8, 0x45, 0xe1, 0x05, 0xa4, 0x13, 0xd6, 0x13,
-	0xfd, 0xa4, 0xe8, 0x3f, 0x22, 0x7d, 0x82, 0xf6, 0xe2, 0xfe, 0x23, 0xe4, 0x1f, 0xea, 0x3f, 0x22,
-	0xe9, 0xf9, 0x56, 0x58, 0xff, 0x63, 0x87, 0x69, 0x13, 0x8d, 0x4c, 0xc5, 0x24, 0xb3, 0x7e, 0xa2,
-	0xf8, 0x47, 0x0d, 0xe6, 0x47, 0x22, 0x39, 0x10, 0xd9, 0x04, 0xe4, 0x46, 0x0e, 0x65, 0x44, 0x0c,
-	0x94, 0xe8, 0x27, 0x4b, 0x8c, 0x39, 0x77, 0xe4, 0x12, 0xf8, 0x6a, 0x2e, 0x32, 0x55, 0xc5, 0x7e,
-	0xaf, 0xc1, 0x99, 0xa8, 0x00, 0x81, 0x2a, 0x75, 0x48, 0x47, 0x3f, 0xad, 0x94, 0xb8, 0x7c, 0x14,
-	0x25, 0xa2, 0xf2, 0x0f, 0x81, 0xa0, 0x9d, 0xb0, 0x5a, 0x78, 0x73, 0xc2, 0x95, 0x23, 0x1b, 0xc5,
-	0x17, 0xec, 0xc0, 0xaa, 0xe1, 0xf9, 0xe6, 0xef, 0x1a, 0x24, 0x36, 0x1d, 0xa7, 0x83, 0x7e, 0x0a,
-	0x73, 0xd4, 0xe1, 0x86, 0xc8, 0x2c, 0x62, 0x19, 0x6a, 0x6c, 0xe0, 0x55, 0xe2, 0xea, 0x0b, 0x6d,
-	0xf5, 0xb7, 0x27, 0x85, 0x51, 0xce, 0x61, 0x03, 0xaa, 0xe9, 0x14, 0x75, 0x78, 0x59, 0x12, 0x6d,
-	0x79, 0x93, 0x85, 0x26, 0xcc, 0x0c, 0x7f, 0xce, 0xab, 0xd6, 0x37, 0x0f, 0xfb, 0xdc, 0xcc, 0xa1,
-	0x9f, 0x4a, 0x37, 0x22, 0xdf, 0x59, 0x9d, 0x12, 0x5e, 0xfb, 0x87, 0xf0, 0xdc, 0x07, 0x90, 0x0d,
-	0x4a, 0xd5, 0xb6, 0x1c, 0x6d, 0x31, 0x74, 0x0b, 0x26, 0xbd, 0x29, 0x97, 0xdf, 0x28, 0x5c, 0x0a,
-	0xa7, 0xb8, 0xb8, 0x61, 0xda, 0xa5, 0xbd, 0xc8, 0x04, 0xd6, 0x63, 0x1a, 0xb2, 0xa7, 0x62, 0x96,
-	0x83, 0xd8, 0x47, 0x31, 0x98, 0x5f, 0x73, 0x28, 0x53, 0x43, 0x1e, 0x95, 0xd5, 0xde, 0x68, 0x76,
-	0x80, 0xae, 0x8e, 0x19, 0x41, 0xa5, 0x47, 0x07, 0x4d, 0x3b, 0x90, 0x11, 0xd7, 0xab, 0xe9, 0xd0,
-	0x97, 0x9c, 0x33, 0xcd, 0x38, 0x1d, 0x4b, 0x49, 0xb4, 0x4b, 0x06, 0x02, 0x97, 0x92, 0x3b, 0x43,
-	0xb8, 0xf1, 0x93, 0xe1, 0x52, 0x72, 0x27, 0x82, 0x7b, 0x0e, 0x92, 0xea, 0x6d, 0x95, 0x90, 0x2f,
-	0x07, 0xb5, 0x42, 0x37, 0x20, 0x2e, 0x4a, 0xe1, 0xa9, 0x63, 0x14, 0x0f, 0xc1, 0x10, 0xb9, 0xd2,
-	0xea, 0x30, 0xaf, 0xa6, 0x04, 0x6c, 0xa3, 0x29, 0x2d, 0x4a, 0xa4, 0x42, 0xef, 0x92, 0xc1, 0x01,
-	0x23, 0x83, 0xf4, 0x91, 0x46, 0x06, 0xd7, 0x7e, 0xab, 0x01, 0x84, 0xf3, 0x32, 0xf4, 0x4d, 0x38,
-	0x5f, 0xde, 0x58, 0xaf, 0x18, 0xf5, 0xad, 0x9b, 0x5b, 0xdb, 0x75, 0x63, 0x7b, 0xbd, 0xbe, 0x59,
-	0x5d, 0xab, 0xdd, 0xaa, 0x55, 0x2b, 0xd9, 0x89, 0x7c, 0xe6, 0xde, 0xfd, 0xc5, 0xd4, 0x36, 0x65,
-	0x3d, 0x62, 0xda, 0x4d, 0x9b, 0x58, 0xe8, 0xff, 0xe1, 0xcc, 0x30, 0xb5, 0x58, 0x55, 0x2b, 0x59,
-	0x2d, 0x9f, 0xbe, 0x77, 0x7f, 0x71, 0xca, 0xeb, 0x0f, 0x88, 0x85, 0xae, 0xc0, 0xd9, 0x51, 0xba,
-	0xda, 0xfa, 0xdb, 0xd9, 0x58, 0x7e, 0xe6, 0xde, 0xfd, 0xc5, 0xe9, 0xa0, 0x91, 0x40, 0x45, 0x40,
-	0x51, 0x4a, 0x85, 0x17, 0xcf, 0xc3, 0xbd, 0xfb, 0x8b, 0x49, 0x2f, 0x65, 0xf2, 0x89, 0x0f, 0x7f,
-	0xbd, 0x30, 0x71, 0xed, 0xc7, 0x00, 0x35, 0xda, 0x74, 0xb1, 0x29, 0x4b, 0x43, 0x1e, 0xce, 0xd5,
-	0xd6, 0x6f, 0xe9, 0x37, 0xd7, 0xb6, 0x6a, 0x1b, 0xeb, 0xc3, 0x62, 0xef, 0x3b, 0xab, 0x6c, 0x6c,
-	0x97, 0xdf, 0xab, 0x1a, 0xf5, 0xda, 0xdb, 0xeb, 0x59, 0x0d, 0x9d, 0x87, 0xd3, 0x43, 0x67, 0xdf,
-	0x5b, 0xdf, 0xaa, 0xbd, 0x5f, 0xcd, 0xc6, 0xca, 0x37, 0x3e, 0x7f, 0xba, 0xa0, 0x3d, 0x7a, 0xba,
-	0xa0, 0xfd, 0xf5, 0xe9, 0x82, 0xf6, 0xf1, 0xb3, 0x85, 0x89, 0x47, 0xcf, 0x16, 0x26, 0xfe, 0xf4,
-	0x6c, 0x61, 0xe2, 0x87, 0xaf, 0x0c, 0x25, 0x63, 0x78, 0x1d, 0xc9, 0xff, 0x73, 0x34, 0x92, 0x32,
-	0x6a, 0xbe, 0xf5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0xa6, 0xd0, 0x99, 0x5f, 0x1a, 0x00,
-	0x00,
+	// 2446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xb4, 0x3e, 0x9e, 0x3e, 0x28, 0x8d, 0x1d, 0x9b, 0x62, 0x6c, 0x49, 0x66, 0xf2,
+	0xff, 0xc7, 0x71, 0x2b, 0xca, 0x72, 0x03, 0x17, 0x10, 0x82, 0x04, 0xa6, 0x28, 0xc7, 0x4c, 0x1c,
+	0x99, 0x59, 0x4a, 0xea, 0x07, 0xda, 0x2c, 0x86, 0xbb, 0x43, 0x6a, 0xab, 0xe5, 0x0c, 0xb3, 0x33,
+	0x94, 0xcd, 0x9e, 0x7b, 0x08, 0x1c, 0x14, 0xc8, 0xa9, 0x08, 0x50, 0x18, 0x0d, 0xd0, 0x43, 0xd3,
+	0x5b, 0x0e, 0x46, 0xef, 0x45, 0x2f, 0x69, 0x81, 0x02, 0x86, 0x4f, 0x45, 0x80, 0x3a, 0x45, 0x72,
+	0x48, 0xd0, 0x5c, 0x8a, 0x9e, 0x7a, 0x2c, 0x66, 0x76, 0xf6, 0x83, 0x1f, 0xb2, 0x25, 0xcb, 0x28,
+	0x82, 0xf6, 0x42, 0x70, 0x66, 0xde, 0xfb, 0xcd, 0xbc, 0x37, 0xef, 0x6b, 0xde, 0xc2, 0xf3, 0x36,
+	0xe3, 0x2d, 0xc6, 0x57, 0xb8, 0xc0, 0x7b, 0x2e, 0x6d, 0xae, 0xec, 0xaf, 0xd6, 0x89, 0xc0, 0xab,
+	0xe1, 0xb8, 0xd8, 0xf6, 0x99, 0x60, 0xe8, 0x74, 0x40, 0x55, 0x0c, 0x67, 0x35, 0x55, 0xfe, 0x54,
+	0x93, 0x35, 0x99, 0x22, 0x59, 0x91, 0xff, 0x02, 0xea, 0xfc, 0x7c, 0x93, 0xb1, 0xa6, 0x47, 0x56,
+	0xd4, 0xa8, 0xde, 0x69, 0xac, 0x60, 0xda, 0xd5, 0x4b, 0x0b, 0xfd, 0x4b, 0x4e, 0xc7, 0xc7, 0xc2,
+	0x65, 0x54, 0xaf, 0x2f, 0xf6, 0xaf, 0x0b, 0xb7, 0x45, 0xb8, 0xc0, 0xad, 0x76, 0x88, 0x1d, 0x9c,
+	0xc4, 0x0a, 0x36, 0xd5, 0xc7, 0xd2, 0xd8, 0x5a, 0x94, 0x3a, 0xe6, 0x24, 0x92, 0xc3, 0x66, 0x6e,
+	0x88, 0x3d, 0x87, 0x5b, 0x2e, 0x65, 0x2b, 0xea, 0x57, 0x4f, 0x9d, 0xb3, 0x59, 0x8b, 0x88, 0x7a,
+	0x43, 0xac, 0x88, 0x6e, 0x9b, 0xf0, 0x95, 0xfd, 0xd5, 0xe0, 0x8f, 0x5e, 0x3e, 0x1b, 0x2d, 0xe3,
+	0xba, 0xed, 0xf6, 0xad, 0x16, 0x3e, 0x30, 0x60, 0xe6, 0xba, 0xcb, 0x05, 0xf3, 0x5d, 0x1b, 0x7b,
+	0x15, 0xda, 0x60, 0xe8, 0x65, 0x18, 0xdd, 0x25, 0xd8, 0x21, 0x7e, 0xce, 0x58, 0x32, 0x2e, 0x4c,
+	0x5e, 0x9e, 0x2f, 0x86, 0x08, 0xc5, 0x80, 0x73, 0x7f, 0xb5, 0x78, 0x5d, 0x11, 0x94, 0x26, 0x3e,
+	0x79, 0xb8, 0x38, 0xf2, 0xd1, 0x97, 0x1f, 0x5f, 0x34, 0x4c, 0xcd, 0x83, 0xca, 0x30, 0xba, 0x8f,
+	0x3d, 0x4e, 0x44, 0x2e, 0xb5, 0x94, 0xbe, 0x30, 0x79, 0xf9, 0x7c, 0x71, 0xb8, 0xda, 0x8b, 0x3b,
+	0xd8, 0x73, 0x1d, 0x2c, 0x58, 0x2f, 0x4a, 0xc0, 0xbb, 0x96, 0xca, 0x19, 0x85, 0xf7, 0x0c, 0x98,
+	0x8d, 0x8f, 0x66, 0x12, 0x9b, 0xf9, 0x0e, 0xca, 0xc1, 0x18, 0x6e, 0xb7, 0x77, 0x31, 0xdf, 0x55,
+	0xa7, 0x9b, 0x32, 0xc3, 0x21, 0x7a, 0x09, 0x32, 0x52, 0xcf, 0xb9, 0x94, 0x3a, 0x74, 0xbe, 0x18,
+	0x5c, 0x42, 0x31, 0xbc, 0x84, 0xe2, 0x56, 0x78, 0x09, 0xa5, 0xcc, 0xfb, 0x9f, 0x2d, 0x1a, 0xa6,
+	0xa2, 0x46, 0x2f, 0x40, 0x76, 0x3f, 0x3c, 0x08, 0xb7, 0x14, 0x6e, 0x5a, 0xe1, 0xce, 0xc4, 0xd3,
+	0xd7, 0x31, 0xdf, 0x2d, 0xfc, 0x22, 0x05, 0xd9, 0x75, 0xd6, 0x6a, 0xb9, 0x9c, 0xbb, 0x8c, 0x9a,
+	0x58, 0x10, 0x8e, 0x5e, 0x87, 0x8c, 0x8f, 0x05, 0x51, 0x27, 0x99, 0x28, 0x5d, 0x91, 0x62, 0x7c,
+	0xfa, 0x70, 0xf1, 0xd9, 0x40, 0x60, 0xee, 0xec, 0x15, 0x5d, 0xb6, 0xd2, 0xc2, 0x62, 0xb7, 0x78,
+	0x83, 0x34, 0xb1, 0xdd, 0x2d, 0x13, 0xfb, 0xc1, 0xbd, 0x65, 0xd0, 0xfa, 0x28, 0x13, 0x3b, 0x90,
+	0x59, 0x61, 0xa0, 0xb7, 0x60, 0xbc, 0x85, 0x6f, 0x5b, 0x0a, 0x2f, 0x75, 0x2c, 0xbc, 0xb1, 0x16,
+	0xbe, 0x2d, 0xcf, 0x87, 0xde, 0x86, 0xac, 0x84, 0xb4, 0x77, 0x31, 0x6d, 0x92, 0x00, 0x39, 0x7d,
+	0x2c, 0xe4, 0xe9, 0x16, 0xbe, 0xbd, 0xae, 0xd0, 0x24, 0xfe, 0x5a, 0xe6, 0xab, 0x0f, 0x17, 0x8d,
+	0xc2, 0xef, 0x0d, 0x80, 0x58, 0x31, 0x08, 0xc3, 0xac, 0x1d, 0x8d, 0xd4, 0xa6, 0x5c, 0xdb, 0xd1,
+	0x0b, 0x07, 0x59, 0x42, 0x9f, 0x5a, 0x4b, 0xd3, 0xf2, 0x78, 0xf7, 0x1f, 0x2e, 0x1a, 0xc1, 0xae,
+	0x59, 0x7b, 0x40, 0xed, 0x93, 0x9d, 0xb6, 0x83, 0x05, 0xb1, 0x0e, 0x79, 0xe1, 0x0a, 0xf0, 0xfd,
+	0xcf, 0x42, 0x40, 0x08, 0xb8, 0xe5, 0xba, 0x96, 0xe1, 0x23, 0x03, 0x26, 0xcb, 0x84, 0xdb, 0xbe,
+	0xdb, 0x96, 0x7e, 0x2c, 0xad, 0xac, 0xc5, 0xa8, 0xbb, 0xa7, 0x7d, 0x60, 0xc2, 0x0c, 0x87, 0x28,
+	0x0f, 0xe3, 0xae, 0x43, 0xa8, 0x70, 0x45, 0x37, 0xb8, 0x26, 0x33, 0x1a, 0x4b, 0xae, 0x5b, 0xa4,
+	0xce, 0xdd, 0x50, 0xcf, 0x66, 0x38, 0x44, 0x2f, 0xc2, 0x2c, 0x27, 0x76, 0xc7, 0x77, 0x45, 0xd7,
+	0xb2, 0x19, 0x15, 0xd8, 0x16, 0xb9, 0x8c, 0x22, 0xc9, 0x86, 0xf3, 0xeb, 0xc1, 0xb4, 0x04, 0x71,
+	0x88, 0xc0, 0xae, 0xc7, 0x73, 0x27, 0x02, 0x10, 0x3d, 0xd4, 0x47, 0xbd, 0x3b, 0x06, 0x13, 0x91,
+	0xeb, 0xa0, 0x75, 0x98, 0x65, 0x6d, 0xe2, 0xcb, 0xff, 0x16, 0x76, 0x1c, 0x9f, 0x70, 0xae, 0xad,
+	0x31, 0xf7, 0xe0, 0xde, 0xf2, 0x29, 0xad, 0xf0, 0xab, 0xc1, 0x4a, 0x4d, 0xf8, 0x2e, 0x6d, 0x9a,
+	0xd9, 0x90, 0x43, 0x4f, 0xa3, 0x1f, 0xc8, 0x2b, 0xa3, 0x9c, 0x50, 0xde, 0xe1, 0x56, 0xbb, 0x53,
+	0xdf, 0x23, 0x5d, 0xad, 0xd4, 0x53, 0x03, 0x4a, 0xbd, 0x4a, 0xbb, 0xa5, 0xdc, 0x9f, 0x62, 0x68,
+	0xdb, 0xef, 0xb6, 0x05, 0x2b, 0x56, 0x3b, 0xf5, 0x37, 0x48, 0xd7, 0xcc, 0x46, 0x38, 0x55, 0x05,
+	0x83, 0x4e, 0xc3, 0xe8, 0x4f, 0xb0, 0xeb, 0x11, 0x47, 0x69, 0x64, 0xdc, 0xd4, 0x23, 0xb4, 0x06,
+	0xa3, 0x5c, 0x60, 0xd1, 0xe1, 0x4a, 0x0d, 0x33, 0x97, 0x0b, 0x07, 0xd9, 0x46, 0x89, 0x51, 0xa7,
+	0xa6, 0x28, 0x4d, 0xcd, 0x81, 0xd6, 0x61, 0x54, 0xb0, 0x3d, 0x42, 0xb5, 0x82, 0x4a, 0xdf, 0xd2,
+	0xd6, 0xfc, 0xcc, 0xa0, 0x35, 0x57, 0xa8, 0x48, 0xd8, 0x71, 0x85, 0x0a, 0x53, 0xb3, 0xa2, 0x1f,
+	0xc1, 0xac, 0x43, 0x3c, 0xd2, 0x54, 0x9a, 0xe3, 0xbb, 0xd8, 0x27, 0x3c, 0x37, 0xaa, 0xe0, 0x56,
+	0x8f, 0xec, 0x1c, 0x66, 0x36, 0x82, 0xaa, 0x29, 0x24, 0x54, 0x85, 0x49, 0x27, 0x36, 0xa7, 0xdc,
+	0x98, 0x52, 0xe6, 0x73, 0x07, 0xc9, 0x98, 0xb0, 0xbc, 0x64, 0x2c, 0x4c, 0x42, 0x48, 0x0b, 0xea,
+	0xd0, 0x3a, 0xa3, 0x8e, 0x4b, 0x9b, 0xd6, 0x2e, 0x71, 0x9b, 0xbb, 0x22, 0x37, 0xbe, 0x64, 0x5c,
+	0x48, 0x9b, 0xd9, 0x68, 0xfe, 0xba, 0x9a, 0x46, 0x55, 0x98, 0x89, 0x49, 0x95, 0x87, 0x4c, 0x1c,
+	0xd5, 0x43, 0xa6, 0x23, 0x00, 0x49, 0x82, 0xde, 0x04, 0x88, 0x7d, 0x30, 0x07, 0x0a, 0xad, 0xf0,
+	0x78, 0x6f, 0x4e, 0x0a, 0x93, 0x00, 0x40, 0x14, 0x4e, 0xb6, 0x5c, 0x6a, 0x71, 0xe2, 0x35, 0x2c,
+	0xad, 0x39, 0x89, 0x3b, 0xa9, 0xd4, 0xff, 0xca, 0x11, 0x6e, 0xf3, 0xd3, 0x7b, 0xcb, 0xd9, 0x60,
+	0xb4, 0xcc, 0x9d, 0xbd, 0xa5, 0x4b, 0xc5, 0x97, 0xbe, 0x6b, 0xce, 0xb5, 0x5c, 0x5a, 0x23, 0x5e,
+	0xa3, 0x1c, 0x01, 0xa3, 0x97, 0xe1, 0xd9, 0x58, 0x21, 0x8c, 0x5a, 0xbb, 0xcc, 0x73, 0x2c, 0x9f,
+	0x34, 0x2c, 0x9b, 0x75, 0xa8, 0xc8, 0x4d, 0x29, 0x35, 0x9e, 0x89, 0x48, 0x6e, 0xd2, 0xeb, 0xcc,
+	0x73, 0x4c, 0xd2, 0x58, 0x97, 0xcb, 0xe8, 0x39, 0x88, 0xb5, 0x61, 0xb9, 0x0e, 0xcf, 0x4d, 0x2f,
+	0xa5, 0x2f, 0x64, 0xcc, 0xa9, 0x68, 0xb2, 0xe2, 0xf0, 0xb5, 0xf1, 0x77, 0x3f, 0x5c, 0x1c, 0xf9,
+	0xea, 0xc3, 0xc5, 0x91, 0xc2, 0x35, 0x98, 0xda, 0xc1, 0x9e, 0x76, 0x2d, 0xc2, 0xd1, 0x15, 0x98,
+	0xc0, 0xe1, 0x20, 0x67, 0x2c, 0xa5, 0x1f, 0xe9, 0x9a, 0x31, 0x69, 0xe1, 0xb7, 0x06, 0x8c, 0x96,
+	0x77, 0xaa, 0xd8, 0xf5, 0xd1, 0x06, 0xcc, 0xc5, 0xb6, 0x7a, 0x58, 0x2f, 0x8f, 0xcd, 0x3b, 0x74,
+	0xf3, 0x4d, 0x98, 0x8b, 0x72, 0x5a, 0x04, 0x13, 0xa4, 0x9a, 0xf3, 0x0f, 0xee, 0x2d, 0x9f, 0xd3,
+	0x30, 0x51, 0x70, 0xe9, 0xc3, 0xdb, 0xef, 0x9b, 0x4f, 0xc8, 0xfc, 0x3a, 0x8c, 0x05, 0x47, 0xe5,
+	0xe8, 0x55, 0x38, 0xd1, 0x96, 0x7f, 0x94, 0xa8, 0x93, 0x97, 0x17, 0x0e, 0xb4, 0x79, 0x45, 0x9f,
+	0xb4, 0x90, 0x80, 0xaf, 0xf0, 0x5e, 0x0a, 0xa0, 0xbc, 0xb3, 0xb3, 0xe5, 0xbb, 0x6d, 0x8f, 0x88,
+	0xa7, 0x25, 0xfb, 0x36, 0x3c, 0x13, 0xcb, 0xce, 0x7d, 0xfb, 0xe8, 0xf2, 0x9f, 0x8c, 0xf8, 0x6b,
+	0xbe, 0x3d, 0x14, 0xd6, 0xe1, 0x22, 0x82, 0x4d, 0x1f, 0x1d, 0xb6, 0xcc, 0xc5, 0xa0, 0x66, 0xbf,
+	0x0f, 0x93, 0xb1, 0x32, 0x38, 0xaa, 0xc0, 0xb8, 0xd0, 0xff, 0xb5, 0x82, 0x0b, 0x07, 0x2b, 0x38,
+	0x64, 0x4b, 0x2a, 0x39, 0x62, 0x2f, 0xfc, 0xcb, 0x00, 0x48, 0xf8, 0xc8, 0x37, 0xd3, 0xc6, 0x50,
+	0x05, 0x46, 0x75, 0x70, 0x4e, 0x3f, 0x69, 0x70, 0xd6, 0x00, 0x09, 0xa5, 0xfe, 0x3c, 0x05, 0x27,
+	0xb7, 0x43, 0xef, 0xfd, 0xe6, 0xeb, 0x60, 0x1b, 0xc6, 0x08, 0x15, 0xbe, 0xab, 0x94, 0x20, 0xef,
+	0xfc, 0xd2, 0x41, 0x77, 0x3e, 0x44, 0xa8, 0x0d, 0x2a, 0xfc, 0x6e, 0xd2, 0x02, 0x42, 0xac, 0x84,
+	0x3e, 0x7e, 0x99, 0x86, 0xdc, 0x41, 0xac, 0xb2, 0x40, 0xb6, 0x7d, 0xa2, 0x26, 0xc2, 0xbc, 0x63,
+	0xa8, 0x80, 0x39, 0x13, 0x4e, 0xeb, 0xb4, 0x63, 0x82, 0x2c, 0xd4, 0xa4, 0x71, 0x49, 0xd2, 0x27,
+	0xab, 0xcc, 0x66, 0x62, 0x04, 0x95, 0x78, 0xb6, 0x20, 0xeb, 0x52, 0x57, 0xb8, 0xd8, 0xb3, 0xea,
+	0xd8, 0xc3, 0xd4, 0x0e, 0x2b, 0xd8, 0x23, 0xe5, 0xfc, 0x19, 0x8d, 0x51, 0x0a, 0x20, 0xd0, 0x06,
+	0x8c, 0x85, 0x68, 0x99, 0xa3, 0xa3, 0x85, 0xbc, 0xe8, 0x3c, 0x4c, 0x25, 0x13, 0x83, 0xaa, 0x46,
+	0x32, 0xe6, 0x64, 0x22, 0x2f, 0x3c, 0x2e, 0xf3, 0x8c, 0x3e, 0x32, 0xf3, 0xe8, 0x82, 0xef, 0x57,
+	0x69, 0x98, 0x33, 0x89, 0xf3, 0xdf, 0x7f, 0x2d, 0x55, 0x80, 0xc0, 0x55, 0x65, 0x24, 0xcd, 0x65,
+	0x9e, 0xd4, 0xdf, 0x27, 0x02, 0x90, 0x32, 0x17, 0xff, 0xa9, 0x1b, 0xfa, 0x6b, 0x0a, 0xa6, 0x92,
+	0x37, 0xf4, 0x3f, 0x99, 0xb4, 0xd0, 0x66, 0x1c, 0xa6, 0x32, 0x2a, 0x4c, 0xbd, 0x78, 0x50, 0x98,
+	0x1a, 0xb0, 0xe6, 0xc7, 0xc4, 0xa7, 0xaf, 0x27, 0x60, 0xb4, 0x8a, 0x7d, 0xdc, 0xe2, 0xe8, 0xe6,
+	0x40, 0x6d, 0x1b, 0xf6, 0x28, 0xfa, 0x8d, 0xb9, 0xac, 0x7b, 0x32, 0x81, 0x2d, 0x7f, 0x70, 0x50,
+	0x69, 0xfb, 0x7f, 0x30, 0x23, 0xdf, 0xc8, 0x91, 0x40, 0x81, 0x72, 0xa7, 0xd5, 0x53, 0x37, 0x92,
+	0x9e, 0xa3, 0x45, 0x98, 0x94, 0x64, 0x71, 0x1c, 0x96, 0x34, 0xd0, 0xc2, 0xb7, 0x37, 0x82, 0x19,
+	0xb4, 0x0c, 0x68, 0x37, 0xea, 0x55, 0x58, 0xb1, 0x22, 0x24, 0xdd, 0x5c, 0xbc, 0x12, 0x92, 0x9f,
+	0x03, 0x90, 0xa7, 0xb0, 0x1c, 0x42, 0x59, 0x4b, 0x3f, 0xf4, 0x26, 0xe4, 0x4c, 0x59, 0x4e, 0xa0,
+	0x9f, 0x19, 0x41, 0x89, 0xdc, 0xf7, 0x92, 0xd6, 0x2f, 0x94, 0xad, 0x43, 0x38, 0xc5, 0x3f, 0x1f,
+	0x2e, 0xe6, 0xbb, 0xb8, 0xe5, 0xad, 0x15, 0x86, 0xe0, 0x14, 0x86, 0x3d, 0xee, 0x65, 0xe1, 0xdc,
+	0xfb, 0x12, 0x47, 0x15, 0x98, 0xdd, 0x23, 0x5d, 0xcb, 0x67, 0x22, 0x08, 0x34, 0x0d, 0x42, 0x72,
+	0x63, 0x51, 0x4f, 0x48, 0xb1, 0xcb, 0x3e, 0x55, 0xa2, 0xf4, 0x77, 0x69, 0x29, 0x23, 0x4f, 0x67,
+	0xce, 0xec, 0x91, 0xae, 0xa9, 0xf9, 0xae, 0x11, 0x82, 0xde, 0x81, 0xf9, 0xa6, 0xc7, 0xea, 0xd8,
+	0xb3, 0x3c, 0xf7, 0x9d, 0x8e, 0xeb, 0x58, 0xda, 0x28, 0x2c, 0x1b, 0xb7, 0x73, 0xe3, 0xc7, 0xea,
+	0x4a, 0x9c, 0x0e, 0x80, 0x6f, 0x28, 0xdc, 0x5a, 0x00, 0xbb, 0x8e, 0xdb, 0xe8, 0x16, 0x9c, 0x8d,
+	0xed, 0x7c, 0xc8, 0xae, 0x13, 0xc7, 0xda, 0x75, 0x3e, 0xc2, 0x1e, 0xd8, 0x98, 0x43, 0xde, 0xa5,
+	0x5c, 0x60, 0x2a, 0xac, 0xd8, 0x58, 0x1b, 0x44, 0xb7, 0x60, 0xe0, 0x58, 0xdb, 0x9e, 0xd1, 0xc8,
+	0x51, 0xbe, 0xbe, 0x46, 0x54, 0x33, 0x06, 0x71, 0x38, 0x37, 0xb8, 0x69, 0xdd, 0x63, 0xf6, 0x9e,
+	0xe5, 0xb9, 0x2d, 0x57, 0xe8, 0xe7, 0xd5, 0xa5, 0x23, 0x44, 0xe8, 0x60, 0xc7, 0x7c, 0xff, 0x8e,
+	0x25, 0x09, 0x7a, 0x43, 0x62, 0xa2, 0x1a, 0xbc, 0x30, 0x5c, 0x52, 0xc1, 0x94, 0xcd, 0x75, 0xa8,
+	0xec, 0x78, 0xb4, 0x19, 0xf3, 0xd4, 0x2b, 0x6b, 0xdc, 0x2c, 0x0c, 0x39, 0xfe, 0x16, 0x5b, 0x0f,
+	0x49, 0xab, 0x8c, 0x79, 0x88, 0xc1, 0x62, 0xc2, 0x5e, 0x75, 0xf7, 0x8a, 0x32, 0xe1, 0xda, 0xc4,
+	0x6a, 0x13, 0xdf, 0x65, 0x4e, 0x6e, 0xfa, 0x88, 0x4e, 0x7f, 0x36, 0x06, 0x0c, 0xfa, 0x57, 0x9b,
+	0x0a, 0xae, 0xaa, 0xd0, 0x50, 0x03, 0x4e, 0xaa, 0x3e, 0x59, 0x9f, 0xb3, 0xcd, 0x1c, 0xeb, 0xa2,
+	0xe6, 0x64, 0xaf, 0xac, 0xc7, 0x9d, 0xd6, 0x9e, 0x97, 0xd9, 0xe2, 0xce, 0x97, 0x1f, 0x5f, 0x7c,
+	0x36, 0x7e, 0xb4, 0xae, 0xdc, 0x8e, 0xba, 0xd6, 0x41, 0x88, 0x93, 0x0f, 0x3f, 0x14, 0x17, 0x61,
+	0x26, 0xe1, 0x6d, 0x46, 0xb9, 0x7a, 0x83, 0x27, 0xde, 0xca, 0xc6, 0xa3, 0xdf, 0xe0, 0x31, 0x7f,
+	0xcf, 0x1b, 0x3c, 0x91, 0xa2, 0x5e, 0x89, 0x6b, 0xa0, 0xd4, 0xe3, 0x3c, 0x3a, 0x19, 0x9d, 0x35,
+	0x93, 0xca, 0x7c, 0x23, 0x85, 0x3f, 0x1b, 0x30, 0x3f, 0x10, 0xcd, 0xa3, 0x23, 0xdb, 0x80, 0xfc,
+	0xc4, 0xa2, 0x8a, 0x8a, 0x5d, 0x7d, 0xf4, 0x27, 0x4b, 0x0e, 0x73, 0x7e, 0xff, 0xea, 0x53, 0x2a,
+	0xe6, 0x74, 0x26, 0xff, 0xa3, 0x01, 0xa7, 0x92, 0x07, 0x88, 0x44, 0xa9, 0xc1, 0x54, 0x72, 0x6b,
+	0x2d, 0xc4, 0xf3, 0x87, 0x11, 0x22, 0x79, 0xfe, 0x1e, 0x10, 0xb4, 0x13, 0x67, 0xcc, 0xa0, 0x57,
+	0xbe, 0x7a, 0x68, 0xa5, 0x84, 0x07, 0x1b, 0x9a, 0x39, 0x83, 0xbb, 0xf9, 0xda, 0x80, 0x8c, 0xf2,
+	0xa7, 0x77, 0x60, 0x8e, 0x32, 0x61, 0x49, 0x87, 0x23, 0x8e, 0xa5, 0x5b, 0x67, 0x41, 0x35, 0xb2,
+	0xf1, 0x48, 0x5d, 0xfd, 0xfd, 0xe1, 0xe2, 0x20, 0xe7, 0xb0, 0x10, 0x91, 0xa5, 0x4c, 0x94, 0x14,
+	0xd1, 0x96, 0xa2, 0x41, 0x0d, 0x98, 0xee, 0xdd, 0x2e, 0xa8, 0x58, 0xae, 0x3e, 0x6e, 0xbb, 0xe9,
+	0xc7, 0x6e, 0x35, 0x55, 0x4f, 0xec, 0xb3, 0x36, 0x2e, 0x6f, 0xed, 0x1f, 0xf2, 0xe6, 0xde, 0x86,
+	0xd9, 0x28, 0x5d, 0x6f, 0xab, 0xf6, 0x2e, 0x47, 0xd7, 0x60, 0x2c, 0xe8, 0xf4, 0x86, 0x8f, 0xe5,
+	0xf3, 0xf1, 0x97, 0x0c, 0xf9, 0x2d, 0x44, 0x7e, 0xc8, 0xe8, 0x63, 0xea, 0xd1, 0xa7, 0x66, 0x56,
+	0x1f, 0x23, 0xee, 0xa7, 0x60, 0x7e, 0x9d, 0x51, 0xae, 0x1b, 0x9d, 0x3a, 0xb3, 0x05, 0x9f, 0x27,
+	0xba, 0xb2, 0x3b, 0x37, 0xb4, 0x0d, 0x3b, 0x35, 0xd8, 0x6c, 0xdd, 0x81, 0xac, 0x2c, 0x31, 0x6d,
+	0x46, 0x8f, 0xd9, 0x6b, 0x9d, 0x66, 0x9e, 0xa3, 0x4f, 0x24, 0x3b, 0xad, 0x3b, 0x90, 0xa5, 0xe4,
+	0x56, 0x0f, 0x6e, 0xfa, 0xc9, 0x70, 0x29, 0xb9, 0x95, 0xc0, 0x3d, 0x2d, 0xbf, 0x06, 0xa9, 0xf7,
+	0x45, 0x46, 0x55, 0xcf, 0x7a, 0x84, 0xae, 0x40, 0x5a, 0x96, 0x03, 0x27, 0x8e, 0x10, 0x3c, 0x24,
+	0x43, 0xa2, 0xac, 0xab, 0xc1, 0xbc, 0xee, 0x94, 0xf1, 0x9b, 0x0d, 0xa5, 0x51, 0xa2, 0x04, 0x7a,
+	0x83, 0x74, 0x87, 0xb4, 0xcd, 0xa6, 0x0e, 0xd7, 0x36, 0xfb, 0x83, 0x01, 0x27, 0x95, 0x71, 0xb8,
+	0x3f, 0x25, 0xaa, 0x19, 0xab, 0xbf, 0x1b, 0xcd, 0x40, 0xca, 0x75, 0xd4, 0x9d, 0x64, 0xcc, 0x94,
+	0xeb, 0xa0, 0x22, 0x9c, 0x60, 0xb7, 0x28, 0xf1, 0xb5, 0x65, 0x1e, 0x8c, 0x1d, 0x90, 0xa9, 0x3a,
+	0x91, 0x39, 0x1d, 0x8f, 0x58, 0xd8, 0x0e, 0x9e, 0x06, 0x41, 0x8b, 0x7f, 0x3a, 0x98, 0xbd, 0x1a,
+	0x4c, 0xa2, 0x57, 0x61, 0x22, 0xaa, 0x0b, 0x72, 0x99, 0xc3, 0xd6, 0xd3, 0x31, 0x8f, 0x8e, 0x43,
+	0xbf, 0x49, 0xc1, 0x99, 0x2a, 0x51, 0x09, 0x72, 0xbd, 0x2f, 0x73, 0x0d, 0x6f, 0x2f, 0x18, 0x4f,
+	0xde, 0x5e, 0x78, 0x0b, 0xc6, 0xa5, 0xe1, 0x3c, 0x8d, 0x0f, 0x4f, 0x94, 0xdc, 0x52, 0xb5, 0x48,
+	0x15, 0x66, 0x48, 0xa3, 0x41, 0x6c, 0xe1, 0xee, 0xeb, 0x6f, 0x34, 0xe9, 0x23, 0x77, 0xa0, 0x23,
+	0x00, 0xf5, 0x99, 0xe6, 0x8c, 0x54, 0xcb, 0x83, 0x7b, 0xcb, 0xd9, 0x28, 0x5d, 0x2e, 0xad, 0x16,
+	0x2f, 0x15, 0x2f, 0x5d, 0xfc, 0x9d, 0x01, 0x10, 0x7f, 0x23, 0x40, 0xdf, 0x86, 0x33, 0xa5, 0x9b,
+	0x9b, 0x65, 0xab, 0xb6, 0x75, 0x75, 0x6b, 0xbb, 0x66, 0x6d, 0x6f, 0xd6, 0xaa, 0x1b, 0xeb, 0x95,
+	0x6b, 0x95, 0x8d, 0xf2, 0xec, 0x48, 0x3e, 0x7b, 0xe7, 0xee, 0xd2, 0xe4, 0x36, 0xe5, 0x6d, 0x62,
+	0xbb, 0x0d, 0x97, 0x38, 0xe8, 0xff, 0xe1, 0x54, 0x2f, 0xb5, 0x1c, 0x6d, 0x94, 0x67, 0x8d, 0xfc,
+	0xd4, 0x9d, 0xbb, 0x4b, 0xe3, 0x41, 0x91, 0x42, 0x1c, 0x74, 0x01, 0x9e, 0x19, 0xa4, 0xab, 0x6c,
+	0xbe, 0x36, 0x9b, 0xca, 0x4f, 0xdf, 0xb9, 0xbb, 0x34, 0x11, 0x55, 0x33, 0xa8, 0x00, 0x28, 0x49,
+	0xa9, 0xf1, 0xd2, 0x79, 0xb8, 0x73, 0x77, 0x69, 0x34, 0x08, 0x91, 0xf9, 0xcc, 0xbb, 0xbf, 0x5e,
+	0x18, 0xb9, 0xf8, 0x63, 0x80, 0x0a, 0x6d, 0xf8, 0xd8, 0x56, 0xa9, 0x20, 0x0f, 0xa7, 0x2b, 0x9b,
+	0xd7, 0xcc, 0xab, 0xeb, 0x5b, 0x95, 0x9b, 0x9b, 0xbd, 0xc7, 0xee, 0x5b, 0x2b, 0xdf, 0xdc, 0x2e,
+	0xdd, 0xd8, 0xb0, 0x6a, 0x95, 0xd7, 0x36, 0x67, 0x0d, 0x74, 0x06, 0x4e, 0xf6, 0xac, 0x7d, 0x6f,
+	0x73, 0xab, 0xf2, 0xe6, 0xc6, 0x6c, 0xaa, 0x74, 0xe5, 0x93, 0xcf, 0x17, 0x8c, 0xfb, 0x9f, 0x2f,
+	0x18, 0x7f, 0xfb, 0x7c, 0xc1, 0x78, 0xff, 0x8b, 0x85, 0x91, 0xfb, 0x5f, 0x2c, 0x8c, 0xfc, 0xe5,
+	0x8b, 0x85, 0x91, 0x1f, 0x9e, 0xed, 0xb9, 0xd5, 0xb8, 0xfc, 0x50, 0xdf, 0x76, 0xeb, 0xa3, 0xea,
+	0x6a, 0xbe, 0xf3, 0xef, 0x01, 0x00, 0xd6, 0x01, 0xa6, 0x8c, 0x53, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1590,735 +1779,822 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11635 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x23, 0x59,
-		0x56, 0x18, 0xdc, 0x29, 0xa9, 0x54, 0xd2, 0x91, 0x54, 0xca, 0xba, 0x55, 0xdd, 0x5d, 0x5d, 0x3d,
-		0xd3, 0x55, 0xad, 0x79, 0x74, 0x4f, 0xcf, 0x4c, 0xf5, 0x74, 0x4f, 0x4f, 0xcf, 0x8c, 0xe6, 0x85,
-		0x54, 0xa5, 0xaa, 0x56, 0x4f, 0xbd, 0x36, 0xa5, 0xaa, 0x9d, 0x19, 0x1e, 0x49, 0x56, 0xea, 0x56,
-		0x55, 0x4e, 0x4b, 0x99, 0xb9, 0x99, 0xa9, 0xee, 0xaa, 0xf9, 0xbe, 0xf8, 0x62, 0xf9, 0x78, 0x7c,
-		0x30, 0x3c, 0xbe, 0xc5, 0x60, 0x58, 0x76, 0xb7, 0x97, 0x05, 0x0c, 0xbb, 0x0b, 0x36, 0x06, 0xef,
-		0xf2, 0x32, 0xe1, 0x07, 0x0e, 0x1b, 0x03, 0x0e, 0xe3, 0x35, 0x3f, 0x6c, 0x82, 0x08, 0x06, 0xd8,
-		0x25, 0x62, 0xd7, 0xb0, 0x60, 0xc0, 0xbb, 0x04, 0xe1, 0x0d, 0x3b, 0x1c, 0xf7, 0x95, 0x0f, 0x29,
-		0x55, 0x52, 0xf5, 0xcc, 0x2c, 0x18, 0xfc, 0xa7, 0xbb, 0xf2, 0xde, 0x73, 0xce, 0x3d, 0xf7, 0xdc,
-		0x73, 0xcf, 0x39, 0xf7, 0xdc, 0x87, 0xe0, 0x0f, 0xaa, 0x30, 0xbf, 0x67, 0x59, 0x7b, 0x6d, 0x7c,
-		0xd9, 0x76, 0x2c, 0xcf, 0xda, 0xe9, 0xee, 0x5e, 0x6e, 0x61, 0x57, 0x77, 0x0c, 0xdb, 0xb3, 0x9c,
-		0x05, 0x5a, 0x86, 0x8a, 0x0c, 0x62, 0x41, 0x40, 0x94, 0xd6, 0x60, 0x72, 0xd9, 0x68, 0xe3, 0x25,
-		0x1f, 0xb0, 0x81, 0x3d, 0xf4, 0x0c, 0xa4, 0x76, 0x8d, 0x36, 0x9e, 0x91, 0xe6, 0x93, 0x17, 0x73,
-		0x57, 0x1f, 0x5c, 0xe8, 0x41, 0x5a, 0x88, 0x62, 0x6c, 0x92, 0x62, 0x85, 0x62, 0x94, 0xfe, 0x67,
-		0x0a, 0xa6, 0x62, 0x6a, 0x11, 0x82, 0x94, 0xa9, 0x75, 0x08, 0x45, 0xe9, 0x62, 0x56, 0xa1, 0x7f,
-		0xa3, 0x19, 0x18, 0xb7, 0x35, 0xfd, 0x96, 0xb6, 0x87, 0x67, 0x12, 0xb4, 0x58, 0x7c, 0xa2, 0x73,
-		0x00, 0x2d, 0x6c, 0x63, 0xb3, 0x85, 0x4d, 0xfd, 0x70, 0x26, 0x39, 0x9f, 0xbc, 0x98, 0x55, 0x42,
-		0x25, 0xe8, 0x51, 0x98, 0xb4, 0xbb, 0x3b, 0x6d, 0x43, 0x57, 0x43, 0x60, 0x30, 0x9f, 0xbc, 0x38,
-		0xa6, 0xc8, 0xac, 0x62, 0x29, 0x00, 0xbe, 0x00, 0xc5, 0x3b, 0x58, 0xbb, 0x15, 0x06, 0xcd, 0x51,
-		0xd0, 0x09, 0x52, 0x1c, 0x02, 0x5c, 0x84, 0x7c, 0x07, 0xbb, 0xae, 0xb6, 0x87, 0x55, 0xef, 0xd0,
-		0xc6, 0x33, 0x29, 0xda, 0xfb, 0xf9, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xc7, 0xb1, 0x9a, 0x87, 0x36,
-		0x46, 0x15, 0xc8, 0x62, 0xb3, 0xdb, 0x61, 0x14, 0xc6, 0x06, 0xc8, 0xaf, 0x66, 0x76, 0x3b, 0xbd,
-		0x54, 0x32, 0x04, 0x8d, 0x93, 0x18, 0x77, 0xb1, 0x73, 0xdb, 0xd0, 0xf1, 0x4c, 0x9a, 0x12, 0xb8,
-		0xd0, 0x47, 0xa0, 0xc1, 0xea, 0x7b, 0x69, 0x08, 0x3c, 0xb4, 0x08, 0x59, 0x7c, 0xe0, 0x61, 0xd3,
-		0x35, 0x2c, 0x73, 0x66, 0x9c, 0x12, 0x79, 0x28, 0x66, 0x14, 0x71, 0xbb, 0xd5, 0x4b, 0x22, 0xc0,
-		0x43, 0xd7, 0x61, 0xdc, 0xb2, 0x3d, 0xc3, 0x32, 0xdd, 0x99, 0xcc, 0xbc, 0x74, 0x31, 0x77, 0xf5,
-		0xbe, 0x58, 0x45, 0xd8, 0x60, 0x30, 0x8a, 0x00, 0x46, 0x75, 0x90, 0x5d, 0xab, 0xeb, 0xe8, 0x58,
-		0xd5, 0xad, 0x16, 0x56, 0x0d, 0x73, 0xd7, 0x9a, 0xc9, 0x52, 0x02, 0x73, 0xfd, 0x1d, 0xa1, 0x80,
-		0x8b, 0x56, 0x0b, 0xd7, 0xcd, 0x5d, 0x4b, 0x99, 0x70, 0x23, 0xdf, 0xe8, 0x14, 0xa4, 0xdd, 0x43,
-		0xd3, 0xd3, 0x0e, 0x66, 0xf2, 0x54, 0x43, 0xf8, 0x17, 0x51, 0x1d, 0xdc, 0x32, 0x48, 0x73, 0x33,
-		0x05, 0xa6, 0x3a, 0xfc, 0xb3, 0xf4, 0x4b, 0x69, 0x28, 0x8e, 0xa2, 0x7c, 0xcf, 0xc1, 0xd8, 0x2e,
-		0xe9, 0xff, 0x4c, 0xe2, 0x38, 0xd2, 0x61, 0x38, 0x51, 0xf1, 0xa6, 0xef, 0x51, 0xbc, 0x15, 0xc8,
-		0x99, 0xd8, 0xf5, 0x70, 0x8b, 0xe9, 0x4a, 0x72, 0x44, 0x6d, 0x03, 0x86, 0xd4, 0xaf, 0x6c, 0xa9,
-		0x7b, 0x52, 0xb6, 0x57, 0xa0, 0xe8, 0xb3, 0xa4, 0x3a, 0x9a, 0xb9, 0x27, 0xb4, 0xf6, 0xf2, 0x30,
-		0x4e, 0x16, 0x6a, 0x02, 0x4f, 0x21, 0x68, 0xca, 0x04, 0x8e, 0x7c, 0xa3, 0x25, 0x00, 0xcb, 0xc4,
-		0xd6, 0xae, 0xda, 0xc2, 0x7a, 0x7b, 0x26, 0x33, 0x40, 0x4a, 0x1b, 0x04, 0xa4, 0x4f, 0x4a, 0x16,
-		0x2b, 0xd5, 0xdb, 0xe8, 0xd9, 0x40, 0x09, 0xc7, 0x07, 0xe8, 0xd0, 0x1a, 0x9b, 0x7e, 0x7d, 0x7a,
-		0xb8, 0x05, 0x13, 0x0e, 0x26, 0x33, 0x02, 0xb7, 0x78, 0xcf, 0xb2, 0x94, 0x89, 0x85, 0xa1, 0x3d,
-		0x53, 0x38, 0x1a, 0xeb, 0x58, 0xc1, 0x09, 0x7f, 0xa2, 0x07, 0xc0, 0x2f, 0x50, 0xa9, 0x5a, 0x01,
-		0xb5, 0x4f, 0x79, 0x51, 0xb8, 0xae, 0x75, 0xf0, 0xec, 0x1b, 0x30, 0x11, 0x15, 0x0f, 0x9a, 0x86,
-		0x31, 0xd7, 0xd3, 0x1c, 0x8f, 0x6a, 0xe1, 0x98, 0xc2, 0x3e, 0x90, 0x0c, 0x49, 0x6c, 0xb6, 0xa8,
-		0xfd, 0x1b, 0x53, 0xc8, 0x9f, 0xe8, 0x6b, 0x82, 0x0e, 0x27, 0x69, 0x87, 0x1f, 0xee, 0x1f, 0xd1,
-		0x08, 0xe5, 0xde, 0x7e, 0xcf, 0x3e, 0x0d, 0x85, 0x48, 0x07, 0x46, 0x6d, 0xba, 0xf4, 0x7f, 0xc3,
-		0xc9, 0x58, 0xd2, 0xe8, 0x15, 0x98, 0xee, 0x9a, 0x86, 0xe9, 0x61, 0xc7, 0x76, 0x30, 0xd1, 0x58,
-		0xd6, 0xd4, 0xcc, 0xe7, 0xc7, 0x07, 0xe8, 0xdc, 0x56, 0x18, 0x9a, 0x51, 0x51, 0xa6, 0xba, 0xfd,
-		0x85, 0x97, 0xb2, 0x99, 0x2f, 0x8c, 0xcb, 0xef, 0x7f, 0xff, 0xfb, 0xdf, 0x9f, 0x28, 0xfd, 0x4a,
-		0x1a, 0xa6, 0xe3, 0xe6, 0x4c, 0xec, 0xf4, 0x3d, 0x05, 0x69, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e, 0x15,
-		0xd2, 0x98, 0xc2, 0xbf, 0x50, 0x05, 0xc6, 0xda, 0xda, 0x0e, 0x6e, 0xcf, 0xa4, 0xe6, 0xa5, 0x8b,
-		0x13, 0x57, 0x1f, 0x1d, 0x69, 0x56, 0x2e, 0xac, 0x12, 0x14, 0x85, 0x61, 0xa2, 0x17, 0x21, 0xc5,
-		0x8d, 0x37, 0xa1, 0x70, 0x69, 0x34, 0x0a, 0x64, 0x2e, 0x29, 0x14, 0x0f, 0x9d, 0x85, 0x2c, 0xf9,
-		0x9f, 0xe9, 0x46, 0x9a, 0xf2, 0x9c, 0x21, 0x05, 0x44, 0x2f, 0xd0, 0x2c, 0x64, 0xe8, 0x34, 0x69,
-		0x61, 0xe1, 0xf4, 0xfc, 0x6f, 0xa2, 0x58, 0x2d, 0xbc, 0xab, 0x75, 0xdb, 0x9e, 0x7a, 0x5b, 0x6b,
-		0x77, 0x31, 0x55, 0xf8, 0xac, 0x92, 0xe7, 0x85, 0xdb, 0xa4, 0x0c, 0xcd, 0x41, 0x8e, 0xcd, 0x2a,
-		0xc3, 0x6c, 0xe1, 0x03, 0x6a, 0x57, 0xc7, 0x14, 0x36, 0xd1, 0xea, 0xa4, 0x84, 0x34, 0xff, 0xba,
-		0x6b, 0x99, 0x42, 0x35, 0x69, 0x13, 0xa4, 0x80, 0x36, 0xff, 0x74, 0xaf, 0x49, 0xbf, 0x3f, 0xbe,
-		0x7b, 0x7d, 0x73, 0xe9, 0x02, 0x14, 0x29, 0xc4, 0x93, 0x7c, 0xe8, 0xb5, 0xf6, 0xcc, 0xe4, 0xbc,
-		0x74, 0x31, 0xa3, 0x4c, 0xb0, 0xe2, 0x0d, 0x5e, 0x5a, 0xfa, 0xf9, 0x04, 0xa4, 0xa8, 0x61, 0x29,
-		0x42, 0xae, 0xf9, 0xea, 0x66, 0x4d, 0x5d, 0xda, 0xd8, 0xaa, 0xae, 0xd6, 0x64, 0x09, 0x4d, 0x00,
-		0xd0, 0x82, 0xe5, 0xd5, 0x8d, 0x4a, 0x53, 0x4e, 0xf8, 0xdf, 0xf5, 0xf5, 0xe6, 0xf5, 0x6b, 0x72,
-		0xd2, 0x47, 0xd8, 0x62, 0x05, 0xa9, 0x30, 0xc0, 0x93, 0x57, 0xe5, 0x31, 0x24, 0x43, 0x9e, 0x11,
-		0xa8, 0xbf, 0x52, 0x5b, 0xba, 0x7e, 0x4d, 0x4e, 0x47, 0x4b, 0x9e, 0xbc, 0x2a, 0x8f, 0xa3, 0x02,
-		0x64, 0x69, 0x49, 0x75, 0x63, 0x63, 0x55, 0xce, 0xf8, 0x34, 0x1b, 0x4d, 0xa5, 0xbe, 0xbe, 0x22,
-		0x67, 0x7d, 0x9a, 0x2b, 0xca, 0xc6, 0xd6, 0xa6, 0x0c, 0x3e, 0x85, 0xb5, 0x5a, 0xa3, 0x51, 0x59,
-		0xa9, 0xc9, 0x39, 0x1f, 0xa2, 0xfa, 0x6a, 0xb3, 0xd6, 0x90, 0xf3, 0x11, 0xb6, 0x9e, 0xbc, 0x2a,
-		0x17, 0xfc, 0x26, 0x6a, 0xeb, 0x5b, 0x6b, 0xf2, 0x04, 0x9a, 0x84, 0x02, 0x6b, 0x42, 0x30, 0x51,
-		0xec, 0x29, 0xba, 0x7e, 0x4d, 0x96, 0x03, 0x46, 0x18, 0x95, 0xc9, 0x48, 0xc1, 0xf5, 0x6b, 0x32,
-		0x2a, 0x2d, 0xc2, 0x18, 0x55, 0x43, 0x84, 0x60, 0x62, 0xb5, 0x52, 0xad, 0xad, 0xaa, 0x1b, 0x9b,
-		0xcd, 0xfa, 0xc6, 0x7a, 0x65, 0x55, 0x96, 0x82, 0x32, 0xa5, 0xf6, 0x9e, 0xad, 0xba, 0x52, 0x5b,
-		0x92, 0x13, 0xe1, 0xb2, 0xcd, 0x5a, 0xa5, 0x59, 0x5b, 0x92, 0x93, 0x25, 0x1d, 0xa6, 0xe3, 0x0c,
-		0x6a, 0xec, 0x14, 0x0a, 0xe9, 0x42, 0x62, 0x80, 0x2e, 0x50, 0x5a, 0xbd, 0xba, 0x50, 0xfa, 0x5c,
-		0x02, 0xa6, 0x62, 0x9c, 0x4a, 0x6c, 0x23, 0x2f, 0xc1, 0x18, 0xd3, 0x65, 0xe6, 0x66, 0x1f, 0x89,
-		0xf5, 0x4e, 0x54, 0xb3, 0xfb, 0x5c, 0x2d, 0xc5, 0x0b, 0x07, 0x21, 0xc9, 0x01, 0x41, 0x08, 0x21,
-		0xd1, 0xa7, 0xb0, 0x5f, 0xdf, 0x67, 0xfc, 0x99, 0x7f, 0xbc, 0x3e, 0x8a, 0x7f, 0xa4, 0x65, 0xc7,
-		0x73, 0x02, 0x63, 0x31, 0x4e, 0xe0, 0x39, 0x98, 0xec, 0x23, 0x34, 0xb2, 0x31, 0xfe, 0x66, 0x09,
-		0x66, 0x06, 0x09, 0x67, 0x88, 0x49, 0x4c, 0x44, 0x4c, 0xe2, 0x73, 0xbd, 0x12, 0x3c, 0x3f, 0x78,
-		0x10, 0xfa, 0xc6, 0xfa, 0xe3, 0x12, 0x9c, 0x8a, 0x0f, 0x36, 0x63, 0x79, 0x78, 0x11, 0xd2, 0x1d,
-		0xec, 0xed, 0x5b, 0x22, 0xac, 0x7a, 0x38, 0xc6, 0x59, 0x93, 0xea, 0xde, 0xc1, 0xe6, 0x58, 0x61,
-		0x6f, 0x9f, 0x1c, 0x14, 0x31, 0x32, 0x6e, 0xfa, 0x38, 0xfd, 0x8e, 0x04, 0x9c, 0x8c, 0x25, 0x1e,
-		0xcb, 0xe8, 0xfd, 0x00, 0x86, 0x69, 0x77, 0x3d, 0x16, 0x3a, 0x31, 0x4b, 0x9c, 0xa5, 0x25, 0xd4,
-		0x78, 0x11, 0x2b, 0xdb, 0xf5, 0xfc, 0xfa, 0x24, 0xad, 0x07, 0x56, 0x44, 0x01, 0x9e, 0x09, 0x18,
-		0x4d, 0x51, 0x46, 0xcf, 0x0d, 0xe8, 0x69, 0x9f, 0x62, 0x3e, 0x01, 0xb2, 0xde, 0x36, 0xb0, 0xe9,
-		0xa9, 0xae, 0xe7, 0x60, 0xad, 0x63, 0x98, 0x7b, 0xd4, 0xd5, 0x64, 0xca, 0x63, 0xbb, 0x5a, 0xdb,
-		0xc5, 0x4a, 0x91, 0x55, 0x37, 0x44, 0x2d, 0xc1, 0xa0, 0x0a, 0xe4, 0x84, 0x30, 0xd2, 0x11, 0x0c,
-		0x56, 0xed, 0x63, 0x94, 0xbe, 0x37, 0x0b, 0xb9, 0x50, 0x68, 0x8e, 0xce, 0x43, 0xfe, 0x75, 0xed,
-		0xb6, 0xa6, 0x8a, 0xe5, 0x16, 0x93, 0x44, 0x8e, 0x94, 0x6d, 0xf2, 0x25, 0xd7, 0x13, 0x30, 0x4d,
-		0x41, 0xac, 0xae, 0x87, 0x1d, 0x55, 0x6f, 0x6b, 0xae, 0x4b, 0x85, 0x96, 0xa1, 0xa0, 0x88, 0xd4,
-		0x6d, 0x90, 0xaa, 0x45, 0x51, 0x83, 0x9e, 0x82, 0x29, 0x8a, 0xd1, 0xe9, 0xb6, 0x3d, 0xc3, 0x6e,
-		0x63, 0x95, 0x2c, 0x00, 0x5d, 0xea, 0x72, 0x7c, 0xce, 0x26, 0x09, 0xc4, 0x1a, 0x07, 0x20, 0x1c,
-		0xb9, 0x68, 0x09, 0xee, 0xa7, 0x68, 0x7b, 0xd8, 0xc4, 0x8e, 0xe6, 0x61, 0x15, 0xbf, 0xaf, 0xab,
-		0xb5, 0x5d, 0x55, 0x33, 0x5b, 0xea, 0xbe, 0xe6, 0xee, 0xcf, 0x4c, 0x13, 0x02, 0xd5, 0xc4, 0x8c,
-		0xa4, 0x9c, 0x21, 0x80, 0x2b, 0x1c, 0xae, 0x46, 0xc1, 0x2a, 0x66, 0xeb, 0x86, 0xe6, 0xee, 0xa3,
-		0x32, 0x9c, 0xa2, 0x54, 0x5c, 0xcf, 0x31, 0xcc, 0x3d, 0x55, 0xdf, 0xc7, 0xfa, 0x2d, 0xb5, 0xeb,
-		0xed, 0x3e, 0x33, 0x73, 0x36, 0xdc, 0x3e, 0xe5, 0xb0, 0x41, 0x61, 0x16, 0x09, 0xc8, 0x96, 0xb7,
-		0xfb, 0x0c, 0x6a, 0x40, 0x9e, 0x0c, 0x46, 0xc7, 0x78, 0x03, 0xab, 0xbb, 0x96, 0x43, 0x7d, 0xe8,
-		0x44, 0x8c, 0x69, 0x0a, 0x49, 0x70, 0x61, 0x83, 0x23, 0xac, 0x59, 0x2d, 0x5c, 0x1e, 0x6b, 0x6c,
-		0xd6, 0x6a, 0x4b, 0x4a, 0x4e, 0x50, 0x59, 0xb6, 0x1c, 0xa2, 0x50, 0x7b, 0x96, 0x2f, 0xe0, 0x1c,
-		0x53, 0xa8, 0x3d, 0x4b, 0x88, 0xf7, 0x29, 0x98, 0xd2, 0x75, 0xd6, 0x67, 0x43, 0x57, 0xf9, 0x32,
-		0xcd, 0x9d, 0x91, 0x23, 0xc2, 0xd2, 0xf5, 0x15, 0x06, 0xc0, 0x75, 0xdc, 0x45, 0xcf, 0xc2, 0xc9,
-		0x40, 0x58, 0x61, 0xc4, 0xc9, 0xbe, 0x5e, 0xf6, 0xa2, 0x3e, 0x05, 0x53, 0xf6, 0x61, 0x3f, 0x22,
-		0x8a, 0xb4, 0x68, 0x1f, 0xf6, 0xa2, 0x3d, 0x0d, 0xd3, 0xf6, 0xbe, 0xdd, 0x8f, 0x77, 0x29, 0x8c,
-		0x87, 0xec, 0x7d, 0xbb, 0x17, 0xf1, 0x21, 0xba, 0x66, 0x77, 0xb0, 0xae, 0x79, 0xb8, 0x35, 0x73,
-		0x3a, 0x0c, 0x1e, 0xaa, 0x40, 0x0b, 0x20, 0xeb, 0xba, 0x8a, 0x4d, 0x6d, 0xa7, 0x8d, 0x55, 0xcd,
-		0xc1, 0xa6, 0xe6, 0xce, 0xcc, 0x51, 0xe0, 0x94, 0xe7, 0x74, 0xb1, 0x32, 0xa1, 0xeb, 0x35, 0x5a,
-		0x59, 0xa1, 0x75, 0xe8, 0x12, 0x4c, 0x5a, 0x3b, 0xaf, 0xeb, 0x4c, 0x23, 0x55, 0xdb, 0xc1, 0xbb,
-		0xc6, 0xc1, 0xcc, 0x83, 0x54, 0xbc, 0x45, 0x52, 0x41, 0xf5, 0x71, 0x93, 0x16, 0xa3, 0x47, 0x40,
-		0xd6, 0xdd, 0x7d, 0xcd, 0xb1, 0xa9, 0x49, 0x76, 0x6d, 0x4d, 0xc7, 0x33, 0x0f, 0x31, 0x50, 0x56,
-		0xbe, 0x2e, 0x8a, 0xc9, 0x8c, 0x70, 0xef, 0x18, 0xbb, 0x9e, 0xa0, 0x78, 0x81, 0xcd, 0x08, 0x5a,
-		0xc6, 0xa9, 0x5d, 0x04, 0x99, 0x48, 0x22, 0xd2, 0xf0, 0x45, 0x0a, 0x36, 0x61, 0xef, 0xdb, 0xe1,
-		0x76, 0x1f, 0x80, 0x02, 0x81, 0x0c, 0x1a, 0x7d, 0x84, 0x05, 0x6e, 0xf6, 0x7e, 0xa8, 0xc5, 0x6b,
-		0x70, 0x8a, 0x00, 0x75, 0xb0, 0xa7, 0xb5, 0x34, 0x4f, 0x0b, 0x41, 0x3f, 0x46, 0xa1, 0x89, 0xd8,
-		0xd7, 0x78, 0x65, 0x84, 0x4f, 0xa7, 0xbb, 0x73, 0xe8, 0x2b, 0xd6, 0xe3, 0x8c, 0x4f, 0x52, 0x26,
-		0x54, 0xeb, 0x5d, 0x0b, 0xce, 0x4b, 0x65, 0xc8, 0x87, 0xf5, 0x1e, 0x65, 0x81, 0x69, 0xbe, 0x2c,
-		0x91, 0x20, 0x68, 0x71, 0x63, 0x89, 0x84, 0x2f, 0xaf, 0xd5, 0xe4, 0x04, 0x09, 0xa3, 0x56, 0xeb,
-		0xcd, 0x9a, 0xaa, 0x6c, 0xad, 0x37, 0xeb, 0x6b, 0x35, 0x39, 0x19, 0x0a, 0xec, 0x6f, 0xa6, 0x32,
-		0x0f, 0xcb, 0x17, 0x4a, 0xbf, 0x9c, 0x84, 0x89, 0xe8, 0x4a, 0x0d, 0x3d, 0x0f, 0xa7, 0x45, 0xc2,
-		0xc5, 0xc5, 0x9e, 0x7a, 0xc7, 0x70, 0xe8, 0x84, 0xec, 0x68, 0xcc, 0x39, 0xfa, 0xfa, 0x33, 0xcd,
-		0xa1, 0x1a, 0xd8, 0x7b, 0xaf, 0xe1, 0x90, 0xe9, 0xd6, 0xd1, 0x3c, 0xb4, 0x0a, 0x73, 0xa6, 0xa5,
-		0xba, 0x9e, 0x66, 0xb6, 0x34, 0xa7, 0xa5, 0x06, 0xa9, 0x2e, 0x55, 0xd3, 0x75, 0xec, 0xba, 0x16,
-		0x73, 0x84, 0x3e, 0x95, 0xfb, 0x4c, 0xab, 0xc1, 0x81, 0x03, 0x0f, 0x51, 0xe1, 0xa0, 0x3d, 0xea,
-		0x9b, 0x1c, 0xa4, 0xbe, 0x67, 0x21, 0xdb, 0xd1, 0x6c, 0x15, 0x9b, 0x9e, 0x73, 0x48, 0xe3, 0xf3,
-		0x8c, 0x92, 0xe9, 0x68, 0x76, 0x8d, 0x7c, 0xa3, 0x6d, 0x78, 0x38, 0x00, 0x55, 0xdb, 0x78, 0x4f,
-		0xd3, 0x0f, 0x55, 0x1a, 0x8c, 0xd3, 0xb4, 0x81, 0xaa, 0x5b, 0xe6, 0x6e, 0xdb, 0xd0, 0x3d, 0x97,
-		0xda, 0x07, 0x66, 0xe3, 0x4a, 0x01, 0xc6, 0x2a, 0x45, 0xb8, 0xe9, 0x5a, 0x26, 0x8d, 0xc1, 0x17,
-		0x05, 0xf4, 0x57, 0x65, 0xf9, 0x75, 0x33, 0x95, 0x49, 0xc9, 0x63, 0x37, 0x53, 0x99, 0x31, 0x39,
-		0x7d, 0x33, 0x95, 0x49, 0xcb, 0xe3, 0x37, 0x53, 0x99, 0x8c, 0x9c, 0xbd, 0x99, 0xca, 0x64, 0x65,
-		0x28, 0xfd, 0x42, 0x06, 0xf2, 0xe1, 0x95, 0x01, 0x59, 0x68, 0xe9, 0xd4, 0x37, 0x4a, 0xd4, 0x7a,
-		0x3e, 0x70, 0xe4, 0x3a, 0x62, 0x61, 0x91, 0x38, 0xcd, 0x72, 0x9a, 0x85, 0xe1, 0x0a, 0xc3, 0x24,
-		0x01, 0x0b, 0x51, 0x6b, 0xcc, 0xc2, 0x9e, 0x8c, 0xc2, 0xbf, 0xd0, 0x0a, 0xa4, 0x5f, 0x77, 0x29,
-		0xed, 0x34, 0xa5, 0xfd, 0xe0, 0xd1, 0xb4, 0x6f, 0x36, 0x28, 0xf1, 0xec, 0xcd, 0x86, 0xba, 0xbe,
-		0xa1, 0xac, 0x55, 0x56, 0x15, 0x8e, 0x8e, 0xce, 0x40, 0xaa, 0xad, 0xbd, 0x71, 0x18, 0x75, 0xaf,
-		0xb4, 0x08, 0x2d, 0x40, 0xb1, 0x6b, 0xde, 0xc6, 0x8e, 0xb1, 0x6b, 0x90, 0xa1, 0x22, 0x50, 0xc5,
-		0x30, 0xd4, 0x44, 0x50, 0xbb, 0x4a, 0xe0, 0x47, 0x54, 0x8f, 0x33, 0x90, 0xba, 0x83, 0xb5, 0x5b,
-		0x51, 0x27, 0x48, 0x8b, 0xd0, 0x45, 0xc8, 0xb7, 0xf0, 0x4e, 0x77, 0x4f, 0x75, 0x70, 0x4b, 0xd3,
-		0xbd, 0xa8, 0xe9, 0xcf, 0xd1, 0x2a, 0x85, 0xd6, 0xa0, 0x97, 0x21, 0x4b, 0xc6, 0xc8, 0xa4, 0x63,
-		0x3c, 0x49, 0x45, 0xf0, 0xf8, 0xd1, 0x22, 0xe0, 0x43, 0x2c, 0x90, 0x94, 0x00, 0x1f, 0x2d, 0x43,
-		0xda, 0xd3, 0x9c, 0x3d, 0xec, 0x51, 0xcb, 0x3f, 0x11, 0x93, 0xfc, 0x88, 0xa1, 0xd4, 0xa4, 0x18,
-		0x74, 0x4d, 0xcb, 0xb1, 0xdf, 0x45, 0x2b, 0x73, 0x19, 0xc6, 0xa8, 0x7a, 0x20, 0x00, 0xae, 0x20,
-		0xf2, 0x09, 0x94, 0x81, 0xd4, 0xe2, 0x86, 0x42, 0x2c, 0x8d, 0x0c, 0x79, 0x56, 0xaa, 0x6e, 0xd6,
-		0x6b, 0x8b, 0x35, 0x39, 0x51, 0x7a, 0x0a, 0xd2, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x47, 0x5d, 0x3e,
-		0xc1, 0x3f, 0x39, 0x0d, 0x49, 0xd4, 0x6e, 0xad, 0x55, 0x6b, 0x8a, 0x9c, 0x28, 0x6d, 0x41, 0xb1,
-		0x47, 0x4e, 0xe8, 0x24, 0x4c, 0x2a, 0xb5, 0x66, 0x6d, 0x9d, 0xac, 0xb3, 0xd4, 0xad, 0xf5, 0x97,
-		0xd7, 0x37, 0xde, 0xbb, 0x2e, 0x9f, 0x88, 0x16, 0x0b, 0x93, 0x26, 0xa1, 0x69, 0x90, 0x83, 0xe2,
-		0xc6, 0xc6, 0x96, 0x42, 0xb9, 0xf9, 0xae, 0x04, 0xc8, 0xbd, 0x52, 0x43, 0xa7, 0x61, 0xaa, 0x59,
-		0x51, 0x56, 0x6a, 0x4d, 0x95, 0xad, 0x1d, 0x7d, 0xd2, 0xd3, 0x20, 0x87, 0x2b, 0x96, 0xeb, 0x74,
-		0x69, 0x3c, 0x07, 0x67, 0xc3, 0xa5, 0xb5, 0x57, 0x9a, 0xb5, 0xf5, 0x06, 0x6d, 0xbc, 0xb2, 0xbe,
-		0x42, 0xec, 0x6b, 0x0f, 0x3d, 0xb1, 0x5a, 0x4d, 0x12, 0x56, 0xa3, 0xf4, 0x6a, 0xab, 0x4b, 0x72,
-		0xaa, 0xb7, 0x78, 0x63, 0xbd, 0xb6, 0xb1, 0x2c, 0x8f, 0xf5, 0xb6, 0x4e, 0x57, 0xb0, 0x69, 0x34,
-		0x0b, 0xa7, 0x7a, 0x4b, 0xd5, 0xda, 0x7a, 0x53, 0x79, 0x55, 0x1e, 0xef, 0x6d, 0xb8, 0x51, 0x53,
-		0xb6, 0xeb, 0x8b, 0x35, 0x39, 0x83, 0x4e, 0x01, 0x8a, 0x72, 0xd4, 0xbc, 0xb1, 0xb1, 0x24, 0x67,
-		0xfb, 0x2c, 0x4a, 0xc9, 0x85, 0x7c, 0x78, 0x19, 0xf9, 0xd5, 0xc9, 0x25, 0x7d, 0x30, 0x01, 0xb9,
-		0xd0, 0xb2, 0x90, 0xc4, 0xf3, 0x5a, 0xbb, 0x6d, 0xdd, 0x51, 0xb5, 0xb6, 0xa1, 0xb9, 0xdc, 0xde,
-		0x00, 0x2d, 0xaa, 0x90, 0x92, 0x51, 0xe7, 0xf7, 0xe8, 0x16, 0x3e, 0xfd, 0x37, 0xd1, 0xc2, 0x8f,
-		0xc9, 0xe9, 0xd2, 0x47, 0x25, 0x90, 0x7b, 0xd7, 0x7b, 0x3d, 0xdd, 0x97, 0x06, 0x75, 0xff, 0xab,
-		0x32, 0x76, 0x1f, 0x91, 0x60, 0x22, 0xba, 0xc8, 0xeb, 0x61, 0xef, 0xfc, 0x5f, 0x2b, 0x7b, 0xbf,
-		0x9f, 0x80, 0x42, 0x64, 0x69, 0x37, 0x2a, 0x77, 0xef, 0x83, 0x49, 0xa3, 0x85, 0x3b, 0xb6, 0xe5,
-		0x61, 0x53, 0x3f, 0x54, 0xdb, 0xf8, 0x36, 0x6e, 0xcf, 0x94, 0xa8, 0x51, 0xbe, 0x7c, 0xf4, 0xe2,
-		0x71, 0xa1, 0x1e, 0xe0, 0xad, 0x12, 0xb4, 0xf2, 0x54, 0x7d, 0xa9, 0xb6, 0xb6, 0xb9, 0xd1, 0xac,
-		0xad, 0x2f, 0xbe, 0x2a, 0xac, 0x8b, 0x22, 0x1b, 0x3d, 0x60, 0xef, 0xa2, 0xd1, 0xde, 0x04, 0xb9,
-		0x97, 0x29, 0x62, 0x2b, 0x62, 0xd8, 0x92, 0x4f, 0xa0, 0x29, 0x28, 0xae, 0x6f, 0xa8, 0x8d, 0xfa,
-		0x52, 0x4d, 0xad, 0x2d, 0x2f, 0xd7, 0x16, 0x9b, 0x0d, 0x96, 0x0e, 0xf4, 0xa1, 0x9b, 0x72, 0x22,
-		0x2c, 0xe2, 0x0f, 0x25, 0x61, 0x2a, 0x86, 0x13, 0x54, 0xe1, 0x0b, 0x79, 0x96, 0x5b, 0x78, 0x7c,
-		0x14, 0xee, 0x17, 0x48, 0x28, 0xbd, 0xa9, 0x39, 0x1e, 0x5f, 0xf7, 0x3f, 0x02, 0x44, 0x4a, 0xa6,
-		0x47, 0x3c, 0xbb, 0xc3, 0xd3, 0xac, 0x6c, 0x75, 0x5f, 0x0c, 0xca, 0x59, 0xa6, 0xf5, 0x31, 0x40,
-		0xb6, 0xe5, 0x1a, 0x9e, 0x71, 0x1b, 0xab, 0x86, 0x29, 0x72, 0xb2, 0x64, 0xb5, 0x9f, 0x52, 0x64,
-		0x51, 0x53, 0x37, 0x3d, 0x1f, 0xda, 0xc4, 0x7b, 0x5a, 0x0f, 0x34, 0x89, 0x3c, 0x92, 0x8a, 0x2c,
-		0x6a, 0x7c, 0xe8, 0xf3, 0x90, 0x6f, 0x59, 0x5d, 0xb2, 0x04, 0x62, 0x70, 0xc4, 0x5a, 0x48, 0x4a,
-		0x8e, 0x95, 0xf9, 0x20, 0x7c, 0x71, 0x1b, 0x24, 0x83, 0xf3, 0x4a, 0x8e, 0x95, 0x31, 0x90, 0x0b,
-		0x50, 0xd4, 0xf6, 0xf6, 0x1c, 0x42, 0x5c, 0x10, 0x62, 0xcb, 0xf5, 0x09, 0xbf, 0x98, 0x02, 0xce,
-		0xde, 0x84, 0x8c, 0x90, 0x03, 0x89, 0x60, 0x89, 0x24, 0x54, 0x9b, 0xe5, 0xa0, 0x12, 0x17, 0xb3,
-		0x4a, 0xc6, 0x14, 0x95, 0xe7, 0x21, 0x6f, 0xb8, 0x6a, 0xb0, 0xb7, 0x95, 0x98, 0x4f, 0x5c, 0xcc,
-		0x28, 0x39, 0xc3, 0xf5, 0xf7, 0x05, 0x4a, 0x1f, 0x4f, 0xc0, 0x44, 0x74, 0xd7, 0x0e, 0x2d, 0x41,
-		0xa6, 0x6d, 0xe9, 0x1a, 0x55, 0x2d, 0xb6, 0x65, 0x7c, 0x71, 0xc8, 0x46, 0xdf, 0xc2, 0x2a, 0x87,
-		0x57, 0x7c, 0xcc, 0xd9, 0xdf, 0x94, 0x20, 0x23, 0x8a, 0xd1, 0x29, 0x48, 0xd9, 0x9a, 0xb7, 0x4f,
-		0xc9, 0x8d, 0x55, 0x13, 0xb2, 0xa4, 0xd0, 0x6f, 0x52, 0xee, 0xda, 0x9a, 0x49, 0x55, 0x80, 0x97,
-		0x93, 0x6f, 0x32, 0xae, 0x6d, 0xac, 0xb5, 0x68, 0x2e, 0xc0, 0xea, 0x74, 0xb0, 0xe9, 0xb9, 0x62,
-		0x5c, 0x79, 0xf9, 0x22, 0x2f, 0x46, 0x8f, 0xc2, 0xa4, 0xe7, 0x68, 0x46, 0x3b, 0x02, 0x9b, 0xa2,
-		0xb0, 0xb2, 0xa8, 0xf0, 0x81, 0xcb, 0x70, 0x46, 0xd0, 0x6d, 0x61, 0x4f, 0xd3, 0xf7, 0x71, 0x2b,
-		0x40, 0x4a, 0xd3, 0x9c, 0xdf, 0x69, 0x0e, 0xb0, 0xc4, 0xeb, 0x05, 0x6e, 0xe9, 0x33, 0x09, 0x98,
-		0x14, 0xd9, 0x8b, 0x96, 0x2f, 0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0x2f, 0x2c, 0xae, 0x7e, 0x55,
-		0xee, 0xc3, 0x5b, 0xa8, 0xf8, 0x48, 0x4a, 0x88, 0xc0, 0xec, 0x1f, 0x4b, 0x00, 0x41, 0xd5, 0x40,
-		0xb9, 0xcd, 0x41, 0x8e, 0xef, 0xc9, 0xd2, 0x8d, 0x7d, 0x96, 0xf0, 0x02, 0x56, 0xb4, 0x6c, 0xb4,
-		0x69, 0x5a, 0x72, 0x07, 0xef, 0x19, 0x26, 0xdf, 0x4f, 0x61, 0x1f, 0x22, 0x2d, 0x99, 0x0a, 0xb6,
-		0xa7, 0x14, 0xc8, 0xb8, 0xb8, 0xa3, 0x99, 0x9e, 0xa1, 0xf3, 0x1d, 0x92, 0xeb, 0xc7, 0x62, 0x7e,
-		0xa1, 0xc1, 0xb1, 0x15, 0x9f, 0x4e, 0xe9, 0x22, 0x64, 0x44, 0x29, 0x09, 0xfc, 0xd6, 0x37, 0xd6,
-		0x6b, 0xf2, 0x09, 0x34, 0x0e, 0xc9, 0x46, 0xad, 0x29, 0x4b, 0x64, 0xd9, 0x59, 0x59, 0xad, 0x57,
-		0x1a, 0x72, 0xa2, 0xfa, 0xff, 0xc0, 0x94, 0x6e, 0x75, 0x7a, 0x1b, 0xac, 0xca, 0x3d, 0x29, 0x3f,
-		0xf7, 0x86, 0xf4, 0xda, 0xe3, 0x1c, 0x68, 0xcf, 0x6a, 0x6b, 0xe6, 0xde, 0x82, 0xe5, 0xec, 0x05,
-		0xc7, 0x22, 0xc8, 0xea, 0xc0, 0x0d, 0x1d, 0x8e, 0xb0, 0x77, 0xfe, 0x4a, 0x92, 0x7e, 0x34, 0x91,
-		0x5c, 0xd9, 0xac, 0xfe, 0x64, 0x62, 0x76, 0x85, 0x21, 0x6e, 0x8a, 0xee, 0x28, 0x78, 0xb7, 0x8d,
-		0x75, 0xc2, 0x3c, 0xfc, 0xc9, 0xa3, 0x30, 0xbd, 0x67, 0xed, 0x59, 0x94, 0xd2, 0x65, 0xf2, 0x17,
-		0x3f, 0x57, 0x91, 0xf5, 0x4b, 0x67, 0x87, 0x1e, 0xc2, 0x28, 0xaf, 0xc3, 0x14, 0x07, 0x56, 0xe9,
-		0xf6, 0x2d, 0x4b, 0x2e, 0xa0, 0x23, 0x33, 0xdb, 0x33, 0x3f, 0xfb, 0x87, 0x34, 0x2a, 0x51, 0x26,
-		0x39, 0x2a, 0xa9, 0x63, 0xf9, 0x87, 0xb2, 0x02, 0x27, 0x23, 0xf4, 0x98, 0x8d, 0xc0, 0xce, 0x10,
-		0x8a, 0xff, 0x86, 0x53, 0x9c, 0x0a, 0x51, 0x6c, 0x70, 0xd4, 0xf2, 0x22, 0x14, 0x8e, 0x43, 0xeb,
-		0x57, 0x39, 0xad, 0x3c, 0x0e, 0x13, 0x59, 0x81, 0x22, 0x25, 0xa2, 0x77, 0x5d, 0xcf, 0xea, 0x50,
-		0x03, 0x7c, 0x34, 0x99, 0x7f, 0xfb, 0x87, 0x6c, 0xd2, 0x4e, 0x10, 0xb4, 0x45, 0x1f, 0xab, 0x5c,
-		0x06, 0xba, 0x63, 0xdd, 0xc2, 0x7a, 0x7b, 0x08, 0x85, 0x5f, 0xe3, 0x8c, 0xf8, 0xf0, 0xe5, 0x6d,
-		0x98, 0x26, 0x7f, 0x53, 0xfb, 0x18, 0xe6, 0x64, 0x78, 0x1a, 0x7c, 0xe6, 0x3f, 0x7e, 0x33, 0xb3,
-		0x0b, 0x53, 0x3e, 0x81, 0x10, 0x4f, 0xa1, 0x51, 0xdc, 0xc3, 0x9e, 0x87, 0x1d, 0x57, 0xd5, 0xda,
-		0x71, 0xec, 0x85, 0xf2, 0x88, 0x33, 0x3f, 0xf4, 0xc5, 0xe8, 0x28, 0xae, 0x30, 0xcc, 0x4a, 0xbb,
-		0x5d, 0xde, 0x82, 0xd3, 0x31, 0x5a, 0x31, 0x02, 0xcd, 0x0f, 0x71, 0x9a, 0xd3, 0x7d, 0x9a, 0x41,
-		0xc8, 0x6e, 0x82, 0x28, 0xf7, 0xc7, 0x72, 0x04, 0x9a, 0x1f, 0xe6, 0x34, 0x11, 0xc7, 0x15, 0x43,
-		0x4a, 0x28, 0xde, 0x84, 0xc9, 0xdb, 0xd8, 0xd9, 0xb1, 0x5c, 0x9e, 0xbb, 0x1d, 0x81, 0xdc, 0x47,
-		0x38, 0xb9, 0x22, 0x47, 0xa4, 0xc9, 0x5c, 0x42, 0xeb, 0x59, 0xc8, 0xec, 0x6a, 0x3a, 0x1e, 0x81,
-		0xc4, 0x5d, 0x4e, 0x62, 0x9c, 0xc0, 0x13, 0xd4, 0x0a, 0xe4, 0xf7, 0x2c, 0xee, 0x22, 0x87, 0xa3,
-		0x7f, 0x94, 0xa3, 0xe7, 0x04, 0x0e, 0x27, 0x61, 0x5b, 0x76, 0xb7, 0x4d, 0xfc, 0xe7, 0x70, 0x12,
-		0x3f, 0x2c, 0x48, 0x08, 0x1c, 0x4e, 0xe2, 0x18, 0x62, 0xfd, 0x98, 0x20, 0xe1, 0x86, 0xe4, 0xf9,
-		0x12, 0xe4, 0x2c, 0xb3, 0x7d, 0x68, 0x99, 0xa3, 0x30, 0xf1, 0x23, 0x9c, 0x02, 0x70, 0x14, 0x42,
-		0xe0, 0x39, 0xc8, 0x8e, 0x3a, 0x10, 0x3f, 0xfe, 0x45, 0x31, 0x3d, 0xc4, 0x08, 0xac, 0x40, 0x51,
-		0x18, 0x28, 0xc3, 0x32, 0x47, 0x20, 0xf1, 0x13, 0x9c, 0xc4, 0x44, 0x08, 0x8d, 0x77, 0xc3, 0xc3,
-		0xae, 0xb7, 0x87, 0x47, 0x21, 0xf2, 0x71, 0xd1, 0x0d, 0x8e, 0xc2, 0x45, 0xb9, 0x83, 0x4d, 0x7d,
-		0x7f, 0x34, 0x0a, 0x9f, 0x10, 0xa2, 0x14, 0x38, 0x84, 0xc4, 0x22, 0x14, 0x3a, 0x9a, 0xe3, 0xee,
-		0x6b, 0xed, 0x91, 0x86, 0xe3, 0x93, 0x9c, 0x46, 0xde, 0x47, 0xe2, 0x12, 0xe9, 0x9a, 0xc7, 0x21,
-		0xf3, 0x93, 0x42, 0x22, 0x21, 0x34, 0x3e, 0xf5, 0x5c, 0x8f, 0x26, 0xba, 0x8f, 0x43, 0xed, 0xa7,
-		0xc4, 0xd4, 0x63, 0xb8, 0x6b, 0x61, 0x8a, 0xcf, 0x41, 0xd6, 0x35, 0xde, 0x18, 0x89, 0xcc, 0x3f,
-		0x14, 0x23, 0x4d, 0x11, 0x08, 0xf2, 0xab, 0x70, 0x26, 0xd6, 0x4d, 0x8c, 0x40, 0xec, 0x1f, 0x71,
-		0x62, 0xa7, 0x62, 0x5c, 0x05, 0x37, 0x09, 0xc7, 0x25, 0xf9, 0xd3, 0xc2, 0x24, 0xe0, 0x1e, 0x5a,
-		0x9b, 0x64, 0xd1, 0xe2, 0x6a, 0xbb, 0xc7, 0x93, 0xda, 0x3f, 0x16, 0x52, 0x63, 0xb8, 0x11, 0xa9,
-		0x35, 0xe1, 0x14, 0xa7, 0x78, 0xbc, 0x71, 0xfd, 0x19, 0x61, 0x58, 0x19, 0xf6, 0x56, 0x74, 0x74,
-		0xbf, 0x16, 0x66, 0x7d, 0x71, 0x8a, 0xe8, 0xd8, 0x55, 0x3b, 0x9a, 0x3d, 0x02, 0xe5, 0x9f, 0xe5,
-		0x94, 0x85, 0xc5, 0xf7, 0xc3, 0x6b, 0x77, 0x4d, 0xb3, 0x09, 0xf1, 0x57, 0x60, 0x46, 0x10, 0xef,
-		0x9a, 0x0e, 0xd6, 0xad, 0x3d, 0xd3, 0x78, 0x03, 0xb7, 0x46, 0x20, 0xfd, 0x4f, 0x7a, 0x86, 0x6a,
-		0x2b, 0x84, 0x4e, 0x28, 0xd7, 0x41, 0xf6, 0x63, 0x15, 0xd5, 0xe8, 0xd8, 0x96, 0xe3, 0x0d, 0xa1,
-		0xf8, 0x29, 0x31, 0x52, 0x3e, 0x5e, 0x9d, 0xa2, 0x95, 0x6b, 0xc0, 0x4e, 0x7f, 0x8c, 0xaa, 0x92,
-		0x9f, 0xe6, 0x84, 0x0a, 0x01, 0x16, 0x37, 0x1c, 0xba, 0xd5, 0xb1, 0x35, 0x67, 0x14, 0xfb, 0xf7,
-		0x73, 0xc2, 0x70, 0x70, 0x14, 0x6e, 0x38, 0x48, 0x44, 0x47, 0xbc, 0xfd, 0x08, 0x14, 0x7e, 0x5e,
-		0x18, 0x0e, 0x81, 0xc3, 0x49, 0x88, 0x80, 0x61, 0x04, 0x12, 0xbf, 0x20, 0x48, 0x08, 0x1c, 0x42,
-		0xe2, 0x3d, 0x81, 0xa3, 0x75, 0xf0, 0x9e, 0xe1, 0x7a, 0x0e, 0x0b, 0xc9, 0x8f, 0x26, 0xf5, 0x8b,
-		0x5f, 0x8c, 0x06, 0x61, 0x4a, 0x08, 0x95, 0x58, 0x22, 0xbe, 0xf5, 0x41, 0x97, 0x6c, 0xc3, 0x19,
-		0xfb, 0x25, 0x61, 0x89, 0x42, 0x68, 0x84, 0xb7, 0x50, 0x84, 0x48, 0xc4, 0xae, 0x93, 0x85, 0xca,
-		0x08, 0xe4, 0xfe, 0x69, 0x0f, 0x73, 0x0d, 0x81, 0x4b, 0x68, 0x86, 0xe2, 0x9f, 0xae, 0x79, 0x0b,
-		0x1f, 0x8e, 0xa4, 0x9d, 0xbf, 0xdc, 0x13, 0xff, 0x6c, 0x31, 0x4c, 0x66, 0x43, 0x8a, 0x3d, 0xf1,
-		0x14, 0x1a, 0x76, 0xd6, 0x6f, 0xe6, 0x9b, 0xbe, 0xc4, 0xfb, 0x1b, 0x0d, 0xa7, 0xca, 0xab, 0x44,
-		0xc9, 0xa3, 0x41, 0xcf, 0x70, 0x62, 0xdf, 0xfc, 0x25, 0x5f, 0xcf, 0x23, 0x31, 0x4f, 0x79, 0x19,
-		0x0a, 0x91, 0x80, 0x67, 0x38, 0xa9, 0x6f, 0xe1, 0xa4, 0xf2, 0xe1, 0x78, 0xa7, 0xfc, 0x14, 0xa4,
-		0x48, 0xf0, 0x32, 0x1c, 0xfd, 0x5b, 0x39, 0x3a, 0x05, 0x2f, 0xbf, 0x00, 0x19, 0x11, 0xb4, 0x0c,
-		0x47, 0xfd, 0x36, 0x8e, 0xea, 0xa3, 0x10, 0x74, 0x11, 0xb0, 0x0c, 0x47, 0xff, 0xff, 0x04, 0xba,
-		0x40, 0x21, 0xe8, 0xa3, 0x8b, 0xf0, 0x5f, 0x7e, 0x67, 0x8a, 0x3b, 0x1d, 0x21, 0xbb, 0xe7, 0x60,
-		0x9c, 0x47, 0x2a, 0xc3, 0xb1, 0xbf, 0x83, 0x37, 0x2e, 0x30, 0xca, 0x4f, 0xc3, 0xd8, 0x88, 0x02,
-		0xff, 0x6e, 0x8e, 0xca, 0xe0, 0xcb, 0x8b, 0x90, 0x0b, 0x45, 0x27, 0xc3, 0xd1, 0xbf, 0x87, 0xa3,
-		0x87, 0xb1, 0x08, 0xeb, 0x3c, 0x3a, 0x19, 0x4e, 0xe0, 0xff, 0x17, 0xac, 0x73, 0x0c, 0x22, 0x36,
-		0x11, 0x98, 0x0c, 0xc7, 0xfe, 0x80, 0x90, 0xba, 0x40, 0x29, 0xbf, 0x04, 0x59, 0xdf, 0xd9, 0x0c,
-		0xc7, 0xff, 0x5e, 0x8e, 0x1f, 0xe0, 0x10, 0x09, 0x84, 0x9c, 0xdd, 0x70, 0x12, 0x7f, 0x4f, 0x48,
-		0x20, 0x84, 0x45, 0xa6, 0x51, 0x6f, 0x00, 0x33, 0x9c, 0xd2, 0xf7, 0x89, 0x69, 0xd4, 0x13, 0xbf,
-		0x90, 0xd1, 0xa4, 0x36, 0x7f, 0x38, 0x89, 0xef, 0x17, 0xa3, 0x49, 0xe1, 0x09, 0x1b, 0xbd, 0x11,
-		0xc1, 0x70, 0x1a, 0x3f, 0x28, 0xd8, 0xe8, 0x09, 0x08, 0xca, 0x9b, 0x80, 0xfa, 0xa3, 0x81, 0xe1,
-		0xf4, 0x3e, 0xc8, 0xe9, 0x4d, 0xf6, 0x05, 0x03, 0xe5, 0xf7, 0xc2, 0xa9, 0xf8, 0x48, 0x60, 0x38,
-		0xd5, 0x1f, 0xfa, 0x52, 0xcf, 0xda, 0x2d, 0x1c, 0x08, 0x94, 0x9b, 0x81, 0x4b, 0x09, 0x47, 0x01,
-		0xc3, 0xc9, 0x7e, 0xe8, 0x4b, 0x51, 0xc3, 0x1d, 0x0e, 0x02, 0xca, 0x15, 0x80, 0xc0, 0x01, 0x0f,
-		0xa7, 0xf5, 0x11, 0x4e, 0x2b, 0x84, 0x44, 0xa6, 0x06, 0xf7, 0xbf, 0xc3, 0xf1, 0xef, 0x8a, 0xa9,
-		0xc1, 0x31, 0xc8, 0xd4, 0x10, 0xae, 0x77, 0x38, 0xf6, 0x47, 0xc5, 0xd4, 0x10, 0x28, 0x44, 0xb3,
-		0x43, 0xde, 0x6d, 0x38, 0x85, 0x1f, 0x11, 0x9a, 0x1d, 0xc2, 0x2a, 0xaf, 0xc3, 0x64, 0x9f, 0x43,
-		0x1c, 0x4e, 0xea, 0x47, 0x39, 0x29, 0xb9, 0xd7, 0x1f, 0x86, 0x9d, 0x17, 0x77, 0x86, 0xc3, 0xa9,
-		0xfd, 0x58, 0x8f, 0xf3, 0xe2, 0xbe, 0xb0, 0xfc, 0x1c, 0x64, 0xcc, 0x6e, 0xbb, 0x4d, 0x26, 0x0f,
-		0x3a, 0xfa, 0x7c, 0xee, 0xcc, 0x7f, 0xf9, 0x0a, 0x97, 0x8e, 0x40, 0x28, 0x3f, 0x05, 0x63, 0xb8,
-		0xb3, 0x83, 0x5b, 0xc3, 0x30, 0xff, 0xe8, 0x2b, 0xc2, 0x60, 0x12, 0xe8, 0xf2, 0x4b, 0x00, 0x2c,
-		0x35, 0x42, 0x37, 0xce, 0x87, 0xe0, 0xfe, 0xf1, 0x57, 0xf8, 0x81, 0xb8, 0x00, 0x25, 0x20, 0xc0,
-		0x8e, 0xd7, 0x1d, 0x4d, 0xe0, 0x8b, 0x51, 0x02, 0x74, 0x44, 0x9e, 0x85, 0xf1, 0xd7, 0x5d, 0xcb,
-		0xf4, 0xb4, 0xbd, 0x61, 0xd8, 0x7f, 0xc2, 0xb1, 0x05, 0x3c, 0x11, 0x58, 0xc7, 0x72, 0xb0, 0xa7,
-		0xed, 0xb9, 0xc3, 0x70, 0xff, 0x94, 0xe3, 0xfa, 0x08, 0x04, 0x59, 0xd7, 0x5c, 0x6f, 0x94, 0x7e,
-		0xff, 0x57, 0x81, 0x2c, 0x10, 0x08, 0xd3, 0xe4, 0xef, 0x5b, 0xf8, 0x70, 0x18, 0xee, 0x9f, 0x09,
-		0xa6, 0x39, 0x7c, 0xf9, 0x05, 0xc8, 0x92, 0x3f, 0xd9, 0x29, 0xd7, 0x21, 0xc8, 0x7f, 0xce, 0x91,
-		0x03, 0x0c, 0xd2, 0xb2, 0xeb, 0xb5, 0x3c, 0x63, 0xb8, 0xb0, 0xff, 0x82, 0x8f, 0xb4, 0x80, 0x2f,
-		0x57, 0x20, 0xe7, 0x7a, 0xad, 0x56, 0x97, 0xc7, 0xa7, 0x43, 0xd0, 0xff, 0xdb, 0x57, 0xfc, 0x94,
-		0x85, 0x8f, 0x43, 0x46, 0xfb, 0xce, 0x2d, 0xcf, 0xb6, 0xe8, 0x7e, 0xcb, 0x30, 0x0a, 0x5f, 0xe2,
-		0x14, 0x42, 0x28, 0xe5, 0x45, 0xc8, 0x93, 0xbe, 0x38, 0xd8, 0xc6, 0x74, 0x73, 0x6c, 0x08, 0x89,
-		0x2f, 0x73, 0x01, 0x44, 0x90, 0xaa, 0xdf, 0xf8, 0x6b, 0x9f, 0x3d, 0x27, 0x7d, 0xe6, 0xb3, 0xe7,
-		0xa4, 0xdf, 0xff, 0xec, 0x39, 0xe9, 0x03, 0x9f, 0x3b, 0x77, 0xe2, 0x33, 0x9f, 0x3b, 0x77, 0xe2,
-		0xb7, 0x3f, 0x77, 0xee, 0x44, 0x7c, 0x96, 0x18, 0x56, 0xac, 0x15, 0x8b, 0xe5, 0x87, 0x5f, 0x7b,
-		0x68, 0xcf, 0xf0, 0xf6, 0xbb, 0x3b, 0x0b, 0xba, 0xd5, 0xb9, 0xac, 0x5b, 0x6e, 0xc7, 0x72, 0x2f,
-		0x47, 0xf3, 0xba, 0xf4, 0x2f, 0xf8, 0x1f, 0x12, 0x59, 0x33, 0x47, 0xd3, 0xb9, 0x9a, 0x79, 0x38,
-		0xe8, 0x32, 0xdd, 0x75, 0x48, 0x56, 0xcc, 0x43, 0x74, 0x86, 0x19, 0x38, 0xb5, 0xeb, 0xb4, 0xf9,
-		0x51, 0xcb, 0x71, 0xf2, 0xbd, 0xe5, 0xb4, 0xd1, 0x74, 0x70, 0x1e, 0x5a, 0xba, 0x98, 0xe7, 0x87,
-		0x9c, 0xab, 0xdf, 0x23, 0x1d, 0xaf, 0x27, 0x99, 0x8a, 0x79, 0x48, 0x3b, 0xb2, 0x29, 0xbd, 0xf6,
-		0xd8, 0xd0, 0x3c, 0xf7, 0x2d, 0xd3, 0xba, 0x63, 0x12, 0xb6, 0xed, 0x1d, 0x91, 0xe3, 0x3e, 0xd7,
-		0x9b, 0xe3, 0x7e, 0x2f, 0x6e, 0xb7, 0x5f, 0x26, 0x70, 0x4d, 0x82, 0xb2, 0x93, 0x66, 0xa7, 0xfa,
-		0xe1, 0xfb, 0x12, 0x70, 0xae, 0x2f, 0x9d, 0xcd, 0x95, 0x60, 0x90, 0x10, 0xca, 0x90, 0x59, 0x12,
-		0xba, 0x35, 0x03, 0xe3, 0x2e, 0xd6, 0x2d, 0xb3, 0xe5, 0x52, 0x41, 0x24, 0x15, 0xf1, 0x49, 0x04,
-		0x61, 0x6a, 0xa6, 0xe5, 0xf2, 0xc3, 0xca, 0xec, 0xa3, 0xfa, 0xe1, 0x63, 0x0a, 0xa2, 0x20, 0x5a,
-		0x12, 0xd2, 0xb8, 0x32, 0xa2, 0x34, 0x44, 0x27, 0x22, 0x99, 0xff, 0x51, 0xa5, 0xf2, 0x83, 0x09,
-		0x98, 0xeb, 0x95, 0x0a, 0x99, 0x59, 0xae, 0xa7, 0x75, 0xec, 0x41, 0x62, 0x79, 0x0e, 0xb2, 0x4d,
-		0x01, 0x73, 0x6c, 0xb9, 0xdc, 0x3d, 0xa6, 0x5c, 0x26, 0xfc, 0xa6, 0x84, 0x60, 0xae, 0x8e, 0x28,
-		0x18, 0xbf, 0x1f, 0xf7, 0x24, 0x99, 0x0f, 0x67, 0xe1, 0x0c, 0x9b, 0x4e, 0x2a, 0x9b, 0x4a, 0xec,
-		0x83, 0xcb, 0x24, 0x1f, 0xae, 0x1a, 0xbe, 0x4f, 0x52, 0x7a, 0x19, 0xa6, 0xea, 0xc4, 0x5a, 0x90,
-		0x55, 0x50, 0xb0, 0xc3, 0x13, 0x7b, 0x9e, 0x7b, 0x3e, 0x12, 0xf0, 0xf3, 0xfd, 0xad, 0x70, 0x51,
-		0xe9, 0x9b, 0x24, 0x90, 0x1b, 0xba, 0xd6, 0xd6, 0x9c, 0xb7, 0x4b, 0x0a, 0x3d, 0x0d, 0xc0, 0x8e,
-		0x7b, 0xf8, 0x17, 0xf7, 0x26, 0xae, 0xce, 0x2c, 0x84, 0x3b, 0xb7, 0xc0, 0x5a, 0xa2, 0x27, 0xa8,
-		0xb2, 0x14, 0x96, 0xfc, 0x79, 0xe9, 0x15, 0x80, 0xa0, 0x02, 0x9d, 0x85, 0xd3, 0x8d, 0xc5, 0xca,
-		0x6a, 0x45, 0x11, 0x87, 0x84, 0x1a, 0x9b, 0xb5, 0xc5, 0xfa, 0x72, 0xbd, 0xb6, 0x24, 0x9f, 0x40,
-		0xa7, 0x00, 0x85, 0x2b, 0xfd, 0x43, 0x4d, 0x27, 0x61, 0x32, 0x5c, 0xce, 0x6e, 0xa9, 0x24, 0xca,
-		0x37, 0xa0, 0xc8, 0x8e, 0xd0, 0xab, 0x5a, 0xab, 0x85, 0x5b, 0xaa, 0x61, 0xa2, 0x21, 0x27, 0xd2,
-		0x67, 0x7e, 0xfd, 0x3f, 0x8d, 0xd1, 0xae, 0x15, 0x18, 0x62, 0x85, 0xe0, 0xd5, 0x4d, 0x12, 0x73,
-		0x1a, 0x1d, 0xbb, 0x8d, 0xe9, 0x1e, 0xa6, 0x6a, 0x08, 0xf9, 0x0f, 0x0f, 0x67, 0x08, 0xbd, 0xe4,
-		0xc5, 0xac, 0x32, 0x15, 0xa0, 0xfb, 0xa3, 0x57, 0x7e, 0x19, 0x64, 0x71, 0xd4, 0xd3, 0x67, 0x70,
-		0x28, 0xc5, 0xdf, 0xe0, 0x1c, 0x8a, 0x6c, 0x86, 0x60, 0x71, 0x15, 0x26, 0x35, 0x5d, 0xc7, 0x76,
-		0x84, 0xbf, 0x21, 0x1e, 0x44, 0xf4, 0x56, 0xe6, 0x98, 0x01, 0x6b, 0x4f, 0x43, 0xda, 0xa5, 0x83,
-		0x32, 0x8c, 0x84, 0x60, 0x87, 0x83, 0x97, 0x6b, 0x30, 0xc1, 0xd4, 0xc0, 0xef, 0xd1, 0x10, 0x02,
-		0xff, 0x8e, 0x13, 0xc8, 0x53, 0x34, 0xd1, 0x1b, 0x13, 0x26, 0x49, 0x5c, 0xab, 0x39, 0x38, 0xd4,
-		0x9b, 0xa3, 0xb3, 0x28, 0xff, 0xec, 0x53, 0x4f, 0xd0, 0x7d, 0xe3, 0xf3, 0x51, 0xa5, 0x8b, 0x99,
-		0x2c, 0x8a, 0xcc, 0x69, 0x07, 0xfd, 0xc5, 0x30, 0x21, 0xda, 0xe3, 0xfd, 0x3e, 0xba, 0xb1, 0x7f,
-		0xce, 0x1b, 0x3b, 0x17, 0xa7, 0xe1, 0xa1, 0x96, 0x0a, 0x9c, 0x2a, 0xab, 0x28, 0x57, 0xa1, 0xb0,
-		0x6b, 0xb4, 0x43, 0xc3, 0x7d, 0x74, 0x2b, 0xff, 0xe2, 0x53, 0x4f, 0xb0, 0x89, 0x46, 0x90, 0xb8,
-		0x68, 0xaa, 0xb5, 0x41, 0x56, 0xef, 0xb5, 0x47, 0xfb, 0xfd, 0x37, 0xfb, 0xef, 0x71, 0x4a, 0xfd,
-		0xb9, 0x30, 0xab, 0x81, 0x75, 0x4a, 0xc1, 0xa4, 0xd6, 0x31, 0x4c, 0xeb, 0x32, 0xfd, 0x97, 0x5b,
-		0xa5, 0x31, 0xfa, 0x31, 0xc2, 0xb6, 0xed, 0x75, 0x66, 0x2c, 0x86, 0xeb, 0xed, 0x9f, 0x7f, 0xd7,
-		0x4f, 0x8c, 0x05, 0x06, 0xa5, 0xbc, 0x16, 0xe8, 0x3e, 0x36, 0x75, 0xab, 0x35, 0x52, 0x1e, 0xe7,
-		0x2f, 0x04, 0x0d, 0x91, 0x01, 0xac, 0x71, 0xd4, 0xf2, 0xf3, 0x90, 0xf1, 0xc9, 0x0c, 0x8b, 0xdd,
-		0x04, 0x11, 0x1f, 0x83, 0x44, 0x6e, 0x4c, 0x69, 0x47, 0x89, 0xd3, 0xbf, 0x24, 0xf0, 0x99, 0x0d,
-		0x5b, 0x27, 0xbd, 0x59, 0x81, 0x89, 0x96, 0x65, 0x7a, 0xaa, 0xd5, 0x31, 0x3c, 0xdc, 0xb1, 0xbd,
-		0xa1, 0x91, 0xef, 0x97, 0x19, 0x91, 0x8c, 0x52, 0x20, 0x78, 0x1b, 0x02, 0x8d, 0x70, 0xc2, 0x6e,
-		0x32, 0x8e, 0xc2, 0xc9, 0x5f, 0xfa, 0x9c, 0x50, 0x1c, 0xc2, 0xc9, 0x3d, 0x69, 0x87, 0xdb, 0xba,
-		0xc5, 0xdd, 0x9d, 0x77, 0xc0, 0xb4, 0xc0, 0xd7, 0x8e, 0x8f, 0x27, 0xe1, 0x1c, 0x07, 0xde, 0xd1,
-		0x5c, 0x7c, 0xf9, 0xf6, 0x95, 0x1d, 0xec, 0x69, 0x57, 0x2e, 0xeb, 0x96, 0x21, 0x62, 0x9d, 0x29,
-		0xee, 0xce, 0x48, 0xfd, 0x02, 0xaf, 0x9f, 0x8d, 0x3d, 0x10, 0x30, 0x3b, 0xd8, 0x0d, 0xce, 0xf6,
-		0xeb, 0x60, 0xa9, 0x0d, 0xa9, 0x45, 0xcb, 0x30, 0x89, 0xf7, 0x6f, 0x61, 0xd3, 0xea, 0x70, 0x87,
-		0xc4, 0x3e, 0xd0, 0x0d, 0x48, 0x6b, 0x1d, 0xab, 0x6b, 0x7a, 0xcc, 0x19, 0x55, 0x9f, 0xf8, 0xb5,
-		0xb7, 0xe6, 0x4e, 0xfc, 0xce, 0x5b, 0x73, 0x27, 0x19, 0x59, 0xb7, 0x75, 0x6b, 0xc1, 0xb0, 0x2e,
-		0x77, 0x34, 0x6f, 0x9f, 0x98, 0x80, 0xdf, 0xfa, 0xf4, 0xe3, 0xc0, 0xdb, 0xab, 0x9b, 0xde, 0x27,
-		0x3e, 0xff, 0x33, 0x97, 0x24, 0x85, 0xe3, 0x97, 0x53, 0x5f, 0xf8, 0xd8, 0x9c, 0x54, 0xb2, 0x61,
-		0x7c, 0x09, 0xeb, 0x47, 0x34, 0x58, 0xef, 0x69, 0xf0, 0x0a, 0x6f, 0xf0, 0x6c, 0x7f, 0x83, 0xec,
-		0x48, 0xe3, 0x12, 0xd6, 0x43, 0xcd, 0x2e, 0x61, 0x3d, 0xda, 0x62, 0x75, 0xe9, 0xb7, 0xff, 0xe0,
-		0xdc, 0x89, 0xf7, 0x7f, 0xf6, 0xdc, 0x89, 0x81, 0x43, 0x56, 0x1a, 0x3e, 0x64, 0xfe, 0x48, 0x7d,
-		0x32, 0x45, 0x46, 0xaa, 0x83, 0xbd, 0x9d, 0x5d, 0xef, 0xb2, 0xee, 0x1c, 0xda, 0x9e, 0x75, 0xf9,
-		0xf6, 0x15, 0x32, 0x73, 0xad, 0x5d, 0x3e, 0x52, 0x48, 0xd4, 0x2f, 0xb0, 0xfa, 0x85, 0xdb, 0x03,
-		0x06, 0xaa, 0xb4, 0x0b, 0x63, 0x9b, 0x04, 0x91, 0x88, 0xc2, 0xb3, 0x3c, 0xad, 0xcd, 0x23, 0x32,
-		0xf6, 0x41, 0x4a, 0xd9, 0x4d, 0xdb, 0x04, 0x2b, 0x35, 0xc4, 0x25, 0xdb, 0x36, 0xd6, 0x76, 0xd9,
-		0x85, 0xa5, 0x24, 0x0d, 0xe5, 0x33, 0xa4, 0x80, 0xde, 0x4d, 0x9a, 0x86, 0x31, 0xad, 0xcb, 0x0e,
-		0x15, 0x25, 0x49, 0x8c, 0x4f, 0x3f, 0x4a, 0xab, 0x30, 0xce, 0xcf, 0x16, 0x20, 0x19, 0x92, 0xb7,
-		0xf0, 0x21, 0x6d, 0x27, 0xaf, 0x90, 0x3f, 0xd1, 0x65, 0x18, 0xa3, 0xdc, 0xf3, 0x9b, 0x98, 0x67,
-		0x16, 0xfa, 0xd9, 0x5f, 0xa0, 0x5c, 0x2a, 0x0c, 0xae, 0x74, 0x13, 0x32, 0x4b, 0x16, 0x51, 0xa0,
-		0x28, 0xb9, 0x2c, 0x23, 0x47, 0x99, 0xb6, 0xbb, 0x7c, 0xf8, 0x14, 0xf6, 0x81, 0x4e, 0x41, 0x9a,
-		0xdd, 0x60, 0xe3, 0x27, 0xa3, 0xf8, 0x57, 0x69, 0x11, 0xc6, 0x29, 0xed, 0x0d, 0x9b, 0xc4, 0x43,
-		0xfe, 0xa1, 0xfe, 0x2c, 0xbf, 0xcf, 0xcc, 0xc9, 0x27, 0x02, 0x6e, 0x11, 0xa4, 0x5a, 0x9a, 0xa7,
-		0xf1, 0x8e, 0xd3, 0xbf, 0x4b, 0x2f, 0x41, 0x86, 0x13, 0x71, 0xd1, 0x93, 0x90, 0xb4, 0x6c, 0x97,
-		0x9f, 0x6d, 0x3a, 0x3b, 0xb0, 0x2f, 0x1b, 0x76, 0x35, 0x45, 0x14, 0x4b, 0x21, 0xd0, 0xd5, 0xb5,
-		0x81, 0xaa, 0xf1, 0x64, 0x44, 0x35, 0xc4, 0xb0, 0x8b, 0x3f, 0x34, 0xdb, 0xb8, 0xdc, 0xaf, 0x0c,
-		0xbe, 0xae, 0xfc, 0x77, 0x09, 0xee, 0x8f, 0xd1, 0x95, 0x5b, 0xf8, 0xd0, 0x3d, 0xb6, 0xaa, 0xbc,
-		0x02, 0xd9, 0x4d, 0xfa, 0xba, 0xc8, 0xcb, 0xf8, 0x10, 0xcd, 0xc2, 0x38, 0x6e, 0x5d, 0x7d, 0xea,
-		0xa9, 0x2b, 0xcf, 0xb2, 0x81, 0xbc, 0x71, 0x42, 0x11, 0x05, 0xe8, 0x1c, 0x64, 0x5d, 0xac, 0xdb,
-		0x57, 0x9f, 0xba, 0x7e, 0xeb, 0x0a, 0x13, 0xdc, 0x8d, 0x13, 0x4a, 0x50, 0x54, 0xce, 0x90, 0x49,
-		0xf1, 0x85, 0x1f, 0x99, 0x93, 0xaa, 0x63, 0x90, 0x74, 0xbb, 0x9d, 0x77, 0xab, 0xf3, 0x7f, 0x99,
-		0x86, 0xf3, 0x7e, 0x35, 0x33, 0x7b, 0xb7, 0xaf, 0x5c, 0xbe, 0xad, 0xb5, 0x8d, 0x96, 0x16, 0xbc,
-		0x09, 0x33, 0xe9, 0x0b, 0x80, 0x82, 0x0c, 0xec, 0xff, 0xec, 0xd1, 0x82, 0x2c, 0x7d, 0x5a, 0x82,
-		0xfc, 0xb6, 0xa0, 0xdd, 0xc0, 0x1e, 0x7a, 0x1e, 0xc0, 0x6f, 0x4b, 0xa8, 0xc3, 0x7d, 0x0b, 0x7d,
-		0xad, 0x2d, 0xf8, 0x48, 0x4a, 0x08, 0x1e, 0x3d, 0x03, 0x19, 0xdb, 0xb1, 0x6c, 0xcb, 0xe5, 0x37,
-		0x5a, 0x87, 0xe1, 0xfa, 0xd0, 0xe8, 0x31, 0x40, 0x74, 0xf2, 0xaa, 0xb7, 0x2d, 0xcf, 0x30, 0xf7,
-		0x54, 0xdb, 0xba, 0xc3, 0x1f, 0x0a, 0x48, 0x2a, 0x32, 0xad, 0xd9, 0xa6, 0x15, 0x9b, 0xa4, 0xbc,
-		0xf4, 0x29, 0x09, 0xb2, 0x3e, 0x15, 0xb2, 0x32, 0xd3, 0x5a, 0x2d, 0x07, 0xbb, 0x2e, 0x9f, 0x9f,
-		0xe2, 0x13, 0x3d, 0x0f, 0xe3, 0x76, 0x77, 0x47, 0x15, 0x73, 0x21, 0x77, 0xf5, 0xfe, 0x58, 0xcd,
-		0x16, 0x0a, 0xc2, 0x75, 0x3b, 0x6d, 0x77, 0x77, 0x88, 0xba, 0x9c, 0x87, 0x7c, 0x0c, 0x37, 0xb9,
-		0xdb, 0x01, 0x23, 0xf4, 0x55, 0x1b, 0xde, 0x05, 0xd5, 0x76, 0x0c, 0xcb, 0x31, 0xbc, 0x43, 0x7a,
-		0xf4, 0x2e, 0xa9, 0xc8, 0xa2, 0x62, 0x93, 0x97, 0x97, 0xda, 0x50, 0x6c, 0xd0, 0x40, 0x3b, 0x60,
-		0xfd, 0x7a, 0xc0, 0xa0, 0x34, 0x02, 0x83, 0x03, 0x59, 0x4b, 0xf4, 0xb1, 0x76, 0xe9, 0x3f, 0x4b,
-		0x90, 0xab, 0xb6, 0x2d, 0xfd, 0x56, 0x7d, 0x69, 0xb9, 0xad, 0xed, 0xa1, 0x2b, 0x70, 0xb2, 0xba,
-		0xba, 0xb1, 0xf8, 0xb2, 0x5a, 0x5f, 0x52, 0x97, 0x57, 0x2b, 0x2b, 0xc1, 0x61, 0xdf, 0xd9, 0x53,
-		0x6f, 0xde, 0x9d, 0x47, 0x21, 0xd8, 0x2d, 0x93, 0x2e, 0x2c, 0xd1, 0x65, 0x98, 0x8e, 0xa2, 0x54,
-		0xaa, 0x8d, 0xda, 0x7a, 0x53, 0x96, 0x66, 0x4f, 0xbe, 0x79, 0x77, 0x7e, 0x32, 0x84, 0x51, 0xd9,
-		0x71, 0xb1, 0xe9, 0xf5, 0x23, 0x2c, 0x6e, 0xac, 0xad, 0xd5, 0x9b, 0x72, 0xa2, 0x0f, 0x61, 0xd1,
-		0xea, 0x74, 0x0c, 0x0f, 0x3d, 0x02, 0x93, 0x51, 0x84, 0xf5, 0xfa, 0xaa, 0x9c, 0x9c, 0x45, 0x6f,
-		0xde, 0x9d, 0x9f, 0x08, 0x41, 0xaf, 0x1b, 0xed, 0xd9, 0xcc, 0xb7, 0xff, 0xd8, 0xb9, 0x13, 0x9f,
-		0xf8, 0x07, 0xe7, 0xa4, 0xea, 0xea, 0xc0, 0x99, 0x77, 0x75, 0xf4, 0x99, 0x27, 0xa6, 0x96, 0x3f,
-		0xf1, 0x3e, 0x9a, 0x80, 0x39, 0xbf, 0xf6, 0x36, 0x76, 0x5c, 0xc3, 0x32, 0xc9, 0x6c, 0x61, 0x6a,
-		0xeb, 0x07, 0x13, 0x7c, 0x70, 0x38, 0xc0, 0x60, 0xc3, 0xf3, 0x02, 0x24, 0x2b, 0xb6, 0x8d, 0x66,
-		0xe9, 0x8c, 0xf0, 0x2c, 0xdd, 0x62, 0x4e, 0x2a, 0xa5, 0xf8, 0xdf, 0xa4, 0xce, 0xb5, 0x76, 0xbd,
-		0x3b, 0x9a, 0xe3, 0x3f, 0x2c, 0x21, 0xbe, 0x4b, 0xcf, 0x42, 0x76, 0xd1, 0x32, 0x5d, 0x6c, 0xba,
-		0x5d, 0x9a, 0x60, 0xd8, 0x21, 0xc2, 0xe0, 0x14, 0xd8, 0x07, 0x31, 0xf2, 0x9a, 0x6d, 0x53, 0xcc,
-		0x94, 0x42, 0xfe, 0xe4, 0x8e, 0x7b, 0x7d, 0xa0, 0x78, 0xae, 0x8d, 0x2e, 0x9e, 0x40, 0x00, 0xbe,
-		0x80, 0xbe, 0xff, 0xfe, 0x90, 0x59, 0xf6, 0x2d, 0x53, 0x58, 0x3c, 0x31, 0x56, 0x69, 0x88, 0xd3,
-		0x9f, 0x1d, 0x6e, 0xeb, 0x66, 0x87, 0x8d, 0xca, 0x00, 0xcb, 0x37, 0x2c, 0xdd, 0x53, 0x7a, 0x16,
-		0x0a, 0x9b, 0x9a, 0xe3, 0x35, 0xb0, 0x77, 0x03, 0x6b, 0x2d, 0xec, 0x44, 0xa3, 0x89, 0x82, 0x88,
-		0x26, 0x10, 0xa4, 0x68, 0xc8, 0xc0, 0x9c, 0x29, 0xfd, 0xbb, 0x64, 0x40, 0x8a, 0x9e, 0xbd, 0xf6,
-		0x23, 0x0d, 0x8e, 0xc1, 0x22, 0x0d, 0x32, 0x5c, 0x87, 0x1e, 0x76, 0x45, 0xc2, 0x90, 0x7e, 0xa0,
-		0xa7, 0x44, 0xbc, 0x90, 0x1c, 0x12, 0x2f, 0x70, 0x2b, 0xc4, 0xa3, 0x86, 0x0e, 0x8c, 0xf3, 0x89,
-		0xe0, 0x73, 0x22, 0x05, 0x9c, 0xa0, 0x75, 0x28, 0xda, 0x9a, 0xe3, 0xd1, 0xcb, 0x98, 0xfb, 0xb4,
-		0x1b, 0xdc, 0xd2, 0xcd, 0xc7, 0x18, 0xde, 0x48, 0x77, 0x79, 0x33, 0x05, 0x3b, 0x5c, 0x58, 0xfa,
-		0x42, 0x0a, 0xd2, 0x5c, 0x1c, 0x2f, 0xc2, 0x38, 0x17, 0x38, 0xb7, 0x4d, 0xe7, 0x16, 0x62, 0xd4,
-		0x7f, 0xc1, 0x57, 0x53, 0x4e, 0x50, 0x20, 0xa1, 0x87, 0x21, 0xa3, 0xef, 0x6b, 0x86, 0xa9, 0x1a,
-		0x2d, 0x1e, 0x93, 0xe6, 0x3e, 0xfb, 0xd6, 0xdc, 0xf8, 0x22, 0x29, 0xab, 0x2f, 0x29, 0xe3, 0xb4,
-		0xb2, 0xde, 0x22, 0x31, 0xce, 0x3e, 0x36, 0xf6, 0xf6, 0x3d, 0x6e, 0x60, 0xf9, 0x17, 0x7a, 0x06,
-		0x52, 0x64, 0xc8, 0xf8, 0x65, 0xfd, 0xd9, 0xbe, 0xc5, 0x86, 0x9f, 0x2f, 0xab, 0x66, 0x48, 0xc3,
-		0x1f, 0xf8, 0xbd, 0x39, 0x49, 0xa1, 0x18, 0x68, 0x09, 0x0a, 0x6d, 0xcd, 0xf5, 0x54, 0x3a, 0x4f,
-		0x48, 0xf3, 0x63, 0x9c, 0x44, 0xbf, 0x48, 0xb8, 0x6c, 0x39, 0xef, 0x39, 0x82, 0xc6, 0x8a, 0x5a,
-		0xe8, 0x22, 0xc8, 0x94, 0x8a, 0x4e, 0x4d, 0x15, 0x8b, 0x1b, 0xd3, 0x54, 0xf4, 0x13, 0xa4, 0x9c,
-		0x59, 0x30, 0x1a, 0x3d, 0x9e, 0x85, 0x2c, 0xbd, 0x1f, 0x4c, 0x41, 0xd8, 0xa1, 0xff, 0x0c, 0x29,
-		0xa0, 0x95, 0x17, 0xa0, 0x18, 0x78, 0x48, 0x06, 0x92, 0x61, 0x54, 0x82, 0x62, 0x0a, 0xf8, 0x04,
-		0x4c, 0x9b, 0xf8, 0x80, 0x5e, 0x43, 0x88, 0x40, 0x67, 0x29, 0x34, 0x22, 0x75, 0xdb, 0x51, 0x8c,
-		0x87, 0x60, 0x42, 0x17, 0xd2, 0x67, 0xb0, 0x40, 0x61, 0x0b, 0x7e, 0x29, 0x05, 0x3b, 0x03, 0x19,
-		0xcd, 0xb6, 0x19, 0x40, 0x8e, 0x3b, 0x48, 0xdb, 0xa6, 0x55, 0x97, 0x60, 0x92, 0xf6, 0xd1, 0xc1,
-		0x6e, 0xb7, 0xed, 0x71, 0x22, 0x79, 0x0a, 0x53, 0x24, 0x15, 0x0a, 0x2b, 0xa7, 0xb0, 0x0f, 0x40,
-		0x01, 0xdf, 0x36, 0x5a, 0xd8, 0xd4, 0x31, 0x83, 0x2b, 0x50, 0xb8, 0xbc, 0x28, 0xa4, 0x40, 0x8f,
-		0x80, 0xef, 0xf7, 0x54, 0xe1, 0x94, 0x27, 0x18, 0x3d, 0x51, 0x5e, 0x61, 0xc5, 0xa5, 0x19, 0x48,
-		0x2d, 0x69, 0x9e, 0x46, 0xec, 0x98, 0x77, 0xc0, 0x62, 0x8d, 0xbc, 0x42, 0xfe, 0x2c, 0xfd, 0x52,
-		0x12, 0x52, 0xdb, 0x96, 0x87, 0xd1, 0xb5, 0x50, 0x6c, 0x3b, 0x11, 0xab, 0xd2, 0x0d, 0x63, 0xcf,
-		0xc4, 0xad, 0x35, 0x77, 0x2f, 0xf4, 0x9a, 0x4f, 0xa0, 0x50, 0x89, 0x88, 0x42, 0x4d, 0xc3, 0x98,
-		0x63, 0x75, 0xcd, 0x96, 0x38, 0x2f, 0x4f, 0x3f, 0xd0, 0x32, 0x64, 0x7c, 0x3d, 0x49, 0x0d, 0xd5,
-		0x93, 0x22, 0xd1, 0x13, 0xa2, 0xc6, 0xbc, 0x40, 0x19, 0xdf, 0xe1, 0xea, 0x52, 0x85, 0xac, 0x6f,
-		0x61, 0x7c, 0x85, 0x1b, 0x45, 0x67, 0x03, 0x34, 0x12, 0x4e, 0xf8, 0xa3, 0xef, 0x8b, 0x8f, 0xe9,
-		0x9c, 0xec, 0x57, 0x70, 0xf9, 0x45, 0x14, 0x8b, 0x3f, 0x2d, 0x34, 0x4e, 0x3b, 0x16, 0x28, 0x16,
-		0x7b, 0x5e, 0xe8, 0x3e, 0xc8, 0xba, 0xc6, 0x9e, 0xa9, 0x79, 0x5d, 0x07, 0x73, 0xdd, 0x0b, 0x0a,
-		0x48, 0x6d, 0x70, 0x79, 0x84, 0xe9, 0x5a, 0xe8, 0xc5, 0xb3, 0xcb, 0x30, 0x15, 0xbc, 0x35, 0x16,
-		0x50, 0x61, 0x7a, 0x86, 0xfc, 0xaa, 0x86, 0xa8, 0x29, 0xfd, 0x2b, 0x09, 0xd2, 0xdc, 0xb9, 0x07,
-		0xe3, 0x20, 0xc5, 0x8f, 0x43, 0x62, 0xd0, 0x38, 0x24, 0xdf, 0xd6, 0x38, 0x80, 0xcf, 0xa7, 0xcb,
-		0x5f, 0x90, 0x89, 0x8b, 0x42, 0x19, 0x93, 0x0d, 0x63, 0x8f, 0xcf, 0xfd, 0x10, 0x56, 0xe9, 0x2d,
-		0x89, 0xb8, 0x5f, 0x5e, 0x8f, 0xaa, 0x50, 0x10, 0x9c, 0xa9, 0xbb, 0x6d, 0x6d, 0x8f, 0xab, 0xe3,
-		0xb9, 0xc1, 0xec, 0x91, 0x98, 0x45, 0xc9, 0x71, 0x8e, 0x68, 0xf4, 0x15, 0x3b, 0xb2, 0x89, 0x01,
-		0x23, 0x1b, 0x51, 0xa5, 0xe4, 0xbd, 0xa9, 0x52, 0x64, 0xd0, 0x53, 0x3d, 0x83, 0x5e, 0xfa, 0x9c,
-		0xc4, 0x1f, 0x3b, 0x6b, 0xb1, 0xcb, 0x2f, 0x7f, 0x6d, 0xa3, 0xf5, 0xb5, 0x5c, 0xbf, 0x5a, 0xb8,
-		0xa5, 0xf6, 0x0d, 0xdb, 0x83, 0x31, 0x24, 0xa3, 0x5c, 0x07, 0xc3, 0x87, 0x04, 0x99, 0x46, 0x30,
-		0x8c, 0x3f, 0x97, 0x80, 0xc9, 0x3e, 0xf8, 0xbf, 0x85, 0xc3, 0x19, 0x9d, 0xc3, 0x63, 0x23, 0xce,
-		0xe1, 0xf4, 0xc0, 0x39, 0xfc, 0x73, 0x09, 0x9a, 0x19, 0xb0, 0x2d, 0x57, 0x6b, 0x7f, 0x55, 0x6c,
-		0xf0, 0x59, 0xc8, 0xda, 0x56, 0x5b, 0x65, 0x35, 0xec, 0xe6, 0x52, 0xc6, 0xb6, 0xda, 0x4a, 0x9f,
-		0xaa, 0x8d, 0xbd, 0x53, 0x06, 0x3a, 0xfd, 0x0e, 0x0c, 0xc3, 0x78, 0xef, 0xac, 0xf2, 0x20, 0xcf,
-		0x64, 0xc1, 0x23, 0xa8, 0x2b, 0x44, 0x08, 0x34, 0x26, 0x93, 0x7a, 0x63, 0x3e, 0x9f, 0x6f, 0x06,
-		0xaa, 0x70, 0x40, 0x82, 0xc2, 0xe2, 0x8d, 0xfe, 0xb4, 0x52, 0x8f, 0xe5, 0x52, 0x38, 0x60, 0xe9,
-		0x83, 0x12, 0xc0, 0x2a, 0x11, 0x2e, 0xed, 0x31, 0x09, 0x7e, 0x5c, 0xca, 0x84, 0x1a, 0x69, 0x7b,
-		0x6e, 0xe0, 0xc0, 0x71, 0x0e, 0xf2, 0x6e, 0x98, 0xf5, 0x25, 0x28, 0x04, 0x0a, 0xee, 0x62, 0xc1,
-		0xce, 0xdc, 0x51, 0xcb, 0xf9, 0x06, 0xf6, 0x94, 0xfc, 0xed, 0xd0, 0x57, 0xe9, 0x5f, 0x4b, 0x90,
-		0xa5, 0x5c, 0xad, 0x61, 0x4f, 0x8b, 0x0c, 0xa4, 0xf4, 0x36, 0x06, 0xf2, 0x7e, 0x00, 0x46, 0xc7,
-		0x35, 0xde, 0xc0, 0x5c, 0xbf, 0xb2, 0xb4, 0xa4, 0x61, 0xbc, 0x81, 0xd1, 0xd3, 0xbe, 0xd4, 0x93,
-		0x43, 0xa4, 0x2e, 0xd6, 0xfb, 0x5c, 0xf6, 0xa7, 0x61, 0x9c, 0xbe, 0xcc, 0x79, 0xe0, 0xf2, 0x25,
-		0x7c, 0xda, 0xec, 0x76, 0x9a, 0x07, 0x6e, 0xe9, 0x16, 0x8c, 0x37, 0x0f, 0x58, 0xc6, 0xf1, 0x2c,
-		0x64, 0x1d, 0xcb, 0xe2, 0xd1, 0x20, 0x0b, 0xc4, 0x33, 0xa4, 0x80, 0x06, 0x3f, 0x22, 0xc9, 0x96,
-		0x08, 0x92, 0x6c, 0x41, 0x9a, 0x30, 0x39, 0x5a, 0x9a, 0x90, 0xac, 0xdb, 0x0b, 0x91, 0x19, 0x85,
-		0x1e, 0x83, 0xd3, 0x8d, 0xfa, 0xca, 0x7a, 0x6d, 0x49, 0x5d, 0x6b, 0xac, 0xf4, 0xbc, 0x4e, 0x30,
-		0x5b, 0x7c, 0xf3, 0xee, 0x7c, 0x8e, 0x2f, 0xd8, 0x07, 0x41, 0x6f, 0x2a, 0xb5, 0xed, 0x8d, 0x66,
-		0x4d, 0x96, 0x18, 0xf4, 0xa6, 0x83, 0x6f, 0x5b, 0x1e, 0x7b, 0xfb, 0xf6, 0x09, 0x38, 0x13, 0x03,
-		0xed, 0x2f, 0xdb, 0x27, 0xdf, 0xbc, 0x3b, 0x5f, 0xd8, 0x74, 0x30, 0x53, 0x35, 0x8a, 0xb1, 0x00,
-		0x33, 0xfd, 0x18, 0x1b, 0x9b, 0x1b, 0x8d, 0xca, 0xaa, 0x3c, 0x3f, 0x2b, 0xbf, 0x79, 0x77, 0x3e,
-		0x2f, 0x6c, 0x07, 0x81, 0x7f, 0xf7, 0xd7, 0xed, 0xa9, 0xfe, 0xf3, 0x0e, 0x77, 0x1c, 0xcd, 0xb6,
-		0xb1, 0xe3, 0x0e, 0xda, 0xd8, 0x7f, 0x00, 0x72, 0x4b, 0xa1, 0x7b, 0xbb, 0xfe, 0x09, 0x0f, 0x89,
-		0xde, 0xe9, 0x65, 0x1f, 0xa5, 0x12, 0xc0, 0x72, 0xdb, 0xd2, 0xbc, 0x18, 0x98, 0x44, 0x08, 0xa6,
-		0x6e, 0x7a, 0xd7, 0xaf, 0xc5, 0xc0, 0x24, 0x05, 0xcc, 0x03, 0x90, 0xdb, 0x1a, 0x04, 0x94, 0x8a,
-		0x12, 0x7a, 0xf2, 0x6a, 0x0c, 0xcc, 0x58, 0x0f, 0xa1, 0x58, 0xa0, 0x82, 0x00, 0x3a, 0x0f, 0xd9,
-		0xaa, 0x65, 0xb5, 0x63, 0x40, 0x32, 0x21, 0x3a, 0x8d, 0xd0, 0x95, 0xe4, 0x08, 0x50, 0x36, 0xc4,
-		0x50, 0x95, 0xac, 0x5b, 0x63, 0x60, 0xfc, 0x33, 0x30, 0xc7, 0x3e, 0xfa, 0xf1, 0x5e, 0x3e, 0x2e,
-		0xc7, 0x3d, 0xfa, 0x21, 0xc6, 0xf3, 0xde, 0x8e, 0x7e, 0xe4, 0x43, 0x5b, 0x0f, 0x7e, 0x96, 0xc1,
-		0xd6, 0x1c, 0xad, 0xe3, 0x1e, 0x37, 0x9d, 0x3a, 0xe4, 0x64, 0xcd, 0xec, 0x10, 0x4d, 0x24, 0x2b,
-		0x9b, 0xa2, 0xbf, 0x60, 0xde, 0xa4, 0x2c, 0xa0, 0x6b, 0xe1, 0xec, 0x4e, 0x6e, 0x70, 0x1c, 0xc2,
-		0xc0, 0x45, 0xf6, 0xe7, 0x05, 0xc8, 0x88, 0x85, 0x17, 0xb7, 0xcd, 0xe7, 0xe3, 0xa2, 0x25, 0x0e,
-		0xc2, 0x71, 0x7d, 0x14, 0xf4, 0x35, 0x90, 0xf5, 0x2d, 0x35, 0x37, 0x4d, 0xa5, 0xa3, 0x6c, 0x3b,
-		0x27, 0x10, 0x20, 0xa1, 0x72, 0x90, 0x1e, 0x48, 0x0d, 0xcc, 0x38, 0x6c, 0x33, 0x08, 0x8e, 0xed,
-		0xa7, 0x06, 0x9e, 0x82, 0x94, 0xb6, 0xa3, 0x1b, 0xdc, 0x9d, 0xdf, 0x1f, 0x83, 0x58, 0xa9, 0x2e,
-		0xd6, 0x19, 0x16, 0x7d, 0x90, 0x83, 0x82, 0x13, 0xa6, 0xdd, 0x43, 0x53, 0xdf, 0x77, 0x2c, 0xf3,
-		0x90, 0x7b, 0xf0, 0x38, 0xa6, 0x1b, 0x02, 0x46, 0x30, 0xed, 0x23, 0x11, 0xa6, 0x77, 0x71, 0xe0,
-		0xbd, 0xe3, 0x99, 0x5e, 0x66, 0x10, 0x82, 0x69, 0x8e, 0x50, 0xaa, 0xf3, 0x7c, 0x2a, 0x1f, 0x36,
-		0xfa, 0xac, 0xd4, 0x81, 0xca, 0x32, 0x3d, 0x6c, 0xc2, 0x67, 0x3a, 0xda, 0x01, 0x9d, 0x34, 0xc4,
-		0x95, 0x90, 0xca, 0x3d, 0xfe, 0x70, 0x49, 0x52, 0x49, 0x77, 0xb4, 0x83, 0x15, 0xcd, 0xbd, 0x99,
-		0xca, 0x24, 0xe5, 0x54, 0xe9, 0x93, 0x24, 0xfc, 0x8e, 0x0c, 0x0d, 0x7a, 0x14, 0x10, 0xc1, 0xd0,
-		0xf6, 0xb0, 0x4a, 0x9c, 0x10, 0x1d, 0x64, 0x41, 0xb7, 0xd8, 0xd1, 0x0e, 0x2a, 0x7b, 0x78, 0xbd,
-		0xdb, 0xa1, 0x0c, 0xb8, 0x68, 0x0d, 0x64, 0x01, 0x2c, 0x14, 0xd0, 0x8f, 0x17, 0xfa, 0x1e, 0x4a,
-		0xe6, 0x00, 0x2c, 0xa0, 0xf9, 0x20, 0x09, 0x68, 0x26, 0x18, 0x3d, 0xff, 0xc8, 0x57, 0xa4, 0x2b,
-		0xc9, 0x68, 0x57, 0x4a, 0x2f, 0x41, 0xb1, 0x47, 0x0b, 0x50, 0x09, 0x0a, 0x3c, 0x6b, 0x4d, 0x8f,
-		0xd3, 0xb0, 0xb5, 0x7b, 0x56, 0xc9, 0xb1, 0xe4, 0x34, 0x9d, 0x7d, 0xe5, 0xcc, 0x2f, 0x7e, 0x6c,
-		0x4e, 0xa2, 0x5b, 0x97, 0x8f, 0x42, 0x21, 0xa2, 0x06, 0x22, 0x71, 0x29, 0x05, 0x89, 0xcb, 0x00,
-		0xf8, 0x35, 0xc8, 0x13, 0x57, 0x8a, 0x5b, 0x1c, 0xf6, 0x61, 0x28, 0x32, 0x5f, 0xdf, 0x2b, 0x6b,
-		0x16, 0xc3, 0xaf, 0x09, 0x81, 0x97, 0x44, 0x50, 0x1f, 0x15, 0x7b, 0x4e, 0x40, 0xad, 0x68, 0x6e,
-		0xe9, 0x07, 0x24, 0x28, 0xf6, 0xe8, 0x06, 0x7a, 0x01, 0xb2, 0xb6, 0x83, 0x75, 0x23, 0x94, 0xe6,
-		0x3a, 0x42, 0x84, 0x29, 0x2a, 0xbe, 0x00, 0x83, 0x84, 0x49, 0xe2, 0x9c, 0x40, 0x0b, 0xb7, 0xb5,
-		0xc3, 0xe1, 0xa3, 0xc0, 0x48, 0x88, 0x57, 0xeb, 0x97, 0x08, 0x52, 0xe9, 0x57, 0x25, 0x28, 0x44,
-		0x94, 0x0e, 0xb5, 0xe0, 0x7e, 0xe2, 0xa2, 0xc3, 0x67, 0xd3, 0xf9, 0xfb, 0x7b, 0xa1, 0x35, 0x5a,
-		0xee, 0xea, 0xd9, 0xbe, 0x76, 0x02, 0x47, 0x43, 0x83, 0x1b, 0x49, 0x99, 0x25, 0x74, 0x82, 0x23,
-		0xea, 0xec, 0xa1, 0xbe, 0x1b, 0x2c, 0x18, 0xdf, 0x00, 0x64, 0xef, 0x78, 0xbd, 0xa4, 0x13, 0xa3,
-		0x92, 0x96, 0x09, 0x72, 0x98, 0x60, 0xa9, 0x01, 0x10, 0x4c, 0x5c, 0x54, 0x19, 0xa5, 0x13, 0xc9,
-		0xa3, 0x38, 0x2c, 0x27, 0x66, 0xa4, 0xea, 0xe6, 0x27, 0x3e, 0x7b, 0x4e, 0x7a, 0x57, 0x42, 0x87,
-		0xdf, 0x6d, 0xc0, 0x7d, 0x01, 0xe8, 0x8e, 0x6e, 0xf4, 0x26, 0xb4, 0x65, 0xdf, 0x38, 0x90, 0x5a,
-		0xe2, 0x16, 0x8e, 0xde, 0x4f, 0x1b, 0x9a, 0xee, 0x1e, 0xe2, 0x88, 0x46, 0x49, 0x87, 0xdf, 0x63,
-		0xb6, 0xfb, 0x3f, 0x64, 0x61, 0x5c, 0xc1, 0xef, 0xeb, 0x62, 0xd7, 0x43, 0x4f, 0x42, 0x0a, 0xeb,
-		0xfb, 0x56, 0xff, 0x96, 0x13, 0xef, 0xe5, 0x42, 0x4d, 0xdf, 0xb7, 0x38, 0xf0, 0x8d, 0x13, 0x0a,
-		0x05, 0x46, 0xd7, 0x61, 0x6c, 0xb7, 0xdd, 0xe5, 0x89, 0xf0, 0x88, 0x9b, 0x12, 0x58, 0xcb, 0xa4,
-		0x3a, 0x40, 0x63, 0xe0, 0xa4, 0x31, 0xfa, 0x73, 0x02, 0xc9, 0x41, 0x8d, 0xd1, 0x5f, 0x11, 0x08,
-		0x1a, 0x23, 0xc0, 0x68, 0x11, 0xc0, 0x30, 0x0d, 0x4f, 0xa5, 0x39, 0x62, 0xee, 0x26, 0x4a, 0x71,
-		0xa8, 0x86, 0x47, 0xf3, 0xc9, 0x01, 0x7e, 0xd6, 0x10, 0x65, 0x84, 0xe3, 0xf7, 0x75, 0xb1, 0x23,
-		0x5c, 0x45, 0x0c, 0xc7, 0xef, 0x21, 0xd5, 0x21, 0x8e, 0x29, 0x38, 0x71, 0xad, 0xec, 0x71, 0x52,
-		0xef, 0x80, 0x3f, 0xb9, 0x3d, 0xdf, 0x8f, 0x4a, 0xdf, 0x26, 0x6d, 0x1e, 0x04, 0xc8, 0xe3, 0x3a,
-		0x2b, 0x41, 0xcf, 0xfa, 0x4b, 0xb8, 0x5c, 0xef, 0x9a, 0xc9, 0x47, 0x66, 0x2b, 0x38, 0x1f, 0x97,
-		0x23, 0xa0, 0x0d, 0x98, 0x68, 0x1b, 0xae, 0xa7, 0xba, 0xa6, 0x66, 0xbb, 0xfb, 0x96, 0xe7, 0xd2,
-		0x5c, 0x6c, 0xee, 0xea, 0xc3, 0xfd, 0x24, 0x56, 0x0d, 0xd7, 0x6b, 0x08, 0xb0, 0x80, 0x52, 0xa1,
-		0x1d, 0x2e, 0x27, 0x04, 0xad, 0xdd, 0x5d, 0xec, 0xf8, 0x14, 0x69, 0xd2, 0x36, 0x96, 0xe0, 0x06,
-		0x81, 0x13, 0x98, 0x21, 0x82, 0x56, 0xb8, 0x1c, 0x7d, 0x1d, 0x4c, 0xb5, 0x2d, 0xad, 0xe5, 0xd3,
-		0x53, 0xf5, 0xfd, 0xae, 0x79, 0x8b, 0xa6, 0x78, 0x73, 0x57, 0x2f, 0xc5, 0xb0, 0x69, 0x69, 0x2d,
-		0x81, 0xbc, 0x48, 0x40, 0x03, 0xca, 0x93, 0xed, 0xde, 0x3a, 0xa4, 0xc2, 0xb4, 0x66, 0xdb, 0xed,
-		0xc3, 0x5e, 0xf2, 0x45, 0x4a, 0xfe, 0xd1, 0x7e, 0xf2, 0x15, 0x02, 0x3d, 0x80, 0x3e, 0xd2, 0xfa,
-		0x2a, 0xd1, 0x16, 0xc8, 0xb6, 0x83, 0xe9, 0xbd, 0x55, 0x9b, 0x2f, 0x52, 0xe8, 0xab, 0x7e, 0xb9,
-		0xab, 0x17, 0xfb, 0x89, 0x6f, 0x32, 0x48, 0xb1, 0x9a, 0x09, 0x28, 0x17, 0xed, 0x68, 0x0d, 0x23,
-		0x6b, 0xe9, 0x98, 0xbe, 0x3a, 0xca, 0xc9, 0x4e, 0x0e, 0x26, 0x4b, 0x21, 0x63, 0xc9, 0x46, 0x6a,
-		0xd0, 0x32, 0xe4, 0x58, 0x56, 0x4b, 0x25, 0x26, 0x92, 0xbe, 0x06, 0x98, 0xbb, 0xfa, 0x40, 0xcc,
-		0x74, 0xa5, 0x40, 0xdb, 0x96, 0x87, 0x03, 0x62, 0x80, 0xfd, 0x42, 0xb4, 0x03, 0x27, 0xe9, 0xcb,
-		0x88, 0x87, 0x6a, 0xd4, 0x1e, 0xcf, 0x4c, 0x51, 0x8a, 0x8f, 0xf5, 0x53, 0xdc, 0xa6, 0xe0, 0xdb,
-		0x61, 0xc3, 0x1c, 0x90, 0x9e, 0xba, 0xdd, 0x5f, 0x4b, 0x34, 0x6d, 0xd7, 0x30, 0xb5, 0xb6, 0xf1,
-		0x06, 0x66, 0xc1, 0x0b, 0x7d, 0x14, 0x38, 0x56, 0xd3, 0x96, 0x39, 0x1c, 0x0d, 0x66, 0x42, 0x9a,
-		0xb6, 0x1b, 0x2e, 0xaf, 0x8e, 0xf3, 0x25, 0x87, 0xff, 0xca, 0xe5, 0xb8, 0x9c, 0x61, 0x2f, 0x5b,
-		0xde, 0x4c, 0x65, 0x40, 0xce, 0x95, 0x2e, 0x40, 0x2e, 0x64, 0xa7, 0xd0, 0x0c, 0x8c, 0x73, 0xa7,
-		0x2a, 0x0e, 0xf0, 0xf3, 0xcf, 0xd2, 0x04, 0xe4, 0xc3, 0xa6, 0xa9, 0xf4, 0x01, 0x09, 0x72, 0x21,
-		0xa3, 0x43, 0x30, 0xc3, 0x1b, 0x5d, 0xd9, 0x20, 0x4e, 0x7d, 0x40, 0x44, 0x15, 0xa2, 0x9e, 0x6d,
-		0xb6, 0xe6, 0x69, 0x21, 0x0f, 0x6a, 0xd0, 0x1c, 0xe4, 0xec, 0xab, 0xb6, 0x0f, 0x92, 0xa4, 0x20,
-		0x60, 0x5f, 0xb5, 0x05, 0xc0, 0x79, 0xc8, 0x93, 0xae, 0xab, 0xe1, 0x70, 0x39, 0xab, 0xe4, 0x48,
-		0x19, 0x07, 0x29, 0xfd, 0x66, 0x02, 0xe4, 0x5e, 0x63, 0xe6, 0x6f, 0x80, 0x49, 0xc7, 0xde, 0x00,
-		0x3b, 0xd3, 0xbb, 0xf5, 0x16, 0xec, 0xb6, 0xad, 0x81, 0x1c, 0xec, 0x19, 0x31, 0xdf, 0x73, 0x44,
-		0xfc, 0xdf, 0xb3, 0x56, 0x51, 0x8a, 0x7a, 0xcf, 0xe2, 0x65, 0x25, 0x72, 0x5e, 0x24, 0xe5, 0x1f,
-		0x71, 0xed, 0xd5, 0x27, 0x01, 0xb3, 0x65, 0xb7, 0x34, 0x0f, 0x8b, 0x94, 0x7b, 0xe8, 0xe8, 0xc8,
-		0xc3, 0x50, 0xd4, 0x6c, 0x5b, 0x75, 0x3d, 0xcd, 0xc3, 0x3c, 0xd0, 0x63, 0x89, 0xcc, 0x82, 0x66,
-		0xdb, 0x0d, 0x52, 0xca, 0x02, 0xbd, 0x87, 0x60, 0x82, 0x58, 0x78, 0x43, 0x6b, 0x8b, 0x28, 0x22,
-		0xcd, 0xe2, 0x41, 0x5e, 0xca, 0x23, 0x91, 0x16, 0xe4, 0xc3, 0xc6, 0xdd, 0x4f, 0xcd, 0x48, 0xa1,
-		0xd4, 0x0c, 0xe2, 0x0f, 0x2f, 0x31, 0x09, 0x89, 0xc7, 0xaa, 0xe2, 0x37, 0x23, 0xa7, 0x69, 0x1a,
-		0xe7, 0x36, 0xcb, 0xbd, 0x66, 0x14, 0xf6, 0x51, 0x7a, 0x15, 0x26, 0xa2, 0x7e, 0x00, 0x4d, 0x40,
-		0xc2, 0x3b, 0xe0, 0xad, 0x24, 0xbc, 0x03, 0x74, 0x85, 0x67, 0x4f, 0x93, 0x34, 0x7b, 0x7a, 0xff,
-		0x40, 0x3f, 0x12, 0xa4, 0x4e, 0x6f, 0xa6, 0x32, 0x09, 0x39, 0x59, 0x2a, 0x42, 0x21, 0xe2, 0x25,
-		0x4a, 0xa7, 0x60, 0x3a, 0xce, 0xe6, 0x97, 0x0c, 0x98, 0x8e, 0x33, 0xdd, 0xe8, 0x3a, 0x64, 0x7c,
-		0xa3, 0xdf, 0x97, 0x6d, 0x13, 0xad, 0xfb, 0x48, 0x3e, 0x6c, 0x64, 0xb7, 0x30, 0x11, 0xd9, 0x2d,
-		0x2c, 0x7d, 0x23, 0xcc, 0x0c, 0xb2, 0xe7, 0x3d, 0xdb, 0x07, 0x29, 0x5f, 0x70, 0xa7, 0x20, 0xcd,
-		0xdf, 0x07, 0x4e, 0xd0, 0x34, 0x05, 0xff, 0x22, 0x02, 0x65, 0xb6, 0x3d, 0xc9, 0xb2, 0x17, 0xf4,
-		0xa3, 0xa4, 0xc2, 0x99, 0x81, 0x26, 0x7d, 0xf0, 0x6e, 0x3b, 0x23, 0xc4, 0x77, 0xdb, 0xe9, 0x07,
-		0xfd, 0x15, 0x22, 0x6c, 0x8a, 0x24, 0x60, 0x56, 0xe1, 0x5f, 0xa5, 0x0f, 0x25, 0xe1, 0x54, 0xbc,
-		0x5d, 0x47, 0xf3, 0x90, 0x27, 0x8b, 0x07, 0x2f, 0xba, 0xce, 0x80, 0x8e, 0x76, 0xd0, 0xe4, 0x8b,
-		0x0c, 0xbe, 0x53, 0x99, 0xf0, 0x77, 0x2a, 0xd1, 0x36, 0x4c, 0xb6, 0x2d, 0x5d, 0x6b, 0xab, 0xa1,
-		0x9d, 0x62, 0x3e, 0x9d, 0x1e, 0x1c, 0x64, 0xa7, 0xc5, 0x5e, 0x04, 0x31, 0x41, 0x7c, 0x22, 0x14,
-		0x29, 0x91, 0x55, 0x7f, 0x57, 0x19, 0xd5, 0x20, 0xd7, 0x31, 0xdc, 0x1d, 0xbc, 0xaf, 0xdd, 0x36,
-		0x2c, 0x87, 0xcf, 0xab, 0x18, 0xed, 0x59, 0x0b, 0x80, 0xc4, 0x16, 0x76, 0x08, 0x2f, 0x34, 0x28,
-		0x63, 0xb1, 0x5b, 0xeb, 0xe9, 0x63, 0x5b, 0x96, 0x41, 0x9b, 0xd4, 0xe3, 0x03, 0x37, 0xa9, 0xe3,
-		0x76, 0x84, 0x33, 0xf1, 0x3b, 0xc2, 0x6f, 0xd2, 0xc1, 0x89, 0xf3, 0x8e, 0xfd, 0x9b, 0xc4, 0xa8,
-		0x09, 0xd3, 0x1c, 0xbf, 0x15, 0x91, 0x7e, 0xdf, 0xb9, 0xb3, 0x68, 0xd0, 0x15, 0x92, 0x3a, 0x12,
-		0xf8, 0x83, 0x05, 0x9f, 0xbc, 0x47, 0xc1, 0x8b, 0xa3, 0x1a, 0xa9, 0xd0, 0x51, 0x8d, 0xff, 0xcd,
-		0x06, 0xe3, 0x5b, 0x93, 0x62, 0xf3, 0x2c, 0x14, 0x58, 0xc4, 0x9e, 0x41, 0x19, 0xb4, 0xd7, 0x23,
-		0x3a, 0x96, 0x3c, 0x76, 0xc7, 0xf8, 0x68, 0xa7, 0x86, 0x8f, 0xf6, 0xd8, 0x3b, 0x39, 0xda, 0xe9,
-		0x7b, 0x1c, 0xed, 0x77, 0x75, 0x1c, 0x3e, 0x22, 0xc1, 0xec, 0xe0, 0x70, 0x2c, 0x76, 0x40, 0x8e,
-		0xb5, 0x3b, 0x39, 0xc8, 0xe3, 0x3d, 0x04, 0x13, 0x3d, 0xd1, 0x22, 0x53, 0xe6, 0x42, 0x64, 0xb9,
-		0x5e, 0xfa, 0xb6, 0x24, 0x4c, 0xc7, 0x05, 0x74, 0x31, 0x33, 0x56, 0x81, 0xa9, 0x16, 0xd6, 0x8d,
-		0xd6, 0x3d, 0x4f, 0xd8, 0x49, 0x8e, 0xfe, 0x7f, 0xe6, 0x6b, 0x8c, 0x9e, 0xfc, 0x38, 0x40, 0x46,
-		0xc1, 0xae, 0x4d, 0x02, 0x34, 0xf6, 0x6b, 0x77, 0x3a, 0xb6, 0xbd, 0x20, 0xad, 0x15, 0xbb, 0x6e,
-		0xe0, 0x20, 0x02, 0x8f, 0xac, 0x9f, 0x7d, 0x3c, 0x74, 0x8d, 0xa7, 0x09, 0x06, 0x2e, 0xf8, 0x59,
-		0xf8, 0xed, 0xa3, 0xb2, 0x3c, 0xc1, 0xd3, 0x22, 0x4f, 0x90, 0x1c, 0xb4, 0xfa, 0xe5, 0xc1, 0xb8,
-		0x8f, 0xc7, 0x13, 0x05, 0xd7, 0x78, 0xa2, 0x20, 0x35, 0xa8, 0x39, 0x16, 0xb3, 0x07, 0xcd, 0x19,
-		0xec, 0x21, 0xd3, 0x70, 0xa6, 0x20, 0x3d, 0xa8, 0xab, 0xa1, 0xe0, 0x3a, 0xe8, 0x6a, 0x90, 0x2a,
-		0x78, 0x5a, 0xa4, 0x0a, 0xc6, 0x07, 0x31, 0xcd, 0xa3, 0xc9, 0x80, 0x69, 0x96, 0x2b, 0x78, 0x31,
-		0x94, 0x2b, 0xc8, 0xf6, 0xa6, 0xe1, 0xfb, 0x72, 0x05, 0x3e, 0xb6, 0x9f, 0x2c, 0x28, 0xfb, 0xc9,
-		0x82, 0xfc, 0xc0, 0x4c, 0x03, 0x0f, 0x03, 0x7d, 0x64, 0x91, 0x2d, 0xd8, 0xec, 0xcb, 0x16, 0xb0,
-		0xc5, 0xfd, 0x85, 0xa1, 0xd9, 0x02, 0x9f, 0x54, 0x4f, 0xba, 0x60, 0xb3, 0x2f, 0x5d, 0x30, 0x31,
-		0x88, 0x62, 0x4f, 0xcc, 0x19, 0x50, 0x8c, 0xe6, 0x0b, 0xbe, 0x3e, 0x3e, 0x5f, 0x30, 0x70, 0x41,
-		0x1f, 0x13, 0x5f, 0xfa, 0xa4, 0x63, 0x12, 0x06, 0xdf, 0x38, 0x20, 0x61, 0x20, 0x0f, 0x5a, 0xd8,
-		0xc6, 0x45, 0x97, 0x7e, 0x03, 0x71, 0x19, 0x83, 0xed, 0x98, 0x8c, 0x01, 0x5b, 0xda, 0x3f, 0x32,
-		0x42, 0xc6, 0xc0, 0x27, 0xdd, 0x97, 0x32, 0xd8, 0x8e, 0x49, 0x19, 0xa0, 0xc1, 0x74, 0x7b, 0x82,
-		0xa2, 0x30, 0xdd, 0x68, 0xce, 0x60, 0x25, 0x9a, 0x33, 0x98, 0x3a, 0x3a, 0x16, 0x65, 0xae, 0xdd,
-		0xa7, 0x16, 0x4e, 0x1a, 0xe8, 0x83, 0x92, 0x06, 0x6c, 0x5d, 0xff, 0xf8, 0x88, 0x49, 0x03, 0x9f,
-		0x76, 0x6c, 0xd6, 0x60, 0xb3, 0x2f, 0x6b, 0x70, 0x72, 0x90, 0xc2, 0xf5, 0x38, 0x99, 0x40, 0xe1,
-		0x06, 0xa6, 0x0d, 0xd8, 0xcf, 0x62, 0xb0, 0x1f, 0xc4, 0x00, 0x39, 0x77, 0x33, 0x95, 0xc9, 0xc9,
-		0xf9, 0xd2, 0x23, 0x24, 0xac, 0xe9, 0xb1, 0x7b, 0x64, 0x11, 0x81, 0x1d, 0xc7, 0x72, 0xc4, 0x1e,
-		0x28, 0xfd, 0x28, 0x5d, 0x84, 0x7c, 0xd8, 0xc4, 0x1d, 0x91, 0x62, 0x28, 0x42, 0x21, 0x62, 0xd5,
-		0x4a, 0xbf, 0x28, 0x41, 0x3e, 0x6c, 0xaf, 0x22, 0x0b, 0xd0, 0x2c, 0x5f, 0x80, 0x86, 0x12, 0x0f,
-		0x89, 0x68, 0xe2, 0x61, 0x0e, 0x72, 0x64, 0x11, 0xd6, 0x93, 0x53, 0xd0, 0x6c, 0x3f, 0xa7, 0x20,
-		0x0e, 0x6e, 0xb2, 0xf4, 0x04, 0xf7, 0x53, 0xec, 0xd4, 0x42, 0xd1, 0x3f, 0xc4, 0xca, 0xd3, 0xfc,
-		0x8f, 0xc3, 0x54, 0x08, 0xd6, 0x5f, 0xdc, 0xb1, 0xe5, 0xb5, 0xec, 0x43, 0x57, 0xf8, 0x2a, 0xef,
-		0x57, 0x25, 0x98, 0xec, 0x33, 0x97, 0xb1, 0x79, 0x03, 0xe9, 0x9d, 0xca, 0x1b, 0x24, 0xee, 0x3d,
-		0x6f, 0x10, 0x5e, 0xae, 0x26, 0xa3, 0xcb, 0xd5, 0xbf, 0x92, 0xa0, 0x10, 0x31, 0xdb, 0x64, 0x10,
-		0x74, 0xab, 0x25, 0x76, 0xcc, 0xe9, 0xdf, 0x24, 0x4e, 0x69, 0x5b, 0x7b, 0x7c, 0x99, 0x48, 0xfe,
-		0x24, 0x50, 0xbe, 0x23, 0xca, 0x72, 0x37, 0xe3, 0xaf, 0x3d, 0xc7, 0xc2, 0x77, 0xca, 0xf8, 0x3d,
-		0xab, 0x74, 0x70, 0xcf, 0xca, 0xdf, 0x28, 0x1f, 0x0f, 0x6d, 0x94, 0xa3, 0x67, 0x21, 0x4b, 0x77,
-		0x01, 0x54, 0xcb, 0x0e, 0x7e, 0x98, 0x77, 0xf0, 0x1d, 0x2b, 0x97, 0x5e, 0x12, 0x60, 0x17, 0xb3,
-		0x82, 0x28, 0x24, 0x1b, 0x89, 0x42, 0xee, 0x83, 0x2c, 0x61, 0x9f, 0xfd, 0x1c, 0x11, 0xf0, 0xa7,
-		0x46, 0x44, 0x41, 0xe9, 0xa7, 0x12, 0x50, 0xec, 0xf1, 0x3a, 0xb1, 0x9d, 0x8f, 0x3b, 0xb1, 0x32,
-		0x9a, 0x40, 0xce, 0x01, 0xec, 0x69, 0xae, 0x7a, 0x47, 0x33, 0x3d, 0xdc, 0xe2, 0x52, 0x09, 0x95,
-		0xa0, 0x59, 0xc8, 0x90, 0xaf, 0xae, 0x8b, 0x5b, 0x3c, 0x43, 0xe3, 0x7f, 0xa3, 0x3a, 0xa4, 0xf1,
-		0x6d, 0xfa, 0x1c, 0x37, 0x7b, 0xd4, 0xfe, 0x74, 0x8c, 0x79, 0x22, 0xf5, 0xd5, 0x19, 0x32, 0xdc,
-		0x7f, 0xf4, 0xd6, 0x9c, 0xcc, 0xc0, 0x1f, 0xf3, 0x2f, 0xb0, 0x2a, 0x9c, 0x40, 0x54, 0x0c, 0x99,
-		0x1e, 0x31, 0xd0, 0x74, 0x61, 0x5e, 0xac, 0xfd, 0x89, 0x50, 0xd9, 0x4d, 0x1c, 0xa5, 0xd0, 0xc1,
-		0x1d, 0xdb, 0xb2, 0xda, 0x2a, 0x9b, 0xe7, 0x15, 0x98, 0x88, 0x3a, 0x59, 0xf6, 0x5b, 0x81, 0x9e,
-		0x66, 0x98, 0x6a, 0x24, 0x36, 0xce, 0xb3, 0x42, 0x36, 0xaf, 0x6e, 0xa6, 0x32, 0x92, 0x9c, 0xe0,
-		0xe9, 0x9a, 0xf7, 0xc0, 0xc9, 0x58, 0x1f, 0x8b, 0x9e, 0x81, 0x6c, 0xe0, 0x9f, 0xd9, 0x7d, 0xaa,
-		0xa3, 0xf2, 0x30, 0x01, 0x70, 0x69, 0x1b, 0x4e, 0xc6, 0x3a, 0x59, 0xf4, 0x02, 0xa4, 0xd9, 0x79,
-		0x6d, 0x7e, 0x26, 0xef, 0xa1, 0xe1, 0xde, 0xb9, 0xdb, 0xf6, 0x14, 0x8e, 0x54, 0xba, 0x02, 0x67,
-		0x06, 0x7a, 0xd9, 0x20, 0x9b, 0x22, 0x85, 0xb2, 0x29, 0xa5, 0x9f, 0x96, 0x60, 0x76, 0xb0, 0xe7,
-		0x44, 0xd5, 0x1e, 0x86, 0x2e, 0x8d, 0xe8, 0x77, 0x43, 0x5c, 0x91, 0xe5, 0x86, 0x83, 0x77, 0xb1,
-		0xa7, 0xef, 0x33, 0x17, 0xce, 0x8c, 0x42, 0x41, 0x29, 0xf0, 0x52, 0x8a, 0xe3, 0x32, 0xb0, 0xd7,
-		0xb1, 0xee, 0xa9, 0x6c, 0x50, 0x5d, 0xfe, 0x53, 0xe3, 0x05, 0x56, 0xda, 0x60, 0x85, 0xa5, 0x47,
-		0xe1, 0xf4, 0x00, 0x5f, 0x1c, 0x73, 0xdc, 0xfc, 0x35, 0x02, 0x1c, 0xeb, 0x60, 0xd1, 0x4b, 0x90,
-		0x76, 0x3d, 0xcd, 0xeb, 0xba, 0xbc, 0x67, 0x17, 0x86, 0xfa, 0xe6, 0x06, 0x05, 0x57, 0x38, 0x5a,
-		0xe9, 0x39, 0x40, 0xfd, 0x9e, 0x36, 0x66, 0x6d, 0x25, 0xc5, 0xad, 0xad, 0x76, 0xe0, 0xec, 0x11,
-		0x3e, 0x15, 0x2d, 0xf6, 0x30, 0xf7, 0xe8, 0x48, 0x2e, 0xb9, 0x87, 0xc1, 0x3f, 0x4d, 0xc0, 0xc9,
-		0x58, 0xd7, 0x1a, 0x9a, 0xa5, 0xd2, 0xdb, 0x9d, 0xa5, 0x2f, 0x00, 0x78, 0x07, 0xe2, 0x92, 0x01,
-		0xb7, 0xf6, 0x71, 0xeb, 0x89, 0x03, 0xac, 0x53, 0x83, 0x45, 0x14, 0x23, 0xeb, 0xf1, 0xbf, 0xc8,
-		0xe2, 0x3f, 0xb4, 0x9e, 0xed, 0x52, 0x4f, 0xe0, 0xf2, 0xa5, 0xde, 0xc8, 0x3e, 0x23, 0x58, 0xf8,
-		0xb2, 0x62, 0x17, 0xbd, 0x06, 0xa7, 0x7b, 0x3c, 0x9a, 0x4f, 0x3b, 0x35, 0xb2, 0x63, 0x3b, 0x19,
-		0x75, 0x6c, 0x82, 0x76, 0xd8, 0x2b, 0x8d, 0x45, 0xbd, 0xd2, 0x6b, 0x00, 0xc1, 0xc2, 0x36, 0x38,
-		0x0f, 0x2b, 0x85, 0xcf, 0xc3, 0x5e, 0x87, 0x31, 0xa2, 0x09, 0x42, 0x54, 0x31, 0x06, 0x83, 0x0c,
-		0x69, 0x68, 0x65, 0xcc, 0xc0, 0x4b, 0xaf, 0x0b, 0x6d, 0x0b, 0xe7, 0x18, 0x07, 0xb4, 0xf1, 0x62,
-		0xb4, 0x8d, 0xd2, 0xe0, 0x74, 0x65, 0x7c, 0x5b, 0xff, 0x17, 0x8c, 0xd1, 0xe1, 0x8f, 0xbd, 0x80,
-		0xfc, 0x0d, 0x00, 0x9a, 0xe7, 0x39, 0xc6, 0x4e, 0x37, 0x68, 0x61, 0x7e, 0x80, 0xfe, 0x54, 0x04,
-		0x60, 0xf5, 0x3e, 0xae, 0x48, 0xd3, 0x01, 0x6e, 0x48, 0x99, 0x42, 0x14, 0x4b, 0xeb, 0x30, 0x11,
-		0xc5, 0x8d, 0xbf, 0x51, 0x1d, 0xbc, 0xdb, 0x24, 0xce, 0xb5, 0x05, 0x8e, 0x9c, 0xbf, 0xa5, 0x46,
-		0x3f, 0x4a, 0xdf, 0x94, 0x80, 0x7c, 0x58, 0xfb, 0xfe, 0x0e, 0x3a, 0xcb, 0xd2, 0xb7, 0x49, 0x90,
-		0xf1, 0xfb, 0x7f, 0xc4, 0x6d, 0x80, 0xe0, 0x6e, 0xbd, 0x9f, 0x83, 0x67, 0xbb, 0x1e, 0x49, 0x7f,
-		0xd7, 0xe3, 0x79, 0xdf, 0x21, 0x0c, 0x5c, 0xcc, 0x87, 0xa5, 0x2d, 0xce, 0xe1, 0x72, 0x07, 0xf5,
-		0xdc, 0x68, 0x97, 0x7b, 0xa7, 0x61, 0x2c, 0x7c, 0x2f, 0x97, 0x7d, 0x94, 0x70, 0xe8, 0xb8, 0x12,
-		0x9b, 0x8d, 0xe1, 0x5b, 0xc0, 0xd2, 0xf1, 0x6f, 0x01, 0xfb, 0xcd, 0x24, 0xc2, 0xcd, 0xfc, 0x7d,
-		0x09, 0x32, 0x62, 0x5e, 0xa0, 0x97, 0xc2, 0x87, 0xe9, 0xc4, 0xc9, 0x9c, 0xc1, 0x76, 0x89, 0x37,
-		0x10, 0x3a, 0x4b, 0xd7, 0x77, 0x25, 0x21, 0x79, 0xec, 0x2b, 0x09, 0x3c, 0x0e, 0xf9, 0xb2, 0x04,
-		0x72, 0xef, 0xbc, 0x7d, 0xfb, 0xfc, 0xf5, 0xfb, 0xab, 0x64, 0x8c, 0xbf, 0x1a, 0x74, 0xd1, 0x20,
-		0x35, 0xe8, 0xa2, 0x41, 0x7f, 0xbf, 0xc7, 0xee, 0xb5, 0xdf, 0xdf, 0x9a, 0x80, 0x5c, 0x28, 0xc7,
-		0x87, 0x9e, 0x8a, 0xdc, 0x5a, 0x38, 0x7f, 0x64, 0x42, 0x30, 0x74, 0x6d, 0x21, 0x22, 0xa9, 0xc4,
-		0x3d, 0x48, 0xea, 0x9d, 0xbf, 0xcc, 0x18, 0x7f, 0x33, 0x7e, 0x6c, 0xc0, 0xcd, 0xf8, 0xff, 0x57,
-		0x82, 0x8c, 0x9f, 0x7c, 0x39, 0xee, 0x9e, 0xdc, 0x29, 0x48, 0xf3, 0xd8, 0x8b, 0x6d, 0xca, 0xf1,
-		0xaf, 0xd8, 0xec, 0xe8, 0x2c, 0x64, 0xc4, 0xef, 0xa2, 0x72, 0x0f, 0xe7, 0x7f, 0x5f, 0xda, 0x81,
-		0x5c, 0x68, 0x5b, 0x13, 0x9d, 0x81, 0x93, 0x8b, 0x37, 0x6a, 0x8b, 0x2f, 0xab, 0xcd, 0x57, 0x7a,
-		0x7f, 0x5b, 0xaf, 0xaf, 0x4a, 0xa9, 0xd1, 0x6f, 0x59, 0x42, 0xa7, 0x61, 0x2a, 0x5a, 0xc5, 0x2a,
-		0x12, 0xb3, 0xa9, 0x6f, 0xff, 0xb1, 0x73, 0x27, 0x2e, 0x7d, 0x59, 0x82, 0xa9, 0x98, 0x28, 0x17,
-		0x9d, 0x87, 0xfb, 0x37, 0x96, 0x97, 0x6b, 0x8a, 0xda, 0x58, 0xaf, 0x6c, 0x36, 0x6e, 0x6c, 0x34,
-		0x55, 0xa5, 0xd6, 0xd8, 0x5a, 0x6d, 0x86, 0x1a, 0x9d, 0x87, 0xfb, 0xe2, 0x41, 0x2a, 0x8b, 0x8b,
-		0xb5, 0xcd, 0x26, 0xfb, 0x71, 0xbf, 0x01, 0x10, 0xd5, 0x0d, 0xa5, 0x29, 0x27, 0x06, 0x93, 0x50,
-		0x6a, 0x37, 0x6b, 0x8b, 0x4d, 0x39, 0x89, 0x2e, 0xc0, 0x03, 0x47, 0x41, 0xa8, 0xcb, 0x1b, 0xca,
-		0x5a, 0xa5, 0x29, 0xa7, 0x86, 0x02, 0x36, 0x6a, 0xeb, 0x4b, 0x35, 0x45, 0x1e, 0xe3, 0xfd, 0xfe,
-		0x58, 0x02, 0x66, 0x06, 0x05, 0xd3, 0x84, 0x56, 0x65, 0x73, 0x73, 0xf5, 0xd5, 0x80, 0xd6, 0xe2,
-		0x8d, 0xad, 0xf5, 0x97, 0xfb, 0x45, 0xf0, 0x30, 0x94, 0x8e, 0x02, 0xf4, 0x05, 0xf1, 0x10, 0x9c,
-		0x3f, 0x12, 0x8e, 0x8b, 0x63, 0x08, 0x98, 0x52, 0x6b, 0x2a, 0xaf, 0xca, 0x49, 0xb4, 0x00, 0x97,
-		0x86, 0x82, 0xf9, 0x75, 0x72, 0x0a, 0x5d, 0x86, 0x47, 0x8f, 0x86, 0x67, 0x02, 0x12, 0x08, 0x42,
-		0x44, 0x6f, 0x4a, 0x70, 0x32, 0x36, 0x2a, 0x47, 0x0f, 0xc0, 0xdc, 0xa6, 0xb2, 0xb1, 0x58, 0x6b,
-		0x34, 0xfc, 0x3b, 0x0b, 0x6a, 0xa3, 0x59, 0x69, 0x6e, 0x35, 0x42, 0xb2, 0x29, 0xc1, 0xb9, 0x41,
-		0x40, 0xbe, 0x5c, 0x8e, 0x80, 0xe1, 0x1a, 0x20, 0xf4, 0xf4, 0xae, 0x04, 0x67, 0x06, 0x46, 0xe1,
-		0xe8, 0x22, 0x3c, 0xb8, 0x5d, 0x53, 0xea, 0xcb, 0xaf, 0xaa, 0xdb, 0x1b, 0xcd, 0xf0, 0xaf, 0x48,
-		0xf6, 0x71, 0x75, 0x01, 0x1e, 0x38, 0x12, 0xd2, 0x67, 0x6d, 0x18, 0x60, 0x0f, 0x7f, 0xdf, 0x22,
-		0x41, 0xb1, 0xc7, 0x16, 0xa2, 0xfb, 0x60, 0x66, 0xad, 0xde, 0xa8, 0xd6, 0x6e, 0x54, 0xb6, 0xeb,
-		0x1b, 0x4a, 0xef, 0x9c, 0x7d, 0x00, 0xe6, 0xfa, 0x6a, 0x97, 0xb6, 0x36, 0x57, 0xeb, 0x8b, 0x95,
-		0x66, 0x4d, 0x65, 0x17, 0x4d, 0x48, 0xc7, 0xfa, 0x80, 0x56, 0xeb, 0x2b, 0x37, 0x9a, 0xea, 0xe2,
-		0x6a, 0xbd, 0xb6, 0xde, 0x54, 0x2b, 0xcd, 0x66, 0x25, 0x98, 0xce, 0xd5, 0x97, 0x07, 0x1e, 0xf0,
-		0xbc, 0x32, 0xfa, 0x01, 0x4f, 0x7e, 0x84, 0xd3, 0x3f, 0xdf, 0xf9, 0x7b, 0xd7, 0xe0, 0x41, 0xfe,
-		0x30, 0x91, 0xeb, 0x69, 0xb7, 0x0c, 0x73, 0xcf, 0x7f, 0x21, 0x8a, 0x7f, 0xf3, 0x73, 0x9e, 0xa7,
-		0xf8, 0x2b, 0x48, 0xa2, 0x74, 0xc8, 0x3b, 0x51, 0x03, 0x9f, 0x17, 0x1d, 0x7a, 0x3f, 0x60, 0xd8,
-		0x31, 0xcd, 0xa3, 0xde, 0xa0, 0x1a, 0xf2, 0xd2, 0x55, 0xcc, 0x1b, 0x55, 0xb3, 0x47, 0xbf, 0xd7,
-		0x30, 0x7b, 0xe4, 0xe1, 0xd7, 0xd2, 0x07, 0x25, 0x98, 0xb8, 0x61, 0xb8, 0x9e, 0xe5, 0x18, 0xba,
-		0xd6, 0xa6, 0x81, 0xc4, 0xf3, 0x23, 0x5f, 0x68, 0xab, 0x66, 0x89, 0x1b, 0xe3, 0x2f, 0x59, 0xed,
-		0x8b, 0x3b, 0x65, 0xe9, 0xdb, 0x5a, 0x9b, 0x5d, 0x26, 0x0b, 0x3f, 0x85, 0xd7, 0x2b, 0xf6, 0x90,
-		0x7f, 0x0d, 0x53, 0x61, 0xb8, 0xe5, 0xc4, 0x8c, 0x54, 0xfa, 0x4e, 0x09, 0xe4, 0x80, 0x35, 0x05,
-		0xeb, 0x96, 0xd3, 0xa2, 0x91, 0xa2, 0x6d, 0x87, 0x36, 0x43, 0xc5, 0x27, 0xbd, 0xc2, 0x68, 0x74,
-		0xc4, 0x3d, 0x89, 0xa3, 0x9c, 0x6f, 0x2a, 0xe4, 0x78, 0x63, 0x2e, 0xee, 0x27, 0xe3, 0x2e, 0xee,
-		0x97, 0x7e, 0x20, 0x01, 0x45, 0xba, 0xdc, 0x72, 0xe9, 0xf2, 0x9c, 0x2e, 0x00, 0x6f, 0x42, 0xca,
-		0xd1, 0x3c, 0xbe, 0x28, 0xaa, 0x5e, 0x3f, 0xf6, 0x63, 0x5c, 0xac, 0xcf, 0x94, 0x06, 0x7a, 0x0f,
-		0x64, 0x3a, 0xda, 0x81, 0x4a, 0xe9, 0x25, 0xde, 0x16, 0xbd, 0xf1, 0x8e, 0x76, 0x40, 0xf8, 0x43,
-		0xdf, 0x00, 0x45, 0x42, 0x52, 0xdf, 0xd7, 0xcc, 0x3d, 0xcc, 0x28, 0x27, 0xdf, 0x16, 0xe5, 0x42,
-		0x47, 0x3b, 0x58, 0xa4, 0xd4, 0x08, 0x7d, 0xfe, 0x68, 0xd9, 0xaf, 0x48, 0x7c, 0xad, 0x4b, 0x05,
-		0x83, 0x34, 0x90, 0x75, 0xff, 0x8b, 0x36, 0x2a, 0x52, 0xc8, 0x17, 0x06, 0x69, 0x42, 0x8f, 0x58,
-		0xab, 0x05, 0xc2, 0xde, 0x67, 0xde, 0x9a, 0x93, 0x58, 0xab, 0x45, 0xbd, 0x4f, 0xec, 0x39, 0xb6,
-		0x86, 0x57, 0x47, 0x1c, 0xf0, 0x82, 0x88, 0xb6, 0x18, 0x41, 0x60, 0xd8, 0xa4, 0x9e, 0xf7, 0xe1,
-		0x13, 0x12, 0xe4, 0x96, 0x42, 0x0f, 0x89, 0xce, 0xc0, 0x78, 0xc7, 0x32, 0x8d, 0x5b, 0xd8, 0xf1,
-		0xf7, 0x00, 0xd8, 0x27, 0x89, 0x88, 0xd8, 0xef, 0x51, 0x7a, 0x87, 0xe2, 0x39, 0x17, 0xf1, 0x4d,
-		0xb0, 0xee, 0xe0, 0x1d, 0xd7, 0x10, 0x72, 0x56, 0xc4, 0x27, 0x7a, 0x04, 0x64, 0x17, 0xeb, 0x5d,
-		0xc7, 0xf0, 0x0e, 0x55, 0xdd, 0x32, 0x3d, 0x4d, 0xf7, 0xf8, 0xd2, 0xb1, 0x28, 0xca, 0x17, 0x59,
-		0x31, 0x21, 0xd2, 0xc2, 0x9e, 0x66, 0xb4, 0xd9, 0xd1, 0xb8, 0xac, 0x22, 0x3e, 0x39, 0xab, 0x77,
-		0xc7, 0xc3, 0x0b, 0xa7, 0x45, 0x90, 0x2d, 0x1b, 0x3b, 0x91, 0x33, 0x00, 0x4c, 0x1b, 0x67, 0x7e,
-		0xeb, 0xd3, 0x8f, 0x4f, 0x73, 0x81, 0xf3, 0xdd, 0x63, 0x76, 0x1f, 0x4c, 0x29, 0x0a, 0x0c, 0x71,
-		0x38, 0xe0, 0xd5, 0x48, 0xd6, 0xbf, 0xbb, 0x13, 0xbc, 0xa4, 0x34, 0xdd, 0x27, 0xd4, 0x8a, 0x79,
-		0x58, 0x9d, 0xf9, 0x8d, 0x80, 0x34, 0x5f, 0x5a, 0x6d, 0xd2, 0x65, 0x54, 0x78, 0x07, 0x80, 0x92,
-		0x21, 0xc1, 0xe6, 0xeb, 0x9a, 0xd1, 0x16, 0x3f, 0xdd, 0xab, 0xf0, 0x2f, 0x54, 0xf6, 0xb3, 0x5a,
-		0x29, 0x1a, 0xbb, 0x97, 0x06, 0xe9, 0x46, 0xd5, 0x32, 0x5b, 0xd1, 0x64, 0x16, 0x5a, 0x84, 0xb4,
-		0x67, 0xdd, 0xc2, 0x26, 0x17, 0x50, 0xf5, 0xd1, 0x63, 0xbc, 0xba, 0xa7, 0x70, 0x54, 0xf4, 0x75,
-		0x20, 0xb7, 0x70, 0x1b, 0xef, 0xb1, 0xab, 0xaf, 0xfb, 0x9a, 0x83, 0xd9, 0x1b, 0x0c, 0xf7, 0xf4,
-		0xa6, 0x5e, 0xd1, 0x27, 0xd5, 0xa0, 0x94, 0xd0, 0x66, 0xf4, 0xa9, 0xda, 0x71, 0x7f, 0xc3, 0x3a,
-		0xb6, 0x8f, 0x21, 0xcd, 0x0b, 0xdb, 0xc2, 0xc8, 0xd3, 0xb6, 0x8f, 0x80, 0xdc, 0x35, 0x77, 0x2c,
-		0x93, 0xfe, 0xe2, 0x25, 0x8f, 0xf7, 0x33, 0x6c, 0x27, 0xc8, 0x2f, 0xe7, 0x3b, 0x41, 0x9b, 0x30,
-		0x11, 0x80, 0xd2, 0x19, 0x92, 0x3d, 0xee, 0x0c, 0x29, 0xf8, 0x04, 0x08, 0x08, 0x5a, 0x03, 0x08,
-		0xe6, 0x20, 0xdd, 0x87, 0xc8, 0x0d, 0x1e, 0xb1, 0x60, 0x36, 0x87, 0x3b, 0x13, 0x22, 0x80, 0x4c,
-		0x98, 0xea, 0x18, 0xa6, 0xea, 0xe2, 0xf6, 0xae, 0xca, 0x25, 0x47, 0xe8, 0xe6, 0xa8, 0xf8, 0x5f,
-		0x3c, 0xc6, 0x68, 0xfe, 0xce, 0xa7, 0x1f, 0x2f, 0x06, 0x8f, 0x11, 0xce, 0x3f, 0xb1, 0x70, 0xed,
-		0x69, 0x65, 0xb2, 0x63, 0x98, 0x0d, 0xdc, 0xde, 0x5d, 0xf2, 0x09, 0xa3, 0xe7, 0xe1, 0x6c, 0x20,
-		0x10, 0xcb, 0x54, 0xf7, 0xad, 0x76, 0x4b, 0x75, 0xf0, 0xae, 0xaa, 0xd3, 0xa7, 0x14, 0xf3, 0x54,
-		0x8c, 0xa7, 0x7d, 0x90, 0x0d, 0xf3, 0x86, 0xd5, 0x6e, 0x29, 0x78, 0x77, 0x91, 0x54, 0xa3, 0x07,
-		0x20, 0x90, 0x86, 0x6a, 0xb4, 0xdc, 0x99, 0xc2, 0x7c, 0xf2, 0x62, 0x4a, 0xc9, 0xfb, 0x85, 0xf5,
-		0x96, 0x5b, 0xce, 0x7c, 0xfb, 0xc7, 0xe6, 0x4e, 0x7c, 0xe1, 0x63, 0x73, 0x27, 0x4a, 0xcb, 0xf4,
-		0xad, 0x35, 0x3e, 0xb5, 0xb0, 0x8b, 0xae, 0x43, 0x56, 0x13, 0x1f, 0xec, 0x0a, 0xd5, 0x11, 0x53,
-		0x33, 0x00, 0x2d, 0x7d, 0x52, 0x82, 0xf4, 0xd2, 0xf6, 0xa6, 0x66, 0x38, 0xa8, 0x06, 0x93, 0x81,
-		0xae, 0x8e, 0x3a, 0xcb, 0x03, 0xf5, 0x16, 0xd3, 0x7c, 0x7d, 0xd0, 0x81, 0xa1, 0x6c, 0xf5, 0xfc,
-		0x6f, 0x7d, 0xfa, 0xf1, 0xfb, 0x39, 0x99, 0xed, 0x9e, 0xb3, 0x43, 0x82, 0x5e, 0xef, 0x99, 0xa2,
-		0x50, 0x9f, 0x6f, 0xc2, 0x38, 0x63, 0xd5, 0x45, 0x2f, 0xc1, 0x98, 0x4d, 0xfe, 0xe0, 0xe9, 0xe4,
-		0x73, 0x03, 0x75, 0x9e, 0xc2, 0x87, 0x35, 0x84, 0xe1, 0x95, 0xbe, 0x33, 0x01, 0xb0, 0xb4, 0xbd,
-		0xdd, 0x74, 0x0c, 0xbb, 0x8d, 0xbd, 0x77, 0xaa, 0xef, 0x5b, 0x70, 0x32, 0x74, 0xd3, 0xdd, 0xd1,
-		0x8f, 0xdf, 0xff, 0xa9, 0xe0, 0xce, 0xbb, 0xa3, 0xc7, 0x92, 0x6d, 0xb9, 0x9e, 0x4f, 0x36, 0x79,
-		0x7c, 0xb2, 0x4b, 0xae, 0xd7, 0x2f, 0xd9, 0x57, 0x20, 0x17, 0x08, 0xc3, 0x45, 0x75, 0xc8, 0x78,
-		0xfc, 0x6f, 0x2e, 0xe0, 0xd2, 0x60, 0x01, 0x0b, 0xb4, 0xb0, 0x90, 0x7d, 0xf4, 0xd2, 0x5f, 0x49,
-		0x00, 0xa1, 0x39, 0xf2, 0x37, 0x53, 0xc7, 0x50, 0x1d, 0xd2, 0xdc, 0x38, 0x27, 0xef, 0xf9, 0xc1,
-		0x53, 0x46, 0x20, 0x24, 0xd4, 0xef, 0x4e, 0xc0, 0xd4, 0x96, 0x98, 0xbd, 0x7f, 0xf3, 0x65, 0xb0,
-		0x05, 0xe3, 0xd8, 0xf4, 0x1c, 0xc3, 0xdf, 0x0e, 0x79, 0x62, 0xd0, 0x98, 0xc7, 0x74, 0xaa, 0x66,
-		0x7a, 0xce, 0x61, 0x58, 0x03, 0x04, 0xad, 0x90, 0x3c, 0x3e, 0x9c, 0x84, 0x99, 0x41, 0xa8, 0x24,
-		0x40, 0xd6, 0x1d, 0x4c, 0x0b, 0xa2, 0x37, 0xfa, 0x26, 0x44, 0x31, 0x77, 0x3b, 0x0a, 0x90, 0x40,
-		0x8d, 0x28, 0x17, 0x01, 0xbd, 0xb7, 0xc8, 0x6c, 0x22, 0xa0, 0x40, 0x1d, 0x4f, 0x13, 0x8a, 0xe2,
-		0x1e, 0xc0, 0x8e, 0xd6, 0xd6, 0x4c, 0x5d, 0x44, 0xb0, 0xc7, 0xf2, 0xf9, 0xe2, 0x2e, 0x41, 0x95,
-		0x91, 0x40, 0x35, 0x18, 0x17, 0xd4, 0x52, 0xc7, 0xa7, 0x26, 0x70, 0xd1, 0x79, 0xc8, 0x87, 0x1d,
-		0x03, 0x8d, 0x46, 0x52, 0x4a, 0x2e, 0xe4, 0x17, 0x86, 0x79, 0x9e, 0xf4, 0x91, 0x9e, 0x87, 0x07,
-		0x7c, 0x3f, 0x9c, 0x84, 0x49, 0x05, 0xb7, 0xfe, 0xf6, 0x0f, 0xcb, 0x26, 0x00, 0x9b, 0xaa, 0xc4,
-		0x92, 0xf2, 0x91, 0xb9, 0x87, 0xf9, 0x9e, 0x65, 0x44, 0x96, 0x5c, 0xef, 0xab, 0x35, 0x42, 0xbf,
-		0x9b, 0x80, 0x7c, 0x78, 0x84, 0xfe, 0x4e, 0x3a, 0x2d, 0xb4, 0x1e, 0x98, 0x29, 0x76, 0x93, 0xe1,
-		0x91, 0x41, 0x66, 0xaa, 0x4f, 0x9b, 0x87, 0xd8, 0xa7, 0xcf, 0x27, 0x21, 0xcd, 0x4f, 0x14, 0x6d,
-		0xf4, 0xc5, 0xb6, 0x43, 0xaf, 0x73, 0x17, 0xc4, 0x8d, 0xf8, 0xd8, 0xd0, 0xf6, 0x21, 0x98, 0x20,
-		0x6b, 0xe4, 0xc8, 0x31, 0x25, 0xe9, 0x62, 0x81, 0x2e, 0x75, 0x83, 0x63, 0xba, 0x68, 0x0e, 0x72,
-		0x04, 0x2c, 0xb0, 0xc3, 0x04, 0x06, 0x3a, 0xda, 0x41, 0x8d, 0x95, 0xa0, 0xc7, 0x01, 0xed, 0xfb,
-		0xb9, 0x0a, 0x35, 0x10, 0x04, 0x81, 0x9b, 0x0c, 0x6a, 0x04, 0xf8, 0xfd, 0x00, 0x84, 0x0b, 0x95,
-		0xbd, 0xf1, 0xcd, 0x5f, 0x50, 0x27, 0x25, 0x4b, 0xf4, 0x9d, 0xef, 0x6f, 0x91, 0x58, 0x88, 0xdc,
-		0xb3, 0x92, 0xe6, 0x2b, 0x94, 0xe6, 0x08, 0x93, 0xe2, 0x2f, 0xde, 0x9a, 0x9b, 0x3d, 0xd4, 0x3a,
-		0xed, 0x72, 0x29, 0x86, 0x4e, 0x29, 0x6e, 0x71, 0x4f, 0x02, 0xe7, 0xe8, 0x4a, 0x1c, 0xd5, 0x41,
-		0xbe, 0x85, 0x0f, 0x55, 0x87, 0xff, 0x44, 0xbc, 0xba, 0x8b, 0xc5, 0x8b, 0x0a, 0x67, 0x16, 0x62,
-		0x5e, 0x5c, 0x5f, 0x58, 0xb4, 0x0c, 0x93, 0xef, 0x96, 0x4c, 0xdc, 0xc2, 0x87, 0x0a, 0xc7, 0x5b,
-		0xc6, 0xb8, 0xfc, 0x20, 0x99, 0x29, 0x6f, 0x7e, 0xfe, 0x67, 0x2e, 0x9d, 0x0d, 0xbd, 0x1e, 0x7e,
-		0xe0, 0x67, 0xec, 0xd8, 0xf0, 0x92, 0xa0, 0x17, 0x05, 0x0e, 0x28, 0x74, 0x2c, 0x0d, 0x42, 0xeb,
-		0x04, 0xe9, 0xe8, 0xf5, 0x47, 0x80, 0x1f, 0x59, 0x7f, 0x84, 0xa6, 0xe7, 0x8b, 0x81, 0xfd, 0x4f,
-		0x0c, 0xeb, 0x4d, 0x58, 0x33, 0x39, 0x12, 0x9d, 0xf5, 0x27, 0x4a, 0xff, 0x5e, 0x82, 0x33, 0x7d,
-		0x9a, 0xec, 0xb3, 0xac, 0x03, 0x72, 0x42, 0x95, 0x54, 0x23, 0xc4, 0xce, 0xe4, 0xbd, 0x4d, 0x8c,
-		0x49, 0xa7, 0xcf, 0x09, 0xbc, 0x33, 0x8e, 0x8c, 0x5b, 0xb1, 0x5f, 0x97, 0x60, 0x3a, 0xcc, 0x80,
-		0xdf, 0x95, 0x06, 0xe4, 0xc3, 0x4d, 0xf3, 0x4e, 0x3c, 0x38, 0x4a, 0x27, 0xc2, 0xfc, 0x47, 0x88,
-		0xa0, 0xed, 0xc0, 0x5a, 0xb0, 0x3c, 0xe1, 0x95, 0x91, 0x85, 0x22, 0x18, 0x8b, 0xb5, 0x1a, 0x6c,
-		0x6c, 0xfe, 0x58, 0x82, 0xd4, 0xa6, 0x65, 0xb5, 0xd1, 0xfb, 0x60, 0xd2, 0xb4, 0x3c, 0x95, 0xcc,
-		0x2c, 0xdc, 0x52, 0x79, 0xda, 0x80, 0x59, 0xe2, 0xda, 0x91, 0xb2, 0xfa, 0xa3, 0xb7, 0xe6, 0xfa,
-		0x31, 0xe3, 0x5e, 0xf0, 0x2f, 0x9a, 0x96, 0x57, 0xa5, 0x40, 0x4d, 0x96, 0x59, 0xd8, 0x85, 0x42,
-		0xb4, 0x39, 0x66, 0xad, 0x2b, 0xc3, 0x9a, 0x2b, 0x0c, 0x6d, 0x2a, 0xbf, 0x13, 0x6a, 0x87, 0xbd,
-		0x55, 0xfe, 0x67, 0x64, 0xe4, 0xbe, 0x01, 0xe4, 0xed, 0xde, 0x73, 0x2f, 0xcb, 0x30, 0x2e, 0xce,
-		0xb9, 0x48, 0xa3, 0x9e, 0xa1, 0x09, 0xcb, 0x93, 0x23, 0xd3, 0x44, 0xec, 0x67, 0x12, 0x70, 0x66,
-		0xd1, 0x32, 0x5d, 0x9e, 0xe4, 0xe1, 0xb3, 0x9a, 0xa5, 0x66, 0x0f, 0xd1, 0x23, 0x03, 0x52, 0x50,
-		0xf9, 0xfe, 0x44, 0xd3, 0x36, 0x14, 0x89, 0x7b, 0xd5, 0x2d, 0xf3, 0x6d, 0xe6, 0x99, 0x0a, 0x56,
-		0xbb, 0xc5, 0x39, 0xba, 0x85, 0x0f, 0x09, 0x5d, 0x13, 0xdf, 0x89, 0xd0, 0x4d, 0xde, 0x1b, 0x5d,
-		0x13, 0xdf, 0x09, 0xd1, 0x0d, 0xb6, 0x56, 0x53, 0x91, 0xad, 0xd5, 0xeb, 0x90, 0x24, 0xa6, 0x70,
-		0xec, 0x18, 0xc6, 0x83, 0x20, 0x84, 0x5c, 0x5a, 0x03, 0xce, 0xf0, 0x2c, 0x81, 0xbb, 0xb1, 0x4b,
-		0x25, 0x8a, 0x69, 0x87, 0x5e, 0xc6, 0x87, 0x31, 0x29, 0x83, 0xfc, 0x48, 0x29, 0x83, 0x4b, 0x3f,
-		0x2f, 0x01, 0x04, 0xf9, 0x32, 0xf4, 0x18, 0x9c, 0xae, 0x6e, 0xac, 0x2f, 0x05, 0xbb, 0x4c, 0xa1,
-		0x9f, 0x31, 0x12, 0x2f, 0x8a, 0xb9, 0x36, 0xd6, 0x8d, 0x5d, 0x03, 0xb7, 0xd0, 0xc3, 0x30, 0x1d,
-		0x85, 0x26, 0x5f, 0xb5, 0x25, 0x59, 0x9a, 0xcd, 0xbf, 0x79, 0x77, 0x3e, 0xc3, 0xd6, 0x07, 0xb8,
-		0x85, 0x2e, 0xc2, 0xc9, 0x7e, 0xb8, 0xfa, 0xfa, 0x8a, 0x9c, 0x98, 0x2d, 0xbc, 0x79, 0x77, 0x3e,
-		0xeb, 0x2f, 0x24, 0x50, 0x09, 0x50, 0x18, 0x92, 0xd3, 0x4b, 0xce, 0xc2, 0x9b, 0x77, 0xe7, 0xd3,
-		0x6c, 0xca, 0xf0, 0xed, 0xa9, 0xaf, 0x07, 0xa8, 0x9b, 0xbb, 0x8e, 0xa6, 0x53, 0xd3, 0x30, 0x0b,
-		0xa7, 0xea, 0xeb, 0xcb, 0x4a, 0x65, 0xb1, 0x59, 0xdf, 0x58, 0xef, 0xf9, 0xf5, 0xa5, 0x68, 0xdd,
-		0xd2, 0xc6, 0x56, 0x75, 0xb5, 0xa6, 0x36, 0xea, 0x2b, 0xeb, 0x6c, 0x2f, 0x39, 0x52, 0xf7, 0xde,
-		0xf5, 0x66, 0x7d, 0xad, 0x26, 0x27, 0xaa, 0xd7, 0x07, 0x6e, 0x3b, 0xdd, 0x17, 0x99, 0x8c, 0x81,
-		0x3b, 0x8a, 0xfc, 0xac, 0xc5, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xfa, 0x3d, 0x4f, 0xc1,
-		0xa5, 0x00, 0x00,
+		// 13028 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x5c, 0xd9,
+		0x55, 0x18, 0xac, 0x5b, 0xef, 0xda, 0xf5, 0xba, 0x7d, 0xba, 0x25, 0x95, 0x5a, 0x33, 0x7a, 0xd4,
+		0xbc, 0x34, 0x1a, 0x4f, 0x6b, 0xa4, 0x99, 0xd1, 0x8c, 0x7a, 0xc6, 0x33, 0x54, 0x55, 0x97, 0xa4,
+		0xd6, 0xb4, 0xba, 0xdb, 0xb7, 0xaa, 0x35, 0x0f, 0xc0, 0x97, 0xdb, 0x55, 0xa7, 0xbb, 0x6b, 0x54,
+		0x75, 0x6f, 0xb9, 0xee, 0x2d, 0x49, 0xed, 0x6f, 0x2d, 0x96, 0x01, 0x63, 0xcc, 0x18, 0xf3, 0x99,
+		0x0f, 0x3e, 0x30, 0x18, 0x19, 0x03, 0x1f, 0xd8, 0x3c, 0x3e, 0x02, 0xd8, 0x10, 0x9c, 0xac, 0x3c,
+		0xc8, 0x83, 0x00, 0x49, 0x88, 0x71, 0x56, 0x12, 0xc2, 0x5a, 0x4c, 0x12, 0x9b, 0x85, 0xc7, 0x0f,
+		0x08, 0x90, 0x31, 0x8b, 0x15, 0xaf, 0x2c, 0x67, 0xed, 0xf3, 0xb8, 0x8f, 0xaa, 0x5b, 0x5d, 0xd5,
+		0x9a, 0xb1, 0xe3, 0x40, 0xfe, 0x74, 0xd7, 0x39, 0x67, 0xef, 0x7d, 0xce, 0xd9, 0x67, 0x9f, 0xbd,
+		0xf7, 0xd9, 0xe7, 0x71, 0xe1, 0x2b, 0x6f, 0x87, 0x13, 0xdb, 0x96, 0xb5, 0xdd, 0xa1, 0x67, 0x7a,
+		0x7d, 0xcb, 0xb1, 0x36, 0x07, 0x5b, 0x67, 0x5a, 0xd4, 0x6e, 0xf6, 0xdb, 0x3d, 0xc7, 0xea, 0x2f,
+		0xb0, 0x3c, 0x52, 0xe0, 0x10, 0x0b, 0x12, 0xa2, 0x74, 0x15, 0x66, 0x2e, 0xb6, 0x3b, 0x74, 0xc9,
+		0x05, 0xac, 0x53, 0x87, 0x3c, 0x09, 0xb1, 0xad, 0x76, 0x87, 0x16, 0x95, 0x13, 0xd1, 0x53, 0x99,
+		0x73, 0xf7, 0x2e, 0x0c, 0x21, 0x2d, 0x04, 0x31, 0xd6, 0x31, 0x5b, 0x63, 0x18, 0xa5, 0x0f, 0xc6,
+		0x61, 0x36, 0xa4, 0x94, 0x10, 0x88, 0x99, 0x46, 0x17, 0x29, 0x2a, 0xa7, 0xd2, 0x1a, 0xfb, 0x4d,
+		0x8a, 0x90, 0xec, 0x19, 0xcd, 0xeb, 0xc6, 0x36, 0x2d, 0x46, 0x58, 0xb6, 0x4c, 0x92, 0x63, 0x00,
+		0x2d, 0xda, 0xa3, 0x66, 0x8b, 0x9a, 0xcd, 0xdd, 0x62, 0xf4, 0x44, 0xf4, 0x54, 0x5a, 0xf3, 0xe5,
+		0x90, 0x87, 0x60, 0xa6, 0x37, 0xd8, 0xec, 0xb4, 0x9b, 0xba, 0x0f, 0x0c, 0x4e, 0x44, 0x4f, 0xc5,
+		0x35, 0x95, 0x17, 0x2c, 0x79, 0xc0, 0x0f, 0x40, 0xe1, 0x26, 0x35, 0xae, 0xfb, 0x41, 0x33, 0x0c,
+		0x34, 0x8f, 0xd9, 0x3e, 0xc0, 0x2a, 0x64, 0xbb, 0xd4, 0xb6, 0x8d, 0x6d, 0xaa, 0x3b, 0xbb, 0x3d,
+		0x5a, 0x8c, 0xb1, 0xde, 0x9f, 0x18, 0xe9, 0xfd, 0x70, 0xcf, 0x33, 0x02, 0xab, 0xb1, 0xdb, 0xa3,
+		0xa4, 0x0c, 0x69, 0x6a, 0x0e, 0xba, 0x9c, 0x42, 0x7c, 0x0c, 0xff, 0x6a, 0xe6, 0xa0, 0x3b, 0x4c,
+		0x25, 0x85, 0x68, 0x82, 0x44, 0xd2, 0xa6, 0xfd, 0x1b, 0xed, 0x26, 0x2d, 0x26, 0x18, 0x81, 0x07,
+		0x46, 0x08, 0xd4, 0x79, 0xf9, 0x30, 0x0d, 0x89, 0x47, 0xaa, 0x90, 0xa6, 0xb7, 0x1c, 0x6a, 0xda,
+		0x6d, 0xcb, 0x2c, 0x26, 0x19, 0x91, 0xfb, 0x42, 0x46, 0x91, 0x76, 0x5a, 0xc3, 0x24, 0x3c, 0x3c,
+		0x72, 0x1e, 0x92, 0x56, 0xcf, 0x69, 0x5b, 0xa6, 0x5d, 0x4c, 0x9d, 0x50, 0x4e, 0x65, 0xce, 0xdd,
+		0x15, 0x2a, 0x08, 0x6b, 0x1c, 0x46, 0x93, 0xc0, 0x64, 0x19, 0x54, 0xdb, 0x1a, 0xf4, 0x9b, 0x54,
+		0x6f, 0x5a, 0x2d, 0xaa, 0xb7, 0xcd, 0x2d, 0xab, 0x98, 0x66, 0x04, 0x8e, 0x8f, 0x76, 0x84, 0x01,
+		0x56, 0xad, 0x16, 0x5d, 0x36, 0xb7, 0x2c, 0x2d, 0x6f, 0x07, 0xd2, 0xe4, 0x10, 0x24, 0xec, 0x5d,
+		0xd3, 0x31, 0x6e, 0x15, 0xb3, 0x4c, 0x42, 0x44, 0x8a, 0x9c, 0x83, 0x24, 0x6d, 0xb5, 0xb1, 0xba,
+		0x62, 0xfe, 0x84, 0x72, 0x2a, 0x7f, 0xae, 0x38, 0xca, 0x63, 0x5e, 0xae, 0x49, 0xc0, 0xd2, 0x27,
+		0x13, 0x50, 0x98, 0x46, 0x2c, 0x9f, 0x82, 0xf8, 0x16, 0x72, 0xa6, 0x18, 0xd9, 0x0f, 0xdf, 0x38,
+		0x4e, 0x90, 0xf1, 0x89, 0x3b, 0x64, 0x7c, 0x19, 0x32, 0x26, 0xb5, 0x1d, 0xda, 0xe2, 0x52, 0x14,
+		0x9d, 0x52, 0x0e, 0x81, 0x23, 0x8d, 0x8a, 0x61, 0xec, 0x8e, 0xc4, 0xf0, 0x05, 0x28, 0xb8, 0x4d,
+		0xd2, 0xfb, 0x86, 0xb9, 0x2d, 0xe5, 0xf9, 0xcc, 0xa4, 0x96, 0x2c, 0xd4, 0x24, 0x9e, 0x86, 0x68,
+		0x5a, 0x9e, 0x06, 0xd2, 0x64, 0x09, 0xc0, 0x32, 0xa9, 0xb5, 0xa5, 0xb7, 0x68, 0xb3, 0x53, 0x4c,
+		0x8d, 0xe1, 0xd2, 0x1a, 0x82, 0x8c, 0x70, 0xc9, 0xe2, 0xb9, 0xcd, 0x0e, 0xb9, 0xe0, 0x89, 0x67,
+		0x72, 0x8c, 0x74, 0x5d, 0xe5, 0x13, 0x73, 0x44, 0x42, 0x37, 0x20, 0xdf, 0xa7, 0x38, 0x57, 0x68,
+		0x4b, 0xf4, 0x2c, 0xcd, 0x1a, 0xb1, 0x30, 0xb1, 0x67, 0x9a, 0x40, 0xe3, 0x1d, 0xcb, 0xf5, 0xfd,
+		0x49, 0x72, 0x0f, 0xb8, 0x19, 0x3a, 0x13, 0x2b, 0x60, 0x9a, 0x2b, 0x2b, 0x33, 0x57, 0x8d, 0x2e,
+		0x9d, 0x7f, 0x27, 0xe4, 0x83, 0xec, 0x21, 0x73, 0x10, 0xb7, 0x1d, 0xa3, 0xef, 0x30, 0x29, 0x8c,
+		0x6b, 0x3c, 0x41, 0x54, 0x88, 0x52, 0xb3, 0xc5, 0x34, 0x63, 0x5c, 0xc3, 0x9f, 0xe4, 0x9b, 0xbc,
+		0x0e, 0x47, 0x59, 0x87, 0xef, 0x1f, 0x1d, 0xd1, 0x00, 0xe5, 0xe1, 0x7e, 0xcf, 0x3f, 0x01, 0xb9,
+		0x40, 0x07, 0xa6, 0xad, 0xba, 0xf4, 0xaf, 0x62, 0x70, 0x30, 0x94, 0x36, 0x79, 0x01, 0xe6, 0x06,
+		0x66, 0xdb, 0x74, 0x68, 0xbf, 0xd7, 0xa7, 0x28, 0xb2, 0xbc, 0xae, 0xe2, 0xe7, 0x92, 0x63, 0x84,
+		0x6e, 0xc3, 0x0f, 0xcd, 0xa9, 0x68, 0xb3, 0x83, 0xd1, 0x4c, 0xf2, 0x22, 0x64, 0x50, 0x3e, 0x8c,
+		0xbe, 0xc1, 0x08, 0xf2, 0xd9, 0x78, 0x6e, 0xba, 0x2e, 0x2f, 0x2c, 0x79, 0x98, 0x95, 0xe8, 0x7b,
+		0x95, 0x88, 0xe6, 0xa7, 0x45, 0x9e, 0x80, 0xd4, 0x16, 0x35, 0x9c, 0x41, 0x9f, 0xda, 0xc5, 0x73,
+		0x8c, 0x95, 0x47, 0x47, 0x27, 0x29, 0x07, 0xa8, 0x53, 0x47, 0x73, 0x81, 0x49, 0x17, 0xb2, 0x37,
+		0x68, 0xbf, 0xbd, 0xd5, 0x6e, 0xf2, 0x46, 0x45, 0x99, 0xf2, 0x79, 0x72, 0xca, 0x46, 0x5d, 0xf3,
+		0xa1, 0xd6, 0x1d, 0xc3, 0xa1, 0x8b, 0xb0, 0xb1, 0x7a, 0xad, 0xa6, 0x2d, 0x5f, 0x5c, 0xae, 0x2d,
+		0xf1, 0x66, 0x06, 0xc8, 0xcf, 0xff, 0x90, 0x02, 0x19, 0x5f, 0x4f, 0x50, 0x1d, 0x9a, 0x83, 0xee,
+		0x26, 0xed, 0x8b, 0xf1, 0x12, 0x29, 0x72, 0x14, 0xd2, 0x5b, 0x83, 0x4e, 0x87, 0x0b, 0x1d, 0xb7,
+		0xa5, 0x29, 0xcc, 0x40, 0x81, 0x43, 0x1d, 0x27, 0xd4, 0x08, 0xd3, 0x71, 0xf8, 0x9b, 0xcc, 0x43,
+		0x4a, 0x0a, 0x65, 0x31, 0x7e, 0x42, 0x39, 0x95, 0xd2, 0xdc, 0x34, 0x2f, 0xeb, 0x51, 0xc3, 0xa1,
+		0xad, 0x62, 0x42, 0x96, 0xf1, 0xf4, 0x95, 0x58, 0x2a, 0xa6, 0xc6, 0x4b, 0x8f, 0xc1, 0xcc, 0x48,
+		0x57, 0x48, 0x01, 0x32, 0x4b, 0xb5, 0xea, 0x4a, 0x59, 0x2b, 0x37, 0x96, 0xd7, 0x56, 0xd5, 0x03,
+		0x24, 0x0f, 0xbe, 0xde, 0xa9, 0xca, 0xe9, 0x74, 0xea, 0xb5, 0xa4, 0xfa, 0xae, 0x77, 0xbd, 0xeb,
+		0x5d, 0x91, 0xd2, 0x6f, 0x26, 0x60, 0x2e, 0x4c, 0x09, 0x86, 0xea, 0x63, 0xaf, 0xd3, 0xd1, 0x40,
+		0xa7, 0xcb, 0x10, 0xef, 0x18, 0x9b, 0xb4, 0x53, 0x8c, 0xb1, 0x41, 0x78, 0x68, 0x2a, 0x35, 0xbb,
+		0xb0, 0x82, 0x28, 0x1a, 0xc7, 0x24, 0xcf, 0x08, 0xd6, 0xc4, 0x19, 0x85, 0xd3, 0xd3, 0x51, 0x40,
+		0xe5, 0x28, 0xd8, 0x78, 0x14, 0xd2, 0xf8, 0x9f, 0xf3, 0x3d, 0xc1, 0xf9, 0x8e, 0x19, 0x8c, 0xef,
+		0xf3, 0x90, 0x62, 0x7a, 0xaf, 0x45, 0xdd, 0x31, 0x91, 0x69, 0xd4, 0x14, 0x2d, 0xba, 0x65, 0x0c,
+		0x3a, 0x8e, 0x7e, 0xc3, 0xe8, 0x0c, 0x28, 0xd3, 0x60, 0x69, 0x2d, 0x2b, 0x32, 0xaf, 0x61, 0x1e,
+		0x39, 0x0e, 0x19, 0xae, 0x26, 0xdb, 0x66, 0x8b, 0xde, 0x62, 0x26, 0x34, 0xae, 0x71, 0xcd, 0xb9,
+		0x8c, 0x39, 0x58, 0xfd, 0xcb, 0xb6, 0x65, 0x4a, 0x5d, 0xc3, 0xaa, 0xc0, 0x0c, 0x56, 0xfd, 0x13,
+		0xc3, 0xd6, 0xfb, 0xee, 0xf0, 0xee, 0x8d, 0x28, 0xc7, 0x07, 0xa0, 0xc0, 0x20, 0x1e, 0x15, 0x53,
+		0xd9, 0xe8, 0x14, 0x67, 0x98, 0x18, 0xe4, 0x79, 0xf6, 0x9a, 0xc8, 0x2d, 0xfd, 0x7a, 0x04, 0x62,
+		0xcc, 0x52, 0x14, 0x20, 0xd3, 0x78, 0x71, 0xbd, 0xa6, 0x2f, 0xad, 0x6d, 0x54, 0x56, 0x6a, 0xaa,
+		0x82, 0x43, 0xcf, 0x32, 0x2e, 0xae, 0xac, 0x95, 0x1b, 0x6a, 0xc4, 0x4d, 0x2f, 0xaf, 0x36, 0xce,
+		0x3f, 0xa6, 0x46, 0x5d, 0x84, 0x0d, 0x9e, 0x11, 0xf3, 0x03, 0x3c, 0x7a, 0x4e, 0x8d, 0x13, 0x15,
+		0xb2, 0x9c, 0xc0, 0xf2, 0x0b, 0xb5, 0xa5, 0xf3, 0x8f, 0xa9, 0x89, 0x60, 0xce, 0xa3, 0xe7, 0xd4,
+		0x24, 0xc9, 0x41, 0x9a, 0xe5, 0x54, 0xd6, 0xd6, 0x56, 0xd4, 0x94, 0x4b, 0xb3, 0xde, 0xd0, 0x96,
+		0x57, 0x2f, 0xa9, 0x69, 0x97, 0xe6, 0x25, 0x6d, 0x6d, 0x63, 0x5d, 0x05, 0x97, 0xc2, 0xd5, 0x5a,
+		0xbd, 0x5e, 0xbe, 0x54, 0x53, 0x33, 0x2e, 0x44, 0xe5, 0xc5, 0x46, 0xad, 0xae, 0x66, 0x03, 0xcd,
+		0x7a, 0xf4, 0x9c, 0x9a, 0x73, 0xab, 0xa8, 0xad, 0x6e, 0x5c, 0x55, 0xf3, 0x64, 0x06, 0x72, 0xbc,
+		0x0a, 0xd9, 0x88, 0xc2, 0x50, 0xd6, 0xf9, 0xc7, 0x54, 0xd5, 0x6b, 0x08, 0xa7, 0x32, 0x13, 0xc8,
+		0x38, 0xff, 0x98, 0x4a, 0x4a, 0x55, 0x88, 0x33, 0x31, 0x24, 0x04, 0xf2, 0x2b, 0xe5, 0x4a, 0x6d,
+		0x45, 0x5f, 0x5b, 0xc7, 0x49, 0x53, 0x5e, 0x51, 0x15, 0x2f, 0x4f, 0xab, 0xad, 0xd7, 0xca, 0x8d,
+		0xda, 0x92, 0x1a, 0xf5, 0xe7, 0xbd, 0x6d, 0x63, 0x59, 0xab, 0x2d, 0xa9, 0x91, 0x52, 0x13, 0xe6,
+		0xc2, 0x2c, 0x64, 0xe8, 0x14, 0xf2, 0xc9, 0x42, 0x64, 0x8c, 0x2c, 0x30, 0x5a, 0xc3, 0xb2, 0x50,
+		0xfa, 0x6c, 0x04, 0x66, 0x43, 0xbc, 0x84, 0xd0, 0x4a, 0x9e, 0x85, 0x38, 0x97, 0x65, 0xae, 0xa9,
+		0x1f, 0x0c, 0x75, 0x37, 0x98, 0x64, 0x8f, 0xf8, 0x4e, 0x0c, 0xcf, 0xef, 0x6f, 0x46, 0xc7, 0xf8,
+		0x9b, 0x48, 0x62, 0x44, 0x60, 0xbf, 0x75, 0xc4, 0x9a, 0x73, 0x87, 0xe7, 0xfc, 0x34, 0x0e, 0x0f,
+		0xcb, 0xdb, 0x9f, 0x55, 0x8f, 0x87, 0x58, 0xf5, 0xa7, 0x60, 0x66, 0x84, 0xd0, 0xd4, 0xd6, 0xf5,
+		0xbb, 0x14, 0x28, 0x8e, 0x63, 0xce, 0x04, 0x95, 0x18, 0x09, 0xa8, 0xc4, 0xa7, 0x86, 0x39, 0x78,
+		0x72, 0xfc, 0x20, 0x8c, 0x8c, 0xf5, 0x47, 0x15, 0x38, 0x14, 0xbe, 0xae, 0x08, 0x6d, 0xc3, 0x33,
+		0x90, 0xe8, 0x52, 0x67, 0xc7, 0x92, 0x7e, 0xf2, 0xfd, 0x21, 0xde, 0x17, 0x16, 0x0f, 0x0f, 0xb6,
+		0xc0, 0x22, 0x17, 0x86, 0xdb, 0x7a, 0x7c, 0xdc, 0x2a, 0x67, 0xa4, 0xa5, 0xdf, 0x1b, 0x81, 0x83,
+		0xa1, 0xc4, 0x43, 0x1b, 0x7a, 0x37, 0x40, 0xdb, 0xec, 0x0d, 0x1c, 0xee, 0x0b, 0x73, 0x4d, 0x9c,
+		0x66, 0x39, 0x4c, 0x79, 0xa1, 0x96, 0x1d, 0x38, 0x6e, 0x39, 0xb7, 0x92, 0xc0, 0xb3, 0x18, 0xc0,
+		0x93, 0x5e, 0x43, 0x63, 0xac, 0xa1, 0xc7, 0xc6, 0xf4, 0x74, 0x44, 0x30, 0x1f, 0x01, 0xb5, 0xd9,
+		0x69, 0x53, 0xd3, 0xd1, 0x6d, 0xa7, 0x4f, 0x8d, 0x6e, 0xdb, 0xdc, 0xe6, 0xd6, 0x76, 0x31, 0xbe,
+		0x65, 0x74, 0x6c, 0xaa, 0x15, 0x78, 0x71, 0x5d, 0x96, 0x22, 0x06, 0x13, 0xa0, 0xbe, 0x0f, 0x23,
+		0x11, 0xc0, 0xe0, 0xc5, 0x2e, 0x46, 0xe9, 0x47, 0xd2, 0x90, 0xf1, 0xad, 0xc2, 0xc8, 0x49, 0xc8,
+		0xbe, 0x6c, 0xdc, 0x30, 0x74, 0xb9, 0xb2, 0xe6, 0x9c, 0xc8, 0x60, 0xde, 0x3a, 0xcf, 0x22, 0x8f,
+		0xc0, 0x1c, 0x03, 0xb1, 0x06, 0x0e, 0xed, 0xeb, 0xcd, 0x8e, 0x61, 0xdb, 0x8c, 0x69, 0x29, 0x06,
+		0x4a, 0xb0, 0x6c, 0x0d, 0x8b, 0xaa, 0xb2, 0x84, 0x3c, 0x0e, 0xb3, 0x0c, 0xa3, 0x3b, 0xe8, 0x38,
+		0xed, 0x5e, 0x87, 0xea, 0xb8, 0xd6, 0xb7, 0x8b, 0xe0, 0x6f, 0xd9, 0x0c, 0x42, 0x5c, 0x15, 0x00,
+		0xd8, 0x22, 0x9b, 0x2c, 0xc1, 0xdd, 0x0c, 0x6d, 0x9b, 0x9a, 0xb4, 0x6f, 0x38, 0x54, 0xa7, 0xef,
+		0x18, 0x18, 0x1d, 0x5b, 0x37, 0xcc, 0x96, 0xbe, 0x63, 0xd8, 0x3b, 0xc5, 0x39, 0x24, 0x50, 0x89,
+		0x14, 0x15, 0xed, 0x08, 0x02, 0x5e, 0x12, 0x70, 0x35, 0x06, 0x56, 0x36, 0x5b, 0x97, 0x0d, 0x7b,
+		0x87, 0x2c, 0xc2, 0x21, 0x46, 0xc5, 0x76, 0xfa, 0x6d, 0x73, 0x5b, 0x6f, 0xee, 0xd0, 0xe6, 0x75,
+		0x7d, 0xe0, 0x6c, 0x3d, 0x59, 0x3c, 0xea, 0xaf, 0x9f, 0xb5, 0xb0, 0xce, 0x60, 0xaa, 0x08, 0xb2,
+		0xe1, 0x6c, 0x3d, 0x49, 0xea, 0x90, 0xc5, 0xc1, 0xe8, 0xb6, 0xdf, 0x49, 0xf5, 0x2d, 0xab, 0xcf,
+		0x6c, 0x68, 0x3e, 0x44, 0x35, 0xf9, 0x38, 0xb8, 0xb0, 0x26, 0x10, 0xae, 0x5a, 0x2d, 0xba, 0x18,
+		0xaf, 0xaf, 0xd7, 0x6a, 0x4b, 0x5a, 0x46, 0x52, 0xb9, 0x68, 0xf5, 0x51, 0xa0, 0xb6, 0x2d, 0x97,
+		0xc1, 0x19, 0x2e, 0x50, 0xdb, 0x96, 0x64, 0xef, 0xe3, 0x30, 0xdb, 0x6c, 0xf2, 0x3e, 0xb7, 0x9b,
+		0xba, 0x58, 0x91, 0xdb, 0x45, 0x35, 0xc0, 0xac, 0x66, 0xf3, 0x12, 0x07, 0x10, 0x32, 0x6e, 0x93,
+		0x0b, 0x70, 0xd0, 0x63, 0x96, 0x1f, 0x71, 0x66, 0xa4, 0x97, 0xc3, 0xa8, 0x8f, 0xc3, 0x6c, 0x6f,
+		0x77, 0x14, 0x91, 0x04, 0x6a, 0xec, 0xed, 0x0e, 0xa3, 0xdd, 0xc7, 0xa2, 0x2c, 0x7d, 0xda, 0x64,
+		0xae, 0xde, 0x61, 0x3f, 0xb4, 0xaf, 0x80, 0x2c, 0x80, 0xda, 0x6c, 0xea, 0xd4, 0x34, 0x36, 0x3b,
+		0x54, 0x37, 0xfa, 0xd4, 0x34, 0xec, 0xe2, 0x71, 0x06, 0x1c, 0x73, 0xfa, 0x03, 0xaa, 0xe5, 0x9b,
+		0xcd, 0x1a, 0x2b, 0x2c, 0xb3, 0x32, 0x72, 0x1a, 0x66, 0xac, 0xcd, 0x97, 0x9b, 0x5c, 0xb0, 0xf4,
+		0x5e, 0x9f, 0x6e, 0xb5, 0x6f, 0x15, 0xef, 0x65, 0x5c, 0x2a, 0x60, 0x01, 0x13, 0xab, 0x75, 0x96,
+		0x4d, 0x1e, 0x04, 0xb5, 0x69, 0xef, 0x18, 0xfd, 0x1e, 0xd3, 0xac, 0x76, 0xcf, 0x68, 0xd2, 0xe2,
+		0x7d, 0x1c, 0x94, 0xe7, 0xaf, 0xca, 0x6c, 0x14, 0x6c, 0xfb, 0x66, 0x7b, 0xcb, 0x91, 0x14, 0x1f,
+		0xe0, 0x82, 0xcd, 0xf2, 0x04, 0xb5, 0x53, 0xa0, 0xf6, 0x76, 0x7a, 0xc1, 0x8a, 0x4f, 0x31, 0xb0,
+		0x7c, 0x6f, 0xa7, 0xe7, 0xaf, 0xf7, 0x1e, 0xc8, 0xf5, 0x76, 0xfc, 0x95, 0x3e, 0xc8, 0xfd, 0xaf,
+		0xde, 0x8e, 0xaf, 0xc6, 0xc7, 0xe0, 0x10, 0x02, 0x75, 0xa9, 0x63, 0xb4, 0x0c, 0xc7, 0xf0, 0x41,
+		0xbf, 0x85, 0x41, 0xcf, 0xf5, 0x76, 0x7a, 0x57, 0x45, 0x61, 0xa0, 0x9d, 0xfd, 0xc1, 0xe6, 0xae,
+		0x2b, 0x1f, 0x0f, 0xf3, 0x76, 0x62, 0x9e, 0x94, 0x90, 0x3b, 0x5e, 0x7e, 0x7c, 0xcd, 0x16, 0x5b,
+		0xa5, 0x45, 0xc8, 0xfa, 0xe5, 0x9e, 0xa4, 0x81, 0x4b, 0xbe, 0xaa, 0xa0, 0x13, 0x54, 0x5d, 0x5b,
+		0x42, 0xf7, 0xe5, 0xa5, 0x9a, 0x1a, 0x41, 0x37, 0x6a, 0x65, 0xb9, 0x51, 0xd3, 0xb5, 0x8d, 0xd5,
+		0xc6, 0xf2, 0xd5, 0x9a, 0x1a, 0xf5, 0x39, 0xf6, 0x57, 0x62, 0xa9, 0xd3, 0xea, 0x43, 0x57, 0x62,
+		0xa9, 0xfb, 0xd5, 0x07, 0x4a, 0xaf, 0x47, 0x21, 0x1f, 0x5c, 0x80, 0x93, 0xa7, 0xe1, 0xb0, 0x8c,
+		0xb0, 0xd9, 0xd4, 0xd1, 0x6f, 0xb6, 0xfb, 0x6c, 0x5a, 0x76, 0x0d, 0x6e, 0x22, 0x5d, 0xf1, 0x9b,
+		0x13, 0x50, 0x75, 0xea, 0x3c, 0xdf, 0xee, 0xe3, 0xa4, 0xeb, 0x1a, 0x0e, 0x59, 0x81, 0xe3, 0xa6,
+		0xa5, 0xdb, 0x8e, 0x61, 0xb6, 0x8c, 0x7e, 0x4b, 0xf7, 0x62, 0x9b, 0xba, 0xd1, 0x6c, 0x52, 0xdb,
+		0xb6, 0xb8, 0x39, 0x74, 0xa9, 0xdc, 0x65, 0x5a, 0x75, 0x01, 0xec, 0xd9, 0x89, 0xb2, 0x00, 0x1d,
+		0x92, 0xfe, 0xe8, 0x38, 0xe9, 0x3f, 0x0a, 0xe9, 0xae, 0xd1, 0xd3, 0xa9, 0xe9, 0xf4, 0x77, 0x99,
+		0x97, 0x9e, 0xd2, 0x52, 0x5d, 0xa3, 0x57, 0xc3, 0x34, 0xb9, 0x06, 0xf7, 0x7b, 0xa0, 0x7a, 0x87,
+		0x6e, 0x1b, 0xcd, 0x5d, 0x9d, 0xb9, 0xe4, 0x2c, 0x1a, 0xa4, 0x37, 0x2d, 0x73, 0xab, 0xd3, 0x6e,
+		0x3a, 0x76, 0x31, 0xe3, 0x6a, 0xba, 0x92, 0x87, 0xb1, 0xc2, 0x10, 0xae, 0xd8, 0x96, 0xc9, 0x3c,
+		0xf1, 0xaa, 0x84, 0x0e, 0x08, 0x48, 0xf6, 0x1b, 0x42, 0x40, 0x82, 0x83, 0x1c, 0x53, 0xe3, 0x57,
+		0x62, 0xa9, 0xb8, 0x9a, 0xb8, 0x12, 0x4b, 0x25, 0xd4, 0xe4, 0x95, 0x58, 0x2a, 0xa5, 0xa6, 0xaf,
+		0xc4, 0x52, 0x69, 0x15, 0x4a, 0xb7, 0x73, 0x90, 0xf5, 0x2f, 0x2c, 0x70, 0x9d, 0xd6, 0x64, 0xa6,
+		0x55, 0x61, 0xca, 0xf7, 0x9e, 0x3d, 0x97, 0x21, 0x0b, 0x55, 0xb4, 0xb9, 0x8b, 0x09, 0xee, 0xc5,
+		0x6b, 0x1c, 0x13, 0xfd, 0x1d, 0x9c, 0x4e, 0x94, 0x7b, 0x4d, 0x29, 0x4d, 0xa4, 0xc8, 0x25, 0x48,
+		0xbc, 0x6c, 0x33, 0xda, 0x09, 0x46, 0xfb, 0xde, 0xbd, 0x69, 0x5f, 0xa9, 0x33, 0xe2, 0xe9, 0x2b,
+		0x75, 0x7d, 0x75, 0x4d, 0xbb, 0x5a, 0x5e, 0xd1, 0x04, 0x3a, 0x39, 0x02, 0xb1, 0x8e, 0xf1, 0xce,
+		0xdd, 0xa0, 0x75, 0x66, 0x59, 0x64, 0x01, 0x0a, 0x03, 0x93, 0xaf, 0xca, 0x71, 0x8c, 0x11, 0xaa,
+		0xe0, 0x87, 0xca, 0x7b, 0xa5, 0x2b, 0x08, 0x3f, 0xa5, 0x5c, 0x1d, 0x81, 0x18, 0x86, 0x9f, 0x83,
+		0x36, 0x94, 0x65, 0x91, 0x53, 0x90, 0x6d, 0xd1, 0xcd, 0xc1, 0xb6, 0xde, 0xa7, 0x2d, 0xa3, 0xe9,
+		0x04, 0x2d, 0x47, 0x86, 0x15, 0x69, 0xac, 0x84, 0x3c, 0x07, 0x69, 0x1c, 0x23, 0x93, 0x8d, 0xf1,
+		0x0c, 0x63, 0xc1, 0xc3, 0x7b, 0xb3, 0x40, 0x0c, 0xb1, 0x44, 0xd2, 0x3c, 0x7c, 0x72, 0x19, 0x92,
+		0x8e, 0xd1, 0xdf, 0xa6, 0x8e, 0x5d, 0x9c, 0x3d, 0x11, 0x3d, 0x95, 0x3f, 0xb7, 0x30, 0x0d, 0xa9,
+		0x06, 0x43, 0x61, 0x6b, 0x62, 0x89, 0x4e, 0x9e, 0x07, 0x55, 0x04, 0x5d, 0x75, 0xb1, 0xa0, 0xb5,
+		0x8b, 0x73, 0x4c, 0x00, 0xdf, 0xb2, 0x37, 0x49, 0x11, 0xb3, 0x5d, 0xe2, 0x48, 0x5a, 0x81, 0x06,
+		0xd2, 0xc1, 0x79, 0x71, 0x70, 0x3f, 0xf3, 0x62, 0x03, 0x0a, 0xe2, 0xb7, 0x6e, 0x0f, 0x7a, 0x3d,
+		0xab, 0xef, 0x14, 0x0f, 0x9d, 0x50, 0x26, 0x37, 0x48, 0x12, 0xe3, 0x38, 0x5a, 0x7e, 0x2b, 0x90,
+		0xfe, 0xda, 0x4d, 0xb7, 0xf9, 0x97, 0x20, 0x1f, 0x64, 0x86, 0x3f, 0xe4, 0x1d, 0x9d, 0x32, 0xe4,
+		0x8d, 0x0b, 0x10, 0xb9, 0x24, 0x43, 0x23, 0xc4, 0x13, 0xf3, 0x3f, 0x1c, 0x81, 0x7c, 0xb0, 0x63,
+		0xe4, 0x12, 0x10, 0x39, 0x62, 0x6d, 0xd3, 0xe9, 0x5b, 0xad, 0x41, 0x93, 0xb6, 0x8a, 0xca, 0x84,
+		0x7a, 0x66, 0x04, 0xce, 0xb2, 0x8b, 0xe2, 0x27, 0xe4, 0x9b, 0x05, 0x91, 0x29, 0x09, 0x2d, 0x79,
+		0xf3, 0xe3, 0x0c, 0xcc, 0x4a, 0x02, 0x48, 0xec, 0xa6, 0xd1, 0x37, 0xd1, 0x19, 0xe6, 0xee, 0x39,
+		0xf1, 0x15, 0x3d, 0xcf, 0x4b, 0x48, 0x19, 0xa4, 0xb8, 0xe8, 0x7d, 0xda, 0xb5, 0x30, 0xb2, 0x15,
+		0x9b, 0x50, 0x6d, 0x5e, 0x20, 0x68, 0x1c, 0xbe, 0x74, 0x06, 0xe2, 0x4c, 0xfd, 0x10, 0x00, 0xa1,
+		0x80, 0xd4, 0x03, 0x24, 0x05, 0xb1, 0xea, 0x9a, 0x86, 0x86, 0x50, 0x85, 0x2c, 0xcf, 0xd5, 0xd7,
+		0x97, 0x6b, 0xd5, 0x9a, 0x1a, 0x29, 0x3d, 0x0e, 0x09, 0xae, 0x53, 0xd0, 0x48, 0xba, 0x5a, 0x45,
+		0x3d, 0x20, 0x92, 0x82, 0x86, 0x22, 0x4b, 0x37, 0xae, 0x56, 0x6a, 0x9a, 0x1a, 0x29, 0x6d, 0x40,
+		0x61, 0x68, 0x1e, 0x92, 0x83, 0x30, 0xa3, 0xd5, 0x1a, 0xb5, 0x55, 0x0c, 0x03, 0xe8, 0x1b, 0xab,
+		0xcf, 0xad, 0xae, 0x3d, 0x8f, 0x31, 0xb4, 0x40, 0xb6, 0xb4, 0xb8, 0x0a, 0x99, 0x03, 0xd5, 0xcb,
+		0xae, 0xaf, 0x6d, 0x68, 0xac, 0x35, 0xdf, 0x17, 0x01, 0x75, 0x78, 0x52, 0x92, 0xc3, 0x30, 0xdb,
+		0x28, 0x6b, 0x97, 0x6a, 0x0d, 0x9d, 0x87, 0x36, 0x5c, 0xd2, 0x73, 0xa0, 0xfa, 0x0b, 0x2e, 0x2e,
+		0xb3, 0xc8, 0xcd, 0x71, 0x38, 0xea, 0xcf, 0xad, 0xbd, 0xd0, 0xa8, 0xad, 0xd6, 0x59, 0xe5, 0xe5,
+		0xd5, 0x4b, 0x68, 0xfe, 0x87, 0xe8, 0xc9, 0x60, 0x4a, 0x14, 0x9b, 0x1a, 0xa4, 0x57, 0x5b, 0x59,
+		0x52, 0x63, 0xc3, 0xd9, 0x6b, 0xab, 0xb5, 0xb5, 0x8b, 0x6a, 0x7c, 0xb8, 0x76, 0x16, 0x60, 0x49,
+		0x90, 0x79, 0x38, 0x34, 0x9c, 0xab, 0xd7, 0x56, 0x1b, 0xda, 0x8b, 0x6a, 0x72, 0xb8, 0xe2, 0x7a,
+		0x4d, 0xbb, 0xb6, 0x5c, 0xad, 0xa9, 0x29, 0x72, 0x08, 0x48, 0xb0, 0x45, 0x8d, 0xcb, 0x6b, 0x4b,
+		0x6a, 0x3a, 0xcc, 0x62, 0x11, 0x75, 0xb6, 0xf4, 0x8b, 0x0a, 0x64, 0xfd, 0xc1, 0x8e, 0x80, 0x52,
+		0x51, 0xbe, 0xd1, 0x8c, 0x6d, 0xe9, 0xf7, 0x23, 0x90, 0xf1, 0x45, 0x3d, 0x70, 0xb9, 0x6a, 0x74,
+		0x3a, 0xd6, 0x4d, 0xdd, 0xe8, 0xb4, 0x0d, 0x5b, 0xd8, 0x43, 0x60, 0x59, 0x65, 0xcc, 0x99, 0xd6,
+		0xfe, 0x4c, 0xef, 0xba, 0x24, 0xee, 0xd8, 0x75, 0x49, 0x7e, 0x03, 0xba, 0x2e, 0x71, 0x35, 0x51,
+		0xfa, 0x8e, 0x08, 0xa8, 0xc3, 0x71, 0x90, 0x21, 0xbe, 0x29, 0xe3, 0xf8, 0xe6, 0xef, 0x5f, 0x64,
+		0x3f, 0xfd, 0x1b, 0xb6, 0xea, 0xd1, 0xb1, 0x56, 0xfd, 0xeb, 0x22, 0x57, 0xff, 0x4e, 0x81, 0x7c,
+		0x30, 0xbe, 0x12, 0xe8, 0x5a, 0x69, 0x3f, 0x5d, 0x0b, 0xb2, 0xee, 0xe4, 0x38, 0xd6, 0x7d, 0x5d,
+		0xfa, 0xf5, 0xa3, 0x51, 0xc8, 0x05, 0xc2, 0x31, 0xd3, 0xb6, 0xee, 0x1d, 0x30, 0xd3, 0x6e, 0xd1,
+		0x6e, 0xcf, 0x72, 0xf0, 0x30, 0x80, 0xde, 0xa1, 0x37, 0x68, 0x87, 0xb1, 0x21, 0x1f, 0xb2, 0xe1,
+		0x19, 0xa8, 0x61, 0x61, 0xd9, 0xc3, 0x5b, 0x41, 0xb4, 0xc5, 0xd9, 0xe5, 0xa5, 0xda, 0xd5, 0xf5,
+		0xb5, 0x46, 0x6d, 0xb5, 0xfa, 0xa2, 0x54, 0xb9, 0x9a, 0xda, 0x1e, 0x02, 0x0b, 0x30, 0xfc, 0x9e,
+		0x6f, 0x8c, 0x75, 0xe0, 0x3a, 0xa8, 0xc3, 0xbd, 0x41, 0xcd, 0x1b, 0xd2, 0x1f, 0xf5, 0x00, 0x99,
+		0x85, 0xc2, 0xea, 0x9a, 0x5e, 0x5f, 0x5e, 0xaa, 0xe9, 0xb5, 0x8b, 0x17, 0x6b, 0xd5, 0x46, 0x9d,
+		0xc7, 0xfe, 0x5d, 0xe8, 0x86, 0x1a, 0xf1, 0x8f, 0xcd, 0x8f, 0x45, 0x61, 0x36, 0xa4, 0x25, 0xa4,
+		0x2c, 0xa2, 0x76, 0x3c, 0x90, 0xf8, 0xf0, 0x34, 0xad, 0x5f, 0xc0, 0x05, 0xf7, 0xba, 0xd1, 0x77,
+		0x44, 0x90, 0xef, 0x41, 0x40, 0xf6, 0x9a, 0x0e, 0xfa, 0xe1, 0x7d, 0xb1, 0xa7, 0xc2, 0x7d, 0x85,
+		0x82, 0x97, 0xcf, 0xb7, 0x55, 0xde, 0x02, 0xa4, 0x67, 0xd9, 0x6d, 0xa7, 0x7d, 0x03, 0xcf, 0x26,
+		0xc8, 0x0d, 0x18, 0xf4, 0x15, 0x62, 0x9a, 0x2a, 0x4b, 0x96, 0x4d, 0xc7, 0x85, 0x36, 0xe9, 0xb6,
+		0x31, 0x04, 0x8d, 0xeb, 0x84, 0xa8, 0xa6, 0xca, 0x12, 0x17, 0xfa, 0x24, 0x64, 0x5b, 0xd6, 0x00,
+		0x03, 0x25, 0x1c, 0x0e, 0x75, 0xa7, 0xa2, 0x65, 0x78, 0x9e, 0x0b, 0x22, 0x22, 0x59, 0xde, 0xce,
+		0x4f, 0x56, 0xcb, 0xf0, 0x3c, 0x0e, 0xf2, 0x00, 0x14, 0x8c, 0xed, 0xed, 0x3e, 0x12, 0x97, 0x84,
+		0x78, 0x6c, 0x2e, 0xef, 0x66, 0x33, 0xc0, 0xf9, 0x2b, 0x90, 0x92, 0x7c, 0xc0, 0x85, 0x2a, 0x72,
+		0x42, 0xef, 0xf1, 0x80, 0x73, 0x04, 0x37, 0x83, 0x4c, 0x59, 0x78, 0x12, 0xb2, 0x6d, 0x5b, 0xf7,
+		0x4e, 0x26, 0x44, 0x4e, 0x44, 0x4e, 0xa5, 0xb4, 0x4c, 0xdb, 0x76, 0x37, 0x2a, 0x4b, 0x9f, 0x04,
+		0x00, 0x4f, 0xd8, 0xc8, 0x0f, 0x28, 0x90, 0xe7, 0x96, 0xa0, 0xd7, 0xa7, 0x36, 0x35, 0x9b, 0x72,
+		0xfd, 0xf6, 0xe0, 0x1e, 0x22, 0xca, 0x9d, 0xe7, 0x75, 0x81, 0x50, 0x79, 0xf6, 0xbd, 0x8a, 0xf2,
+		0x41, 0x25, 0xf6, 0x41, 0x45, 0xf9, 0x29, 0x25, 0x47, 0x52, 0xb5, 0x17, 0xd6, 0x57, 0x96, 0xab,
+		0xcb, 0x8d, 0xe2, 0x9f, 0x24, 0x59, 0x7a, 0xf9, 0xaa, 0x48, 0x7f, 0x2e, 0x19, 0x2c, 0x7f, 0x2d,
+		0xf9, 0xab, 0x4a, 0x34, 0xf5, 0x5a, 0x52, 0xcb, 0x6d, 0xf9, 0xe9, 0x91, 0x8e, 0xff, 0x50, 0x43,
+		0x64, 0xdc, 0x8a, 0xcf, 0x6b, 0x4d, 0x4d, 0x1c, 0x65, 0xa8, 0x3c, 0xc8, 0x1a, 0x92, 0x60, 0x0d,
+		0xc9, 0x90, 0x44, 0x75, 0x65, 0xad, 0x5e, 0x5b, 0x62, 0xcd, 0x48, 0x93, 0xd8, 0xda, 0x7a, 0x6d,
+		0xb5, 0xf8, 0x39, 0x59, 0xa5, 0x77, 0xfe, 0xe1, 0x83, 0x0a, 0x1c, 0x96, 0x1b, 0x9f, 0xc2, 0x28,
+		0x52, 0xb3, 0x69, 0xb5, 0xa4, 0x1b, 0x9a, 0x3f, 0x77, 0x76, 0xaf, 0xca, 0x35, 0x81, 0xca, 0x58,
+		0x52, 0x13, 0x88, 0x95, 0x87, 0x47, 0x58, 0x52, 0x5e, 0x5d, 0x12, 0x6d, 0xc9, 0x90, 0xc4, 0x7a,
+		0xb9, 0xfa, 0x5c, 0x6d, 0xc9, 0x6b, 0xcd, 0xc1, 0x7e, 0x18, 0x15, 0xf2, 0xed, 0x50, 0xc0, 0x00,
+		0x28, 0xca, 0x46, 0xbb, 0xc5, 0x77, 0xa2, 0x63, 0xe3, 0xb6, 0x30, 0xbd, 0x16, 0x61, 0x44, 0xf4,
+		0x9a, 0x8b, 0x51, 0x79, 0xd0, 0xd7, 0x94, 0x34, 0x89, 0xad, 0xae, 0xad, 0xd6, 0x64, 0x33, 0xd8,
+		0xae, 0xed, 0x8b, 0x5e, 0x33, 0xf2, 0x83, 0x00, 0x2a, 0xf9, 0x76, 0x50, 0x65, 0x1c, 0xc7, 0x65,
+		0x49, 0x7c, 0xdc, 0x2e, 0xac, 0xd7, 0x00, 0x11, 0x0d, 0x72, 0x99, 0x71, 0xbf, 0xaf, 0x05, 0x73,
+		0xa4, 0xb0, 0x52, 0x5b, 0xbd, 0xd4, 0xb8, 0xac, 0xaf, 0x6b, 0x35, 0xb6, 0x99, 0x56, 0xfc, 0x13,
+		0x59, 0x7d, 0xa1, 0x1b, 0x44, 0x24, 0xdf, 0xa9, 0x40, 0x86, 0xfb, 0x2a, 0x3c, 0x78, 0xc4, 0x57,
+		0xff, 0xf7, 0xef, 0x55, 0x37, 0x73, 0x55, 0x18, 0x74, 0xe5, 0x02, 0xab, 0x36, 0x2a, 0x05, 0xe2,
+		0x30, 0x21, 0x2b, 0xb5, 0x4b, 0xe5, 0xea, 0x8b, 0x7a, 0xa5, 0x56, 0x6f, 0xa0, 0x26, 0x5b, 0xd3,
+		0xb8, 0x8c, 0x02, 0x89, 0x97, 0x57, 0x56, 0xd6, 0x9e, 0xf7, 0x18, 0x01, 0x2f, 0xbb, 0x64, 0x4a,
+		0xdf, 0x02, 0xb9, 0x80, 0xb8, 0xa3, 0xf7, 0xca, 0xbc, 0x5e, 0xec, 0x41, 0xbd, 0xb6, 0x5a, 0xf5,
+		0x7b, 0xdb, 0x59, 0x70, 0xc5, 0x5b, 0x55, 0x30, 0x25, 0x85, 0x5f, 0x8d, 0xa0, 0x1a, 0x15, 0x0d,
+		0x70, 0xb7, 0xf7, 0xa2, 0xa5, 0x27, 0x20, 0x25, 0xc5, 0x17, 0x7d, 0x68, 0xe6, 0x0a, 0x0f, 0x79,
+		0xf0, 0x29, 0x60, 0xb2, 0xab, 0x2a, 0xb8, 0x5e, 0xe1, 0x32, 0xad, 0x46, 0x4a, 0xd7, 0xe0, 0x60,
+		0xa8, 0xe8, 0x91, 0x7b, 0xe0, 0xb8, 0xdc, 0x52, 0xe4, 0xde, 0xb9, 0x5e, 0x5b, 0xad, 0xae, 0x2d,
+		0xe1, 0x7a, 0xc6, 0xa3, 0x09, 0x20, 0x64, 0x90, 0xb7, 0x52, 0xca, 0xa7, 0x1a, 0x29, 0x55, 0x21,
+		0x1f, 0x14, 0x20, 0x72, 0x14, 0x0e, 0x6f, 0x34, 0x2e, 0x3e, 0xa9, 0x5f, 0x2b, 0xaf, 0x2c, 0x2f,
+		0x95, 0x87, 0x56, 0x2e, 0x00, 0x42, 0x8a, 0xd4, 0x08, 0x36, 0x14, 0xa5, 0x4b, 0x8d, 0x96, 0xea,
+		0x50, 0x18, 0x12, 0x02, 0x72, 0x17, 0x14, 0xc5, 0x22, 0x22, 0xac, 0x3d, 0xb3, 0x30, 0x2c, 0x16,
+		0x7c, 0x39, 0xb5, 0x54, 0x5b, 0x59, 0xbe, 0xba, 0xdc, 0x60, 0x2d, 0xbb, 0x0c, 0xe0, 0x8d, 0x2e,
+		0x5a, 0xab, 0x2b, 0xf5, 0xb5, 0x55, 0xfd, 0x22, 0xae, 0xc5, 0x1a, 0x3e, 0x52, 0x69, 0xe0, 0xa3,
+		0xa9, 0x2a, 0xb8, 0x64, 0x18, 0x1d, 0x72, 0x35, 0x72, 0x3a, 0x81, 0xb6, 0xea, 0xf3, 0xc9, 0xd3,
+		0x89, 0xd4, 0xe7, 0x93, 0xea, 0x17, 0xf0, 0xff, 0x17, 0x92, 0xea, 0x17, 0xf1, 0xff, 0x7b, 0x56,
+		0xd5, 0xef, 0x59, 0x3d, 0x9d, 0x48, 0xbd, 0x6f, 0x55, 0xfd, 0x00, 0xfe, 0xff, 0xc0, 0xaa, 0xfa,
+		0x03, 0xab, 0x57, 0x12, 0xa9, 0xcf, 0x25, 0xd5, 0xd7, 0x92, 0xa5, 0xff, 0x18, 0x05, 0xe2, 0xc9,
+		0x9a, 0x1b, 0xae, 0x78, 0x01, 0x52, 0x6e, 0xfc, 0x83, 0x1f, 0xa5, 0x7c, 0x7a, 0x0f, 0x11, 0x95,
+		0x68, 0xbe, 0xac, 0xa1, 0x78, 0x88, 0x4b, 0x0d, 0x17, 0xbb, 0xdd, 0xb6, 0xd9, 0xee, 0x0e, 0xba,
+		0xba, 0x0c, 0x0a, 0x4c, 0x5c, 0xec, 0x0a, 0x04, 0x91, 0x66, 0x24, 0x8c, 0x5b, 0x01, 0x12, 0xf1,
+		0x89, 0x24, 0x38, 0x82, 0x48, 0xcf, 0x7f, 0x46, 0x81, 0xe2, 0xb8, 0xc6, 0xde, 0x51, 0xbc, 0x62,
+		0x15, 0xe6, 0xac, 0x1b, 0xb4, 0xdf, 0x6f, 0xb7, 0xd8, 0x66, 0x83, 0xeb, 0x1c, 0xc5, 0x26, 0x3b,
+		0x47, 0xb3, 0x3e, 0x44, 0x91, 0x6d, 0x93, 0x0a, 0xda, 0xb0, 0x5b, 0xa8, 0xbe, 0x25, 0xa5, 0xf8,
+		0x64, 0x4a, 0x39, 0x86, 0x22, 0x69, 0x94, 0x3e, 0x1a, 0x81, 0x7c, 0xf0, 0x94, 0x22, 0x59, 0x82,
+		0x54, 0xc7, 0x12, 0x27, 0x80, 0xf8, 0xb8, 0x9e, 0x9a, 0x70, 0xb0, 0x71, 0x61, 0x45, 0xc0, 0x6b,
+		0x2e, 0xe6, 0xfc, 0xef, 0x29, 0x90, 0x92, 0xd9, 0xe4, 0x10, 0xc4, 0x7a, 0x86, 0xb3, 0xc3, 0xc8,
+		0xc5, 0x2b, 0x11, 0x55, 0xd1, 0x58, 0x1a, 0xf3, 0xed, 0x9e, 0xc1, 0x4f, 0x3f, 0x89, 0x7c, 0x4c,
+		0xa3, 0xbf, 0xd3, 0xa1, 0x46, 0x8b, 0x6d, 0x88, 0x59, 0xdd, 0x2e, 0x35, 0x1d, 0x5b, 0xfa, 0x3b,
+		0x22, 0xbf, 0x2a, 0xb2, 0xf1, 0xb0, 0xac, 0xd3, 0x37, 0xda, 0x9d, 0x00, 0x6c, 0x8c, 0xc1, 0xaa,
+		0xb2, 0xc0, 0x05, 0x5e, 0x84, 0x23, 0x92, 0x6e, 0x8b, 0x3a, 0x46, 0x73, 0x87, 0xb6, 0x3c, 0xa4,
+		0x04, 0xdb, 0xf8, 0x3e, 0x2c, 0x00, 0x96, 0x44, 0xb9, 0xc4, 0x2d, 0x7d, 0x2a, 0x02, 0x33, 0x72,
+		0x0b, 0xaf, 0xe5, 0x32, 0xeb, 0x2a, 0x80, 0x61, 0x9a, 0x96, 0xe3, 0x67, 0xd7, 0xa8, 0x8b, 0x37,
+		0x82, 0xb7, 0x50, 0x76, 0x91, 0x34, 0x1f, 0x81, 0xf9, 0x2f, 0x2a, 0x00, 0x5e, 0xd1, 0x58, 0xbe,
+		0x1d, 0x87, 0x8c, 0x38, 0x83, 0xca, 0x0e, 0x32, 0xf3, 0xf8, 0x17, 0xf0, 0x2c, 0xdc, 0xec, 0xc3,
+		0xd0, 0xd8, 0x26, 0xdd, 0x6e, 0x9b, 0xe2, 0x50, 0x11, 0x4f, 0xc8, 0xbd, 0xf9, 0x98, 0x77, 0xe8,
+		0x4e, 0x83, 0x94, 0x4d, 0xbb, 0x86, 0xe9, 0xb4, 0x9b, 0x62, 0x7e, 0x9c, 0xdf, 0x57, 0xe3, 0x17,
+		0xea, 0x02, 0x5b, 0x73, 0xe9, 0x94, 0x4e, 0x41, 0x4a, 0xe6, 0xba, 0xba, 0xf1, 0x00, 0x49, 0x42,
+		0xb4, 0x5e, 0x43, 0xeb, 0xc0, 0x14, 0xd5, 0x72, 0xb9, 0xae, 0x46, 0x4e, 0x7f, 0x34, 0x02, 0x49,
+		0x39, 0x61, 0x67, 0xa1, 0x50, 0x5b, 0x5a, 0x1e, 0x52, 0xb3, 0xb3, 0x90, 0x97, 0x99, 0x5c, 0xa3,
+		0xa9, 0xef, 0x4e, 0xfa, 0x33, 0xd7, 0xb5, 0xb5, 0xc6, 0xda, 0x39, 0xf5, 0x4f, 0x46, 0x33, 0x1f,
+		0x55, 0x3f, 0x97, 0x24, 0x33, 0x90, 0x95, 0x99, 0xe7, 0x1e, 0x39, 0xf7, 0xa8, 0xfa, 0xda, 0x70,
+		0xd6, 0x63, 0xea, 0xe7, 0x59, 0xe8, 0x45, 0x66, 0x9d, 0xd5, 0x1b, 0xa8, 0x31, 0xd7, 0x56, 0x57,
+		0x5e, 0x54, 0x15, 0x7f, 0xc1, 0x39, 0x5f, 0x41, 0x84, 0xdc, 0x0d, 0x87, 0x65, 0xc1, 0x85, 0x0b,
+		0x17, 0x2e, 0x3c, 0xe1, 0x2b, 0xbc, 0xfd, 0xfe, 0xc4, 0x70, 0xf1, 0x93, 0xbe, 0xe2, 0x0f, 0x8f,
+		0x16, 0x5f, 0xf0, 0x15, 0xff, 0xc4, 0xfb, 0x13, 0x64, 0x16, 0x32, 0xb2, 0xf8, 0x6a, 0xf9, 0x05,
+		0xf5, 0xab, 0x5f, 0xfd, 0xea, 0x57, 0x93, 0x95, 0x6f, 0x87, 0xd9, 0xa6, 0xd5, 0x1d, 0x1e, 0x9a,
+		0x8a, 0x3a, 0x74, 0x42, 0xc0, 0xbe, 0xac, 0xbc, 0xf4, 0xb0, 0x00, 0xda, 0xb6, 0x3a, 0x86, 0xb9,
+		0xbd, 0x60, 0xf5, 0xb7, 0xbd, 0x03, 0xf3, 0xe8, 0x5a, 0xda, 0xbe, 0x63, 0xf3, 0xbd, 0xcd, 0xbf,
+		0x56, 0x94, 0x9f, 0x8a, 0x44, 0x2f, 0xad, 0x57, 0x7e, 0x3e, 0x32, 0x7f, 0x89, 0x23, 0xae, 0xcb,
+		0x81, 0xd7, 0xe8, 0x56, 0x87, 0x36, 0x71, 0x74, 0xe0, 0x4f, 0x1f, 0x82, 0xb9, 0x6d, 0x6b, 0xdb,
+		0x62, 0x94, 0xce, 0xe0, 0x2f, 0xde, 0x08, 0x92, 0x76, 0x73, 0xe7, 0x27, 0x1e, 0xcf, 0x5f, 0x5c,
+		0x85, 0x59, 0x01, 0xac, 0x33, 0x4f, 0x97, 0x6f, 0x62, 0x92, 0x3d, 0x0f, 0xc2, 0x14, 0x7f, 0xe5,
+		0x8f, 0x59, 0x2c, 0x41, 0x9b, 0x11, 0xa8, 0x58, 0xc6, 0xf7, 0x39, 0x17, 0x35, 0x38, 0x18, 0xa0,
+		0xc7, 0x57, 0x19, 0xb4, 0x3f, 0x81, 0xe2, 0x3f, 0x17, 0x14, 0x67, 0x7d, 0x14, 0xeb, 0x02, 0x75,
+		0xb1, 0x0a, 0xb9, 0xfd, 0xd0, 0xfa, 0x2d, 0x41, 0x2b, 0x4b, 0xfd, 0x44, 0x2e, 0x41, 0x81, 0x11,
+		0x69, 0x0e, 0x6c, 0xc7, 0xea, 0xb2, 0x25, 0xdc, 0xde, 0x64, 0xfe, 0xc5, 0x1f, 0x73, 0xf5, 0x96,
+		0x47, 0xb4, 0xaa, 0x8b, 0xb5, 0xb8, 0x08, 0xcc, 0x63, 0xc7, 0xd3, 0x9d, 0x13, 0x28, 0xfc, 0xb6,
+		0x68, 0x88, 0x0b, 0xbf, 0x78, 0x0d, 0xe6, 0xf0, 0x37, 0x5b, 0x61, 0xf9, 0x5b, 0x32, 0xf9, 0xd4,
+		0x4c, 0xf1, 0xf7, 0xbf, 0x8b, 0x6b, 0xd0, 0x59, 0x97, 0x80, 0xaf, 0x4d, 0xbe, 0x51, 0xdc, 0xa6,
+		0x8e, 0x43, 0xfb, 0xb6, 0x6e, 0x74, 0xc2, 0x9a, 0xe7, 0x3b, 0x76, 0x50, 0xfc, 0xd1, 0x2f, 0x05,
+		0x47, 0xf1, 0x12, 0xc7, 0x2c, 0x77, 0x3a, 0x8b, 0x1b, 0x70, 0x38, 0x44, 0x2a, 0xa6, 0xa0, 0xf9,
+		0x63, 0x82, 0xe6, 0xdc, 0x88, 0x64, 0x20, 0xd9, 0x75, 0x90, 0xf9, 0xee, 0x58, 0x4e, 0x41, 0xf3,
+		0x43, 0x82, 0x26, 0x11, 0xb8, 0x72, 0x48, 0x91, 0xe2, 0x15, 0x98, 0xb9, 0x41, 0xfb, 0x9b, 0x96,
+		0x2d, 0x8e, 0x7a, 0x4c, 0x41, 0xee, 0xc7, 0x05, 0xb9, 0x82, 0x40, 0x64, 0x67, 0x3f, 0x90, 0xd6,
+		0x05, 0x48, 0x6d, 0x19, 0x4d, 0x3a, 0x05, 0x89, 0xdb, 0x82, 0x44, 0x12, 0xe1, 0x11, 0xb5, 0x0c,
+		0xd9, 0x6d, 0x4b, 0x2c, 0xb2, 0x27, 0xa3, 0x7f, 0x58, 0xa0, 0x67, 0x24, 0x8e, 0x20, 0xd1, 0xb3,
+		0x7a, 0x83, 0x0e, 0xae, 0xc0, 0x27, 0x93, 0xf8, 0x09, 0x49, 0x42, 0xe2, 0x08, 0x12, 0xfb, 0x60,
+		0xeb, 0x47, 0x24, 0x09, 0xdb, 0xc7, 0xcf, 0x67, 0xf1, 0x04, 0x68, 0x67, 0xd7, 0x32, 0xa7, 0x69,
+		0xc4, 0x4f, 0x0a, 0x0a, 0x20, 0x50, 0x90, 0xc0, 0x53, 0x90, 0x9e, 0x76, 0x20, 0x7e, 0xe6, 0x4b,
+		0x72, 0x7a, 0xc8, 0x11, 0xb8, 0x04, 0x05, 0xa9, 0xa0, 0x70, 0x57, 0x65, 0x32, 0x89, 0x9f, 0x15,
+		0x24, 0xf2, 0x3e, 0x34, 0xd1, 0x0d, 0x87, 0xda, 0xce, 0x36, 0x9d, 0x86, 0xc8, 0x47, 0x65, 0x37,
+		0x04, 0x8a, 0x60, 0xe5, 0x26, 0x35, 0x9b, 0x3b, 0xd3, 0x51, 0xf8, 0x98, 0x64, 0xa5, 0xc4, 0x41,
+		0x12, 0x55, 0xc8, 0x75, 0x8d, 0xbe, 0xbd, 0x63, 0x74, 0xa6, 0x1a, 0x8e, 0x9f, 0x13, 0x34, 0xb2,
+		0x2e, 0x92, 0xe0, 0xc8, 0xc0, 0xdc, 0x0f, 0x99, 0x9f, 0x97, 0x1c, 0x19, 0x98, 0x01, 0x42, 0xeb,
+		0x30, 0x67, 0x3b, 0xcc, 0xc7, 0xdd, 0x0f, 0xb5, 0x5f, 0x90, 0x53, 0x8f, 0xe3, 0x5e, 0xf5, 0x53,
+		0x7c, 0x0a, 0xd2, 0x76, 0xfb, 0x9d, 0x53, 0x91, 0xf9, 0x45, 0x39, 0xd2, 0x0c, 0x01, 0x91, 0x5f,
+		0x84, 0x23, 0xa1, 0x66, 0x62, 0x0a, 0x62, 0xff, 0xbf, 0x20, 0x76, 0x28, 0xc4, 0x54, 0x08, 0x95,
+		0xb0, 0x5f, 0x92, 0xbf, 0x24, 0x55, 0x02, 0x1d, 0xa2, 0xb5, 0x8e, 0x61, 0x4f, 0xdb, 0xd8, 0xda,
+		0x1f, 0xd7, 0xfe, 0x8e, 0xe4, 0x1a, 0xc7, 0x0d, 0x70, 0xad, 0x01, 0x87, 0x04, 0xc5, 0xfd, 0x8d,
+		0xeb, 0x2f, 0x4b, 0xc5, 0xca, 0xb1, 0x37, 0x82, 0xa3, 0xfb, 0xcd, 0x30, 0xef, 0xb2, 0x53, 0xc6,
+		0xd7, 0x6c, 0x1d, 0x8f, 0x91, 0x4c, 0xa6, 0xfc, 0x2b, 0x82, 0xb2, 0xd4, 0xf8, 0x6e, 0x80, 0xce,
+		0xbe, 0x6a, 0xf4, 0x90, 0xf8, 0x0b, 0x50, 0x94, 0xc4, 0x07, 0x66, 0x9f, 0x36, 0xad, 0x6d, 0xb3,
+		0xfd, 0x4e, 0xda, 0x9a, 0x82, 0xf4, 0xaf, 0x0e, 0x0d, 0xd5, 0x86, 0x0f, 0x1d, 0x29, 0x2f, 0x83,
+		0xea, 0xfa, 0x2a, 0x7a, 0xbb, 0xcb, 0x36, 0x86, 0xf7, 0xa6, 0xf8, 0x71, 0x39, 0x52, 0x2e, 0xde,
+		0x32, 0x43, 0x5b, 0xac, 0x01, 0x3f, 0x2c, 0x3e, 0xad, 0x48, 0x7e, 0x42, 0x10, 0xca, 0x79, 0x58,
+		0x42, 0x71, 0x34, 0xad, 0x6e, 0xcf, 0xe8, 0x4f, 0xa3, 0xff, 0x7e, 0x4d, 0x2a, 0x0e, 0x81, 0x22,
+		0x14, 0x07, 0x7a, 0x74, 0x68, 0xed, 0xa7, 0xa0, 0xf0, 0xeb, 0x52, 0x71, 0x48, 0x1c, 0x41, 0x42,
+		0x3a, 0x0c, 0x53, 0x90, 0xf8, 0xbb, 0x92, 0x84, 0xc4, 0x41, 0x12, 0x6f, 0xf3, 0x0c, 0x6d, 0x9f,
+		0x6e, 0xb7, 0x6d, 0x47, 0x5c, 0xe7, 0xd8, 0x9b, 0xd4, 0x6f, 0x7c, 0x29, 0xe8, 0x84, 0x69, 0x3e,
+		0x54, 0xd4, 0x44, 0x22, 0x28, 0xc6, 0x82, 0xbe, 0x93, 0x1b, 0xf6, 0x49, 0xa9, 0x89, 0x7c, 0x68,
+		0xd8, 0x36, 0x9f, 0x87, 0x88, 0x6c, 0x6f, 0xe2, 0x92, 0x6e, 0x0a, 0x72, 0x7f, 0x6f, 0xa8, 0x71,
+		0x75, 0x89, 0x8b, 0x34, 0x7d, 0xfe, 0xcf, 0xc0, 0xbc, 0x4e, 0x77, 0xa7, 0x92, 0xce, 0xbf, 0x3f,
+		0xe4, 0xff, 0x6c, 0x70, 0x4c, 0xae, 0x43, 0x0a, 0x43, 0xfe, 0x14, 0x99, 0x74, 0xd7, 0xab, 0xf8,
+		0x1d, 0xaf, 0x8b, 0xfe, 0x06, 0xdd, 0xa9, 0xc5, 0x15, 0x50, 0x45, 0x8e, 0xe7, 0xc0, 0x4e, 0x24,
+		0xf6, 0x5d, 0xaf, 0xbb, 0x72, 0x1e, 0xf0, 0x79, 0x16, 0x2f, 0x42, 0x2e, 0xe0, 0xf0, 0x4c, 0x26,
+		0xf5, 0x6e, 0x41, 0x2a, 0xeb, 0xf7, 0x77, 0x16, 0x1f, 0x87, 0x18, 0x3a, 0x2f, 0x93, 0xd1, 0xbf,
+		0x5b, 0xa0, 0x33, 0xf0, 0xc5, 0xb7, 0x42, 0x4a, 0x3a, 0x2d, 0x93, 0x51, 0xdf, 0x23, 0x50, 0x5d,
+		0x14, 0x44, 0x97, 0x0e, 0xcb, 0x64, 0xf4, 0xef, 0x91, 0xe8, 0x12, 0x05, 0xd1, 0xa7, 0x67, 0xe1,
+		0x3f, 0x7e, 0x5f, 0x8c, 0xa3, 0x4b, 0x94, 0x45, 0x3c, 0xac, 0xce, 0x3d, 0x95, 0xc9, 0xd8, 0xdf,
+		0x2b, 0x2a, 0x97, 0x18, 0x8b, 0x4f, 0x40, 0x7c, 0x4a, 0x86, 0xbf, 0x5f, 0xa0, 0x72, 0xf8, 0xc5,
+		0x2a, 0x64, 0x7c, 0xde, 0xc9, 0x64, 0xf4, 0xef, 0x17, 0xe8, 0x7e, 0x2c, 0x6c, 0xba, 0xf0, 0x4e,
+		0x26, 0x13, 0xf8, 0xbf, 0x65, 0xd3, 0x05, 0x06, 0xb2, 0x4d, 0x3a, 0x26, 0x93, 0xb1, 0x3f, 0x20,
+		0xb9, 0x2e, 0x51, 0x16, 0x9f, 0x85, 0xb4, 0x6b, 0x6c, 0x26, 0xe3, 0xff, 0x80, 0xc0, 0xf7, 0x70,
+		0x90, 0x03, 0x03, 0x73, 0x1f, 0x24, 0xfe, 0x1f, 0xc9, 0x01, 0x1f, 0x16, 0x4e, 0xa3, 0x61, 0x07,
+		0x66, 0x32, 0xa5, 0x1f, 0x94, 0xd3, 0x68, 0xc8, 0x7f, 0xc1, 0xd1, 0x64, 0x3a, 0x7f, 0x32, 0x89,
+		0x1f, 0x92, 0xa3, 0xc9, 0xe0, 0xb1, 0x19, 0xc3, 0x1e, 0xc1, 0x64, 0x1a, 0x3f, 0x22, 0x9b, 0x31,
+		0xe4, 0x10, 0x2c, 0xae, 0x03, 0x19, 0xf5, 0x06, 0x26, 0xd3, 0xfb, 0xa0, 0xa0, 0x37, 0x33, 0xe2,
+		0x0c, 0x2c, 0x3e, 0x0f, 0x87, 0xc2, 0x3d, 0x81, 0xc9, 0x54, 0x7f, 0xf4, 0xf5, 0xa1, 0xb5, 0x9b,
+		0xdf, 0x11, 0x58, 0x6c, 0xc0, 0x5c, 0x98, 0x17, 0x30, 0x99, 0xec, 0x8f, 0xbd, 0x1e, 0x54, 0xdc,
+		0x7e, 0x27, 0x60, 0xb1, 0x0c, 0xe0, 0x19, 0xe0, 0xc9, 0xb4, 0x7e, 0x5c, 0xd0, 0xf2, 0x21, 0xe1,
+		0xd4, 0x10, 0xf6, 0x77, 0x32, 0xfe, 0x6d, 0x39, 0x35, 0x04, 0x06, 0x4e, 0x0d, 0x69, 0x7a, 0x27,
+		0x63, 0x7f, 0x58, 0x4e, 0x0d, 0x89, 0x82, 0x92, 0xed, 0xb3, 0x6e, 0x93, 0x29, 0xfc, 0xa4, 0x94,
+		0x6c, 0x1f, 0xd6, 0xe2, 0x2a, 0xcc, 0x8c, 0x18, 0xc4, 0xc9, 0xa4, 0x7e, 0x4a, 0x90, 0x52, 0x87,
+		0xed, 0xa1, 0xdf, 0x78, 0x09, 0x63, 0x38, 0x99, 0xda, 0x4f, 0x0f, 0x19, 0x2f, 0x61, 0x0b, 0x17,
+		0x9f, 0x82, 0x94, 0x39, 0xe8, 0x74, 0x70, 0xf2, 0x90, 0xbd, 0xaf, 0xf3, 0x15, 0x3f, 0xff, 0x15,
+		0xc1, 0x1d, 0x89, 0xb0, 0xf8, 0x38, 0xc4, 0x69, 0x77, 0x93, 0xb6, 0x26, 0x61, 0x7e, 0xe1, 0x2b,
+		0x52, 0x61, 0x22, 0xf4, 0xe2, 0xb3, 0x00, 0x3c, 0x34, 0xc2, 0x0e, 0xca, 0x4e, 0xc0, 0xfd, 0xe2,
+		0x57, 0xc4, 0xfd, 0x19, 0x0f, 0xc5, 0x23, 0xc0, 0x6f, 0xe3, 0xec, 0x4d, 0xe0, 0x4b, 0x41, 0x02,
+		0x6c, 0x44, 0x2e, 0x40, 0x12, 0xb7, 0xdd, 0x1c, 0x63, 0x7b, 0x12, 0xf6, 0x9f, 0x0a, 0x6c, 0x09,
+		0x8f, 0x0c, 0xeb, 0x5a, 0x7d, 0xea, 0x18, 0xdb, 0xf6, 0x24, 0xdc, 0x3f, 0x13, 0xb8, 0x2e, 0x02,
+		0x22, 0x37, 0x0d, 0xdb, 0x99, 0xa6, 0xdf, 0xff, 0x55, 0x22, 0x4b, 0x04, 0x6c, 0x34, 0xfe, 0xbe,
+		0x4e, 0x77, 0x27, 0xe1, 0xfe, 0xb9, 0x6c, 0xb4, 0x80, 0x5f, 0x7c, 0x2b, 0xa4, 0xf1, 0x27, 0xbf,
+		0x14, 0x37, 0x01, 0xf9, 0x2f, 0x04, 0xb2, 0x87, 0x81, 0x35, 0xdb, 0x4e, 0xcb, 0x69, 0x4f, 0x66,
+		0xf6, 0x5f, 0x8a, 0x91, 0x96, 0xf0, 0x8b, 0x65, 0xc8, 0xd8, 0x4e, 0xab, 0x35, 0x10, 0xfe, 0xe9,
+		0x04, 0xf4, 0xff, 0xf6, 0x15, 0x37, 0x64, 0xe1, 0xe2, 0xe0, 0x68, 0xdf, 0xbc, 0xee, 0xf4, 0x2c,
+		0x76, 0x62, 0x63, 0x12, 0x85, 0xd7, 0x05, 0x05, 0x1f, 0xca, 0x62, 0x15, 0xb2, 0xd8, 0x17, 0xb9,
+		0xf1, 0x3d, 0x89, 0xc4, 0x97, 0x05, 0x03, 0x02, 0x48, 0x95, 0x6f, 0xfb, 0xed, 0xcf, 0x1c, 0x53,
+		0x3e, 0xf5, 0x99, 0x63, 0xca, 0x7f, 0xfe, 0xcc, 0x31, 0xe5, 0x03, 0x9f, 0x3d, 0x76, 0xe0, 0x53,
+		0x9f, 0x3d, 0x76, 0xe0, 0x0f, 0x3e, 0x7b, 0xec, 0x40, 0x78, 0x94, 0x18, 0x2e, 0x59, 0x97, 0x2c,
+		0x1e, 0x1f, 0x7e, 0xe9, 0xbe, 0xed, 0xb6, 0xb3, 0x33, 0xd8, 0x5c, 0x68, 0x5a, 0xdd, 0x33, 0x4d,
+		0xcb, 0xee, 0x5a, 0xf6, 0x99, 0x60, 0x5c, 0x97, 0xfd, 0x82, 0xff, 0xa1, 0xc0, 0x11, 0x4e, 0xc6,
+		0x0b, 0xe7, 0x1a, 0xe6, 0xee, 0xb8, 0x67, 0x56, 0xce, 0x43, 0xb4, 0x6c, 0xee, 0x92, 0x23, 0x5c,
+		0xc1, 0xe9, 0x83, 0x7e, 0x47, 0xdc, 0xcc, 0x4a, 0x62, 0x7a, 0xa3, 0xdf, 0x09, 0x9e, 0xd5, 0xcd,
+		0x8a, 0xb3, 0xba, 0x95, 0xef, 0x57, 0xf6, 0xd7, 0x93, 0x54, 0xd9, 0xdc, 0x65, 0x1d, 0x59, 0x57,
+		0x5e, 0x7a, 0xcb, 0xc4, 0x38, 0xf7, 0x75, 0xd3, 0xba, 0x69, 0x62, 0xb3, 0x7b, 0x9b, 0x32, 0xc6,
+		0x7d, 0x6c, 0x38, 0xc6, 0xfd, 0x3c, 0xed, 0x74, 0x9e, 0x43, 0x38, 0xdc, 0x8a, 0xb6, 0x37, 0x13,
+		0xfc, 0x12, 0x30, 0xfc, 0x60, 0x04, 0x8e, 0x8d, 0x84, 0xb3, 0x85, 0x10, 0x8c, 0x63, 0xc2, 0x22,
+		0xa4, 0x96, 0xa4, 0x6c, 0x15, 0xf1, 0x91, 0x93, 0xa6, 0x65, 0xb6, 0xf8, 0xa1, 0xcb, 0xa8, 0x26,
+		0x93, 0xc8, 0x08, 0xd3, 0x30, 0x2d, 0x5b, 0xdc, 0x6d, 0xe4, 0x89, 0xca, 0x87, 0xf6, 0xc9, 0x88,
+		0x9c, 0xac, 0x49, 0x72, 0xe3, 0xec, 0x94, 0xdc, 0x90, 0x9d, 0x08, 0x44, 0xfe, 0xa7, 0xe5, 0xca,
+		0x8f, 0x44, 0xe0, 0xf8, 0x30, 0x57, 0x70, 0x66, 0xd9, 0x8e, 0xd1, 0xed, 0x8d, 0x63, 0xcb, 0x53,
+		0x90, 0x6e, 0x48, 0x98, 0x7d, 0xf3, 0xe5, 0xf6, 0x3e, 0xf9, 0x92, 0x77, 0xab, 0x92, 0x8c, 0x39,
+		0x37, 0x25, 0x63, 0xdc, 0x7e, 0xdc, 0x11, 0x67, 0x3e, 0x94, 0x86, 0x23, 0x7c, 0x3a, 0xe9, 0x7c,
+		0x2a, 0xf1, 0x84, 0xe0, 0x49, 0xd6, 0x5f, 0x34, 0x79, 0x9f, 0xa4, 0xf4, 0x1c, 0xcc, 0x2e, 0xa3,
+		0xb6, 0xc0, 0x55, 0x90, 0xb7, 0xc3, 0x13, 0x7a, 0xfd, 0xf3, 0x44, 0xc0, 0xe1, 0x17, 0x3b, 0x81,
+		0xfe, 0xac, 0xd2, 0x77, 0x28, 0xa0, 0xd6, 0x9b, 0x46, 0xc7, 0xe8, 0xbf, 0x51, 0x52, 0xe4, 0x09,
+		0x00, 0x7e, 0x52, 0xc8, 0x7d, 0xb8, 0x05, 0xf7, 0xbd, 0xfd, 0x9d, 0x5b, 0xe0, 0x35, 0xb1, 0x0b,
+		0x13, 0x69, 0x06, 0x8b, 0x3f, 0x4f, 0xbf, 0x00, 0xe0, 0x15, 0xe0, 0xc9, 0x8a, 0x7a, 0xb5, 0xbc,
+		0x52, 0xd6, 0xe4, 0x91, 0x8f, 0xfa, 0x7a, 0xad, 0xca, 0xdf, 0x51, 0x38, 0x80, 0x87, 0x17, 0xfc,
+		0x85, 0xee, 0x21, 0xf3, 0x83, 0x30, 0xe3, 0xcf, 0xe7, 0x97, 0xda, 0x23, 0x8b, 0x97, 0x31, 0x52,
+		0x80, 0xa7, 0x15, 0x75, 0xa3, 0xd5, 0xa2, 0x2d, 0xbd, 0x6d, 0x92, 0x09, 0x17, 0x58, 0x8b, 0xbf,
+		0xf3, 0xef, 0xe3, 0xac, 0x6b, 0x39, 0x8e, 0x58, 0x46, 0xbc, 0x65, 0x13, 0x7d, 0xce, 0x76, 0xb7,
+		0xd7, 0xa1, 0x6c, 0xb7, 0x57, 0x6f, 0x4b, 0xfe, 0x4f, 0x76, 0x67, 0x90, 0x1e, 0xee, 0x1c, 0xcf,
+		0x7a, 0xe8, 0xee, 0xe8, 0x2d, 0x3e, 0xe7, 0x9d, 0x25, 0x72, 0x1b, 0x38, 0x91, 0xe2, 0xef, 0x8a,
+		0x16, 0xca, 0x68, 0x86, 0x6c, 0xe2, 0x0a, 0xcc, 0xe0, 0x5d, 0xb0, 0x5e, 0xa0, 0x7d, 0x13, 0x2c,
+		0x88, 0xec, 0xad, 0x2a, 0x30, 0xbd, 0xa6, 0x3d, 0x01, 0x09, 0x9b, 0x0d, 0xca, 0x24, 0x12, 0xb2,
+		0x39, 0x02, 0x1c, 0xc3, 0x5f, 0x5c, 0x0c, 0xdc, 0x1e, 0x4d, 0x20, 0xf0, 0x2f, 0x05, 0x81, 0x2c,
+		0x43, 0x93, 0xbd, 0x31, 0x61, 0x86, 0xbf, 0x5a, 0x42, 0x7d, 0xbd, 0xd9, 0x3b, 0x8a, 0xf2, 0x0f,
+		0x3e, 0xfe, 0x08, 0xdb, 0x61, 0x3f, 0x19, 0x14, 0xba, 0x90, 0xc9, 0xa2, 0xa9, 0x82, 0xb6, 0xd7,
+		0x5f, 0x0a, 0x79, 0x59, 0x9f, 0xe8, 0xf7, 0xde, 0x95, 0xfd, 0x43, 0x51, 0xd9, 0xb1, 0x30, 0x09,
+		0xf7, 0xd5, 0x94, 0x13, 0x54, 0x79, 0xc1, 0x62, 0x05, 0x72, 0xb8, 0x29, 0xef, 0x31, 0x67, 0xef,
+		0x5a, 0xfe, 0xd1, 0xc7, 0x1f, 0xe1, 0x13, 0x0d, 0x91, 0x04, 0x6b, 0x2a, 0xb5, 0x71, 0x5a, 0xef,
+		0xa5, 0x87, 0x46, 0xed, 0x37, 0xff, 0xf7, 0x30, 0xa3, 0xfe, 0x94, 0xbf, 0xa9, 0x9e, 0x76, 0x8a,
+		0xc1, 0x0c, 0xde, 0xa7, 0xb6, 0xce, 0xb0, 0xbf, 0x42, 0x2b, 0xc5, 0x59, 0x62, 0x8a, 0x6d, 0xdb,
+		0xf3, 0x5c, 0x59, 0x4c, 0x96, 0xdb, 0xbf, 0xf8, 0xbe, 0x9f, 0x8d, 0x7b, 0x0a, 0x65, 0xf1, 0xea,
+		0xe8, 0x39, 0xba, 0xc9, 0x34, 0xfe, 0x52, 0xd2, 0x18, 0x3e, 0x16, 0xb7, 0xf8, 0x34, 0xee, 0x85,
+		0x0a, 0x32, 0x93, 0x7c, 0x37, 0x49, 0xc4, 0xc5, 0x40, 0xcf, 0x8d, 0x0b, 0xed, 0x34, 0x7e, 0xfa,
+		0xeb, 0x12, 0x9f, 0xeb, 0x30, 0x3c, 0x85, 0xba, 0x78, 0x09, 0xf2, 0x2d, 0xcb, 0x74, 0x74, 0xab,
+		0xdb, 0x76, 0x68, 0xb7, 0xe7, 0x4c, 0xf4, 0x7c, 0xbf, 0xcc, 0x89, 0xa4, 0xb4, 0x1c, 0xe2, 0xad,
+		0x49, 0x34, 0x6c, 0x09, 0x7f, 0xf8, 0x64, 0x9a, 0x96, 0xfc, 0x95, 0xdb, 0x12, 0x86, 0x83, 0x2d,
+		0xb9, 0x23, 0xe9, 0xb0, 0x5b, 0xd7, 0x85, 0xb9, 0x73, 0x6e, 0x71, 0x29, 0x70, 0xa5, 0xe3, 0xa3,
+		0x51, 0x38, 0x26, 0x80, 0x37, 0x0d, 0x9b, 0x9e, 0xb9, 0x71, 0x76, 0x93, 0x3a, 0xc6, 0xd9, 0x33,
+		0x4d, 0xab, 0x2d, 0x7d, 0x9d, 0x59, 0x5e, 0xbe, 0x80, 0xe5, 0x0b, 0xa2, 0x7c, 0x3e, 0xf4, 0x40,
+		0xc0, 0xfc, 0x78, 0x33, 0x38, 0x3f, 0x2a, 0x83, 0xa5, 0x0e, 0xc4, 0xaa, 0x56, 0x9b, 0x5d, 0xe5,
+		0x6a, 0x51, 0xd3, 0xea, 0x0a, 0x83, 0xc4, 0x13, 0xe4, 0x32, 0x24, 0x8c, 0xae, 0x35, 0x30, 0x1d,
+		0x6e, 0x8c, 0x2a, 0x8f, 0xfc, 0xf6, 0xab, 0xc7, 0x0f, 0xfc, 0xe1, 0xab, 0xc7, 0x0f, 0x72, 0xb2,
+		0x76, 0xeb, 0xfa, 0x42, 0xdb, 0x3a, 0xd3, 0x35, 0x9c, 0x1d, 0x54, 0x01, 0x9f, 0xfe, 0xc4, 0xc3,
+		0x20, 0xea, 0x5b, 0x36, 0x9d, 0x8f, 0x7d, 0xee, 0x97, 0x4f, 0x2b, 0x9a, 0xc0, 0x5f, 0x8c, 0xbd,
+		0xf6, 0x91, 0xe3, 0x4a, 0xa9, 0x07, 0xc9, 0x25, 0xda, 0xdc, 0xa3, 0xc2, 0xe5, 0xa1, 0x0a, 0xcf,
+		0x8a, 0x0a, 0x8f, 0x8e, 0x56, 0xc8, 0xaf, 0x88, 0x2c, 0xd1, 0xa6, 0xaf, 0xda, 0x25, 0xda, 0x0c,
+		0xd6, 0x58, 0x59, 0xfa, 0x83, 0xff, 0x72, 0xec, 0xc0, 0xbb, 0x3e, 0x73, 0xec, 0xc0, 0xd8, 0x21,
+		0x2b, 0x4d, 0x1e, 0x32, 0x77, 0xa4, 0x7e, 0x2e, 0x86, 0x23, 0xd5, 0xa5, 0xce, 0xe6, 0x96, 0x73,
+		0xa6, 0xd9, 0xdf, 0xed, 0x39, 0xd6, 0x99, 0x1b, 0x67, 0x71, 0xe6, 0x5a, 0x5b, 0x62, 0xa4, 0x88,
+		0x2c, 0x5f, 0xe0, 0xe5, 0x0b, 0x37, 0xc6, 0x0c, 0x54, 0x69, 0x0b, 0xe2, 0xeb, 0x88, 0x88, 0xac,
+		0x70, 0x2c, 0xc7, 0xe8, 0x08, 0x8f, 0x8c, 0x27, 0x30, 0x97, 0x3f, 0xcc, 0x13, 0xe1, 0xb9, 0x6d,
+		0xf9, 0x26, 0x4f, 0x87, 0x1a, 0x5b, 0xfc, 0x7d, 0x83, 0x28, 0x73, 0xe5, 0x53, 0x98, 0xc1, 0x9e,
+		0x32, 0x98, 0x83, 0xb8, 0x31, 0xe0, 0xc7, 0xaf, 0xa2, 0xe8, 0xe3, 0xb3, 0x44, 0x69, 0x05, 0x92,
+		0xe2, 0x6c, 0x01, 0x9e, 0x3f, 0xba, 0x4e, 0x77, 0x59, 0x3d, 0x59, 0x0d, 0x7f, 0x92, 0x33, 0x10,
+		0x67, 0xad, 0x17, 0x97, 0x4d, 0x8e, 0x2c, 0x8c, 0x36, 0x7f, 0x81, 0xb5, 0x52, 0xe3, 0x70, 0xa5,
+		0x2b, 0x90, 0x5a, 0xb2, 0x50, 0x80, 0x82, 0xe4, 0xd2, 0x9c, 0x1c, 0x6b, 0x74, 0x6f, 0xe0, 0xc8,
+		0x1b, 0x81, 0x2c, 0x81, 0xf7, 0x6b, 0xf9, 0x83, 0x17, 0xe2, 0x0c, 0x99, 0x48, 0x95, 0xaa, 0x90,
+		0x64, 0xb4, 0xd7, 0x7a, 0xee, 0x2b, 0x52, 0x8a, 0xef, 0x15, 0x29, 0x41, 0x3e, 0xe2, 0xb5, 0x96,
+		0x40, 0x0c, 0x6f, 0xc3, 0x8b, 0x8e, 0xb3, 0xdf, 0xa5, 0x67, 0x21, 0x25, 0x88, 0xd8, 0xe4, 0x51,
+		0x88, 0x5a, 0x3d, 0x79, 0x18, 0xf2, 0xe8, 0xd8, 0xbe, 0xac, 0xf5, 0x2a, 0x31, 0x14, 0x2c, 0x0d,
+		0xa1, 0x2b, 0x57, 0xc7, 0x8a, 0xc6, 0xa3, 0x01, 0xd1, 0x90, 0xc3, 0x2e, 0x7f, 0x18, 0xbd, 0xf6,
+		0x99, 0x51, 0x61, 0x70, 0x65, 0xe5, 0xbf, 0x2b, 0x70, 0x77, 0x88, 0xac, 0x5c, 0xa7, 0xbb, 0xf6,
+		0xbe, 0x45, 0xe5, 0x05, 0x48, 0xaf, 0xb3, 0x77, 0x27, 0x9f, 0xa3, 0xbb, 0x64, 0x1e, 0x4f, 0x3e,
+		0x9e, 0x7b, 0xfc, 0xf1, 0xb3, 0x17, 0xf8, 0x40, 0x5e, 0x3e, 0xa0, 0xc9, 0x0c, 0x72, 0x0c, 0xd2,
+		0x36, 0x6d, 0xf6, 0xce, 0x3d, 0x7e, 0xfe, 0xfa, 0x59, 0xce, 0xb8, 0xcb, 0x07, 0x34, 0x2f, 0x6b,
+		0x31, 0x85, 0x93, 0xe2, 0xb5, 0x9f, 0x3c, 0xae, 0x54, 0xe2, 0x10, 0xb5, 0x07, 0xdd, 0xaf, 0x55,
+		0xe7, 0xff, 0x2a, 0x01, 0x27, 0xdd, 0x62, 0xae, 0xf6, 0x6e, 0x9c, 0x3d, 0x23, 0xce, 0x93, 0xbb,
+		0xaf, 0x85, 0xce, 0xb8, 0x0c, 0x60, 0x20, 0x63, 0xfb, 0x3f, 0xbf, 0x37, 0x23, 0x4b, 0x9f, 0x50,
+		0x20, 0x7b, 0x4d, 0xd2, 0xc6, 0xdb, 0x05, 0x4f, 0x03, 0xb8, 0x75, 0x49, 0x71, 0xb8, 0x6b, 0x61,
+		0xa4, 0xb6, 0x05, 0x17, 0x49, 0xf3, 0xc1, 0x93, 0x27, 0x21, 0xd5, 0xeb, 0x5b, 0x3d, 0xcb, 0x16,
+		0x0f, 0xe0, 0x4c, 0xc2, 0x75, 0xa1, 0xf1, 0x36, 0x07, 0x9b, 0xbc, 0xfa, 0x0d, 0xcb, 0xc1, 0xd3,
+		0x21, 0x3d, 0xeb, 0xa6, 0x78, 0x57, 0x2c, 0xaa, 0xa9, 0xac, 0xe4, 0x1a, 0x2b, 0x58, 0xc7, 0xfc,
+		0xd2, 0xc7, 0x15, 0x48, 0xbb, 0x54, 0x70, 0x65, 0x66, 0xb4, 0x5a, 0x7d, 0x6a, 0xdb, 0x62, 0x7e,
+		0xca, 0x24, 0x79, 0x1a, 0x92, 0xbd, 0xc1, 0xa6, 0x2e, 0xe7, 0x02, 0x3e, 0xaf, 0x14, 0x26, 0xd9,
+		0x52, 0x40, 0x84, 0x6c, 0x27, 0x7a, 0x83, 0x4d, 0x14, 0x97, 0x93, 0x90, 0x0d, 0x69, 0x4d, 0xe6,
+		0x86, 0xd7, 0x10, 0xf6, 0xde, 0xa9, 0xe8, 0x82, 0xde, 0xeb, 0xb7, 0xad, 0x7e, 0xdb, 0xd9, 0x65,
+		0x87, 0x14, 0xa3, 0x9a, 0x2a, 0x0b, 0xd6, 0x45, 0x7e, 0xa9, 0x03, 0x85, 0x3a, 0x73, 0xb4, 0xbd,
+		0xa6, 0x9f, 0xf7, 0x1a, 0xa8, 0x4c, 0xd1, 0xc0, 0xb1, 0x4d, 0x8b, 0x8c, 0x34, 0xed, 0xf4, 0x7f,
+		0x50, 0x20, 0x53, 0xe9, 0x58, 0xcd, 0xeb, 0xcb, 0x4b, 0x17, 0x3b, 0xc6, 0x36, 0x39, 0x0b, 0x07,
+		0x2b, 0x2b, 0x6b, 0xd5, 0xe7, 0xf4, 0xe5, 0x25, 0xfd, 0xe2, 0x4a, 0xd9, 0x77, 0x96, 0x7b, 0xfe,
+		0xd0, 0x2b, 0xb7, 0x4f, 0x10, 0x1f, 0xec, 0x86, 0xc9, 0x16, 0x96, 0xe4, 0x0c, 0xcc, 0x05, 0x51,
+		0xca, 0x95, 0x3a, 0xde, 0x1d, 0x52, 0xe6, 0x0f, 0xbe, 0x72, 0xfb, 0xc4, 0x8c, 0x0f, 0xa3, 0xbc,
+		0x69, 0x53, 0xd3, 0x19, 0x45, 0xa8, 0xae, 0x5d, 0xbd, 0x8a, 0x47, 0xe9, 0x47, 0x10, 0xf0, 0x6c,
+		0x6a, 0xdb, 0x21, 0x0f, 0xc2, 0x4c, 0x10, 0x61, 0x75, 0x79, 0x45, 0x8d, 0xce, 0x93, 0x57, 0x6e,
+		0x9f, 0xc8, 0xfb, 0xa0, 0x57, 0xdb, 0x9d, 0xf9, 0xd4, 0x7b, 0x7f, 0xfa, 0xd8, 0x81, 0x8f, 0xfd,
+		0x7f, 0xc7, 0x94, 0xca, 0xca, 0xd8, 0x99, 0x77, 0x6e, 0xfa, 0x99, 0x27, 0xa7, 0x96, 0x3b, 0xf1,
+		0x3e, 0x1c, 0x81, 0xe3, 0x6e, 0xe9, 0x0d, 0xda, 0xc7, 0x88, 0x3e, 0xce, 0x16, 0x2e, 0xb6, 0xae,
+		0x33, 0x21, 0x06, 0x47, 0x00, 0x8c, 0x57, 0x3c, 0x6f, 0x85, 0x68, 0xb9, 0xd7, 0xc3, 0xb7, 0xe6,
+		0x58, 0xba, 0x69, 0x71, 0x23, 0x15, 0xd3, 0xdc, 0x34, 0x96, 0xd9, 0xd6, 0x96, 0x73, 0xd3, 0xe8,
+		0xbb, 0xef, 0xd0, 0xc9, 0x74, 0xe9, 0x02, 0xa4, 0xab, 0x96, 0x69, 0x53, 0xd3, 0x1e, 0xb0, 0x00,
+		0xc3, 0x26, 0x32, 0x43, 0x50, 0xe0, 0x09, 0x54, 0xf2, 0x46, 0xaf, 0xc7, 0x30, 0x63, 0x1a, 0xfe,
+		0x14, 0x86, 0x7b, 0x75, 0x2c, 0x7b, 0x1e, 0x9b, 0x9e, 0x3d, 0x1e, 0x03, 0x5c, 0x06, 0xfd, 0xd0,
+		0xdd, 0x3e, 0xb5, 0xec, 0x6a, 0x26, 0x3f, 0x7b, 0x42, 0xb4, 0xd2, 0x04, 0xa3, 0x3f, 0x3f, 0x59,
+		0xd7, 0xcd, 0x4f, 0x1a, 0x95, 0x31, 0x9a, 0x6f, 0x52, 0xb8, 0xa7, 0x74, 0x01, 0x72, 0x78, 0x05,
+		0xab, 0x4e, 0x9d, 0xcb, 0xd4, 0x68, 0xd1, 0x7e, 0xd0, 0x9b, 0xc8, 0x49, 0x6f, 0x82, 0x40, 0x8c,
+		0xb9, 0x0c, 0xdc, 0x98, 0xb2, 0xdf, 0xa5, 0x36, 0xc4, 0x10, 0xd5, 0xf3, 0x34, 0x04, 0x06, 0x4b,
+		0xb0, 0xe1, 0xda, 0x75, 0xc4, 0x35, 0xd4, 0xac, 0xc6, 0x13, 0xe4, 0x71, 0xe9, 0x2f, 0x44, 0x27,
+		0xf8, 0x0b, 0x42, 0x0b, 0x09, 0xaf, 0xa1, 0x0b, 0x49, 0x31, 0x11, 0xdc, 0x96, 0x28, 0x5e, 0x4b,
+		0xc8, 0x2a, 0x14, 0x7a, 0x46, 0xdf, 0x61, 0xaf, 0xb6, 0xec, 0xb0, 0x6e, 0x08, 0x4d, 0x77, 0x22,
+		0x44, 0xf1, 0x06, 0xba, 0x2b, 0xaa, 0xc9, 0xf5, 0xfc, 0x99, 0xa5, 0xd7, 0x62, 0x90, 0x10, 0xec,
+		0x78, 0x06, 0x92, 0x82, 0xe1, 0x42, 0x37, 0x1d, 0x5b, 0x08, 0x11, 0xff, 0x05, 0x57, 0x4c, 0x05,
+		0x41, 0x89, 0x44, 0xee, 0x87, 0x54, 0x73, 0xc7, 0x68, 0x9b, 0x7a, 0xbb, 0x25, 0x7c, 0xd2, 0xcc,
+		0x67, 0x5e, 0x3d, 0x9e, 0xac, 0x62, 0xde, 0xf2, 0x92, 0x96, 0x64, 0x85, 0xcb, 0x2d, 0xf4, 0x71,
+		0x76, 0x68, 0x7b, 0x7b, 0xc7, 0x11, 0x0a, 0x56, 0xa4, 0xf0, 0xad, 0x6b, 0x1c, 0x32, 0x71, 0xc7,
+		0x60, 0x7e, 0x64, 0xb1, 0xe1, 0xc6, 0xcb, 0x2a, 0x29, 0xac, 0xf8, 0x03, 0xff, 0xe9, 0xb8, 0xa2,
+		0x31, 0x0c, 0xb2, 0x04, 0xb9, 0x8e, 0x61, 0x3b, 0x3a, 0x9b, 0x27, 0x58, 0x7d, 0x5c, 0x90, 0x18,
+		0x65, 0x89, 0xe0, 0xad, 0x68, 0x7b, 0x06, 0xd1, 0x78, 0x56, 0x0b, 0x1f, 0x2d, 0x62, 0x54, 0x9a,
+		0x4c, 0x55, 0x71, 0xbf, 0x31, 0xc1, 0x58, 0x9f, 0xc7, 0x7c, 0xae, 0xc1, 0x98, 0xf7, 0x78, 0x14,
+		0xd2, 0xec, 0x1d, 0x22, 0x06, 0xc2, 0xaf, 0x0d, 0xa6, 0x30, 0x83, 0x15, 0x3e, 0x00, 0x05, 0xcf,
+		0x42, 0x72, 0x90, 0x14, 0xa7, 0xe2, 0x65, 0x33, 0xc0, 0x47, 0x60, 0xce, 0xa4, 0xb7, 0x1c, 0x7d,
+		0x18, 0x3a, 0xcd, 0xa0, 0x09, 0x96, 0x5d, 0x0b, 0x62, 0xdc, 0x07, 0xf9, 0xa6, 0xe4, 0x3e, 0x87,
+		0x05, 0x06, 0x9b, 0x73, 0x73, 0x19, 0xd8, 0x11, 0x48, 0x19, 0xbd, 0x1e, 0x07, 0xc8, 0x08, 0x03,
+		0xd9, 0xeb, 0xb1, 0xa2, 0xd3, 0x30, 0xc3, 0xfa, 0xd8, 0xa7, 0x36, 0x5e, 0x5f, 0xe1, 0x30, 0x59,
+		0x06, 0x53, 0xc0, 0x02, 0x8d, 0xe7, 0x33, 0xd8, 0x7b, 0x20, 0x47, 0x6f, 0xe0, 0x9d, 0xcd, 0x26,
+		0xe5, 0x70, 0x39, 0x06, 0x97, 0x95, 0x99, 0x0c, 0xe8, 0x41, 0x70, 0xed, 0x9e, 0x2e, 0x8d, 0x72,
+		0x9e, 0xd3, 0x93, 0xf9, 0x65, 0x9e, 0x5d, 0x2a, 0x42, 0x6c, 0xc9, 0x70, 0x0c, 0xd4, 0x63, 0xce,
+		0x2d, 0xee, 0x6b, 0x64, 0x35, 0xfc, 0x59, 0xfa, 0x64, 0x14, 0x62, 0xd7, 0x2c, 0x07, 0x1f, 0x7a,
+		0x8a, 0xf9, 0x1e, 0xa8, 0x09, 0x13, 0xe9, 0x7a, 0x7b, 0xdb, 0xa4, 0xad, 0xab, 0xf6, 0xb6, 0xef,
+		0xf1, 0x4f, 0x4f, 0xa0, 0x22, 0x01, 0x81, 0x9a, 0x83, 0x78, 0xdf, 0x1a, 0x98, 0x2d, 0x79, 0xb3,
+		0x80, 0x25, 0xc8, 0x45, 0x48, 0xb9, 0x72, 0x12, 0x9b, 0x28, 0x27, 0x05, 0x94, 0x13, 0x14, 0x63,
+		0x91, 0xa1, 0x25, 0x37, 0x85, 0xb8, 0x54, 0x20, 0xed, 0x6a, 0x98, 0x62, 0x7c, 0x1f, 0x32, 0xeb,
+		0xa1, 0xa1, 0x3b, 0xe1, 0x8e, 0xbe, 0xcb, 0x3e, 0x2e, 0x73, 0xaa, 0x5b, 0x20, 0xf8, 0x17, 0x10,
+		0x2c, 0xf1, 0x12, 0x69, 0x92, 0x75, 0xcc, 0x13, 0x2c, 0xfe, 0x1a, 0xe9, 0x5d, 0x78, 0x02, 0x71,
+		0xdb, 0x64, 0xd7, 0x66, 0x84, 0xec, 0x79, 0x19, 0x58, 0xea, 0x5d, 0x3f, 0xe5, 0xb2, 0xe6, 0x65,
+		0xe0, 0x6b, 0x1f, 0x6e, 0x42, 0xf7, 0xa8, 0x70, 0x39, 0x23, 0x6e, 0x51, 0x5d, 0x96, 0x94, 0xfe,
+		0x89, 0x02, 0x09, 0x61, 0xdc, 0xbd, 0x71, 0x50, 0xc2, 0xc7, 0x21, 0x32, 0x6e, 0x1c, 0xa2, 0x6f,
+		0x68, 0x1c, 0xc0, 0x6d, 0xa7, 0x2d, 0x1e, 0x9c, 0x0c, 0xf3, 0x42, 0x79, 0x23, 0xeb, 0xed, 0x6d,
+		0x31, 0xf7, 0x7d, 0x58, 0xa5, 0x57, 0x15, 0x48, 0xbb, 0xe5, 0xa4, 0x02, 0x39, 0xd9, 0x32, 0x7d,
+		0xab, 0x63, 0x6c, 0x0b, 0x71, 0x3c, 0x36, 0xbe, 0x79, 0xe8, 0xb3, 0x68, 0x19, 0xd1, 0x22, 0x4c,
+		0x84, 0x8f, 0x6c, 0x64, 0xcc, 0xc8, 0x06, 0x44, 0x29, 0x7a, 0x67, 0xa2, 0x14, 0x18, 0xf4, 0xd8,
+		0xd0, 0xa0, 0x97, 0x3e, 0xab, 0x88, 0xc7, 0xae, 0x5b, 0xb4, 0xf5, 0xbf, 0x74, 0xb4, 0xbe, 0x59,
+		0xc8, 0x17, 0x06, 0x1c, 0x47, 0x86, 0xed, 0xde, 0x10, 0x92, 0xc1, 0x56, 0x7b, 0xc3, 0x47, 0x24,
+		0x99, 0xba, 0x37, 0x8c, 0xbf, 0x16, 0x81, 0x99, 0x11, 0xf8, 0xbf, 0x81, 0xc3, 0x19, 0x9c, 0xc3,
+		0xf1, 0x29, 0xe7, 0x70, 0x62, 0xec, 0x1c, 0xfe, 0xb5, 0x08, 0x8b, 0x0c, 0xf4, 0x2c, 0xdb, 0xe8,
+		0x7c, 0x5d, 0x74, 0xf0, 0x51, 0x48, 0xf7, 0xac, 0x8e, 0xce, 0x4b, 0xf8, 0x1d, 0xaf, 0x54, 0xcf,
+		0xea, 0x68, 0x23, 0xa2, 0x16, 0x7f, 0xb3, 0x14, 0x74, 0xe2, 0x4d, 0x18, 0x86, 0xe4, 0xf0, 0xac,
+		0x72, 0x20, 0xcb, 0x79, 0x21, 0x3c, 0xa8, 0xb3, 0xc8, 0x04, 0xfc, 0x55, 0x54, 0x86, 0x7d, 0x3e,
+		0xb7, 0xdd, 0x1c, 0x54, 0x4b, 0xec, 0xb8, 0x28, 0xdc, 0xdf, 0x28, 0x46, 0xc6, 0xa2, 0x70, 0x51,
+		0xd6, 0x04, 0x60, 0xe9, 0x83, 0x0a, 0xc0, 0x0a, 0x32, 0x97, 0xf5, 0x18, 0x9d, 0x1f, 0x9b, 0x35,
+		0x42, 0x0f, 0xd4, 0x7d, 0x7c, 0xec, 0xc0, 0x89, 0x16, 0x64, 0x6d, 0x7f, 0xd3, 0x97, 0x20, 0xe7,
+		0x09, 0xb8, 0x4d, 0x65, 0x73, 0x8e, 0xef, 0xb5, 0x9c, 0xc7, 0x3b, 0x9a, 0xd9, 0x1b, 0xbe, 0x54,
+		0xe9, 0x9f, 0x29, 0x90, 0x66, 0xad, 0xc2, 0xd7, 0x18, 0x03, 0x03, 0xa9, 0xbc, 0x81, 0x81, 0xbc,
+		0x1b, 0x80, 0xd3, 0xc1, 0x63, 0x40, 0x42, 0xbe, 0xd2, 0x2c, 0x07, 0x0f, 0xf7, 0x90, 0x27, 0x5c,
+		0xae, 0x47, 0x27, 0x70, 0x5d, 0xae, 0xf7, 0x05, 0xef, 0x0f, 0x43, 0x92, 0x3d, 0x62, 0x70, 0xcb,
+		0x16, 0x4b, 0x78, 0x7c, 0xbd, 0xb7, 0x71, 0xcb, 0x2e, 0x5d, 0x87, 0x64, 0xe3, 0x16, 0x8f, 0x38,
+		0x1e, 0x85, 0x74, 0xdf, 0xb2, 0x84, 0x37, 0xc8, 0x1d, 0xf1, 0x14, 0x66, 0x30, 0xe7, 0x47, 0x06,
+		0xd9, 0x22, 0x5e, 0x90, 0xcd, 0x0b, 0x13, 0x46, 0xa7, 0x0b, 0x13, 0xe2, 0xba, 0x3d, 0x17, 0x98,
+		0x51, 0xe4, 0x2d, 0x70, 0xb8, 0xbe, 0x7c, 0x69, 0xb5, 0xb6, 0xa4, 0x5f, 0xad, 0x5f, 0x1a, 0xba,
+		0x6b, 0x3e, 0x5f, 0x78, 0xe5, 0xf6, 0x89, 0x8c, 0x58, 0xb0, 0x8f, 0x83, 0x5e, 0xd7, 0x6a, 0xd7,
+		0xd6, 0x1a, 0x35, 0x55, 0xe1, 0xd0, 0xeb, 0x7d, 0x7a, 0xc3, 0x72, 0xf8, 0x57, 0x51, 0x1e, 0x81,
+		0x23, 0x21, 0xd0, 0xee, 0xb2, 0x7d, 0xe6, 0x95, 0xdb, 0x27, 0x72, 0xeb, 0x7d, 0xca, 0x45, 0x8d,
+		0x61, 0x2c, 0x40, 0x71, 0x14, 0x63, 0x6d, 0x7d, 0xad, 0x5e, 0x5e, 0x51, 0x4f, 0xcc, 0xab, 0xaf,
+		0xdc, 0x3e, 0x91, 0x95, 0xba, 0x03, 0xe1, 0xbf, 0xf6, 0xeb, 0xf6, 0xd8, 0xe8, 0x79, 0x87, 0x9b,
+		0x7d, 0xa3, 0xd7, 0xa3, 0x7d, 0x7b, 0xdc, 0xc6, 0xfe, 0x3d, 0x90, 0x59, 0xf2, 0xbd, 0xfc, 0xe1,
+		0x9e, 0xf0, 0x50, 0xd8, 0xab, 0x20, 0x3c, 0x51, 0x2a, 0x01, 0x5c, 0xec, 0x58, 0x86, 0x13, 0x02,
+		0x13, 0xf1, 0xc1, 0x2c, 0x9b, 0xce, 0xf9, 0xc7, 0x42, 0x60, 0xa2, 0x12, 0xe6, 0x1e, 0xc8, 0x6c,
+		0x8c, 0x03, 0x8a, 0x05, 0x09, 0x3d, 0x7a, 0x2e, 0x04, 0x26, 0x3e, 0x44, 0x28, 0x14, 0x28, 0x27,
+		0x81, 0x4e, 0x42, 0xba, 0x62, 0x59, 0x9d, 0x10, 0x90, 0x94, 0x8f, 0x4e, 0xdd, 0xf7, 0xa8, 0x49,
+		0x00, 0x28, 0xed, 0x6b, 0x50, 0x05, 0xd7, 0xad, 0x21, 0x30, 0xee, 0x19, 0x98, 0x7d, 0x1f, 0xfd,
+		0x78, 0x5e, 0x8c, 0xcb, 0x7e, 0x8f, 0x7e, 0xc8, 0xf1, 0xbc, 0xb3, 0xa3, 0x1f, 0x59, 0xdf, 0xd6,
+		0x83, 0x1b, 0x65, 0xe8, 0x19, 0x7d, 0xa3, 0x6b, 0xef, 0x37, 0x9c, 0x3a, 0xe1, 0x64, 0xcd, 0xfc,
+		0x04, 0x49, 0xc4, 0x95, 0x4d, 0xc1, 0x5d, 0x30, 0xaf, 0xb3, 0x26, 0x90, 0xc7, 0xfc, 0xd1, 0x9d,
+		0xcc, 0x78, 0x3f, 0x84, 0x83, 0xcb, 0xe8, 0xcf, 0x5b, 0x21, 0x25, 0x17, 0x5e, 0x42, 0x37, 0x9f,
+		0x0c, 0xf3, 0x96, 0x04, 0x88, 0xc0, 0x75, 0x51, 0xc8, 0x37, 0x41, 0xda, 0xd5, 0xd4, 0x42, 0x35,
+		0x95, 0xf6, 0xd2, 0xed, 0x82, 0x80, 0x87, 0x44, 0x16, 0xbd, 0xf0, 0x40, 0x6c, 0x6c, 0xc4, 0xe1,
+		0x1a, 0x87, 0x10, 0xd8, 0x12, 0x81, 0x3c, 0x0e, 0x31, 0x63, 0xb3, 0xd9, 0x2e, 0xc6, 0x87, 0x63,
+		0x9e, 0x2e, 0x62, 0xb9, 0x52, 0x5d, 0xe6, 0x58, 0xec, 0x81, 0x33, 0x06, 0x8e, 0x8d, 0xb6, 0x77,
+		0xcd, 0xe6, 0x4e, 0xdf, 0x32, 0x77, 0x8b, 0x89, 0xb1, 0x8d, 0xae, 0x4b, 0x18, 0xd9, 0x68, 0x17,
+		0x09, 0x1b, 0xbd, 0x45, 0x3d, 0xeb, 0x1d, 0xde, 0x68, 0xf1, 0xc2, 0x80, 0x6c, 0xb4, 0x40, 0x28,
+		0x2d, 0x8b, 0x78, 0xaa, 0x18, 0x36, 0xf6, 0xfe, 0xec, 0x2d, 0x9d, 0x47, 0x7a, 0xf8, 0x84, 0x4f,
+		0x75, 0x8d, 0x5b, 0x6c, 0xd2, 0xa0, 0x29, 0xc1, 0xc2, 0x6d, 0xf1, 0x10, 0x5c, 0x54, 0x4b, 0x74,
+		0x8d, 0x5b, 0x97, 0x0c, 0xfb, 0x4a, 0x2c, 0x15, 0x55, 0x63, 0xa5, 0x9f, 0x43, 0xf7, 0x3b, 0x30,
+		0x34, 0xe4, 0x21, 0x20, 0x88, 0x81, 0x3b, 0xce, 0x68, 0x84, 0xd8, 0x20, 0x4b, 0xba, 0xf8, 0x1e,
+		0x44, 0x79, 0x9b, 0xae, 0x0e, 0xba, 0xac, 0x01, 0x36, 0xc1, 0xed, 0x69, 0x01, 0x2c, 0x05, 0xd0,
+		0xf5, 0x17, 0x46, 0x3e, 0x94, 0x23, 0x00, 0xb8, 0x43, 0xf3, 0x41, 0x74, 0x68, 0xf2, 0x9c, 0x9e,
+		0x2c, 0x09, 0x76, 0x25, 0x1a, 0xec, 0x4a, 0xe9, 0x59, 0x28, 0x0c, 0x49, 0x01, 0x29, 0x41, 0x4e,
+		0x44, 0xad, 0xd9, 0x71, 0x1a, 0xbe, 0x76, 0x4f, 0x6b, 0x19, 0x1e, 0x9c, 0x66, 0xb3, 0x6f, 0x31,
+		0xf5, 0x1b, 0xb8, 0x5f, 0x82, 0x5b, 0x97, 0x0f, 0x41, 0x2e, 0x20, 0x06, 0x32, 0x70, 0xa9, 0x78,
+		0x81, 0x4b, 0x0f, 0xf8, 0x25, 0xc8, 0xa2, 0x29, 0xa5, 0x2d, 0x01, 0x7b, 0x3f, 0x14, 0xb8, 0xad,
+		0x1f, 0xe6, 0x35, 0xf7, 0xe1, 0xaf, 0x4a, 0x86, 0x97, 0x20, 0xe7, 0xc1, 0x79, 0x6c, 0xcf, 0x48,
+		0xa8, 0x4b, 0x86, 0x5d, 0xfa, 0x61, 0x05, 0x0a, 0x43, 0xb2, 0x41, 0xde, 0x0a, 0xe9, 0x5e, 0x9f,
+		0x36, 0xdb, 0xbe, 0x30, 0xd7, 0x1e, 0x2c, 0x8c, 0x31, 0xf6, 0x79, 0x18, 0xe8, 0x26, 0xc9, 0x73,
+		0x02, 0x2d, 0xda, 0x31, 0x76, 0x8b, 0x91, 0xe9, 0x48, 0xc8, 0xef, 0x99, 0x2d, 0x21, 0x52, 0xe9,
+		0xb7, 0x14, 0xc8, 0x05, 0x84, 0x8e, 0xb4, 0xe0, 0x6e, 0x34, 0xd1, 0xfe, 0xb3, 0xe9, 0xe2, 0x9d,
+		0x6f, 0xdf, 0x1a, 0x2d, 0xec, 0xb9, 0x0c, 0xcf, 0xd0, 0x30, 0xe7, 0x46, 0xd1, 0xe6, 0x91, 0x8e,
+		0x77, 0x44, 0x9d, 0x3f, 0x08, 0x7e, 0x99, 0x3b, 0xe3, 0x6b, 0x40, 0x7a, 0x9b, 0xce, 0x30, 0xe9,
+		0xc8, 0xb4, 0xa4, 0x55, 0x44, 0xf6, 0x13, 0x2c, 0xd5, 0x01, 0xbc, 0x89, 0x4b, 0xca, 0xd3, 0x74,
+		0x22, 0xba, 0x57, 0x0b, 0x17, 0x23, 0x45, 0xa5, 0xb2, 0xfe, 0xb1, 0xcf, 0x1c, 0x53, 0xbe, 0x26,
+		0xae, 0xc3, 0x1f, 0xd5, 0xe1, 0x2e, 0x0f, 0x74, 0xb3, 0xd9, 0x1e, 0x0e, 0x68, 0xab, 0xb2, 0x74,
+		0x01, 0x4b, 0xd1, 0x2c, 0xec, 0xbd, 0x9f, 0x36, 0x31, 0xdc, 0x3d, 0xc1, 0x10, 0x4d, 0x13, 0x0e,
+		0xbf, 0xc3, 0x68, 0xf7, 0xbf, 0x49, 0x43, 0x52, 0xa3, 0xef, 0x18, 0x50, 0xdb, 0x21, 0x8f, 0x42,
+		0x8c, 0x36, 0x77, 0xac, 0xd1, 0x2d, 0x27, 0xd1, 0xcb, 0x85, 0x5a, 0x73, 0xc7, 0x12, 0xc0, 0x97,
+		0x0f, 0x68, 0x0c, 0x98, 0x9c, 0x87, 0xf8, 0x56, 0x67, 0x20, 0x02, 0xe1, 0x01, 0x33, 0x25, 0xb1,
+		0x2e, 0x62, 0xb1, 0x87, 0xc6, 0xc1, 0xb1, 0x32, 0xf6, 0xa1, 0xb9, 0xe8, 0xb8, 0xca, 0xd8, 0xf7,
+		0xe5, 0xbc, 0xca, 0x10, 0x98, 0x54, 0x01, 0xda, 0x66, 0xdb, 0xd1, 0x59, 0x8c, 0xb8, 0x18, 0x1f,
+		0x56, 0xf5, 0x1e, 0x6a, 0xdb, 0x61, 0xf1, 0x64, 0x0f, 0x3f, 0xdd, 0x96, 0x79, 0xd8, 0xe2, 0x77,
+		0x0c, 0x68, 0x5f, 0x9a, 0x8a, 0x90, 0x16, 0xbf, 0x0d, 0x8b, 0x7d, 0x2d, 0x66, 0xe0, 0x68, 0x5a,
+		0xf9, 0xb7, 0x0c, 0x9c, 0x5b, 0xc5, 0xd4, 0xb0, 0x95, 0x90, 0xa8, 0xec, 0x53, 0x06, 0x8d, 0x5b,
+		0x1e, 0x72, 0xb2, 0xc9, 0x73, 0xc8, 0x05, 0x77, 0x09, 0x97, 0x19, 0x5e, 0x33, 0xb9, 0xc8, 0xac,
+		0xdc, 0xc3, 0x15, 0x08, 0x64, 0x0d, 0xf2, 0x9d, 0xb6, 0xed, 0xe8, 0xb6, 0x69, 0xf4, 0xec, 0x1d,
+		0xcb, 0x91, 0x8f, 0x8c, 0xdf, 0x3f, 0x4a, 0x62, 0xa5, 0x6d, 0x3b, 0x75, 0x09, 0xe6, 0x51, 0xca,
+		0x75, 0xfc, 0xf9, 0x48, 0xd0, 0xda, 0xda, 0xa2, 0x7d, 0x97, 0x62, 0x31, 0x37, 0x8e, 0xe0, 0x1a,
+		0xc2, 0x49, 0x4c, 0x1f, 0x41, 0xcb, 0x9f, 0x4f, 0xbe, 0x05, 0x66, 0x3b, 0x96, 0xd1, 0x72, 0xe9,
+		0xe9, 0xcd, 0x9d, 0x81, 0x79, 0x9d, 0x85, 0x78, 0x33, 0xe7, 0x4e, 0x87, 0x34, 0xd3, 0x32, 0x5a,
+		0x12, 0xb9, 0x8a, 0xa0, 0x1e, 0xe5, 0x99, 0xce, 0x70, 0x19, 0xd1, 0x61, 0xce, 0xe8, 0xf5, 0x3a,
+		0xbb, 0xc3, 0xe4, 0x0b, 0x8c, 0xfc, 0x43, 0xa3, 0xe4, 0xcb, 0x08, 0x3d, 0x86, 0x3e, 0x31, 0x46,
+		0x0a, 0xc9, 0x06, 0x86, 0xa7, 0x29, 0xbb, 0xb7, 0xda, 0x13, 0x8b, 0x14, 0xf6, 0x8a, 0x37, 0x3e,
+		0x14, 0x34, 0x42, 0x7c, 0x9d, 0x43, 0xca, 0xd5, 0x8c, 0x47, 0xb9, 0xd0, 0x0b, 0x96, 0x70, 0xb2,
+		0x56, 0x93, 0xb2, 0xaf, 0x1b, 0x08, 0xb2, 0x33, 0xe3, 0xc9, 0x32, 0xc8, 0x50, 0xb2, 0x81, 0x12,
+		0x72, 0x11, 0x32, 0x3c, 0xaa, 0x85, 0xbb, 0xe2, 0x94, 0x7d, 0x36, 0x22, 0x73, 0xee, 0x9e, 0x90,
+		0xe9, 0xca, 0x80, 0x30, 0x62, 0xee, 0x11, 0x03, 0xea, 0x66, 0x92, 0x4d, 0x38, 0xc8, 0x5e, 0x42,
+		0xdf, 0xd5, 0x83, 0xfa, 0xb8, 0x38, 0x2b, 0x9e, 0xda, 0x1e, 0xa1, 0xc8, 0xbe, 0x22, 0xb6, 0x7b,
+		0xcd, 0xaf, 0x98, 0x3d, 0xd2, 0xb3, 0x37, 0x46, 0x4b, 0x51, 0xd2, 0xb6, 0xda, 0xa6, 0xd1, 0xc1,
+		0x0f, 0x7a, 0x70, 0x77, 0x76, 0x6e, 0x9c, 0xa4, 0x5d, 0x14, 0x70, 0xcc, 0x99, 0xf1, 0x49, 0xda,
+		0x96, 0x3f, 0xbf, 0x92, 0x14, 0x4b, 0x0e, 0xf7, 0x8d, 0xe0, 0xa4, 0x9a, 0xe2, 0x2f, 0xd9, 0x5f,
+		0x89, 0xa5, 0x40, 0xcd, 0x94, 0x1e, 0x80, 0x8c, 0x4f, 0x4f, 0xe1, 0x29, 0x00, 0x61, 0x54, 0xe5,
+		0x01, 0x7e, 0x91, 0x2c, 0xe5, 0x21, 0xeb, 0x57, 0x4d, 0xa5, 0x0f, 0x28, 0x90, 0xf1, 0x29, 0x1d,
+		0xc4, 0xf4, 0x6f, 0x74, 0xa5, 0x3d, 0x3f, 0xf5, 0x1e, 0xe9, 0x55, 0xc8, 0x72, 0xbe, 0xd9, 0x9a,
+		0x65, 0x99, 0xc2, 0xa9, 0xc1, 0x17, 0x8d, 0x7a, 0xe7, 0x7a, 0x2e, 0x48, 0x94, 0x81, 0x40, 0xef,
+		0x5c, 0x4f, 0x02, 0x9c, 0x84, 0x2c, 0x76, 0x5d, 0xf7, 0xbb, 0xcb, 0x69, 0x2d, 0x83, 0x79, 0x02,
+		0xa4, 0xf4, 0x7b, 0x11, 0x50, 0x87, 0x95, 0x99, 0xbb, 0x01, 0xa6, 0xec, 0x7b, 0x03, 0xec, 0xc8,
+		0xf0, 0xd6, 0x9b, 0xb7, 0xdb, 0x76, 0x15, 0x54, 0x6f, 0xcf, 0x88, 0xdb, 0x9e, 0x3d, 0xfc, 0xff,
+		0xa1, 0xb5, 0x8a, 0x56, 0x68, 0x06, 0x33, 0xc8, 0xa5, 0xc0, 0x79, 0x91, 0x98, 0x7b, 0xc4, 0x75,
+		0x58, 0x9e, 0x24, 0xcc, 0x46, 0xaf, 0x65, 0x38, 0x54, 0x86, 0xdc, 0x3d, 0x54, 0x74, 0xf4, 0x70,
+		0x93, 0xca, 0x76, 0x0c, 0x87, 0x0a, 0x47, 0x8f, 0x07, 0x32, 0x73, 0x46, 0xaf, 0xc7, 0x3e, 0x64,
+		0xc7, 0x1d, 0xbd, 0xfb, 0x20, 0x8f, 0x1a, 0xbe, 0x6d, 0x74, 0xa4, 0x17, 0x91, 0xe0, 0xfe, 0xa0,
+		0xc8, 0x15, 0x9e, 0x48, 0x0b, 0xb2, 0x7e, 0xe5, 0xee, 0x86, 0x66, 0x14, 0x5f, 0x68, 0x86, 0x88,
+		0x27, 0xaa, 0x38, 0x87, 0xe4, 0xb3, 0x5e, 0xe1, 0x9b, 0x91, 0x73, 0x2c, 0x8c, 0x73, 0x83, 0xc7,
+		0x5e, 0x53, 0x1a, 0x4f, 0x94, 0x5e, 0x84, 0x7c, 0xd0, 0x0e, 0x90, 0x3c, 0x44, 0x9c, 0x5b, 0xa2,
+		0x96, 0x88, 0x73, 0x8b, 0x9c, 0xf5, 0x7d, 0xe3, 0x2f, 0x1f, 0x66, 0xfd, 0x04, 0xbe, 0x17, 0x3a,
+		0xbd, 0x12, 0x4b, 0x45, 0xd4, 0x68, 0xa9, 0x00, 0xb9, 0x80, 0x95, 0x28, 0x1d, 0x82, 0xb9, 0x30,
+		0x9d, 0x5f, 0x6a, 0xc3, 0x5c, 0x98, 0xea, 0x26, 0xe7, 0x21, 0xe5, 0x2a, 0xfd, 0x91, 0x68, 0x9b,
+		0xac, 0xdd, 0x45, 0x72, 0x61, 0x03, 0xbb, 0x85, 0x91, 0xc0, 0x6e, 0x61, 0xe9, 0xdb, 0xa0, 0x38,
+		0x4e, 0x9f, 0x0f, 0x6d, 0x1f, 0xc4, 0x5c, 0xc6, 0x1d, 0x82, 0x84, 0x78, 0x0b, 0x32, 0xc2, 0xc2,
+		0x14, 0x22, 0x85, 0x0c, 0xe5, 0xba, 0x3d, 0xca, 0xb2, 0x79, 0xa2, 0xa4, 0xc3, 0x91, 0xb1, 0x2a,
+		0x7d, 0xfc, 0x6e, 0x3b, 0x27, 0x24, 0x76, 0xdb, 0x59, 0x02, 0xab, 0xb5, 0x31, 0xee, 0xdf, 0x97,
+		0x07, 0xe7, 0x78, 0x0a, 0x5f, 0xba, 0x3d, 0x14, 0xae, 0xd7, 0xc9, 0x09, 0xc8, 0xe2, 0xe2, 0xc1,
+		0x09, 0xae, 0x33, 0xa0, 0x6b, 0xdc, 0x6a, 0x88, 0x45, 0x86, 0xd8, 0xa9, 0x8c, 0xb8, 0x3b, 0x95,
+		0xe4, 0x1a, 0xcc, 0x74, 0xac, 0xa6, 0xd1, 0xd1, 0x7d, 0x3b, 0xc5, 0x62, 0x3a, 0xdd, 0x3b, 0x4e,
+		0x4f, 0xcb, 0xbd, 0x08, 0x54, 0x41, 0x62, 0x22, 0x14, 0x18, 0x91, 0x15, 0x77, 0x57, 0x99, 0xd4,
+		0x20, 0xd3, 0x6d, 0xdb, 0x9b, 0x74, 0xc7, 0xb8, 0xd1, 0xb6, 0xfa, 0x62, 0x5e, 0x85, 0x48, 0xcf,
+		0x55, 0x0f, 0x48, 0x6e, 0x61, 0xfb, 0xf0, 0x7c, 0x83, 0x12, 0x0f, 0xdd, 0x5a, 0x4f, 0xec, 0x5b,
+		0xb3, 0x8c, 0xdb, 0xa4, 0x4e, 0x8e, 0xdd, 0xa4, 0x0e, 0xdb, 0x11, 0x4e, 0x85, 0xef, 0x08, 0xbf,
+		0xc2, 0x06, 0x27, 0xcc, 0x3a, 0x8e, 0x6e, 0x12, 0x93, 0x06, 0xcc, 0x09, 0xfc, 0x56, 0x80, 0xfb,
+		0x23, 0xe7, 0xce, 0x82, 0x4e, 0x97, 0x8f, 0xeb, 0x44, 0xe2, 0x8f, 0x67, 0x7c, 0xf4, 0x0e, 0x19,
+		0x2f, 0x8f, 0x6a, 0xc4, 0x7c, 0x47, 0x35, 0xfe, 0x37, 0x1b, 0x8c, 0xef, 0x8e, 0xca, 0xcd, 0x33,
+		0x9f, 0x63, 0x11, 0x7a, 0x06, 0x65, 0xdc, 0x5e, 0x8f, 0xec, 0x58, 0x74, 0xdf, 0x1d, 0x13, 0xa3,
+		0x1d, 0x9b, 0x3c, 0xda, 0xf1, 0x37, 0x73, 0xb4, 0x13, 0x77, 0x38, 0xda, 0x5f, 0xd3, 0x71, 0xf8,
+		0x71, 0x05, 0xe6, 0xc7, 0xbb, 0x63, 0xa1, 0x03, 0xb2, 0xaf, 0xdd, 0xc9, 0x71, 0x16, 0xef, 0x3e,
+		0xc8, 0x0f, 0x79, 0x8b, 0x5c, 0x98, 0x73, 0x81, 0xe5, 0x7a, 0xe9, 0x3d, 0x51, 0xfc, 0x62, 0xec,
+		0xa8, 0x43, 0x17, 0x32, 0x63, 0x35, 0xfc, 0x72, 0x48, 0xb3, 0xdd, 0xba, 0xe3, 0x09, 0x3b, 0x23,
+		0xd0, 0xff, 0xcf, 0x7c, 0x0d, 0x91, 0x93, 0x9f, 0x01, 0x48, 0x69, 0xd4, 0xee, 0xa1, 0x83, 0xc6,
+		0xbf, 0x76, 0xde, 0xa4, 0x3d, 0xc7, 0x0b, 0x6b, 0x85, 0xae, 0x1b, 0x04, 0x88, 0xc4, 0xc3, 0xf5,
+		0xb3, 0x8b, 0x87, 0x3b, 0xbf, 0x2c, 0x4c, 0x30, 0x76, 0xc1, 0xcf, 0xdd, 0x6f, 0x17, 0x95, 0x41,
+		0x93, 0x27, 0x64, 0x9c, 0x20, 0x3a, 0x6e, 0xf5, 0x2b, 0x9c, 0x71, 0x17, 0x8f, 0xc3, 0x63, 0x75,
+		0x2c, 0x50, 0x10, 0x1b, 0x57, 0x1d, 0xf7, 0xd9, 0xbd, 0xea, 0xda, 0xfc, 0xc9, 0x57, 0x7f, 0xa4,
+		0x20, 0x31, 0xae, 0xab, 0x3e, 0xe7, 0xda, 0xeb, 0xaa, 0x17, 0x2a, 0x78, 0x42, 0x86, 0x0a, 0x92,
+		0xe3, 0x1a, 0x2d, 0xbc, 0x49, 0xaf, 0xd1, 0x0c, 0x9e, 0x3c, 0xe3, 0x8b, 0x15, 0xa4, 0x87, 0xc3,
+		0xf0, 0x23, 0xb1, 0x02, 0x17, 0xdb, 0x0d, 0x16, 0x2c, 0xba, 0xc1, 0x82, 0xec, 0xd8, 0x48, 0x83,
+		0x70, 0x03, 0x5d, 0x64, 0x81, 0x41, 0xd6, 0x47, 0xa2, 0x05, 0x7c, 0x71, 0xff, 0xc0, 0xc4, 0x68,
+		0x81, 0x4b, 0x6a, 0x28, 0x5c, 0xb0, 0x3e, 0x12, 0x2e, 0xc8, 0x8f, 0xa3, 0x38, 0xe4, 0x73, 0x7a,
+		0x14, 0x83, 0xf1, 0x82, 0x6f, 0x0d, 0x8f, 0x17, 0x8c, 0x5d, 0xd0, 0x87, 0xf8, 0x97, 0x2e, 0xe9,
+		0x90, 0x80, 0xc1, 0xb7, 0x8d, 0x09, 0x18, 0xa8, 0xe3, 0x16, 0xb6, 0x61, 0xde, 0xa5, 0x5b, 0x41,
+		0x58, 0xc4, 0xe0, 0x5a, 0x48, 0xc4, 0x80, 0x2f, 0xed, 0x1f, 0x9c, 0x22, 0x62, 0xe0, 0x92, 0x1e,
+		0x09, 0x19, 0x5c, 0x0b, 0x09, 0x19, 0x90, 0xf1, 0x74, 0x87, 0x9c, 0x22, 0x3f, 0xdd, 0x40, 0x11,
+		0xb9, 0x14, 0x8c, 0x19, 0xcc, 0xee, 0xed, 0x8b, 0x72, 0xd3, 0xee, 0x52, 0xf3, 0x07, 0x0d, 0x9a,
+		0xe3, 0x82, 0x06, 0x7c, 0x5d, 0xff, 0xf0, 0x94, 0x41, 0x03, 0x97, 0x76, 0x68, 0xd4, 0x60, 0x7d,
+		0x24, 0x6a, 0x70, 0x70, 0x9c, 0xc0, 0x0d, 0x19, 0x19, 0x4f, 0xe0, 0xc6, 0x86, 0x0d, 0xf8, 0x67,
+		0xf0, 0xf8, 0x07, 0xf0, 0x40, 0xcd, 0x5c, 0x89, 0xa5, 0x32, 0x6a, 0xb6, 0xf4, 0x20, 0xba, 0x35,
+		0x43, 0x7a, 0x0f, 0x17, 0x11, 0xb4, 0xdf, 0xb7, 0xfa, 0x72, 0x0f, 0x94, 0x25, 0x4a, 0xa7, 0x20,
+		0xeb, 0x57, 0x71, 0x7b, 0x84, 0x18, 0x0a, 0x90, 0x0b, 0x68, 0xb5, 0xd2, 0x6f, 0x28, 0x90, 0xf5,
+		0xeb, 0xab, 0xc0, 0x02, 0x34, 0x2d, 0x16, 0xa0, 0xbe, 0xc0, 0x43, 0x24, 0x18, 0x78, 0xc0, 0x8f,
+		0x09, 0xf5, 0x46, 0x62, 0x0a, 0x46, 0xcf, 0x8d, 0x29, 0xc8, 0x83, 0x9b, 0x3c, 0x3c, 0x21, 0xec,
+		0x14, 0x3f, 0xb5, 0x50, 0x70, 0x0f, 0xb1, 0x8a, 0x30, 0xff, 0xc3, 0x30, 0xeb, 0x83, 0x75, 0x17,
+		0x77, 0x7c, 0x79, 0xad, 0xba, 0xd0, 0x65, 0xb1, 0xca, 0xfb, 0x2d, 0x05, 0x66, 0x46, 0xd4, 0x65,
+		0x68, 0xdc, 0x40, 0x79, 0xb3, 0xe2, 0x06, 0x91, 0x3b, 0x8f, 0x1b, 0xf8, 0x97, 0xab, 0xd1, 0xe0,
+		0x72, 0xf5, 0xaf, 0x15, 0xc8, 0x05, 0xd4, 0x36, 0x0e, 0x42, 0xd3, 0x6a, 0xc9, 0x1d, 0x73, 0xf6,
+		0x1b, 0xfd, 0x94, 0x8e, 0x25, 0xbf, 0x5f, 0x86, 0x3f, 0x11, 0xca, 0x35, 0x44, 0x69, 0x61, 0x66,
+		0xdc, 0xb5, 0x67, 0xdc, 0x7f, 0xa7, 0x4c, 0xdc, 0xb3, 0x4a, 0x78, 0xf7, 0xac, 0xdc, 0x8d, 0xf2,
+		0xa4, 0x6f, 0xa3, 0x9c, 0x5c, 0xc0, 0x5d, 0x26, 0xcb, 0xda, 0xd2, 0xad, 0x1e, 0xb7, 0xdf, 0x01,
+		0x7f, 0x67, 0xe4, 0x8e, 0x95, 0xcd, 0x2e, 0x09, 0xb0, 0x5f, 0x3e, 0x2f, 0x24, 0x1d, 0xf0, 0x42,
+		0xee, 0x82, 0x34, 0x36, 0x9f, 0x7f, 0xf6, 0x14, 0xc4, 0x53, 0x23, 0x32, 0xa3, 0xf4, 0x0b, 0x11,
+		0x28, 0x0c, 0x59, 0x9d, 0xd0, 0xce, 0x87, 0x9d, 0x58, 0x99, 0x8e, 0x21, 0xc7, 0x00, 0xb6, 0x0d,
+		0x5b, 0xbf, 0x69, 0x98, 0x0e, 0x6d, 0x09, 0xae, 0xf8, 0x72, 0xf0, 0x72, 0x03, 0xa6, 0x06, 0x36,
+		0x6d, 0x89, 0x08, 0x8d, 0x9b, 0xc6, 0xbb, 0x8a, 0xf4, 0x06, 0x7b, 0xb8, 0x9c, 0x7f, 0x16, 0xe7,
+		0x70, 0x88, 0x7a, 0xc2, 0xf2, 0x4a, 0x11, 0x87, 0xfb, 0x0b, 0xaf, 0x1e, 0x57, 0x39, 0xf8, 0x5b,
+		0xdc, 0x0b, 0xac, 0x9a, 0x20, 0x10, 0x64, 0x43, 0x6a, 0x88, 0x0d, 0x2c, 0x5c, 0x98, 0x95, 0x6b,
+		0x7f, 0x64, 0x2a, 0xbf, 0x89, 0x83, 0xf7, 0xe7, 0xbb, 0x3d, 0xcb, 0xea, 0xe8, 0x7c, 0x9e, 0x97,
+		0x21, 0x1f, 0x34, 0xb2, 0xfc, 0xd3, 0xe2, 0x0e, 0x46, 0xd0, 0x02, 0xbe, 0x71, 0x96, 0x67, 0xf2,
+		0x79, 0x75, 0x25, 0x96, 0x52, 0xd4, 0x88, 0x08, 0xd7, 0xbc, 0x0d, 0x0e, 0x86, 0xda, 0x58, 0xf2,
+		0x24, 0xa4, 0x3d, 0xfb, 0xcc, 0xef, 0x53, 0xed, 0x15, 0x87, 0xf1, 0x80, 0xf1, 0x7b, 0x11, 0xa1,
+		0x46, 0x96, 0xbc, 0x15, 0x12, 0xfc, 0xbc, 0xb6, 0x38, 0x93, 0x77, 0xdf, 0x64, 0xeb, 0x8c, 0x1f,
+		0x29, 0x10, 0x48, 0xa5, 0xb3, 0x70, 0x64, 0xac, 0x95, 0xf5, 0xa2, 0x29, 0x8a, 0x2f, 0x9a, 0x52,
+		0xfa, 0x25, 0x05, 0xe6, 0xc7, 0x5b, 0x4e, 0x52, 0x19, 0x6a, 0xd0, 0xe9, 0x29, 0xed, 0xae, 0xaf,
+		0x55, 0xb8, 0xdc, 0xe8, 0xd3, 0x2d, 0xea, 0x34, 0x77, 0xb8, 0x09, 0xe7, 0x4a, 0x21, 0xa7, 0xe5,
+		0x44, 0x2e, 0xc3, 0xb1, 0x39, 0xd8, 0xcb, 0xb4, 0xe9, 0xe8, 0x7c, 0x50, 0x6d, 0xe6, 0xf2, 0xa7,
+		0xb5, 0x1c, 0xcf, 0xad, 0xf3, 0xcc, 0xd2, 0x43, 0x70, 0x78, 0x8c, 0x2d, 0x0e, 0x39, 0x6e, 0xfe,
+		0x12, 0x02, 0x87, 0x1a, 0x58, 0xf2, 0x2c, 0x24, 0x6c, 0xc7, 0x70, 0x06, 0xb6, 0xe8, 0xd9, 0x03,
+		0x13, 0x6d, 0x73, 0x9d, 0x81, 0x6b, 0x02, 0xad, 0xf4, 0x14, 0x90, 0x51, 0x4b, 0x1b, 0xb2, 0xb6,
+		0x52, 0xc2, 0xd6, 0x56, 0x9b, 0x70, 0x74, 0x0f, 0x9b, 0x4a, 0xaa, 0x43, 0x8d, 0x7b, 0x68, 0x2a,
+		0x93, 0x3c, 0xd4, 0xc0, 0x3f, 0x8b, 0xc0, 0xc1, 0x50, 0xd3, 0xea, 0x9b, 0xa5, 0xca, 0x1b, 0x9d,
+		0xa5, 0x6f, 0x05, 0x70, 0x6e, 0xc9, 0x4b, 0x06, 0x42, 0xdb, 0x87, 0xad, 0x27, 0x6e, 0xd1, 0x66,
+		0xe3, 0x96, 0x10, 0x8c, 0xb4, 0x23, 0x7e, 0xe1, 0xe2, 0xdf, 0xb7, 0x9e, 0x1d, 0x30, 0x4b, 0x60,
+		0x17, 0xa3, 0xfb, 0xb3, 0x19, 0xea, 0x8d, 0x60, 0xb6, 0x4d, 0x5e, 0x82, 0xc3, 0x43, 0x16, 0xcd,
+		0xa5, 0x1d, 0x9b, 0xda, 0xb0, 0x1d, 0x0c, 0x1a, 0x36, 0x49, 0xdb, 0x6f, 0x95, 0xe2, 0x41, 0xab,
+		0xf4, 0x12, 0x80, 0xb7, 0xb0, 0xf5, 0xce, 0xc3, 0x2a, 0xfe, 0xf3, 0xb0, 0xe7, 0x21, 0x8e, 0x92,
+		0x20, 0x59, 0x15, 0xa2, 0x30, 0x70, 0x48, 0x7d, 0x2b, 0x63, 0x0e, 0x5e, 0x7a, 0x19, 0xc8, 0x68,
+		0x8c, 0x71, 0x4c, 0x1d, 0xcf, 0x04, 0xeb, 0x28, 0x8d, 0x0f, 0x57, 0x86, 0xd7, 0xf5, 0x7f, 0x41,
+		0x9c, 0x0d, 0x7f, 0xe8, 0x05, 0xe4, 0xb7, 0x03, 0x18, 0x8e, 0xd3, 0x6f, 0x6f, 0x0e, 0xbc, 0x1a,
+		0x4e, 0x8c, 0x91, 0x9f, 0xb2, 0x04, 0xac, 0xdc, 0x25, 0x04, 0x69, 0xce, 0xc3, 0xf5, 0x09, 0x93,
+		0x8f, 0x62, 0x69, 0x15, 0xf2, 0x41, 0xdc, 0xf0, 0x1b, 0xd5, 0xa3, 0xdf, 0x58, 0xf5, 0x0c, 0xb9,
+		0x78, 0x4b, 0x8d, 0x25, 0xf0, 0xc3, 0x83, 0x59, 0xbf, 0xf4, 0xfd, 0x2d, 0x34, 0x96, 0xa5, 0xf7,
+		0x28, 0x90, 0x72, 0xfb, 0xbf, 0xc7, 0x6d, 0x00, 0xef, 0x6e, 0xbd, 0x1b, 0x83, 0xe7, 0xbb, 0x1e,
+		0x51, 0x77, 0xd7, 0xe3, 0x69, 0xd7, 0x20, 0x8c, 0x5d, 0xcc, 0xfb, 0xb9, 0x2d, 0xcf, 0xe1, 0x0a,
+		0x03, 0xf5, 0xd4, 0x74, 0x97, 0x7b, 0x71, 0x4b, 0xc6, 0x77, 0x2f, 0x97, 0x27, 0x4a, 0xd4, 0x77,
+		0x5c, 0x89, 0xcf, 0x46, 0xff, 0x2d, 0x60, 0x65, 0xff, 0xb7, 0x80, 0xdd, 0x6a, 0x22, 0xfe, 0x6a,
+		0xfe, 0x5f, 0x05, 0x52, 0x72, 0x5e, 0x90, 0x67, 0xfd, 0x87, 0xe9, 0xe4, 0xc9, 0x9c, 0xf1, 0x7a,
+		0x49, 0x54, 0xe0, 0xe1, 0x8c, 0x5e, 0x49, 0x88, 0xee, 0xfb, 0x4a, 0x82, 0xf0, 0x43, 0xbe, 0xac,
+		0x80, 0x3a, 0x3c, 0x6f, 0xdf, 0x78, 0xfb, 0x46, 0xed, 0x55, 0x34, 0xc4, 0x5e, 0x8d, 0xbb, 0x68,
+		0x10, 0x1b, 0x77, 0xd1, 0x60, 0xb4, 0xdf, 0xf1, 0x3b, 0xed, 0xf7, 0x77, 0x47, 0x20, 0xe3, 0x8b,
+		0xf1, 0xe1, 0x09, 0x43, 0xdf, 0xad, 0x85, 0x93, 0x7b, 0x06, 0x04, 0x7d, 0xd7, 0x16, 0x02, 0x9c,
+		0x8a, 0xdc, 0x01, 0xa7, 0xde, 0xfc, 0xcb, 0x8c, 0xe1, 0x37, 0xe3, 0xe3, 0x63, 0x6e, 0xc6, 0x7f,
+		0xa7, 0x02, 0x29, 0x37, 0xf8, 0xb2, 0xdf, 0x3d, 0xb9, 0x43, 0x90, 0x10, 0xbe, 0x17, 0xdf, 0x94,
+		0x13, 0xa9, 0xd0, 0xe8, 0xe8, 0x3c, 0xa4, 0xba, 0xd4, 0x31, 0x98, 0x42, 0xe4, 0x16, 0xce, 0x4d,
+		0x9f, 0xde, 0x84, 0x8c, 0x6f, 0x5b, 0x93, 0x1c, 0x81, 0x83, 0xd5, 0xcb, 0xb5, 0xea, 0x73, 0x7a,
+		0xe3, 0x85, 0xe1, 0x2f, 0xa5, 0x8d, 0x14, 0x69, 0x35, 0x96, 0xe6, 0x9f, 0xb0, 0x09, 0x16, 0xf1,
+		0x82, 0xc8, 0x7c, 0x0c, 0x0f, 0x94, 0x9f, 0xfe, 0xb2, 0x02, 0xb3, 0x21, 0x5e, 0x2e, 0x39, 0x09,
+		0x77, 0xaf, 0x5d, 0xbc, 0x58, 0xd3, 0xf4, 0xfa, 0x6a, 0x79, 0xbd, 0x7e, 0x79, 0xad, 0xa1, 0x6b,
+		0xb5, 0xfa, 0xc6, 0x8a, 0xff, 0x7b, 0x63, 0x27, 0xe0, 0xae, 0x70, 0x90, 0x72, 0xb5, 0x5a, 0x5b,
+		0x6f, 0xf0, 0x8f, 0x2d, 0x8f, 0x81, 0xa8, 0xb0, 0xef, 0x91, 0x8d, 0x27, 0xa1, 0xd5, 0xae, 0xd4,
+		0xaa, 0x0d, 0x35, 0x4a, 0x1e, 0x80, 0x7b, 0xf6, 0x82, 0x10, 0x5f, 0x41, 0x53, 0x63, 0x13, 0x01,
+		0xeb, 0xb5, 0xd5, 0xa5, 0x9a, 0xa6, 0xc6, 0x45, 0xbf, 0x3f, 0x12, 0x81, 0xe2, 0x38, 0x67, 0x1a,
+		0x69, 0x95, 0xd7, 0xd7, 0x57, 0x5e, 0xf4, 0x68, 0x55, 0x2f, 0x6f, 0xac, 0x3e, 0x37, 0xca, 0x82,
+		0xfb, 0xa1, 0xb4, 0x17, 0xa0, 0xcb, 0x88, 0xfb, 0xe0, 0xe4, 0x9e, 0x70, 0x82, 0x1d, 0x13, 0xc0,
+		0xb4, 0x1a, 0x7e, 0x29, 0x3a, 0x4a, 0x16, 0xe0, 0xf4, 0x44, 0x30, 0xb7, 0x4c, 0x8d, 0x91, 0x33,
+		0xf0, 0xd0, 0xde, 0xf0, 0x9c, 0x41, 0x12, 0x41, 0xb2, 0xe8, 0x15, 0x05, 0x0e, 0x86, 0x7a, 0xe5,
+		0xf8, 0xa5, 0xbd, 0x75, 0x6d, 0xad, 0x5a, 0xab, 0xd7, 0xdd, 0x3b, 0x0b, 0x7a, 0xbd, 0x51, 0x6e,
+		0x6c, 0xd4, 0x7d, 0xbc, 0x29, 0xc1, 0xb1, 0x71, 0x40, 0x2e, 0x5f, 0xf6, 0x80, 0x11, 0x12, 0x20,
+		0xe5, 0xf4, 0xb6, 0x02, 0x47, 0xc6, 0x7a, 0xe1, 0xe4, 0x14, 0xdc, 0xcb, 0x3f, 0xc6, 0xa7, 0xe3,
+		0x05, 0x0d, 0xdf, 0x57, 0xbd, 0x47, 0x5a, 0xf5, 0x00, 0xdc, 0xb3, 0x27, 0xa4, 0xdb, 0xb4, 0x49,
+		0x80, 0x43, 0xed, 0x7b, 0xb7, 0x02, 0x85, 0x21, 0x5d, 0xc8, 0xbe, 0xfc, 0xb7, 0x5c, 0xaf, 0xd4,
+		0x2e, 0x97, 0xaf, 0x2d, 0xaf, 0x69, 0xc3, 0x73, 0xf6, 0x1e, 0x38, 0x3e, 0x52, 0xba, 0xb4, 0x81,
+		0x1f, 0x4d, 0x2c, 0x37, 0x6a, 0x3a, 0xbf, 0x68, 0x82, 0x1d, 0x1b, 0x01, 0x5a, 0x59, 0xbe, 0x74,
+		0xb9, 0xa1, 0x57, 0x57, 0x96, 0x6b, 0xab, 0x0d, 0xbd, 0xdc, 0x68, 0x94, 0xbd, 0xe9, 0x5c, 0x79,
+		0x6e, 0xec, 0x01, 0xcf, 0xb3, 0xd3, 0x1f, 0xf0, 0x14, 0x47, 0x38, 0xdd, 0xf3, 0x9d, 0xff, 0xf6,
+		0x19, 0xb8, 0x57, 0x3c, 0x4c, 0x64, 0x3b, 0xc6, 0xf5, 0xb6, 0xb9, 0xed, 0xbe, 0x10, 0x25, 0xd2,
+		0xe2, 0x9c, 0xe7, 0x21, 0x0e, 0xb5, 0x20, 0x73, 0x27, 0xbc, 0x13, 0x35, 0xf6, 0x79, 0xd1, 0x89,
+		0xf7, 0x03, 0x26, 0x1d, 0xd3, 0xdc, 0xeb, 0x0d, 0xaa, 0x09, 0x2f, 0x5d, 0x85, 0xbc, 0x51, 0x35,
+		0xbf, 0xf7, 0x7b, 0x0d, 0xf3, 0x7b, 0x1e, 0x7e, 0xc5, 0x7b, 0x65, 0xf9, 0xcb, 0x6d, 0xdb, 0xb1,
+		0xfa, 0xed, 0xa6, 0xd1, 0x61, 0x8e, 0xc4, 0xd3, 0x53, 0x5f, 0x68, 0xab, 0xa4, 0xd1, 0x8c, 0x89,
+		0x97, 0xac, 0x76, 0xe4, 0x9d, 0xb2, 0xc4, 0x0d, 0xa3, 0xc3, 0x2f, 0x93, 0xf9, 0x9f, 0xc2, 0x1b,
+		0x66, 0xbb, 0xcf, 0xbe, 0xfa, 0xa9, 0x70, 0x5c, 0x3c, 0x12, 0x5c, 0x7a, 0x9f, 0x02, 0xaa, 0xd7,
+		0x34, 0x8d, 0x36, 0xad, 0x7e, 0x8b, 0x79, 0x8a, 0xbd, 0x9e, 0x6f, 0x33, 0x54, 0x26, 0xc9, 0x63,
+		0xc2, 0xf8, 0x46, 0x26, 0x1a, 0xdf, 0x98, 0xcf, 0xf0, 0x86, 0x5c, 0xdc, 0x8f, 0x86, 0x5d, 0xdc,
+		0x2f, 0xfd, 0x30, 0x06, 0xdb, 0x70, 0xb9, 0x65, 0xb3, 0xe5, 0x39, 0x5b, 0x00, 0x5e, 0x81, 0x58,
+		0xdf, 0x70, 0xc4, 0xa2, 0xa8, 0x72, 0x7e, 0xdf, 0x8f, 0x71, 0xf1, 0x3e, 0x33, 0x1a, 0xe4, 0x6d,
+		0x80, 0xa7, 0xf1, 0x75, 0x46, 0x2f, 0xf2, 0x86, 0xe8, 0xe1, 0xa5, 0x04, 0x6c, 0x1f, 0x79, 0x3b,
+		0xfb, 0xc6, 0x24, 0x6e, 0x9a, 0x99, 0xdb, 0x94, 0x53, 0x8e, 0xbe, 0x21, 0xca, 0xb9, 0xae, 0x71,
+		0xab, 0xca, 0xa8, 0x21, 0x7d, 0xf1, 0x68, 0xd9, 0x6f, 0x2a, 0x00, 0x1e, 0x63, 0x88, 0x81, 0x21,
+		0x64, 0x99, 0x62, 0x95, 0xca, 0x10, 0xf2, 0x03, 0xe3, 0x24, 0x61, 0x88, 0xad, 0x95, 0x1c, 0x36,
+		0xef, 0x53, 0xaf, 0x1e, 0x57, 0x78, 0xad, 0x85, 0xe6, 0x08, 0xdb, 0x33, 0x7c, 0x0d, 0xaf, 0x4f,
+		0x39, 0xe0, 0x39, 0xe9, 0x6d, 0x71, 0x82, 0xc0, 0xb1, 0xb1, 0x5c, 0xf4, 0xe1, 0x63, 0x0a, 0x64,
+		0x96, 0x7c, 0x0f, 0x89, 0xe2, 0x1e, 0x80, 0x65, 0xb6, 0xaf, 0xd3, 0xbe, 0xbb, 0x07, 0xc0, 0x93,
+		0xe8, 0x11, 0xf1, 0x2f, 0x5a, 0x3b, 0xbb, 0xf2, 0x39, 0x17, 0x99, 0x46, 0xac, 0x9b, 0x74, 0xd3,
+		0x6e, 0x4b, 0x3e, 0x6b, 0x32, 0x89, 0x3b, 0xbc, 0x36, 0x6d, 0x0e, 0x30, 0x2c, 0x89, 0x9f, 0xf6,
+		0x77, 0xf0, 0x0b, 0xf4, 0x7c, 0xe9, 0x58, 0x90, 0xf9, 0x55, 0x9e, 0x8d, 0x44, 0x5a, 0xd4, 0x31,
+		0xda, 0x1d, 0x7e, 0x34, 0x2e, 0xad, 0xc9, 0xa4, 0x68, 0xea, 0xed, 0xa4, 0x7f, 0xe1, 0x54, 0x05,
+		0xd5, 0xea, 0xd1, 0x7e, 0xe0, 0x0c, 0x00, 0x97, 0xc6, 0xe2, 0xa7, 0x3f, 0xf1, 0xf0, 0x9c, 0x60,
+		0xb8, 0xd8, 0x3d, 0xe6, 0xf7, 0xc1, 0xb4, 0x82, 0xc4, 0x10, 0xd9, 0xe4, 0xc5, 0x40, 0xd4, 0x7f,
+		0xb0, 0xe9, 0xbd, 0xa4, 0x34, 0x37, 0xc2, 0xd4, 0xb2, 0xb9, 0x5b, 0x29, 0xfe, 0xae, 0x47, 0x5a,
+		0x2c, 0xad, 0xd6, 0xd9, 0x32, 0xca, 0xbf, 0x03, 0xc0, 0xc8, 0xa0, 0xb3, 0xf9, 0xb2, 0xd1, 0xee,
+		0xd0, 0x96, 0x58, 0x89, 0x8b, 0x14, 0xee, 0x88, 0x8a, 0xa8, 0x16, 0xff, 0x70, 0x6a, 0x69, 0x9c,
+		0x6c, 0x54, 0x2c, 0xb3, 0x15, 0x0c, 0x66, 0x61, 0x44, 0xcc, 0xb1, 0xae, 0x53, 0x53, 0x30, 0xa8,
+		0xf2, 0xd0, 0x3e, 0x5e, 0xdd, 0xd3, 0x04, 0x2a, 0xf9, 0x16, 0x50, 0x5b, 0xb4, 0x43, 0xb7, 0x19,
+		0xe7, 0xec, 0x1d, 0x03, 0xef, 0xa3, 0x27, 0xee, 0xf4, 0x4d, 0xbd, 0x82, 0x4b, 0xaa, 0xce, 0x28,
+		0x91, 0xf5, 0xe0, 0x53, 0xb5, 0x49, 0x77, 0xc3, 0x3a, 0xb4, 0x8f, 0x3e, 0xc9, 0xf3, 0xeb, 0x42,
+		0x3f, 0x09, 0x94, 0xa0, 0x81, 0xb9, 0x69, 0x99, 0xec, 0xdb, 0xa0, 0xc2, 0xdf, 0x4f, 0xf1, 0x9d,
+		0x20, 0x37, 0x5f, 0xec, 0x04, 0xad, 0x43, 0xde, 0x03, 0x65, 0x33, 0x24, 0xbd, 0xdf, 0x19, 0x92,
+		0x73, 0x09, 0x20, 0x08, 0x7e, 0x44, 0xd4, 0x9b, 0x83, 0x6c, 0x1f, 0x22, 0x73, 0xae, 0x34, 0x79,
+		0x36, 0xfb, 0x3b, 0xe3, 0x23, 0x40, 0x4c, 0x98, 0xed, 0xb6, 0x4d, 0xdd, 0xa6, 0x9d, 0x2d, 0x5d,
+		0x70, 0x0e, 0xe9, 0x66, 0x18, 0xfb, 0x9f, 0xd9, 0xc7, 0x68, 0xfe, 0xe1, 0x27, 0x1e, 0x2e, 0x78,
+		0x8f, 0x11, 0x9e, 0x78, 0x64, 0xe1, 0xb1, 0x27, 0xb4, 0x99, 0x6e, 0xdb, 0xac, 0xd3, 0xce, 0xd6,
+		0x92, 0x4b, 0x98, 0x3c, 0x0d, 0x47, 0x3d, 0x86, 0x58, 0xa6, 0xbe, 0x63, 0x75, 0x5a, 0x7a, 0x9f,
+		0x6e, 0xe9, 0x4d, 0xf6, 0x94, 0x62, 0x96, 0xb1, 0xf1, 0xb0, 0x0b, 0xb2, 0x66, 0x5e, 0xb6, 0x3a,
+		0x2d, 0x8d, 0x6e, 0x55, 0xb1, 0x18, 0x77, 0x09, 0x3c, 0xec, 0x76, 0x0b, 0xf7, 0xdf, 0xa3, 0x78,
+		0x3c, 0xd8, 0xcd, 0x5c, 0x6e, 0xd9, 0x8b, 0xa9, 0xf7, 0x7e, 0xe4, 0xf8, 0x81, 0xd7, 0x3e, 0x72,
+		0xfc, 0x40, 0xe9, 0x22, 0x7b, 0x6b, 0x4d, 0x4c, 0x2d, 0x6a, 0x93, 0xf3, 0x90, 0x36, 0x64, 0x82,
+		0x5f, 0xa1, 0xda, 0x63, 0x6a, 0x7a, 0xa0, 0x78, 0x7b, 0x2c, 0xb1, 0x74, 0x6d, 0xdd, 0x68, 0xf7,
+		0x49, 0x0d, 0x1f, 0xa2, 0x95, 0xb2, 0x3a, 0xed, 0x2c, 0xf7, 0xc4, 0x5b, 0x4e, 0xf3, 0xd5, 0x71,
+		0x07, 0x86, 0xd2, 0x95, 0x93, 0x9f, 0xfe, 0xc4, 0xc3, 0x77, 0x0b, 0x32, 0xd7, 0x86, 0xce, 0x0e,
+		0x49, 0x7a, 0xc3, 0x67, 0x8a, 0x7c, 0x7d, 0xbe, 0x02, 0x49, 0xde, 0x54, 0x9b, 0x3c, 0x0b, 0xf1,
+		0x1e, 0xfe, 0x10, 0xe1, 0xe4, 0x63, 0x63, 0x65, 0x9e, 0xc1, 0xfb, 0x25, 0x84, 0xe3, 0x95, 0xde,
+		0x17, 0x01, 0x58, 0xba, 0x76, 0xad, 0xd1, 0x6f, 0xf7, 0x3a, 0xd4, 0x79, 0xb3, 0xfa, 0xbe, 0x01,
+		0x07, 0xbd, 0xbe, 0xdb, 0xfd, 0xe6, 0xfe, 0xfb, 0x3f, 0xeb, 0xdd, 0x79, 0xef, 0x37, 0x43, 0xc9,
+		0xb6, 0x6c, 0xc7, 0x25, 0x1b, 0xdd, 0x3f, 0xd9, 0x25, 0xdb, 0x19, 0xe5, 0xec, 0x0b, 0x90, 0xf1,
+		0x98, 0x61, 0x93, 0x65, 0x48, 0x39, 0xe2, 0xb7, 0x60, 0x70, 0x69, 0x3c, 0x83, 0x25, 0x9a, 0x9f,
+		0xc9, 0x2e, 0x3a, 0xee, 0x9b, 0x82, 0x6f, 0x8e, 0x7c, 0x63, 0xca, 0x18, 0xc6, 0x45, 0x85, 0x72,
+		0x8e, 0xde, 0xf1, 0x83, 0xa7, 0x9c, 0x80, 0x8f, 0xa9, 0xef, 0x8f, 0xc0, 0xec, 0x86, 0x9c, 0xbd,
+		0xdf, 0xf8, 0x3c, 0xd8, 0x80, 0x24, 0x35, 0x9d, 0x7e, 0xdb, 0xdd, 0x0e, 0x79, 0x64, 0xdc, 0x98,
+		0x87, 0x74, 0xaa, 0x66, 0x3a, 0xfd, 0x5d, 0xbf, 0x04, 0x48, 0x5a, 0x3e, 0x7e, 0x7c, 0x28, 0x0a,
+		0xc5, 0x71, 0xa8, 0xe8, 0x20, 0x37, 0xfb, 0x94, 0x65, 0x04, 0x6f, 0xf4, 0xe5, 0x65, 0xb6, 0x30,
+		0x3b, 0x1a, 0xa0, 0xa3, 0x86, 0xc2, 0x85, 0xa0, 0x77, 0xe6, 0x99, 0xe5, 0x3d, 0x0a, 0xcc, 0xf0,
+		0x34, 0xa0, 0x20, 0xef, 0x01, 0x6c, 0x1a, 0x1d, 0x03, 0xaf, 0x41, 0x47, 0xf7, 0x6f, 0xf3, 0xe5,
+		0x5d, 0x82, 0x0a, 0x27, 0x41, 0x6a, 0x90, 0x94, 0xd4, 0x62, 0xfb, 0xa7, 0x26, 0x71, 0xf1, 0xc6,
+		0x87, 0xdf, 0x30, 0x30, 0x6f, 0x24, 0xa6, 0x65, 0xdc, 0xbc, 0xe5, 0xd6, 0x24, 0xcb, 0x93, 0xd8,
+		0xd3, 0xf2, 0x08, 0x87, 0xef, 0x27, 0xa2, 0x30, 0xa3, 0xd1, 0xd6, 0xdf, 0xfc, 0x61, 0x59, 0x07,
+		0xe0, 0x53, 0x15, 0x35, 0x69, 0x31, 0x76, 0xa7, 0xf3, 0x3d, 0xcd, 0x89, 0x2c, 0xd9, 0xce, 0xd7,
+		0x6b, 0x84, 0xfe, 0x28, 0x02, 0x59, 0xff, 0x08, 0xfd, 0xad, 0x34, 0x5a, 0x64, 0xd5, 0x53, 0x53,
+		0xfc, 0x26, 0xc3, 0x83, 0xe3, 0xd4, 0xd4, 0x88, 0x34, 0x4f, 0xd0, 0x4f, 0x5f, 0x4c, 0x43, 0x42,
+		0x9c, 0x28, 0x5a, 0x1b, 0xf1, 0x6d, 0x27, 0x5e, 0xe7, 0xce, 0xc9, 0x1b, 0xf1, 0xa1, 0xae, 0xed,
+		0x7d, 0x80, 0xf7, 0xe4, 0xf5, 0xc0, 0x31, 0x25, 0x8c, 0x8a, 0xe3, 0x52, 0xd7, 0xed, 0xbd, 0x8d,
+		0x47, 0xb5, 0x10, 0xcc, 0xd3, 0xc3, 0x08, 0x83, 0xb7, 0x46, 0x6a, 0x3c, 0x87, 0x3c, 0x0c, 0x64,
+		0xc7, 0x8d, 0x55, 0xe8, 0x1e, 0x23, 0x10, 0x6e, 0xc6, 0x2b, 0x91, 0xe0, 0xf8, 0xba, 0x8d, 0x65,
+		0xb6, 0x74, 0xfe, 0xc6, 0xb7, 0x78, 0x41, 0x1d, 0x73, 0x96, 0x30, 0x83, 0xbc, 0x5b, 0xe1, 0x2e,
+		0xf2, 0xd0, 0x4a, 0x5a, 0xac, 0x50, 0x1a, 0x53, 0x4c, 0x8a, 0xbf, 0x7c, 0xf5, 0xf8, 0xfc, 0xae,
+		0xd1, 0xed, 0x2c, 0x96, 0x42, 0xe8, 0x94, 0xc2, 0x16, 0xf7, 0xe8, 0x38, 0x07, 0x57, 0xe2, 0x64,
+		0x19, 0x54, 0xbc, 0xfe, 0xdf, 0x17, 0x1f, 0xd3, 0xd7, 0xb7, 0xa8, 0x7c, 0x51, 0xe1, 0xc8, 0x42,
+		0xc8, 0x8b, 0xeb, 0x0b, 0xf8, 0x72, 0xb9, 0xd8, 0x2d, 0xc9, 0x5f, 0xa7, 0xbb, 0x9a, 0xc0, 0xbb,
+		0x48, 0x29, 0x79, 0x07, 0x1c, 0xd9, 0xee, 0x58, 0x9b, 0x78, 0x89, 0xa6, 0xfd, 0x8e, 0x41, 0xbb,
+		0xa5, 0x0b, 0xa1, 0xd0, 0x9b, 0x46, 0xaf, 0x98, 0x7a, 0x43, 0x51, 0x89, 0x43, 0x9c, 0xf0, 0x0a,
+		0xa3, 0x5b, 0xe7, 0x64, 0xab, 0x46, 0x8f, 0xdc, 0x84, 0xbb, 0x3c, 0x39, 0x0f, 0xa9, 0x35, 0xfd,
+		0x86, 0x6a, 0x3d, 0xe2, 0xd2, 0x1e, 0xa9, 0xd8, 0x86, 0xf9, 0xb6, 0x69, 0x3b, 0x86, 0xe9, 0xe8,
+		0x9e, 0xb0, 0x6e, 0x51, 0x11, 0x82, 0x81, 0x37, 0x54, 0xed, 0x61, 0x41, 0xd9, 0xb5, 0xd7, 0x17,
+		0x29, 0x0b, 0xc6, 0x10, 0x1b, 0xee, 0x1e, 0xad, 0x94, 0xef, 0xbb, 0x75, 0xda, 0xf2, 0x9e, 0xf2,
+		0x9d, 0x3c, 0x51, 0x3f, 0x3f, 0x5c, 0x23, 0xdb, 0xa4, 0x5b, 0x41, 0x9a, 0xa4, 0x0e, 0x0f, 0x84,
+		0xf7, 0xd4, 0xb1, 0x98, 0xcc, 0x0d, 0x4c, 0x8c, 0x78, 0xf4, 0x2c, 0xab, 0xc3, 0x56, 0x59, 0x29,
+		0xad, 0x14, 0xd2, 0xfc, 0x86, 0x55, 0x95, 0xa0, 0xeb, 0x96, 0xd5, 0x21, 0x16, 0x7b, 0xa2, 0x57,
+		0xca, 0xab, 0x88, 0x5e, 0x99, 0x96, 0xd3, 0x6e, 0x52, 0xbd, 0x47, 0xfb, 0x6d, 0xab, 0x55, 0xcc,
+		0xed, 0x73, 0xd2, 0xdf, 0xe5, 0x11, 0xe4, 0xf1, 0xab, 0x55, 0x46, 0x6e, 0x9d, 0x51, 0x23, 0x5b,
+		0x30, 0xcb, 0xe2, 0x64, 0x43, 0x93, 0x2d, 0xff, 0x86, 0x06, 0x6a, 0x06, 0x63, 0x65, 0x81, 0xe9,
+		0xb4, 0x78, 0x2f, 0x5a, 0x8b, 0x57, 0x3e, 0xf7, 0xcb, 0xa7, 0x8f, 0xfa, 0x5e, 0xd0, 0xbf, 0xe5,
+		0x46, 0xad, 0xb9, 0x8a, 0xc3, 0x85, 0x1f, 0xf1, 0x9c, 0x30, 0xdf, 0xd1, 0x4c, 0xf0, 0xad, 0x95,
+		0x95, 0xbd, 0xd7, 0xe0, 0x1e, 0x7e, 0x60, 0x0d, 0xee, 0x33, 0x51, 0xcf, 0x78, 0x3e, 0x50, 0x64,
+		0xd2, 0x8c, 0xf6, 0x6b, 0x67, 0x81, 0xc4, 0x2c, 0xdf, 0x81, 0xd2, 0xbf, 0x56, 0xe0, 0xc8, 0x88,
+		0x36, 0x77, 0x9b, 0xdc, 0x04, 0xd2, 0xf7, 0x15, 0x32, 0xad, 0x28, 0x77, 0xe7, 0xef, 0xcc, 0x38,
+		0xcc, 0xf4, 0x87, 0x4b, 0xdf, 0x24, 0x67, 0x4e, 0x58, 0xf2, 0xdf, 0x51, 0x60, 0xce, 0xdf, 0x00,
+		0xb7, 0x2b, 0x75, 0xc8, 0xfa, 0xab, 0x2e, 0x2a, 0xee, 0x89, 0xeb, 0x89, 0x9d, 0xf0, 0xb7, 0x3f,
+		0x40, 0x84, 0x5c, 0xf3, 0x2c, 0x26, 0x8f, 0x95, 0x9f, 0x9d, 0x9a, 0x29, 0xb2, 0x61, 0xa1, 0x96,
+		0x93, 0x8f, 0xcd, 0x17, 0x15, 0x88, 0xb1, 0xf9, 0xf4, 0x0e, 0x98, 0x31, 0x2d, 0x47, 0xc7, 0x09,
+		0x47, 0x5b, 0xba, 0x08, 0x9d, 0x71, 0x6f, 0xa4, 0xb6, 0x27, 0xaf, 0xbe, 0xf0, 0xea, 0xf1, 0x51,
+		0xcc, 0x30, 0x15, 0x51, 0x30, 0x2d, 0xa7, 0xc2, 0x80, 0x1a, 0x0c, 0x86, 0x6c, 0x41, 0x2e, 0x58,
+		0x1d, 0xf7, 0x58, 0xca, 0x93, 0xaa, 0xcb, 0x4d, 0xac, 0x2a, 0xbb, 0xe9, 0xab, 0x87, 0xbf, 0xd7,
+		0xff, 0xe7, 0x38, 0x72, 0x6f, 0x07, 0xf5, 0xda, 0xf0, 0xd9, 0xaf, 0x8b, 0x90, 0x94, 0x67, 0xbd,
+		0x94, 0x69, 0xcf, 0x91, 0xf9, 0xf9, 0x29, 0x90, 0xd9, 0x66, 0xc4, 0xa7, 0x22, 0x70, 0x04, 0x8f,
+		0x85, 0x89, 0x40, 0xa7, 0xb0, 0x6c, 0x7c, 0x7b, 0x62, 0x17, 0xa3, 0x73, 0xa1, 0x61, 0xd8, 0xec,
+		0x68, 0xb0, 0xf5, 0x1a, 0x14, 0xd0, 0xc5, 0xc4, 0x40, 0xe9, 0x1b, 0x8b, 0xb5, 0xe6, 0xac, 0x4e,
+		0x4b, 0xb4, 0x08, 0x23, 0xad, 0xd7, 0xa0, 0x60, 0xd2, 0x9b, 0x01, 0xba, 0xd1, 0x3b, 0xa3, 0x6b,
+		0xd2, 0x9b, 0x3e, 0xba, 0xde, 0xf1, 0x82, 0x58, 0xe0, 0x78, 0xc1, 0x79, 0x88, 0xa2, 0x3b, 0x10,
+		0xdf, 0x87, 0xf2, 0x40, 0x04, 0x9f, 0x5b, 0x57, 0x87, 0x23, 0x22, 0x52, 0x66, 0xaf, 0x6d, 0x31,
+		0x8e, 0x52, 0xd6, 0xa1, 0xe7, 0xe8, 0x6e, 0x48, 0xd8, 0x2c, 0x3b, 0x5d, 0xd8, 0xec, 0x9f, 0x2a,
+		0x30, 0xcb, 0x84, 0xa3, 0xfd, 0x4e, 0xca, 0x82, 0xb1, 0x62, 0xdf, 0x28, 0x0f, 0x11, 0xf1, 0x20,
+		0x61, 0x4c, 0x8b, 0xb4, 0x5b, 0x64, 0x01, 0xe2, 0xd6, 0x4d, 0x53, 0x1c, 0xf8, 0xd9, 0xcb, 0x2d,
+		0xe7, 0x60, 0xcc, 0x4f, 0xb4, 0x5a, 0x83, 0x0e, 0xd5, 0x8d, 0x26, 0x5f, 0x1a, 0x44, 0xc5, 0x47,
+		0xba, 0x58, 0x6e, 0x99, 0x67, 0x06, 0x8f, 0x96, 0xc4, 0xa6, 0xf5, 0xa7, 0x3d, 0x1c, 0xa1, 0x87,
+		0x3e, 0x1a, 0x81, 0xc3, 0xeb, 0x94, 0x19, 0xc8, 0xea, 0x90, 0xe5, 0x0a, 0x0f, 0x2f, 0x28, 0x77,
+		0x1e, 0x5e, 0x78, 0x1b, 0xa4, 0x50, 0x70, 0xde, 0x8c, 0x8d, 0x27, 0x93, 0xde, 0x64, 0xbe, 0xc8,
+		0x3a, 0xe4, 0xe9, 0xd6, 0x16, 0x6d, 0x3a, 0xed, 0x1b, 0x62, 0x8f, 0x26, 0xba, 0xef, 0x08, 0xb4,
+		0x4b, 0x80, 0x6d, 0xd3, 0x1c, 0x46, 0xb6, 0x7c, 0xfa, 0x13, 0x0f, 0x17, 0x5c, 0x73, 0x79, 0xe2,
+		0xec, 0xc2, 0x23, 0x0b, 0x8f, 0x9c, 0xfe, 0x75, 0x05, 0xc0, 0xdb, 0x23, 0xc0, 0x77, 0x11, 0x2b,
+		0x6b, 0xab, 0x4b, 0xde, 0xce, 0xba, 0xef, 0xd3, 0x6d, 0xf2, 0x15, 0x45, 0xbb, 0x47, 0x9b, 0xed,
+		0xad, 0x36, 0x6d, 0x91, 0xfb, 0x61, 0x2e, 0x08, 0x8d, 0xa9, 0xda, 0x92, 0xaa, 0xcc, 0x67, 0x5f,
+		0xb9, 0x7d, 0x22, 0xc5, 0x9d, 0x14, 0x8a, 0x8f, 0x84, 0x1f, 0x1c, 0x85, 0xc3, 0xcf, 0xbe, 0x45,
+		0xe6, 0x73, 0xaf, 0xdc, 0x3e, 0x91, 0x76, 0xbd, 0x19, 0x52, 0x02, 0xe2, 0x87, 0x14, 0xf4, 0xa2,
+		0xf3, 0xf0, 0xca, 0xed, 0x13, 0x09, 0xae, 0x22, 0xc5, 0x96, 0xfc, 0xb7, 0xe2, 0x5b, 0x80, 0x5b,
+		0x7d, 0xa3, 0xc9, 0x4c, 0xc1, 0x3c, 0x1c, 0x5a, 0x5e, 0xbd, 0xa8, 0x95, 0xab, 0x0d, 0xdc, 0xc4,
+		0x0f, 0x34, 0x7b, 0xa8, 0x6c, 0x69, 0x6d, 0xa3, 0xb2, 0x52, 0xd3, 0xf1, 0x75, 0x46, 0x7e, 0x7e,
+		0x26, 0x50, 0xf6, 0xfc, 0x6a, 0x63, 0xf9, 0x6a, 0x4d, 0x8d, 0x54, 0xce, 0x8f, 0xdd, 0x6a, 0xbf,
+		0x2b, 0x30, 0xaa, 0x9e, 0xfb, 0x11, 0xf8, 0x94, 0xcf, 0xff, 0x1c, 0x00, 0x89, 0xe7, 0x1c, 0xd9,
+		0xcf, 0xb8, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2545,6 +2821,27 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.InstantUnbondingFeeRate.Equal(that1.InstantUnbondingFeeRate) {
+		return false
+	}
+	if !this.InstantUnbondingBlockLimit.Equal(that1.InstantUnbondingBlockLimit) {
+		return false
+	}
+	if this.InstantUnbondingFeeToCommunityPool != that1.InstantUnbondingFeeToCommunityPool {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
+	if !this.MaxCommissionRate.Equal(that1.MaxCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2601,6 +2898,69 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *PendingCommissionChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingCommissionChange)
+	if !ok {
+		that2, ok := that.(PendingCommissionChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.NewRate.Equal(that1.NewRate) {
+		return false
+	}
+	if !this.EffectiveTime.Equal(that1.EffectiveTime) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3485,6 +3845,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x6a
+	if m.InstantUnbondingFeeToCommunityPool {
+		i--
+		if m.InstantUnbondingFeeToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.InstantUnbondingBlockLimit.Size()
+		i -= size
+		if _, err := m.InstantUnbondingBlockLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InstantUnbondingFeeRate.Size()
+		i -= size
+		if _, err := m.InstantUnbondingFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3527,12 +3955,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3852,59 +4280,156 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
-	}
-	return n
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Apphash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorsHash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NewRate.Size()
+		i -= size
+		if _, err := m.NewRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Apphash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Rate.Size()
@@ -4231,6 +4756,21 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InstantUnbondingFeeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InstantUnbondingBlockLimit.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.InstantUnbondingFeeToCommunityPool {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4346,6 +4886,47 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.NewRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7193,61 +7774,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7257,30 +7822,31 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnbondingFeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7290,35 +7856,273 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InstantUnbondingFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnbondingBlockLimit", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnbondingBlockLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnbondingFeeToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InstantUnbondingFeeToCommunityPool = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -8056,6 +8860,320 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0